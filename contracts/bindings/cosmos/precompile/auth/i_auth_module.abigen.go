@@ -43,9 +43,15 @@ type IAuthModuleBaseAccount struct {
 	Sequence      uint64
 }
 
+// IAuthModuleGrant is an auto generated low-level Go binding around an user-defined struct.
+type IAuthModuleGrant struct {
+	MsgTypeUrl string
+	Expiration *big.Int
+}
+
 // AuthModuleMetaData contains all meta data concerning the AuthModule contract.
 var AuthModuleMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"convertBech32ToHexAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"convertHexToBech32\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"getAccountInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"pubKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"accountNumber\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.BaseAccount\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getAccountInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"pubKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"accountNumber\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.BaseAccount\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"setSendAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"convertBech32ToHexAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"convertHexToBech32\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"account\",\"type\":\"string\"}],\"name\":\"getAccountInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"pubKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"accountNumber\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.BaseAccount\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getAccountInfo\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"pubKey\",\"type\":\"bytes\"},{\"internalType\":\"uint64\",\"name\":\"accountNumber\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structIAuthModule.BaseAccount\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"granter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"}],\"name\":\"getGrants\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"internalType\":\"structIAuthModule.Grant[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"name\":\"getSendAllowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"grant\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"grantee\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"msgTypeUrl\",\"type\":\"string\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"}],\"internalType\":\"structCosmos.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256\",\"name\":\"expiration\",\"type\":\"uint256\"}],\"name\":\"setSendAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // AuthModuleABI is the input ABI used to generate the binding from.
@@ -318,6 +324,37 @@ func (_AuthModule *AuthModuleCallerSession) GetAccountInfo0(account common.Addre
 	return _AuthModule.Contract.GetAccountInfo0(&_AuthModule.CallOpts, account)
 }
 
+// GetGrants is a free data retrieval call binding the contract method 0x594a9045.
+//
+// Solidity: function getGrants(address granter, address grantee) view returns((string,uint256)[])
+func (_AuthModule *AuthModuleCaller) GetGrants(opts *bind.CallOpts, granter common.Address, grantee common.Address) ([]IAuthModuleGrant, error) {
+	var out []interface{}
+	err := _AuthModule.contract.Call(opts, &out, "getGrants", granter, grantee)
+
+	if err != nil {
+		return *new([]IAuthModuleGrant), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IAuthModuleGrant)).(*[]IAuthModuleGrant)
+
+	return out0, err
+
+}
+
+// GetGrants is a free data retrieval call binding the contract method 0x594a9045.
+//
+// Solidity: function getGrants(address granter, address grantee) view returns((string,uint256)[])
+func (_AuthModule *AuthModuleSession) GetGrants(granter common.Address, grantee common.Address) ([]IAuthModuleGrant, error) {
+	return _AuthModule.Contract.GetGrants(&_AuthModule.CallOpts, granter, grantee)
+}
+
+// GetGrants is a free data retrieval call binding the contract method 0x594a9045.
+//
+// Solidity: function getGrants(address granter, address grantee) view returns((string,uint256)[])
+func (_AuthModule *AuthModuleCallerSession) GetGrants(granter common.Address, grantee common.Address) ([]IAuthModuleGrant, error) {
+	return _AuthModule.Contract.GetGrants(&_AuthModule.CallOpts, granter, grantee)
+}
+
 // GetSendAllowance is a free data retrieval call binding the contract method 0xfbdb0e87.
 //
 // Solidity: function getSendAllowance(address owner, address spender, string denom) view returns(uint256)
@@ -349,6 +386,48 @@ func (_AuthModule *AuthModuleCallerSession) GetSendAllowance(owner common.Addres
 	return _AuthModule.Contract.GetSendAllowance(&_AuthModule.CallOpts, owner, spender, denom)
 }
 
+// Grant is a paid mutator transaction binding the contract method 0x3e004565.
+//
+// Solidity: function grant(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactor) Grant(opts *bind.TransactOpts, grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "grant", grantee, msgTypeUrl, expiration)
+}
+
+// Grant is a paid mutator transaction binding the contract method 0x3e004565.
+//
+// Solidity: function grant(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleSession) Grant(grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.Grant(&_AuthModule.TransactOpts, grantee, msgTypeUrl, expiration)
+}
+
+// Grant is a paid mutator transaction binding the contract method 0x3e004565.
+//
+// Solidity: function grant(address grantee, string msgTypeUrl, uint256 expiration) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) Grant(grantee common.Address, msgTypeUrl string, expiration *big.Int) (*types.Transaction, error) {
+	return _AuthModule.Contract.Grant(&_AuthModule.TransactOpts, grantee, msgTypeUrl, expiration)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleTransactor) Revoke(opts *bind.TransactOpts, grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.contract.Transact(opts, "revoke", grantee, msgTypeUrl)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleSession) Revoke(grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.Contract.Revoke(&_AuthModule.TransactOpts, grantee, msgTypeUrl)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xafd0224b.
+//
+// Solidity: function revoke(address grantee, string msgTypeUrl) returns(bool)
+func (_AuthModule *AuthModuleTransactorSession) Revoke(grantee common.Address, msgTypeUrl string) (*types.Transaction, error) {
+	return _AuthModule.Contract.Revoke(&_AuthModule.TransactOpts, grantee, msgTypeUrl)
+}
+
 // SetSendAllowance is a paid mutator transaction binding the contract method 0x2b6b7ab5.
 //
 // Solidity: function setSendAllowance(address owner, address spender, (uint256,string)[] amount, uint256 expiration) returns(bool)
@@ -31,6 +31,10 @@ package contracts
 //go:generate abigen --pkg distribution --abi ./out/Distribution.sol/IDistributionModule.abi.json --bin ./out/Distribution.sol/IDistributionModule.bin --out ./bindings/cosmos/precompile/distribution/i_distribution_module.abigen.go --type DistributionModule --exc "IBankModuleCoin"
 //go:generate abigen --pkg governance --abi ./out/Governance.sol/IGovernanceModule.abi.json --bin ./out/Governance.sol/IGovernanceModule.bin --out ./bindings/cosmos/precompile/governance/i_governance_module.abigen.go --type GovernanceModule
 //go:generate abigen --pkg erc20 --abi ./out/ERC20Module.sol/IERC20Module.abi.json --bin ./out/ERC20Module.sol/IERC20Module.bin --out ./bindings/cosmos/precompile/erc20/i_erc20_module.abigen.go --type ERC20Module
+//go:generate abigen --pkg mint --abi ./out/Mint.sol/IMintModule.abi.json --bin ./out/Mint.sol/IMintModule.bin --out ./bindings/cosmos/precompile/mint/i_mint_module.abigen.go --type MintModule
+//go:generate abigen --pkg oracle --abi ./out/Oracle.sol/IOracleModule.abi.json --bin ./out/Oracle.sol/IOracleModule.bin --out ./bindings/cosmos/precompile/oracle/i_oracle_module.abigen.go --type OracleModule
+//go:generate abigen --pkg slashing --abi ./out/Slashing.sol/ISlashingModule.abi.json --bin ./out/Slashing.sol/ISlashingModule.bin --out ./bindings/cosmos/precompile/slashing/i_slashing_module.abigen.go --type SlashingModule
+//go:generate abigen --pkg msgrouter --abi ./out/MsgRouter.sol/IMsgRouterModule.abi.json --bin ./out/MsgRouter.sol/IMsgRouterModule.bin --out ./bindings/cosmos/precompile/msgrouter/i_msg_router_module.abigen.go --type MsgRouterModule
 
 //go:generate abigen --pkg cosmos --abi ./out/PolarisERC20.sol/PolarisERC20.abi.json --bin ./out/PolarisERC20.sol/PolarisERC20.bin --out ./bindings/cosmos/polaris_erc20.abigen.go --type PolarisERC20
 
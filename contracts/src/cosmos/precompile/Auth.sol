@@ -71,6 +71,30 @@ interface IAuthModule {
      */
     function getSendAllowance(address owner, address spender, string calldata denom) external view returns (uint256);
 
+    /**
+     * @dev grant creates a generic authorization from the caller to the grantee for the given
+     * message type.
+     * @param grantee the account being granted the authorization
+     * @param msgTypeUrl the type url of the Cosmos message the grantee may execute
+     * @param expiration the expiration time of the grant (0 means no expiration)
+     */
+    function grant(address grantee, string calldata msgTypeUrl, uint256 expiration) external returns (bool);
+
+    /**
+     * @dev revoke removes the authorization from the caller to the grantee for the given message
+     * type.
+     * @param grantee the account that was granted the authorization
+     * @param msgTypeUrl the type url of the Cosmos message that was authorized
+     */
+    function revoke(address grantee, string calldata msgTypeUrl) external returns (bool);
+
+    /**
+     * @dev getGrants returns all unexpired authorizations from granter to grantee.
+     * @param granter the account that granted the authorizations
+     * @param grantee the account that was granted the authorizations
+     */
+    function getGrants(address granter, address grantee) external view returns (Grant[] memory);
+
     //////////////////////////////////////////// UTILS ////////////////////////////////////////////
 
     /**
@@ -82,4 +106,12 @@ interface IAuthModule {
         uint64 accountNumber;
         uint64 sequence; // represents account nonce
     }
+
+    /**
+     * @dev Represents an x/authz grant.
+     */
+    struct Grant {
+        string msgTypeUrl; // the type url of the authorized Cosmos message
+        uint256 expiration; // the unix time of the expiration (0 means no expiration)
+    }
 }
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+import {Cosmos} from "../CosmosTypes.sol";
+
+/**
+ * @dev Interface of the mint module precompiled contract
+ */
+interface IMintModule {
+    /**
+     * @dev Returns the current annual inflation rate, scaled by 1e18.
+     */
+    function getInflation() external view returns (uint256);
+
+    /**
+     * @dev Returns the amount of tokens minted per year at the current inflation rate, scaled by
+     * 1e18.
+     */
+    function getAnnualProvisions() external view returns (uint256);
+
+    /**
+     * @dev Returns the fraction of the staking tokens which are bonded, scaled by 1e18. The
+     * inflation rate moves towards its maximum while it is below the goal of the mint params.
+     */
+    function getBondedRatio() external view returns (uint256);
+
+    /**
+     * @dev Returns the coins minted by the next block. The mint module mints at every block, so
+     * a block is the epoch of the emissions.
+     */
+    function getBlockProvision() external view returns (Cosmos.Coin memory);
+
+    /**
+     * @dev Returns the expected number of blocks per year, used to derive the block provision
+     * from the annual provisions.
+     */
+    function getBlocksPerYear() external view returns (uint64);
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the msg router precompiled contract
+ */
+interface IMsgRouterModule {
+    /**
+     * @dev execute runs the given Cosmos message through the msg service router, with the caller
+     * as its only signer. Only messages of an allow-listed type can be executed.
+     * @param msgTypeUrl the type url of the message (e.g. "/cosmos.bank.v1beta1.MsgSend")
+     * @param data the protobuf encoding of the message
+     * @return the protobuf encoding of the message response
+     */
+    function execute(string calldata msgTypeUrl, bytes calldata data) external returns (bytes memory);
+
+    /**
+     * @dev isAllowed returns whether messages of the given type can be executed.
+     * @param msgTypeUrl the type url of the message
+     */
+    function isAllowed(string calldata msgTypeUrl) external view returns (bool);
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.4;
+
+/**
+ * @dev Interface of the slashing module precompiled contract
+ */
+interface ISlashingModule {
+    /**
+     * @dev Returns the signing info of the given validator, which tracks its liveness.
+     * @param validatorAddress the operator address of the validator
+     */
+    function getSigningInfo(address validatorAddress) external view returns (SigningInfo memory);
+
+    /**
+     * @dev Returns whether the given validator is jailed, and hence not part of the active set.
+     * @param validatorAddress the operator address of the validator
+     */
+    function isJailed(address validatorAddress) external view returns (bool);
+
+    /**
+     * @dev Returns the number of blocks over which the missed blocks of a validator are counted.
+     */
+    function getSignedBlocksWindow() external view returns (int64);
+
+    //////////////////////////////////////////// UTILS ////////////////////////////////////////////
+
+    /**
+     * @dev Represents the signing info of a validator.
+     */
+    struct SigningInfo {
+        address consAddress; // the consensus address of the validator
+        int64 startHeight; // the height at which the validator started signing
+        int64 indexOffset; // the index of the current block in the signed blocks window
+        uint64 jailedUntil; // the unix time (in seconds) until which the validator is jailed
+        bool tombstoned; // whether the validator was tombstoned for double signing
+        int64 missedBlocksCounter; // the number of blocks missed in the current window
+    }
+}
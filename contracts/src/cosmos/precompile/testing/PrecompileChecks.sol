@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+pragma solidity ^0.8.17;
+
+import {IAuthModule} from "../Auth.sol";
+import {IBankModule} from "../Bank.sol";
+import {IDistributionModule} from "../Distribution.sol";
+import {IERC20Module} from "../ERC20Module.sol";
+import {IGovernanceModule} from "../Governance.sol";
+import {IMintModule} from "../Mint.sol";
+import {ISlashingModule} from "../Slashing.sol";
+import {IStakingModule} from "../Staking.sol";
+import {Cosmos} from "../../CosmosTypes.sol";
+
+/**
+ * @dev The checks in this file are deployed onto a running Polaris chain by the end-to-end
+ * precompile simulator. Each contract exposes a single `run` function that calls a stateful
+ * precompile through its Solidity interface and reverts if the result is not what a freshly
+ * started localnet should return. Running these from a deployed contract, rather than from a
+ * forge test, is required because Foundry executes tests in its own EVM, which does not have
+ * the Cosmos precompiles installed.
+ */
+
+/**
+ * @dev Checks the auth precompile.
+ */
+contract AuthCheck {
+    IAuthModule public immutable auth = IAuthModule(0xBDF49C3C3882102fc017FFb661108c63a836D065);
+
+    function run() external view {
+        string memory bech32 = auth.convertHexToBech32(tx.origin);
+        require(bytes(bech32).length > 0, "AuthCheck: empty bech32 address");
+        require(auth.convertBech32ToHexAddress(bech32) == tx.origin, "AuthCheck: address round trip");
+
+        IAuthModule.BaseAccount memory account = auth.getAccountInfo(tx.origin);
+        require(account.addr == tx.origin, "AuthCheck: wrong account address");
+        require(account.sequence > 0, "AuthCheck: sender has no sequence");
+    }
+}
+
+/**
+ * @dev Checks the bank precompile. The value sent along with `run` is returned to the sender
+ * through the bank precompile.
+ */
+contract BankCheck {
+    IBankModule public immutable bank = IBankModule(0x4381dC2aB14285160c808659aEe005D51255adD7);
+    string public constant denom = "abera";
+
+    function run() external payable {
+        require(msg.value > 0, "BankCheck: no value sent");
+        require(bank.getBalance(address(this), denom) == address(this).balance, "BankCheck: balance mismatch");
+        require(bank.getSupply(denom) > 0, "BankCheck: no supply");
+
+        Cosmos.Coin[] memory coins = new Cosmos.Coin[](1);
+        coins[0] = Cosmos.Coin({amount: msg.value, denom: denom});
+        require(bank.send(address(this), tx.origin, coins), "BankCheck: send failed");
+        require(address(this).balance == 0, "BankCheck: value not returned");
+    }
+}
+
+/**
+ * @dev Checks the distribution precompile.
+ */
+contract DistributionCheck {
+    IDistributionModule public immutable distribution = IDistributionModule(address(0x69));
+
+    function run() external {
+        require(distribution.getWithdrawEnabled(), "DistributionCheck: withdraw disabled");
+        require(distribution.setWithdrawAddress(address(this)), "DistributionCheck: set withdraw address");
+    }
+}
+
+/**
+ * @dev Checks the erc20 precompile.
+ */
+contract ERC20Check {
+    IERC20Module public immutable erc20 = IERC20Module(0x0000000000000000000000000000000000696969);
+
+    function run() external view {
+        // The EVM denom has not been converted yet, so it must not map to a token.
+        require(address(erc20.erc20AddressForCoinDenom("abera")) == address(0), "ERC20Check: unexpected token");
+    }
+}
+
+/**
+ * @dev Checks the governance precompile.
+ */
+contract GovernanceCheck {
+    IGovernanceModule public immutable gov = IGovernanceModule(0x7b5Fe22B5446f7C62Ea27B8BD71CeF94e03f3dF2);
+
+    function run() external view {
+        // A freshly started localnet has no proposals.
+        require(gov.getProposals(0).length == 0, "GovernanceCheck: unexpected proposals");
+    }
+}
+
+/**
+ * @dev Checks the mint precompile.
+ */
+contract MintCheck {
+    IMintModule public immutable mint = IMintModule(0xDc6F17BBEc824FFf8F86587966B2047Db6aB7367);
+
+    function run() external view {
+        require(mint.getBlocksPerYear() > 0, "MintCheck: no blocks per year");
+        require(mint.getInflation() > 0, "MintCheck: no inflation");
+    }
+}
+
+/**
+ * @dev Checks the slashing precompile.
+ */
+contract SlashingCheck {
+    ISlashingModule public immutable slashing = ISlashingModule(0x31Af10958C444C19b6Bc71C82b07c30Cd24661f7);
+
+    function run() external view {
+        require(slashing.getSignedBlocksWindow() > 0, "SlashingCheck: no signed blocks window");
+    }
+}
+
+/**
+ * @dev Checks the staking precompile against the genesis validator.
+ */
+contract StakingCheck {
+    IStakingModule public immutable staking = IStakingModule(0xd9A998CaC66092748FfEc7cFBD155Aae1737C2fF);
+
+    function run() external view {
+        address[] memory validators = staking.getActiveValidators();
+        require(validators.length > 0, "StakingCheck: no active validators");
+
+        IStakingModule.Validator memory validator = staking.getValidator(validators[0]);
+        require(!validator.jailed, "StakingCheck: validator jailed");
+        require(validator.tokens > 0, "StakingCheck: validator has no tokens");
+    }
+}
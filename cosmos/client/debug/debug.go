@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+)
+
+// Cmd returns the Cosmos SDK debug commands, extended with `debug convert-address`.
+func Cmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(ConvertAddressCmd())
+	return cmd
+}
+
+// ConvertAddressCmd returns the command that converts an account address between its Bech32 and
+// its Ethereum representation.
+func ConvertAddressCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-address <address>",
+		Short: "Convert an account address between its Bech32 and its Ethereum representation",
+		Long: `Convert an account address, given either in Bech32 or in hex, to both its Bech32 and
+its checksummed Ethereum representation. The checksum of Bech32 addresses is always
+validated, the EIP-55 checksum of hex addresses only when they are mixed-case.`,
+		Example: fmt.Sprintf(
+			"%s debug convert-address 0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4", version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, ethAddress, err := cosmlib.ConvertAddress(args[0])
+			if err != nil {
+				return err
+			}
+			cmd.Printf("address: %s\neth_address: %s\n", address, ethAddress)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	polariskeyring "pkg.berachain.dev/polaris/cosmos/crypto/keyring"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+)
+
+// keyFileMode is the mode of exported keystore files, which only the owner can read and write.
+const keyFileMode = 0o600
+
+// EthCommands returns the `keys eth` commands, which move eth_secp256k1 keys between the keyring
+// and Ethereum wallets such as MetaMask and geth, as hex private keys or keystore files.
+func EthCommands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eth",
+		Short: "Import and export Ethereum private keys and keystore files",
+		Long: `Import and export the eth_secp256k1 keys of the keyring as hex encoded Ethereum
+private keys, or as Ethereum keystore (web3 secret storage) files, which wallets
+such as MetaMask and geth import and export.`,
+		RunE: client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		showCmd(),
+		importKeystoreCmd(),
+		exportKeystoreCmd(),
+		importHexCmd(),
+		exportHexCmd(),
+	)
+	return cmd
+}
+
+// showCmd returns the command that shows the Bech32 and the Ethereum address of a key or an
+// address.
+func showCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <name|address>",
+		Short: "Show the Bech32 and the Ethereum address of a key or an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var address sdk.AccAddress
+			if record, err := clientCtx.Keyring.Key(args[0]); err == nil {
+				if address, err = record.GetAddress(); err != nil {
+					return err
+				}
+			} else if address, err = cosmlib.AccAddressFromString(args[0]); err != nil {
+				return fmt.Errorf("%s is neither a key nor an address: %w", args[0], err)
+			}
+
+			cmd.Printf("address: %s\neth_address: %s\n", address, cosmlib.AccAddressToEthAddress(address))
+			return nil
+		},
+	}
+}
+
+// importKeystoreCmd returns the command that imports a keystore file into the keyring.
+func importKeystoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <name> <keyfile>",
+		Short: "Import an Ethereum keystore file into the keyring",
+		Args:  cobra.ExactArgs(2), //nolint:gomnd // name and keyfile.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			passphrase, err := input.GetPassword(
+				"Enter the passphrase of the keystore file:", bufio.NewReader(cmd.InOrStdin()),
+			)
+			if err != nil {
+				return err
+			}
+
+			record, err := polariskeyring.ImportKeystore(clientCtx.Keyring, args[0], keyJSON, passphrase)
+			if err != nil {
+				return err
+			}
+			return printImported(cmd, record)
+		},
+	}
+}
+
+// exportKeystoreCmd returns the command that exports a key of the keyring as a keystore file.
+func exportKeystoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-keystore <name> <keyfile>",
+		Short: "Export a key of the keyring as an Ethereum keystore file",
+		Args:  cobra.ExactArgs(2), //nolint:gomnd // name and keyfile.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			buf := bufio.NewReader(cmd.InOrStdin())
+			passphrase, err := input.GetPassword("Enter a passphrase to encrypt the keystore file:", buf)
+			if err != nil {
+				return err
+			}
+			confirmed, err := input.GetPassword("Repeat the passphrase:", buf)
+			if err != nil {
+				return err
+			}
+			if passphrase != confirmed {
+				return errors.New("passphrases do not match")
+			}
+
+			keyJSON, err := polariskeyring.ExportKeystore(clientCtx.Keyring, args[0], passphrase)
+			if err != nil {
+				return err
+			}
+			// The keystore file is never overwritten, as it may hold another key.
+			file, err := os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyFileMode)
+			if err != nil {
+				return err
+			}
+			if _, err = file.Write(keyJSON); err != nil {
+				_ = file.Close()
+				return err
+			}
+			return file.Close()
+		},
+	}
+}
+
+// importHexCmd returns the command that imports a hex private key into the keyring.
+func importHexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-hex <name> [hex]",
+		Short: "Import a hex encoded Ethereum private key into the keyring",
+		Long: `Import a hex encoded Ethereum private key into the keyring. The key is prompted
+for if it is not given, which keeps it out of the shell history.`,
+		Args: cobra.RangeArgs(1, 2), //nolint:gomnd // name and optional key.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			var privKeyHex string
+			if len(args) > 1 {
+				privKeyHex = args[1]
+			} else if privKeyHex, err = input.GetPassword(
+				"Enter the hex encoded private key:", bufio.NewReader(cmd.InOrStdin()),
+			); err != nil {
+				return err
+			}
+
+			record, err := polariskeyring.ImportHex(clientCtx.Keyring, args[0], privKeyHex)
+			if err != nil {
+				return err
+			}
+			return printImported(cmd, record)
+		},
+	}
+}
+
+// exportHexCmd returns the command that exports a key of the keyring as a hex private key.
+func exportHexCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-hex <name>",
+		Short: "Export a key of the keyring as a hex encoded Ethereum private key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if ok, err := input.GetConfirmation(
+				"WARNING: The private key will be exported unencrypted. USE AT YOUR OWN RISK. Continue?",
+				bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr(),
+			); err != nil || !ok {
+				return err
+			}
+
+			privKeyHex, err := polariskeyring.ExportHex(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+			cmd.Println(privKeyHex)
+			return nil
+		},
+	}
+}
+
+// printImported prints the name and the addresses of the given imported key.
+func printImported(cmd *cobra.Command, record *keyring.Record) error {
+	address, err := record.GetAddress()
+	if err != nil {
+		return err
+	}
+	cmd.Printf(
+		"imported key %s with address %s (%s)\n",
+		record.Name, address, cosmlib.AccAddressToEthAddress(address),
+	)
+	return nil
+}
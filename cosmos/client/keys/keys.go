@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keys
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	sdkkeys "github.com/cosmos/cosmos-sdk/client/keys"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/hd"
+	"pkg.berachain.dev/polaris/eth/accounts"
+)
+
+const (
+	// flagKeyAlgo is the flag of the signing algorithm of the keys derived by the keys commands.
+	flagKeyAlgo = "algo"
+	// flagCoinType is the flag of the BIP44 coin type of the keys derived by the keys commands.
+	flagCoinType = "coin-type"
+)
+
+// Commands returns the Cosmos SDK keys commands, which derive eth_secp256k1 keys on the Ethereum
+// HD path (m/44'/60'/0'/0) by default, so that the keys of a mnemonic have the same addresses as
+// in Ethereum wallets, extended with the `keys eth` commands.
+func Commands(defaultNodeHome string) *cobra.Command {
+	cmd := sdkkeys.Commands(defaultNodeHome)
+	for _, subCmd := range cmd.Commands() {
+		setFlagDefault(subCmd, flagKeyAlgo, string(hd.EthSecp256k1Type))
+		setFlagDefault(subCmd, flagCoinType, strconv.FormatUint(uint64(accounts.Bip44CoinType), 10))
+	}
+	cmd.AddCommand(EthCommands())
+	return cmd
+}
+
+// setFlagDefault sets the default value of the given flag of the given command, if it has one.
+func setFlagDefault(cmd *cobra.Command, name, value string) {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		return
+	}
+	if err := flag.Value.Set(value); err != nil {
+		panic(err)
+	}
+	flag.DefValue = value
+}
@@ -0,0 +1,424 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package config defines the `[polaris]` section of app.toml, which configures the EVM of a node,
+// and reloads its runtime settings on SIGHUP.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/polar"
+)
+
+const (
+	// defaultMaxPendingTxs is the default maximum number of pending Ethereum transactions of a
+	// sender, the account slots of the mempool.
+	defaultMaxPendingTxs = 64
+	// defaultMaxTxSize is the default maximum size of an Ethereum transaction, the one of the
+	// txpool of go-ethereum.
+	defaultMaxTxSize = 128 * 1024
+)
+
+// The modes of the startup self-check.
+const (
+	// SelfCheckStrict refuses to start the node on a finding that would make it serve wrong data.
+	SelfCheckStrict = "strict"
+	// SelfCheckWarn logs all the findings, the node still starts.
+	SelfCheckWarn = "warn"
+	// SelfCheckOff skips the self-check.
+	SelfCheckOff = "off"
+)
+
+// checkPruning is the name of the pruning check of the startup self-check.
+const checkPruning = "pruning"
+
+// The keys of the settings in app.toml, which are also the node flags that override them.
+const (
+	FlagSelfCheck           = "polaris.self-check"
+	FlagArchive             = "polaris.archive"
+	FlagGasAudit            = "polaris.gas-audit"
+	FlagCallTraces          = "polaris.call-traces"
+	FlagCallTracesRetention = "polaris.call-traces-retention"
+
+	FlagRPCGasCap       = "polaris.rpc.gas-cap"
+	FlagRPCEVMTimeout   = "polaris.rpc.evm-timeout"
+	FlagRPCTraceTimeout = "polaris.rpc.trace-timeout"
+	FlagRPCTxFeeCap     = "polaris.rpc.tx-fee-cap"
+
+	FlagTxPoolMaxPendingTxs = "polaris.txpool.max-pending-txs"
+	FlagTxPoolMinTip        = "polaris.txpool.min-tip"
+	FlagTxPoolGlobalSlots   = "polaris.txpool.global-slots"
+	FlagTxPoolAccountSlots  = "polaris.txpool.account-slots"
+	FlagTxPoolLifetime      = "polaris.txpool.lifetime"
+	FlagTxPoolPriceBump     = "polaris.txpool.price-bump"
+	FlagTxPoolMaxTxSize     = "polaris.txpool.max-tx-size"
+	FlagTxPoolMaxCalldata   = "polaris.txpool.max-calldata"
+)
+
+var (
+	// ErrInvalidConfig is returned when a setting of the `[polaris]` section is invalid.
+	ErrInvalidConfig = errors.New("invalid polaris config")
+	// ErrArchivePruned is returned by the startup self-check when an archive node prunes the state
+	// or the blocks of the past heights.
+	ErrArchivePruned = errors.New("archive node prunes historical data")
+)
+
+// Config is the `[polaris]` section of app.toml. The settings local to the node, i.e. the gas
+// audit, the rpc caps and the mempool limits except the price bump, are reloaded on SIGHUP, the
+// other ones require a restart.
+type Config struct {
+	// SelfCheck is the mode of the startup self-check, one of `strict`, `warn` and `off`.
+	SelfCheck string `mapstructure:"self-check"`
+
+	// Archive is set if the node must serve the state and the historical data of all the blocks,
+	// which the self-check ensures are not pruned.
+	Archive bool `mapstructure:"archive"`
+
+	// GasAudit enables the gas audit mode, in which the gas consumed on the Cosmos gas meter by
+	// each Ethereum transaction is checked against the gas used by the EVM.
+	GasAudit bool `mapstructure:"gas-audit"`
+
+	// CallTraces persists the call traces of the Ethereum transactions as they are executed, which
+	// the `trace` namespace serves without re-executing the transactions.
+	CallTraces bool `mapstructure:"call-traces"`
+
+	// CallTracesRetention is the number of recent blocks whose call traces are kept, or zero to
+	// keep all of them.
+	CallTracesRetention uint64 `mapstructure:"call-traces-retention"`
+
+	// RPC are the caps of the rpc calls.
+	RPC RPCConfig `mapstructure:"rpc"`
+
+	// TxPool are the limits of the Ethereum transactions accepted in the mempool.
+	TxPool TxPoolConfig `mapstructure:"txpool"`
+}
+
+// RPCConfig is the `[polaris.rpc]` section of app.toml.
+type RPCConfig struct {
+	// GasCap is the global gas cap for eth-call variants.
+	GasCap uint64 `mapstructure:"gas-cap"`
+
+	// EVMTimeout is the global timeout for eth-call.
+	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
+
+	// TraceTimeout is the maximum time a tracer may run for, whatever the timeout requested in its
+	// config, or zero for no maximum.
+	TraceTimeout time.Duration `mapstructure:"trace-timeout"`
+
+	// TxFeeCap is the global transaction fee (price * gaslimit) cap for send-transaction
+	// variants, in ether.
+	TxFeeCap float64 `mapstructure:"tx-fee-cap"`
+}
+
+// TxPoolConfig is the `[polaris.txpool]` section of app.toml.
+type TxPoolConfig struct {
+	// MaxPendingTxs is the maximum number of pending Ethereum transactions of a sender accepted in
+	// CheckTx, or zero for no limit.
+	MaxPendingTxs uint64 `mapstructure:"max-pending-txs"`
+
+	// MinTip is the minimum effective tip in wei of the Ethereum transactions accepted in CheckTx.
+	MinTip string `mapstructure:"min-tip"`
+
+	// GlobalSlots is the maximum number of transactions in the mempool.
+	GlobalSlots uint64 `mapstructure:"global-slots"`
+
+	// AccountSlots is the maximum number of Ethereum transactions of an account in the mempool.
+	AccountSlots uint64 `mapstructure:"account-slots"`
+
+	// Lifetime is the maximum time the Ethereum transactions of an account stay in the mempool
+	// without it sending a new one, or zero to keep them forever.
+	Lifetime time.Duration `mapstructure:"lifetime"`
+
+	// PriceBump is the minimum price bump percentage to replace an Ethereum transaction of the
+	// same nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+
+	// MaxTxSize is the maximum size in bytes of an encoded Ethereum transaction accepted over rpc
+	// and in CheckTx, or zero for no limit.
+	MaxTxSize uint64 `mapstructure:"max-tx-size"`
+
+	// MaxCalldata is the maximum size in bytes of the calldata of an Ethereum transaction
+	// accepted over rpc and in CheckTx, or zero for no limit.
+	MaxCalldata uint64 `mapstructure:"max-calldata"`
+}
+
+// DefaultConfig returns the default `[polaris]` section.
+func DefaultConfig() Config {
+	rpc := polar.DefaultConfig()
+	txPool := mempool.DefaultConfig()
+	return Config{
+		SelfCheck: SelfCheckStrict,
+		RPC: RPCConfig{
+			GasCap:       rpc.RPCGasCap,
+			EVMTimeout:   rpc.RPCEVMTimeout,
+			TraceTimeout: rpc.RPCTraceTimeout,
+			TxFeeCap:     rpc.RPCTxFeeCap,
+		},
+		TxPool: TxPoolConfig{
+			MaxPendingTxs: defaultMaxPendingTxs,
+			MinTip:        "0",
+			GlobalSlots:   txPool.GlobalSlots,
+			AccountSlots:  txPool.AccountSlots,
+			Lifetime:      txPool.Lifetime,
+			PriceBump:     txPool.PriceBump,
+			MaxTxSize:     defaultMaxTxSize,
+		},
+	}
+}
+
+// ReadConfig returns the `[polaris]` section set in the app options, i.e. app.toml and the node
+// flags, the unset settings keeping their default values. It does not validate the config.
+func ReadConfig(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	r := reader{appOpts: appOpts}
+	r.readString(FlagSelfCheck, &cfg.SelfCheck)
+	r.readBool(FlagArchive, &cfg.Archive)
+	r.readBool(FlagGasAudit, &cfg.GasAudit)
+	r.readBool(FlagCallTraces, &cfg.CallTraces)
+	r.readUint64(FlagCallTracesRetention, &cfg.CallTracesRetention)
+
+	r.readUint64(FlagRPCGasCap, &cfg.RPC.GasCap)
+	r.readDuration(FlagRPCEVMTimeout, &cfg.RPC.EVMTimeout)
+	r.readDuration(FlagRPCTraceTimeout, &cfg.RPC.TraceTimeout)
+	r.readFloat64(FlagRPCTxFeeCap, &cfg.RPC.TxFeeCap)
+
+	r.readUint64(FlagTxPoolMaxPendingTxs, &cfg.TxPool.MaxPendingTxs)
+	r.readString(FlagTxPoolMinTip, &cfg.TxPool.MinTip)
+	r.readUint64(FlagTxPoolGlobalSlots, &cfg.TxPool.GlobalSlots)
+	r.readUint64(FlagTxPoolAccountSlots, &cfg.TxPool.AccountSlots)
+	r.readDuration(FlagTxPoolLifetime, &cfg.TxPool.Lifetime)
+	r.readUint64(FlagTxPoolPriceBump, &cfg.TxPool.PriceBump)
+	r.readUint64(FlagTxPoolMaxTxSize, &cfg.TxPool.MaxTxSize)
+	r.readUint64(FlagTxPoolMaxCalldata, &cfg.TxPool.MaxCalldata)
+	return cfg, r.err
+}
+
+// Validate returns an error if a setting is invalid.
+func (c *Config) Validate() error {
+	switch {
+	case c.SelfCheck != SelfCheckStrict && c.SelfCheck != SelfCheckWarn &&
+		c.SelfCheck != SelfCheckOff:
+		return fmt.Errorf("%w: %s %q", ErrInvalidConfig, FlagSelfCheck, c.SelfCheck)
+	case c.RPC.EVMTimeout < 0:
+		return fmt.Errorf("%w: negative %s", ErrInvalidConfig, FlagRPCEVMTimeout)
+	case c.RPC.TraceTimeout < 0:
+		return fmt.Errorf("%w: negative %s", ErrInvalidConfig, FlagRPCTraceTimeout)
+	case c.RPC.TxFeeCap < 0:
+		return fmt.Errorf("%w: negative %s", ErrInvalidConfig, FlagRPCTxFeeCap)
+	case c.TxPool.GlobalSlots == 0:
+		return fmt.Errorf("%w: zero %s", ErrInvalidConfig, FlagTxPoolGlobalSlots)
+	case c.TxPool.AccountSlots == 0:
+		return fmt.Errorf("%w: zero %s", ErrInvalidConfig, FlagTxPoolAccountSlots)
+	case c.TxPool.AccountSlots > c.TxPool.GlobalSlots:
+		return fmt.Errorf(
+			"%w: %s exceeds %s", ErrInvalidConfig, FlagTxPoolAccountSlots, FlagTxPoolGlobalSlots,
+		)
+	case c.TxPool.Lifetime < 0:
+		return fmt.Errorf("%w: negative %s", ErrInvalidConfig, FlagTxPoolLifetime)
+	case c.TxPool.PriceBump == 0:
+		return fmt.Errorf("%w: zero %s", ErrInvalidConfig, FlagTxPoolPriceBump)
+	}
+	if _, err := c.MinTip(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MinTip returns the minimum effective tip in wei of the Ethereum transactions accepted in
+// CheckTx.
+func (c *Config) MinTip() (*big.Int, error) {
+	minTip, ok := new(big.Int).SetString(c.TxPool.MinTip, 10) //nolint:gomnd // base 10.
+	if !ok || minTip.Sign() < 0 {
+		return nil, fmt.Errorf(
+			"%w: %s %q", ErrInvalidConfig, FlagTxPoolMinTip, c.TxPool.MinTip,
+		)
+	}
+	return minTip, nil
+}
+
+// CheckPruning is the pruning check of the startup self-check. It ensures that an archive node
+// prunes neither the state nor the blocks of the past heights, as set in the given app options.
+func (c *Config) CheckPruning(appOpts servertypes.AppOptions) evmtypes.Diagnostics {
+	if !c.Archive {
+		return nil
+	}
+	var ds evmtypes.Diagnostics
+	pruning := cast.ToString(appOpts.Get(server.FlagPruning))
+	if pruning != pruningtypes.PruningOptionNothing {
+		ds = append(ds, evmtypes.Fatal(checkPruning, fmt.Errorf(
+			"%w: state pruning %q, want %q",
+			ErrArchivePruned, pruning, pruningtypes.PruningOptionNothing,
+		)))
+	}
+	minRetainBlocks := cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))
+	if minRetainBlocks > 0 {
+		ds = append(ds, evmtypes.Fatal(checkPruning, fmt.Errorf(
+			"%w: only the latest %d blocks are retained", ErrArchivePruned, minRetainBlocks,
+		)))
+	}
+	if c.CallTraces && c.CallTracesRetention > 0 {
+		ds = append(ds, evmtypes.Warning(checkPruning, fmt.Errorf(
+			"only the call traces of the latest %d blocks are retained", c.CallTracesRetention,
+		)))
+	}
+	return ds
+}
+
+// RPCLimits returns the caps of the rpc calls of the Polaris EVM.
+func (c *Config) RPCLimits() polar.RPCLimits {
+	return polar.RPCLimits{
+		GasCap:       c.RPC.GasCap,
+		EVMTimeout:   c.RPC.EVMTimeout,
+		TraceTimeout: c.RPC.TraceTimeout,
+		TxFeeCap:     c.RPC.TxFeeCap,
+		MaxTxSize:    c.TxPool.MaxTxSize,
+		MaxCalldata:  c.TxPool.MaxCalldata,
+	}
+}
+
+// MempoolConfig returns the limits of the Ethereum transaction mempool.
+func (c *Config) MempoolConfig() mempool.Config {
+	return mempool.Config{
+		GlobalSlots:  c.TxPool.GlobalSlots,
+		AccountSlots: c.TxPool.AccountSlots,
+		Lifetime:     c.TxPool.Lifetime,
+		PriceBump:    c.TxPool.PriceBump,
+	}
+}
+
+// AddFlags adds the node flags that override the `[polaris]` section of app.toml to the start
+// command.
+func AddFlags(startCmd *cobra.Command) {
+	cfg := DefaultConfig()
+	startCmd.Flags().String(
+		FlagSelfCheck, cfg.SelfCheck,
+		"Mode of the startup self-check of the EVM (strict|warn|off)",
+	)
+	startCmd.Flags().Bool(
+		FlagArchive, cfg.Archive,
+		"Serve the state and historical data of all blocks, which must not be pruned",
+	)
+	startCmd.Flags().Bool(
+		FlagGasAudit, cfg.GasAudit,
+		"Log the Ethereum transactions whose Cosmos gas consumed differs from their EVM gas used",
+	)
+	startCmd.Flags().Bool(
+		FlagCallTraces, cfg.CallTraces,
+		"Persist the call traces of the Ethereum transactions as they are executed",
+	)
+	startCmd.Flags().Uint64(
+		FlagCallTracesRetention, cfg.CallTracesRetention,
+		"Number of recent blocks whose call traces are kept (0 keeps all)",
+	)
+
+	startCmd.Flags().Uint64(
+		FlagRPCGasCap, cfg.RPC.GasCap, "Global gas cap of the eth_call and eth_estimateGas calls",
+	)
+	startCmd.Flags().Duration(
+		FlagRPCEVMTimeout, cfg.RPC.EVMTimeout, "Global timeout of the eth_call calls",
+	)
+	startCmd.Flags().Duration(
+		FlagRPCTraceTimeout, cfg.RPC.TraceTimeout,
+		"Maximum time a tracer may run for, whatever its requested timeout (0 for no maximum)",
+	)
+	startCmd.Flags().Float64(
+		FlagRPCTxFeeCap, cfg.RPC.TxFeeCap,
+		"Global fee cap in ether of the transactions sent over rpc (0 for no cap)",
+	)
+
+	startCmd.Flags().Uint64(
+		FlagTxPoolMaxPendingTxs, cfg.TxPool.MaxPendingTxs,
+		"Maximum number of pending Ethereum transactions of a sender accepted in CheckTx",
+	)
+	startCmd.Flags().String(
+		FlagTxPoolMinTip, cfg.TxPool.MinTip,
+		"Minimum effective tip in wei of the Ethereum transactions accepted in CheckTx",
+	)
+	startCmd.Flags().Uint64(
+		FlagTxPoolGlobalSlots, cfg.TxPool.GlobalSlots,
+		"Maximum number of transactions in the mempool",
+	)
+	startCmd.Flags().Uint64(
+		FlagTxPoolAccountSlots, cfg.TxPool.AccountSlots,
+		"Maximum number of Ethereum transactions of an account in the mempool",
+	)
+	startCmd.Flags().Duration(
+		FlagTxPoolLifetime, cfg.TxPool.Lifetime,
+		"Maximum time the Ethereum transactions of an inactive account stay in the mempool",
+	)
+	startCmd.Flags().Uint64(
+		FlagTxPoolPriceBump, cfg.TxPool.PriceBump,
+		"Minimum price bump percentage to replace an Ethereum transaction",
+	)
+	startCmd.Flags().Uint64(
+		FlagTxPoolMaxTxSize, cfg.TxPool.MaxTxSize,
+		"Maximum size in bytes of an Ethereum transaction (0 for no limit)",
+	)
+	startCmd.Flags().Uint64(
+		FlagTxPoolMaxCalldata, cfg.TxPool.MaxCalldata,
+		"Maximum size in bytes of the calldata of an Ethereum transaction (0 for no limit)",
+	)
+}
+
+// reader reads the settings set in the app options, keeping the first error.
+type reader struct {
+	appOpts servertypes.AppOptions
+	err     error
+}
+
+// read sets the value of the given key with the given conversion, if it is set.
+func read[T any](r *reader, key string, to func(any) (T, error), dst *T) {
+	raw := r.appOpts.Get(key)
+	if raw == nil || r.err != nil {
+		return
+	}
+	v, err := to(raw)
+	if err != nil {
+		r.err = fmt.Errorf("%w: %s: %w", ErrInvalidConfig, key, err)
+		return
+	}
+	*dst = v
+}
+
+func (r *reader) readBool(key string, dst *bool) { read(r, key, cast.ToBoolE, dst) }
+
+func (r *reader) readUint64(key string, dst *uint64) { read(r, key, cast.ToUint64E, dst) }
+
+func (r *reader) readFloat64(key string, dst *float64) { read(r, key, cast.ToFloat64E, dst) }
+
+func (r *reader) readString(key string, dst *string) { read(r, key, cast.ToStringE, dst) }
+
+func (r *reader) readDuration(key string, dst *time.Duration) {
+	read(r, key, cast.ToDurationE, dst)
+}
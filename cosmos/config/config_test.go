@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"pkg.berachain.dev/polaris/cosmos/config"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/config")
+}
+
+var _ = Describe("Config", func() {
+	It("should default to the mempool and rpc defaults", func() {
+		cfg := config.DefaultConfig()
+		Expect(cfg.Validate()).To(Succeed())
+		Expect(cfg.MempoolConfig()).To(Equal(mempool.DefaultConfig()))
+		Expect(cfg.TxPool.MaxPendingTxs).To(Equal(uint64(64)))
+
+		minTip, err := cfg.MinTip()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(minTip.Sign()).To(BeZero())
+	})
+
+	It("should read the set settings on top of the defaults", func() {
+		v := viper.New()
+		v.Set(config.FlagGasAudit, true)
+		v.Set(config.FlagRPCEVMTimeout, "10s")
+		v.Set(config.FlagRPCTraceTimeout, "1m")
+		v.Set(config.FlagTxPoolMinTip, "1000")
+		v.Set(config.FlagTxPoolGlobalSlots, 128)
+		v.Set(config.FlagTxPoolMaxCalldata, 1024)
+
+		cfg, err := config.ReadConfig(v)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.GasAudit).To(BeTrue())
+		Expect(cfg.RPCLimits().EVMTimeout).To(Equal(10 * time.Second))
+		Expect(cfg.RPCLimits().TraceTimeout).To(Equal(time.Minute))
+		Expect(cfg.RPCLimits().GasCap).To(Equal(config.DefaultConfig().RPC.GasCap))
+		Expect(cfg.RPCLimits().MaxCalldata).To(Equal(uint64(1024)))
+		Expect(cfg.RPCLimits().MaxTxSize).To(Equal(config.DefaultConfig().TxPool.MaxTxSize))
+		Expect(cfg.MempoolConfig().GlobalSlots).To(Equal(uint64(128)))
+		Expect(cfg.MempoolConfig().AccountSlots).To(Equal(mempool.DefaultConfig().AccountSlots))
+
+		minTip, err := cfg.MinTip()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(minTip).To(Equal(big.NewInt(1000)))
+	})
+
+	It("should reject a malformed setting", func() {
+		v := viper.New()
+		v.Set(config.FlagTxPoolLifetime, "forever")
+		_, err := config.ReadConfig(v)
+		Expect(err).To(MatchError(config.ErrInvalidConfig))
+	})
+
+	It("should refuse the pruning of an archive node", func() {
+		v := viper.New()
+		v.Set(server.FlagPruning, pruningtypes.PruningOptionDefault)
+		v.Set(server.FlagMinRetainBlocks, 100)
+		cfg := config.DefaultConfig()
+		Expect(cfg.CheckPruning(v)).To(BeEmpty())
+
+		cfg.Archive = true
+		ds := cfg.CheckPruning(v)
+		Expect(ds).To(HaveLen(2))
+		Expect(ds.Err()).To(MatchError(config.ErrArchivePruned))
+
+		v.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+		v.Set(server.FlagMinRetainBlocks, 0)
+		Expect(cfg.CheckPruning(v)).To(BeEmpty())
+
+		cfg.CallTraces, cfg.CallTracesRetention = true, 128
+		ds = cfg.CheckPruning(v)
+		Expect(ds).To(HaveLen(1))
+		Expect(ds.Err()).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should reject an invalid config",
+		func(modify func(*config.Config)) {
+			cfg := config.DefaultConfig()
+			modify(&cfg)
+			Expect(cfg.Validate()).To(MatchError(config.ErrInvalidConfig))
+		},
+		Entry("unknown self-check mode", func(c *config.Config) { c.SelfCheck = "lenient" }),
+		Entry("negative evm timeout", func(c *config.Config) { c.RPC.EVMTimeout = -time.Second }),
+		Entry("negative trace timeout", func(c *config.Config) {
+			c.RPC.TraceTimeout = -time.Second
+		}),
+		Entry("negative tx fee cap", func(c *config.Config) { c.RPC.TxFeeCap = -1 }),
+		Entry("no global slots", func(c *config.Config) { c.TxPool.GlobalSlots = 0 }),
+		Entry("more account slots than global slots", func(c *config.Config) {
+			c.TxPool.AccountSlots = c.TxPool.GlobalSlots + 1
+		}),
+		Entry("no price bump", func(c *config.Config) { c.TxPool.PriceBump = 0 }),
+		Entry("negative min tip", func(c *config.Config) { c.TxPool.MinTip = "-1" }),
+		Entry("non decimal min tip", func(c *config.Config) { c.TxPool.MinTip = "0x10" }),
+	)
+})
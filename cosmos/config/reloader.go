@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+)
+
+// Reloader reloads the `[polaris]` section of app.toml on SIGHUP, and applies the settings that
+// can change while the node runs with the registered handlers. The settings that require a
+// restart keep their current values, a warning is logged if they changed.
+type Reloader struct {
+	path   string
+	logger log.Logger
+
+	mu       sync.Mutex
+	current  Config
+	handlers []func(Config)
+	signals  chan os.Signal
+}
+
+// NewReloader returns a Reloader of the app.toml at the given path, whose `[polaris]` section is
+// the given config.
+func NewReloader(path string, current Config, logger log.Logger) *Reloader {
+	return &Reloader{
+		path:    path,
+		logger:  logger.With("module", "polaris-config"),
+		current: current,
+	}
+}
+
+// OnReload registers a handler called with the reloaded config. It must be called before `Start`.
+func (r *Reloader) OnReload(handler func(Config)) {
+	r.handlers = append(r.handlers, handler)
+}
+
+// Start reloads the config on each SIGHUP until `Stop` is called.
+func (r *Reloader) Start() {
+	r.signals = make(chan os.Signal, 1)
+	signal.Notify(r.signals, syscall.SIGHUP)
+	go func() {
+		for range r.signals {
+			if err := r.Reload(); err != nil {
+				r.logger.Error("failed to reload the config, keeping the current one", "err", err)
+			}
+		}
+	}()
+}
+
+// Stop stops reloading the config on SIGHUP.
+func (r *Reloader) Stop() {
+	if r.signals != nil {
+		signal.Stop(r.signals)
+		close(r.signals)
+		r.signals = nil
+	}
+}
+
+// Reload reads and validates the `[polaris]` section of app.toml, then calls the handlers with it.
+// The current config is kept if it is invalid. The settings set by node flags on startup are
+// replaced by their values in app.toml.
+func (r *Reloader) Reload() error {
+	v := viper.New()
+	v.SetConfigFile(r.path)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	cfg, err := ReadConfig(v)
+	if err != nil {
+		return err
+	}
+	if err = cfg.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keepRestartSettings(&cfg)
+	r.current = cfg
+	for _, handler := range r.handlers {
+		handler(cfg)
+	}
+	r.logger.Info("reloaded the config", "path", r.path)
+	return nil
+}
+
+// Current returns the config applied last.
+func (r *Reloader) Current() Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// keepRestartSettings sets the settings that require a restart to their current values.
+func (r *Reloader) keepRestartSettings(cfg *Config) {
+	restart := []struct {
+		key     string
+		changed bool
+	}{
+		{FlagCallTraces, cfg.CallTraces != r.current.CallTraces},
+		{FlagCallTracesRetention, cfg.CallTracesRetention != r.current.CallTracesRetention},
+		{FlagTxPoolPriceBump, cfg.TxPool.PriceBump != r.current.TxPool.PriceBump},
+	}
+	for _, s := range restart {
+		if s.changed {
+			r.logger.Warn("setting requires a restart, keeping its current value", "key", s.key)
+		}
+	}
+	cfg.CallTraces = r.current.CallTraces
+	cfg.CallTracesRetention = r.current.CallTracesRetention
+	cfg.TxPool.PriceBump = r.current.TxPool.PriceBump
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"cosmossdk.io/log"
+
+	"pkg.berachain.dev/polaris/cosmos/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		path     string
+		reloader *config.Reloader
+		reloaded []config.Config
+	)
+
+	// writeConfig renders the given config to app.toml with the default template.
+	writeConfig := func(cfg config.Config) {
+		tmpl := template.Must(template.New("app").Parse(config.DefaultConfigTemplate))
+		f, err := os.Create(path)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(tmpl.Execute(f, struct{ Polaris config.Config }{cfg})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "app.toml")
+		writeConfig(config.DefaultConfig())
+		reloader = config.NewReloader(path, config.DefaultConfig(), log.NewNopLogger())
+		reloaded = nil
+		reloader.OnReload(func(cfg config.Config) { reloaded = append(reloaded, cfg) })
+	})
+
+	It("should round trip the default config through the template", func() {
+		Expect(reloader.Reload()).To(Succeed())
+		Expect(reloaded).To(Equal([]config.Config{config.DefaultConfig()}))
+	})
+
+	It("should apply the runtime settings", func() {
+		cfg := config.DefaultConfig()
+		cfg.GasAudit = true
+		cfg.RPC.GasCap = 1_000_000
+		cfg.TxPool.MinTip = "42"
+		cfg.TxPool.AccountSlots = 16
+		writeConfig(cfg)
+
+		Expect(reloader.Reload()).To(Succeed())
+		Expect(reloaded).To(Equal([]config.Config{cfg}))
+		Expect(reloader.Current()).To(Equal(cfg))
+	})
+
+	It("should keep the settings that require a restart", func() {
+		cfg := config.DefaultConfig()
+		cfg.CallTraces = true
+		cfg.TxPool.PriceBump = 25
+		cfg.TxPool.GlobalSlots = 512
+		writeConfig(cfg)
+
+		Expect(reloader.Reload()).To(Succeed())
+		expected := config.DefaultConfig()
+		expected.TxPool.GlobalSlots = 512
+		Expect(reloaded).To(Equal([]config.Config{expected}))
+	})
+
+	It("should keep the current config if the new one is invalid", func() {
+		cfg := config.DefaultConfig()
+		cfg.TxPool.GlobalSlots = 0
+		writeConfig(cfg)
+
+		Expect(reloader.Reload()).To(MatchError(config.ErrInvalidConfig))
+		Expect(reloaded).To(BeEmpty())
+		Expect(reloader.Current()).To(Equal(config.DefaultConfig()))
+	})
+})
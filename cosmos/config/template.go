@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+// DefaultConfigTemplate is the template of the `[polaris]` section of app.toml, to be appended to
+// the template of the server config, which renders the `Polaris` field of the app config.
+const DefaultConfigTemplate = `
+
+###############################################################################
+###                                Polaris                                  ###
+###############################################################################
+
+# The settings marked as reloadable are applied on SIGHUP, the other ones require a restart.
+
+# The PREVRANDAO opcode returns a hash of the CometBFT header of the block, which is not a VRF
+# output: the proposer knows it before proposing the block and can bias it through the commit
+# signatures it includes. Contracts must not use it as randomness where the proposer has an
+# incentive to bias the outcome.
+
+[polaris]
+# Mode of the startup self-check, which validates the chain config against the genesis, the
+# pruning against the archive setting, and the off-chain database against the app height. It is
+# one of "strict", which refuses to start the node on a mismatch, "warn" or "off".
+self-check = "{{ .Polaris.SelfCheck }}"
+# Serve the state and the historical data of all the blocks, which requires pruning = "nothing"
+# and min-retain-blocks = 0.
+archive = {{ .Polaris.Archive }}
+# Log the Ethereum transactions whose Cosmos gas consumed differs from their EVM gas used
+# (reloadable).
+gas-audit = {{ .Polaris.GasAudit }}
+# Persist the call traces of the Ethereum transactions as they are executed.
+call-traces = {{ .Polaris.CallTraces }}
+# Number of recent blocks whose call traces are kept, 0 to keep all of them.
+call-traces-retention = {{ .Polaris.CallTracesRetention }}
+
+[polaris.rpc]
+# Global gas cap of the eth_call and eth_estimateGas calls (reloadable).
+gas-cap = {{ .Polaris.RPC.GasCap }}
+# Global timeout of the eth_call calls (reloadable).
+evm-timeout = "{{ .Polaris.RPC.EVMTimeout }}"
+# Maximum time a tracer may run for, whatever the timeout requested in its config, 0 for no
+# maximum (reloadable).
+trace-timeout = "{{ .Polaris.RPC.TraceTimeout }}"
+# Global fee cap in ether of the transactions sent over rpc, 0 for no cap (reloadable).
+tx-fee-cap = {{ .Polaris.RPC.TxFeeCap }}
+
+[polaris.txpool]
+# Maximum number of pending Ethereum transactions of a sender accepted in CheckTx, i.e. how far
+# ahead of its nonce a transaction can be, 0 for no limit (reloadable).
+max-pending-txs = {{ .Polaris.TxPool.MaxPendingTxs }}
+# Minimum effective tip in wei of the Ethereum transactions accepted in CheckTx (reloadable).
+min-tip = "{{ .Polaris.TxPool.MinTip }}"
+# Maximum number of transactions in the mempool. When it is full, an Ethereum transaction evicts
+# the last one of the account paying the lowest tip, if it pays more (reloadable).
+global-slots = {{ .Polaris.TxPool.GlobalSlots }}
+# Maximum number of Ethereum transactions of an account in the mempool (reloadable).
+account-slots = {{ .Polaris.TxPool.AccountSlots }}
+# Maximum time the Ethereum transactions of an account stay in the mempool without it sending a
+# new one, 0 to keep them forever (reloadable).
+lifetime = "{{ .Polaris.TxPool.Lifetime }}"
+# Minimum price bump percentage to replace an Ethereum transaction of the same nonce.
+price-bump = {{ .Polaris.TxPool.PriceBump }}
+# Maximum size in bytes of an encoded Ethereum transaction accepted over rpc and in CheckTx, 0 for
+# no limit. Larger ones are rejected with an "oversized data" error (reloadable).
+max-tx-size = {{ .Polaris.TxPool.MaxTxSize }}
+# Maximum size in bytes of the calldata of an Ethereum transaction accepted over rpc and in
+# CheckTx, 0 for no limit (reloadable).
+max-calldata = {{ .Polaris.TxPool.MaxCalldata }}
+`
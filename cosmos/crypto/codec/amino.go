@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package codec
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/keys/ethsecp256k1"
+)
+
+const (
+	// pubKeyAminoName is the amino name of the ethsecp256k1 public key.
+	pubKeyAminoName = "polaris/PubKeyEthSecp256k1"
+	// privKeyAminoName is the amino name of the ethsecp256k1 private key.
+	privKeyAminoName = "polaris/PrivKeyEthSecp256k1"
+)
+
+func init() {
+	// The keyring armors the private keys that it imports and exports with the global amino
+	// codec, which must know the ethsecp256k1 keys.
+	RegisterLegacyAminoCodec(legacy.Cdc)
+}
+
+// RegisterLegacyAminoCodec registers the ethsecp256k1 key types with the given amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ethsecp256k1.PubKey{}, pubKeyAminoName, nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{}, privKeyAminoName, nil)
+}
@@ -23,7 +23,11 @@ package codec
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/keys/ethsecp256k1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -38,4 +42,15 @@ var _ = Describe("Codec", func() {
 	It("should not panic", func() {
 		RegisterInterfaces(types.NewInterfaceRegistry())
 	})
+
+	It("should register the keys with the global amino codec", func() {
+		privKey, err := ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		bz, err := legacy.Cdc.Marshal(privKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		var decoded cryptotypes.PrivKey
+		Expect(legacy.Cdc.Unmarshal(bz, &decoded)).To(Succeed())
+		Expect(decoded.Equals(privKey)).To(BeTrue())
+	})
 })
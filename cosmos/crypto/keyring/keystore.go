@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keyring
+
+import (
+	"errors"
+	"fmt"
+
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	// The keys are armored with the global amino codec, which must know the ethsecp256k1 keys.
+	_ "pkg.berachain.dev/polaris/cosmos/crypto/codec"
+	"pkg.berachain.dev/polaris/cosmos/crypto/hd"
+	"pkg.berachain.dev/polaris/cosmos/crypto/keys/ethsecp256k1"
+	"pkg.berachain.dev/polaris/eth/accounts/keystore"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/crypto"
+)
+
+// importPassphrase encrypts the armor of the private keys imported into a keyring, which never
+// leaves the memory.
+const importPassphrase = "polaris-import"
+
+// ErrNotEthSecp256k1 is returned when exporting a key that is not an eth_secp256k1 key, whose
+// address would not be the Ethereum address of the private key.
+var ErrNotEthSecp256k1 = errors.New("key is not an eth_secp256k1 key")
+
+// ImportKeystore decrypts the given Ethereum keystore (web3 secret storage) file with the given
+// passphrase and imports its private key into the keyring as the eth_secp256k1 key of the given
+// name.
+func ImportKeystore(
+	kr keyring.Keyring, name string, keyJSON []byte, passphrase string,
+) (*keyring.Record, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting keystore: %w", err)
+	}
+	return importPrivKey(kr, name, crypto.FromECDSA(key.PrivateKey))
+}
+
+// ImportHex imports the given hex encoded Ethereum private key into the keyring as the
+// eth_secp256k1 key of the given name.
+func ImportHex(kr keyring.Keyring, name, privKeyHex string) (*keyring.Record, error) {
+	key, err := crypto.HexToECDSA(strip0x(privKeyHex))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding private key: %w", err)
+	}
+	return importPrivKey(kr, name, crypto.FromECDSA(key))
+}
+
+// ExportKeystore exports the eth_secp256k1 key of the given name from the keyring as an Ethereum
+// keystore (web3 secret storage) file encrypted with the given passphrase, which MetaMask and
+// geth import.
+func ExportKeystore(kr keyring.Keyring, name, passphrase string) ([]byte, error) {
+	privKey, err := exportPrivKey(kr, name)
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKey, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.NewKeyFromECDSA(ecdsaKey)
+	if err != nil {
+		return nil, err
+	}
+	return keystore.EncryptKey(key, passphrase, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// ExportHex exports the eth_secp256k1 key of the given name from the keyring as a hex encoded
+// Ethereum private key.
+func ExportHex(kr keyring.Keyring, name string) (string, error) {
+	privKey, err := exportPrivKey(kr, name)
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(privKey.Bytes()), nil
+}
+
+// importPrivKey imports the given private key into the keyring as the eth_secp256k1 key of the
+// given name.
+func importPrivKey(kr keyring.Keyring, name string, key []byte) (*keyring.Record, error) {
+	armor := sdkcrypto.EncryptArmorPrivKey(
+		&ethsecp256k1.PrivKey{Key: key}, importPassphrase, string(hd.EthSecp256k1Type),
+	)
+	if err := kr.ImportPrivKey(name, armor, importPassphrase); err != nil {
+		return nil, err
+	}
+	return kr.Key(name)
+}
+
+// exportPrivKey returns the private key of the eth_secp256k1 key of the given name.
+func exportPrivKey(kr keyring.Keyring, name string) (*ethsecp256k1.PrivKey, error) {
+	armor, err := kr.ExportPrivKeyArmor(name, importPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	privKey, _, err := sdkcrypto.UnarmorDecryptPrivKey(armor, importPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotEthSecp256k1, privKey.Type())
+	}
+	return ethPrivKey, nil
+}
+
+// strip0x returns the given hex string without its 0x prefix, if any.
+func strip0x(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
+}
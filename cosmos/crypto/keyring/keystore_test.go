@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keyring
+
+import (
+	"os"
+	"strings"
+
+	sdkhd "github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/hd"
+	accounts "pkg.berachain.dev/polaris/eth/accounts"
+	"pkg.berachain.dev/polaris/eth/accounts/keystore"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keystore", func() {
+	var (
+		dir string
+		kr  keyring.Keyring
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "keystore_test")
+		Expect(err).NotTo(HaveOccurred())
+		registerCodec()
+
+		kr, err = keyring.New(
+			"accounts", keyring.BackendTest, dir, strings.NewReader(""), cdc, EthSecp256k1Option(),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should import and export a hex private key", func() {
+		key, err := crypto.GenerateEthKey()
+		Expect(err).NotTo(HaveOccurred())
+		privKeyHex := hexutil.Encode(crypto.FromECDSA(key))
+
+		record, err := ImportHex(kr, "foo", privKeyHex)
+		Expect(err).NotTo(HaveOccurred())
+		pubKey, err := record.GetPubKey()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pubKey.Type()).To(Equal(string(hd.EthSecp256k1Type)))
+		Expect(common.BytesToAddress(pubKey.Address())).
+			To(Equal(crypto.PubkeyToAddress(key.PublicKey)))
+
+		exported, err := ExportHex(kr, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exported).To(Equal(privKeyHex))
+	})
+
+	It("should import and export a keystore file", func() {
+		key, err := crypto.GenerateEthKey()
+		Expect(err).NotTo(HaveOccurred())
+		ksKey, err := keystore.NewKeyFromECDSA(key)
+		Expect(err).NotTo(HaveOccurred())
+		keyJSON, err := keystore.EncryptKey(ksKey, "password", 2, 1) // light scrypt for speed.
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ImportKeystore(kr, "foo", keyJSON, "wrong")
+		Expect(err).To(HaveOccurred())
+		record, err := ImportKeystore(kr, "foo", keyJSON, "password")
+		Expect(err).NotTo(HaveOccurred())
+		address, err := record.GetAddress()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(common.BytesToAddress(address)).To(Equal(ksKey.Address))
+
+		exported, err := ExportKeystore(kr, "foo", "new password")
+		Expect(err).NotTo(HaveOccurred())
+		decrypted, err := keystore.DecryptKey(exported, "new password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decrypted.Address).To(Equal(ksKey.Address))
+		Expect(crypto.FromECDSA(decrypted.PrivateKey)).To(Equal(crypto.FromECDSA(key)))
+	})
+
+	It("should not export a key that is not an eth_secp256k1 key", func() {
+		_, _, err := kr.NewMnemonic("foo", keyring.English, accounts.BIP44HDPath,
+			keyring.DefaultBIP39Passphrase, sdkhd.Secp256k1)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ExportHex(kr, "foo")
+		Expect(err).To(MatchError(ErrNotEthSecp256k1))
+		_, err = ExportKeystore(kr, "foo", "password")
+		Expect(err).To(MatchError(ErrNotEthSecp256k1))
+	})
+})
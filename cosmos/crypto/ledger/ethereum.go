@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ledger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	ledgergo "github.com/zondax/ledger-go"
+
+	"pkg.berachain.dev/polaris/eth/accounts"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// The APDU commands of the Ethereum app of Ledger devices.
+const (
+	ethCLA              = 0xe0
+	ethInsGetAddress    = 0x02
+	ethInsSignTx        = 0x04
+	ethInsSignEIP712    = 0x0c
+	ethP1FirstChunk     = 0x00
+	ethP1NextChunk      = 0x80
+	ethP2NoChainCode    = 0x00
+	ethP1NoConfirm      = 0x00
+	ethMaxChunkSize     = 255
+	ethSignatureLength  = 65
+	ethRecoveryIDIndex  = 64
+	ethHexAddressLength = 2 * common.AddressLength
+)
+
+var (
+	// ErrUnsupportedTxType is returned when signing a transaction type that the Ethereum app of
+	// Ledger devices does not sign.
+	ErrUnsupportedTxType = errors.New("transaction type not supported by the Ledger")
+
+	// errInvalidReply is returned when the device replies a malformed address or signature.
+	errInvalidReply = errors.New("invalid Ledger reply")
+)
+
+// device is a connected Ledger device, which exchanges APDU commands.
+type device interface {
+	Exchange(command []byte) ([]byte, error)
+	Close() error
+}
+
+// Ethereum signs with the Ethereum app of a Ledger device, which validators and multisig operators
+// use to keep their keys off the machines that build the transactions.
+type Ethereum struct {
+	device device
+}
+
+// Open connects to the first Ledger device, whose Ethereum app must be open.
+func Open() (*Ethereum, error) {
+	dev, err := ledgergo.NewLedgerAdmin().Connect(0)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to the Ledger: %w", err)
+	}
+	return &Ethereum{device: dev}, nil
+}
+
+// Close disconnects from the Ledger device.
+func (e *Ethereum) Close() error {
+	return e.device.Close()
+}
+
+// Address returns the address of the key of the given derivation path.
+func (e *Ethereum) Address(path accounts.DerivationPath) (common.Address, error) {
+	reply, err := e.exchange(ethInsGetAddress, ethP1NoConfirm, serializePath(path))
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// The reply is the public key and the hex address, both prefixed with their length.
+	if len(reply) == 0 || len(reply) < 2+int(reply[0])+ethHexAddressLength ||
+		reply[1+int(reply[0])] != ethHexAddressLength {
+		return common.Address{}, errInvalidReply
+	}
+	hexAddress := reply[2+int(reply[0]):][:ethHexAddressLength]
+	return common.HexToAddress(string(hexAddress)), nil
+}
+
+// SignTx signs the given transaction for the given chain with the key of the given derivation
+// path, once confirmed on the device, and returns the signed transaction.
+func (e *Ethereum) SignTx(
+	path accounts.DerivationPath, tx *coretypes.Transaction, chainID *big.Int,
+) (*coretypes.Transaction, error) {
+	payload, err := signingPayload(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction is streamed in chunks, the first one prefixed with the derivation path.
+	var (
+		data  = append(serializePath(path), payload...)
+		p1    = byte(ethP1FirstChunk)
+		reply []byte
+	)
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > ethMaxChunkSize {
+			chunk = chunk[:ethMaxChunkSize]
+		}
+		if reply, err = e.exchange(ethInsSignTx, p1, chunk); err != nil {
+			return nil, err
+		}
+		data, p1 = data[len(chunk):], ethP1NextChunk
+	}
+
+	sig, err := signature(reply)
+	if err != nil {
+		return nil, err
+	}
+	// The device replies the EIP-155 V of legacy transactions, truncated to a byte.
+	signer := coretypes.LatestSignerForChainID(chainID)
+	if tx.Type() == coretypes.LegacyTxType {
+		signer = coretypes.NewEIP155Signer(chainID)
+		sig[ethRecoveryIDIndex] -= byte(chainID.Uint64()*2 + 35) //nolint:gomnd // EIP-155.
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// SignTypedData signs the EIP-712 typed data of the given domain separator and message hash with
+// the key of the given derivation path, once confirmed on the device, and returns the 65 bytes
+// [R || S || V] signature, with a V of 27 or 28.
+func (e *Ethereum) SignTypedData(
+	path accounts.DerivationPath, domainSeparator, messageHash common.Hash,
+) ([]byte, error) {
+	data := append(serializePath(path), domainSeparator.Bytes()...)
+	reply, err := e.exchange(ethInsSignEIP712, ethP1FirstChunk, append(data, messageHash.Bytes()...))
+	if err != nil {
+		return nil, err
+	}
+	return signature(reply)
+}
+
+// exchange sends the given command of the Ethereum app to the device and returns its reply.
+func (e *Ethereum) exchange(ins, p1 byte, data []byte) ([]byte, error) {
+	if len(data) > ethMaxChunkSize {
+		return nil, errors.New("ledger command too large")
+	}
+	command := append([]byte{ethCLA, ins, p1, ethP2NoChainCode, byte(len(data))}, data...)
+	reply, err := e.device.Exchange(command)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: %w", err)
+	}
+	return reply, nil
+}
+
+// signature converts the [V || R || S] signature replied by the device to [R || S || V].
+func signature(reply []byte) ([]byte, error) {
+	if len(reply) != ethSignatureLength {
+		return nil, errInvalidReply
+	}
+	return append(reply[1:ethSignatureLength:ethSignatureLength], reply[0]), nil
+}
+
+// serializePath serializes the given derivation path as its length followed by its big endian
+// components.
+func serializePath(path accounts.DerivationPath) []byte {
+	serialized := make([]byte, 1+4*len(path)) //nolint:gomnd // uint32 components.
+	serialized[0] = byte(len(path))
+	for i, component := range path {
+		binary.BigEndian.PutUint32(serialized[1+4*i:], component) //nolint:gomnd // uint32.
+	}
+	return serialized
+}
+
+// signingPayload returns the payload that the device signs for the given transaction, whose hash
+// is the signing hash of the transaction.
+func signingPayload(tx *coretypes.Transaction, chainID *big.Int) ([]byte, error) {
+	switch tx.Type() {
+	case coretypes.LegacyTxType:
+		return rlp.EncodeToBytes([]any{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			chainID, uint(0), uint(0),
+		})
+	case coretypes.AccessListTxType:
+		payload, err := rlp.EncodeToBytes([]any{
+			chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(),
+		})
+		return append([]byte{tx.Type()}, payload...), err
+	case coretypes.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes([]any{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(),
+			tx.Data(), tx.AccessList(),
+		})
+		return append([]byte{tx.Type()}, payload...), err
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
+	}
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ledger
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/accounts"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ethereum", func() {
+	var (
+		key     *ecdsa.PrivateKey
+		address common.Address
+		dev     *fakeDevice
+		ledger  *Ethereum
+		path    accounts.DerivationPath
+		chainID = big.NewInt(2061)
+		to      = common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4")
+	)
+
+	BeforeEach(func() {
+		var err error
+		key, err = crypto.GenerateEthKey()
+		Expect(err).NotTo(HaveOccurred())
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		dev = &fakeDevice{key: key, chainID: chainID}
+		ledger = &Ethereum{device: dev}
+		path, err = accounts.ParseDerivationPath("m/44'/60'/0'/0/0")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should return the address of the derivation path", func() {
+		Expect(ledger.Address(path)).To(Equal(address))
+		Expect(dev.path).To(Equal(serializePath(path)))
+	})
+
+	It("should sign transactions of every type", func() {
+		for _, txData := range []coretypes.TxData{
+			&coretypes.LegacyTx{
+				Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1),
+			},
+			&coretypes.AccessListTx{
+				ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to,
+				AccessList: coretypes.AccessList{{Address: to}},
+			},
+			&coretypes.DynamicFeeTx{
+				ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(2e9),
+				Gas: 1e6, Data: make([]byte, 600),
+			},
+		} {
+			tx := coretypes.NewTx(txData)
+			signedTx, err := ledger.SignTx(path, tx, chainID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signedTx.ChainId()).To(Equal(chainID))
+			signer := coretypes.LatestSignerForChainID(chainID)
+			Expect(coretypes.Sender(signer, signedTx)).To(Equal(address))
+		}
+		// The contract creation above was streamed in several chunks.
+		Expect(dev.chunks).To(BeNumerically(">", 1))
+	})
+
+	It("should sign typed data", func() {
+		domainSeparator := common.Hash{1}
+		messageHash := common.Hash{2}
+		sig, err := ledger.SignTypedData(path, domainSeparator, messageHash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sig).To(HaveLen(ethSignatureLength))
+		Expect(sig[ethRecoveryIDIndex]).To(BeNumerically(">=", 27))
+
+		hash := crypto.Keccak256(
+			[]byte{0x19, 0x01}, domainSeparator.Bytes(), messageHash.Bytes(),
+		)
+		sig[ethRecoveryIDIndex] -= 27
+		pubKey, err := crypto.SigToPub(hash, sig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crypto.PubkeyToAddress(*pubKey)).To(Equal(address))
+	})
+})
+
+// fakeDevice emulates the Ethereum app of a Ledger device with the given key.
+type fakeDevice struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+
+	path    []byte
+	payload []byte
+	chunks  int
+}
+
+func (d *fakeDevice) Exchange(command []byte) ([]byte, error) {
+	Expect(command[0]).To(Equal(byte(ethCLA)))
+	Expect(command[4]).To(BeEquivalentTo(len(command) - 5))
+	ins, p1, data := command[1], command[2], command[5:]
+
+	// The first chunk of every command starts with the derivation path.
+	if p1 == ethP1FirstChunk {
+		d.path = data[:1+4*int(data[0])]
+		data = data[len(d.path):]
+	}
+
+	switch ins {
+	case ethInsGetAddress:
+		pubKey := crypto.FromECDSAPub(&d.key.PublicKey)
+		hexAddress := hex.EncodeToString(crypto.PubkeyToAddress(d.key.PublicKey).Bytes())
+		reply := append([]byte{byte(len(pubKey))}, pubKey...)
+		return append(append(reply, byte(len(hexAddress))), hexAddress...), nil
+	case ethInsSignTx:
+		if p1 == ethP1FirstChunk {
+			d.payload, d.chunks = nil, 0
+		}
+		d.payload = append(d.payload, data...)
+		d.chunks++
+		sig, err := crypto.EthSign(crypto.Keccak256(d.payload), d.key)
+		Expect(err).NotTo(HaveOccurred())
+		v := sig[ethRecoveryIDIndex]
+		if d.payload[0] >= 0xc0 { // Legacy transactions are RLP lists.
+			v += byte(d.chainID.Uint64()*2 + 35)
+		}
+		return append([]byte{v}, sig[:ethRecoveryIDIndex]...), nil
+	case ethInsSignEIP712:
+		sig, err := crypto.EthSign(crypto.Keccak256([]byte{0x19, 0x01}, data), d.key)
+		Expect(err).NotTo(HaveOccurred())
+		return append([]byte{sig[ethRecoveryIDIndex] + 27}, sig[:ethRecoveryIDIndex]...), nil
+	default:
+		Fail("unexpected instruction")
+		return nil, nil
+	}
+}
+
+func (d *fakeDevice) Close() error { return nil }
@@ -21,6 +21,10 @@
 package lib
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/eth/common"
@@ -44,6 +48,43 @@ func Bech32FromEthAddress(ethAddr common.Address) string {
 	return AddressToAccAddress(ethAddr).String()
 }
 
+// ErrInvalidChecksum is returned when parsing a mixed-case hex address whose EIP-55 checksum is
+// invalid.
+var ErrInvalidChecksum = errors.New("invalid EIP-55 address checksum")
+
+// AccAddressFromString parses an account address given either as a Bech32 string or as a hex
+// Ethereum address. The checksum of Bech32 addresses is always validated, the EIP-55 checksum of
+// hex addresses only when they are mixed-case, like Ethereum wallets do.
+func AccAddressFromString(address string) (sdk.AccAddress, error) {
+	if !common.IsHexAddress(address) {
+		return sdk.AccAddressFromBech32(address)
+	}
+	if !hasValidChecksum(address) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidChecksum, address)
+	}
+	return AddressToAccAddress(common.HexToAddress(address)), nil
+}
+
+// hasValidChecksum returns true if the given hex address is either not mixed-case or matches its
+// EIP-55 checksum.
+func hasValidChecksum(address string) bool {
+	digits := address[len(address)-2*common.AddressLength:]
+	if digits == strings.ToLower(digits) || digits == strings.ToUpper(digits) {
+		return true
+	}
+	return digits == common.HexToAddress(address).Hex()[2:]
+}
+
+// ConvertAddress parses the given Bech32 or hex account address, see `AccAddressFromString`, and
+// returns its Bech32 representation and its checksummed Ethereum representation.
+func ConvertAddress(address string) (string, common.Address, error) {
+	accAddress, err := AccAddressFromString(address)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+	return accAddress.String(), AccAddressToEthAddress(accAddress), nil
+}
+
 // ConsAddressToEthAddress converts a Cosmos SDK `ConsAddress` to an Ethereum `Address`.
 func ConsAddressToEthAddress(consAddress sdk.ConsAddress) common.Address {
 	return common.BytesToAddress(consAddress)
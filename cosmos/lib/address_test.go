@@ -57,4 +57,40 @@ var _ = Describe("Address", func() {
 		Expect(cosmlib.EthAddressFromBech32(bech32)).To(Equal(addr))
 		Expect(cosmlib.Bech32FromEthAddress(addr)).To(Equal(bech32))
 	})
+
+	It("should parse both bech32 and hex account addresses", func() {
+		addr := common.HexToAddress("0xCd8c4Cb0C7f93a2B74B3e522a1C7BE35bE1Fbc73")
+		bech32 := "cosmos1ekxyevx8lyazka9nu532r3a7xklpl0rnjrc2a9"
+
+		acc, err := cosmlib.AccAddressFromString(bech32)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cosmlib.AccAddressToEthAddress(acc)).To(Equal(addr))
+
+		acc, err = cosmlib.AccAddressFromString(addr.Hex())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acc.String()).To(Equal(bech32))
+
+		_, err = cosmlib.AccAddressFromString("0xCd8c4Cb0")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should validate the checksum of mixed-case hex addresses", func() {
+		bech32 := "cosmos1ekxyevx8lyazka9nu532r3a7xklpl0rnjrc2a9"
+		for _, address := range []string{
+			"0xCd8c4Cb0C7f93a2B74B3e522a1C7BE35bE1Fbc73",
+			"0xcd8c4cb0c7f93a2b74b3e522a1c7be35be1fbc73",
+			"0xCD8C4CB0C7F93A2B74B3E522A1C7BE35BE1FBC73",
+			bech32,
+		} {
+			converted, ethAddress, err := cosmlib.ConvertAddress(address)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(converted).To(Equal(bech32))
+			Expect(ethAddress.Hex()).To(Equal("0xCd8c4Cb0C7f93a2B74B3e522a1C7BE35bE1Fbc73"))
+		}
+
+		_, _, err := cosmlib.ConvertAddress("0xcD8c4Cb0C7f93a2B74B3e522a1C7BE35bE1Fbc73")
+		Expect(err).To(MatchError(cosmlib.ErrInvalidChecksum))
+		_, _, err = cosmlib.ConvertAddress("cosmos1ekxyevx8lyazka9nu532r3a7xklpl0rnjrc2a8")
+		Expect(err).To(HaveOccurred())
+	})
 })
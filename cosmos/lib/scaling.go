@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// weiDecimals is the number of decimals of the native token of the EVM.
+const weiDecimals = 18
+
+// DenomScaler converts the wei amounts of the EVM to and from the amounts of the bank denom that
+// backs them, which may have fewer decimals than wei (e.g. 6 decimals for an `u` denom).
+type DenomScaler struct {
+	denom string
+	// factor is the number of wei in a single unit of the denom.
+	factor *big.Int
+}
+
+// NewDenomScaler returns a DenomScaler for the given bank denom, whose amounts have the given
+// number of decimals. It panics if the denom has more decimals than wei.
+func NewDenomScaler(denom string, decimals uint8) DenomScaler {
+	if decimals > weiDecimals {
+		panic(fmt.Sprintf("denom %s has %d decimals, more than wei", denom, decimals))
+	}
+	return DenomScaler{
+		denom:  denom,
+		factor: new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(weiDecimals-decimals)), nil),
+	}
+}
+
+// Denom returns the bank denom backing the wei amounts.
+func (s DenomScaler) Denom() string {
+	return s.denom
+}
+
+// Factor returns the number of wei in a single unit of the denom.
+func (s DenomScaler) Factor() *big.Int {
+	return new(big.Int).Set(s.factor)
+}
+
+// ToWei returns the wei amount of the given amount of the denom.
+func (s DenomScaler) ToWei(amount sdkmath.Int) *big.Int {
+	return new(big.Int).Mul(amount.BigInt(), s.factor)
+}
+
+// FromWei splits the given wei amount into whole units of the denom and the remaining wei, which
+// are less than a single unit.
+func (s DenomScaler) FromWei(wei *big.Int) (sdkmath.Int, *big.Int) {
+	units, remainder := new(big.Int).QuoRem(wei, s.factor, new(big.Int))
+	return sdkmath.NewIntFromBigInt(units), remainder
+}
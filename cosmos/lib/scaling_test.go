@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package lib_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DenomScaler", func() {
+	It("should scale a 6 decimals denom", func() {
+		s := cosmlib.NewDenomScaler("ubera", 6)
+		Expect(s.Denom()).To(Equal("ubera"))
+		Expect(s.Factor()).To(Equal(big.NewInt(1e12)))
+		Expect(s.ToWei(sdkmath.NewInt(3))).To(Equal(big.NewInt(3e12)))
+
+		units, remainder := s.FromWei(big.NewInt(3e12 + 7))
+		Expect(units).To(Equal(sdkmath.NewInt(3)))
+		Expect(remainder).To(Equal(big.NewInt(7)))
+	})
+
+	It("should not scale an 18 decimals denom", func() {
+		s := cosmlib.NewDenomScaler("abera", 18)
+		Expect(s.Factor()).To(Equal(big.NewInt(1)))
+
+		units, remainder := s.FromWei(big.NewInt(42))
+		Expect(units).To(Equal(sdkmath.NewInt(42)))
+		Expect(remainder.Sign()).To(BeZero())
+	})
+
+	It("should reject a denom with more decimals than wei", func() {
+		Expect(func() { cosmlib.NewDenomScaler("atto", 19) }).To(Panic())
+	})
+})
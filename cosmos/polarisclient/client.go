@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package polarisclient provides a Go client for Polaris chains. It extends the go-ethereum
+// `ethclient` with typed access to the stateful precompiles, which pass queries through to the
+// Cosmos modules, and to the JSON-RPC namespaces that `ethclient` does not cover.
+package polarisclient
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// Client is an Ethereum client for Polaris chains. As it embeds an `ethclient.Client`, it can be
+// used wherever one is expected, including as the backend of contract bindings.
+type Client struct {
+	*ethclient.Client
+	c *rpc.Client
+}
+
+// Dial connects a client to the given URL.
+func Dial(rawurl string) (*Client, error) {
+	return DialContext(context.Background(), rawurl)
+}
+
+// DialContext connects a client to the given URL with the given context.
+func DialContext(ctx context.Context, rawurl string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(c), nil
+}
+
+// NewClient creates a client that uses the given RPC client.
+func NewClient(c *rpc.Client) *Client {
+	return &Client{
+		Client: ethclient.NewClient(c),
+		c:      c,
+	}
+}
+
+// Close closes the underlying RPC connection.
+func (pc *Client) Close() {
+	pc.c.Close()
+}
+
+// RPC returns the underlying RPC client, for the methods that are not covered by the client.
+func (pc *Client) RPC() *rpc.Client {
+	return pc.c
+}
+
+// TxPoolStatus returns the number of pending and queued transactions in the transaction pool of
+// the node. The `txpool` namespace is an admin module, which is only served on the admin and IPC
+// endpoints of a Polaris node.
+func (pc *Client) TxPoolStatus(ctx context.Context) (uint64, uint64, error) {
+	var status map[string]hexutil.Uint
+	if err := pc.c.CallContext(ctx, &status, "txpool_status"); err != nil {
+		return 0, 0, err
+	}
+	return uint64(status["pending"]), uint64(status["queued"]), nil
+}
+
+// TxPoolContentFrom returns the pending and queued transactions of the given account in the
+// transaction pool of the node, each keyed by its nonce. See TxPoolStatus for where the
+// `txpool` namespace is served.
+func (pc *Client) TxPoolContentFrom(
+	ctx context.Context, account common.Address,
+) (map[uint64]*types.Transaction, map[uint64]*types.Transaction, error) {
+	var content map[string]map[string]*types.Transaction
+	if err := pc.c.CallContext(ctx, &content, "txpool_contentFrom", account); err != nil {
+		return nil, nil, err
+	}
+	pending, err := byNonce(content["pending"])
+	if err != nil {
+		return nil, nil, err
+	}
+	queued, err := byNonce(content["queued"])
+	if err != nil {
+		return nil, nil, err
+	}
+	return pending, queued, nil
+}
+
+// byNonce re-keys the transactions of the transaction pool content, which are keyed by their
+// nonce in decimal, by their nonce.
+func byNonce(txs map[string]*types.Transaction) (map[uint64]*types.Transaction, error) {
+	res := make(map[uint64]*types.Transaction, len(txs))
+	for key, tx := range txs {
+		nonce, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		res[nonce] = tx
+	}
+	return res, nil
+}
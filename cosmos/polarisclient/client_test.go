@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarisclient_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/cosmos/polarisclient"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPolarisClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/polarisclient")
+}
+
+// txPoolAPI serves a fixed transaction pool content.
+type txPoolAPI struct {
+	account common.Address
+	txs     []*types.Transaction
+}
+
+func (api *txPoolAPI) Status() map[string]hexutil.Uint {
+	return map[string]hexutil.Uint{"pending": 2, "queued": 1}
+}
+
+func (api *txPoolAPI) ContentFrom(
+	account common.Address,
+) map[string]map[string]*types.Transaction {
+	content := map[string]map[string]*types.Transaction{"pending": {}, "queued": {}}
+	if account != api.account {
+		return content
+	}
+	content["pending"]["0"] = api.txs[0]
+	content["pending"]["1"] = api.txs[1]
+	content["queued"]["12"] = api.txs[2]
+	return content
+}
+
+var _ = Describe("Client", func() {
+	var (
+		server  *rpc.Server
+		client  *polarisclient.Client
+		account = common.HexToAddress("0x1")
+		txs     []*types.Transaction
+	)
+
+	BeforeEach(func() {
+		for _, nonce := range []uint64{0, 1, 12} {
+			txs = append(txs, types.NewTx(&types.LegacyTx{
+				Nonce:    nonce,
+				GasPrice: big.NewInt(1),
+				Gas:      21000,
+				To:       &account,
+				Value:    big.NewInt(1),
+			}))
+		}
+
+		server = rpc.NewServer()
+		Expect(server.RegisterName("txpool", &txPoolAPI{account: account, txs: txs})).To(Succeed())
+		client = polarisclient.NewClient(rpc.DialInProc(server))
+	})
+
+	AfterEach(func() {
+		client.Close()
+		server.Stop()
+		txs = nil
+	})
+
+	It("should return the transaction pool status", func() {
+		pending, queued, err := client.TxPoolStatus(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(Equal(uint64(2)))
+		Expect(queued).To(Equal(uint64(1)))
+	})
+
+	It("should return the transaction pool content of an account by nonce", func() {
+		pending, queued, err := client.TxPoolContentFrom(context.Background(), account)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(HaveLen(2))
+		Expect(pending[0].Hash()).To(Equal(txs[0].Hash()))
+		Expect(pending[1].Hash()).To(Equal(txs[1].Hash()))
+		Expect(queued).To(HaveLen(1))
+		Expect(queued[12].Hash()).To(Equal(txs[2].Hash()))
+
+		pending, queued, err = client.TxPoolContentFrom(context.Background(), common.Address{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+		Expect(queued).To(BeEmpty())
+	})
+
+	It("should use the addresses of the precompiles installed by the app", func() {
+		Expect(polarisclient.AuthPrecompileAddress).To(Equal(
+			common.HexToAddress("0xBDF49C3C3882102fc017FFb661108c63a836D065")))
+		Expect(polarisclient.BankPrecompileAddress).To(Equal(
+			common.HexToAddress("0x4381dC2aB14285160c808659aEe005D51255adD7")))
+		Expect(polarisclient.GovernancePrecompileAddress).To(Equal(
+			common.HexToAddress("0x7b5Fe22B5446f7C62Ea27B8BD71CeF94e03f3dF2")))
+		Expect(polarisclient.StakingPrecompileAddress).To(Equal(
+			common.HexToAddress("0xd9A998CaC66092748FfEc7cFBD155Aae1737C2fF")))
+
+		bank, err := client.Bank()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bank).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polarisclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/auth"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/distribution"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/erc20"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/governance"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/mint"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/slashing"
+	"pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/staking"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// The addresses of the stateful precompiles installed by the Polaris app.
+var (
+	AuthPrecompileAddress = moduleAddress(authtypes.ModuleName)
+	BankPrecompileAddress = moduleAddress(banktypes.ModuleName)
+	// DistributionPrecompileAddress is not derived from the module name of x/distribution.
+	DistributionPrecompileAddress = common.BytesToAddress([]byte{0x69})
+	// ERC20PrecompileAddress is not derived from the module name of x/erc20.
+	ERC20PrecompileAddress      = common.HexToAddress("0x696969")
+	GovernancePrecompileAddress = moduleAddress(govtypes.ModuleName)
+	MintPrecompileAddress       = moduleAddress(minttypes.ModuleName)
+	SlashingPrecompileAddress   = moduleAddress(slashingtypes.ModuleName)
+	StakingPrecompileAddress    = moduleAddress(stakingtypes.ModuleName)
+)
+
+// moduleAddress returns the address of the precompile of the Cosmos module with the given name.
+func moduleAddress(name string) common.Address {
+	return cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(name))
+}
+
+// Auth returns the binding of the auth precompile.
+func (pc *Client) Auth() (*auth.AuthModule, error) {
+	return auth.NewAuthModule(AuthPrecompileAddress, pc)
+}
+
+// Bank returns the binding of the bank precompile.
+func (pc *Client) Bank() (*bank.BankModule, error) {
+	return bank.NewBankModule(BankPrecompileAddress, pc)
+}
+
+// Distribution returns the binding of the distribution precompile.
+func (pc *Client) Distribution() (*distribution.DistributionModule, error) {
+	return distribution.NewDistributionModule(DistributionPrecompileAddress, pc)
+}
+
+// ERC20 returns the binding of the erc20 precompile.
+func (pc *Client) ERC20() (*erc20.ERC20Module, error) {
+	return erc20.NewERC20Module(ERC20PrecompileAddress, pc)
+}
+
+// Governance returns the binding of the governance precompile.
+func (pc *Client) Governance() (*governance.GovernanceModule, error) {
+	return governance.NewGovernanceModule(GovernancePrecompileAddress, pc)
+}
+
+// Mint returns the binding of the mint precompile.
+func (pc *Client) Mint() (*mint.MintModule, error) {
+	return mint.NewMintModule(MintPrecompileAddress, pc)
+}
+
+// Slashing returns the binding of the slashing precompile.
+func (pc *Client) Slashing() (*slashing.SlashingModule, error) {
+	return slashing.NewSlashingModule(SlashingPrecompileAddress, pc)
+}
+
+// Staking returns the binding of the staking precompile.
+func (pc *Client) Staking() (*staking.StakingModule, error) {
+	return staking.NewStakingModule(StakingPrecompileAddress, pc)
+}
+
+// Bech32Address returns the Cosmos bech32 address of the given account, as converted by the
+// auth module of the chain.
+func (pc *Client) Bech32Address(ctx context.Context, account common.Address) (string, error) {
+	a, err := pc.Auth()
+	if err != nil {
+		return "", err
+	}
+	return a.ConvertHexToBech32(&bind.CallOpts{Context: ctx}, account)
+}
+
+// HexAddress returns the account of the given Cosmos bech32 address.
+func (pc *Client) HexAddress(ctx context.Context, bech32 string) (common.Address, error) {
+	a, err := pc.Auth()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return a.ConvertBech32ToHexAddress(&bind.CallOpts{Context: ctx}, bech32)
+}
+
+// CosmosBalance returns the bank balance of the given account in the given denom.
+func (pc *Client) CosmosBalance(
+	ctx context.Context, account common.Address, denom string,
+) (*big.Int, error) {
+	b, err := pc.Bank()
+	if err != nil {
+		return nil, err
+	}
+	return b.GetBalance(&bind.CallOpts{Context: ctx}, account, denom)
+}
+
+// ERC20AddressForDenom returns the address of the ERC20 token that represents the given Cosmos
+// denom, or the zero address if the denom has no token.
+func (pc *Client) ERC20AddressForDenom(ctx context.Context, denom string) (common.Address, error) {
+	e, err := pc.ERC20()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return e.Erc20AddressForCoinDenom(&bind.CallOpts{Context: ctx}, denom)
+}
+
+// DenomForERC20Address returns the Cosmos denom that represents the given ERC20 token, or an
+// empty string if the token has no denom.
+func (pc *Client) DenomForERC20Address(ctx context.Context, token common.Address) (string, error) {
+	e, err := pc.ERC20()
+	if err != nil {
+		return "", err
+	}
+	return e.CoinDenomForERC20Address(&bind.CallOpts{Context: ctx}, token)
+}
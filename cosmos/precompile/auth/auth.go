@@ -89,6 +89,18 @@ func (c *Contract) PrecompileMethods() ethprecompile.Methods {
 			AbiSig:  "getSendAllowance(address,address,string)",
 			Execute: c.GetSendAllowance,
 		},
+		{
+			AbiSig:  "grant(address,string,uint256)",
+			Execute: c.Grant,
+		},
+		{
+			AbiSig:  "revoke(address,string)",
+			Execute: c.Revoke,
+		},
+		{
+			AbiSig:  "getGrants(address,address)",
+			Execute: c.GetGrants,
+		},
 		{
 			AbiSig:  "getAccountInfo(address)",
 			Execute: c.GetAccountInfoAddrInput,
@@ -225,6 +237,92 @@ func (c *Contract) GetSendAllowance(
 	)
 }
 
+// Grant sends a generic authorization message, from the caller to the grantee, to the authz
+// module.
+func (c *Contract) Grant(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	expiration, ok := utils.GetAs[*big.Int](args[2])
+	if !ok {
+		return nil, precompile.ErrInvalidBigInt
+	}
+
+	return c.grantHelper(
+		ctx,
+		time.Unix(int64(evm.GetContext().Time), 0),
+		cosmlib.AddressToAccAddress(caller),
+		cosmlib.AddressToAccAddress(grantee),
+		msgTypeURL,
+		expiration,
+	)
+}
+
+// Revoke sends a revoke message, for the grant from the caller to the grantee, to the authz
+// module.
+func (c *Contract) Revoke(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	grantee, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	_, err := c.msgServer.Revoke(ctx, &authz.MsgRevoke{
+		Granter:    cosmlib.AddressToAccAddress(caller).String(),
+		Grantee:    cosmlib.AddressToAccAddress(grantee).String(),
+		MsgTypeUrl: msgTypeURL,
+	})
+	return []any{err == nil}, err
+}
+
+// GetGrants returns the unexpired grants from the granter to the grantee.
+func (c *Contract) GetGrants(
+	ctx context.Context,
+	evm ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	granter, ok := utils.GetAs[common.Address](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+	grantee, ok := utils.GetAs[common.Address](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidHexAddress
+	}
+
+	return c.getGrantsHelper(
+		ctx,
+		time.Unix(int64(evm.GetContext().Time), 0),
+		cosmlib.AddressToAccAddress(granter),
+		cosmlib.AddressToAccAddress(grantee),
+	)
+}
+
 // getHighestAllowance returns the highest allowance for a given coin denom.
 func getHighestAllowance(sendAuths []*banktypes.SendAuthorization, coinDenom string) *big.Int {
 	// Init the max to 0.
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/auth"
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
@@ -107,6 +108,84 @@ func (c *Contract) getSendAllownaceHelper(
 	return []any{allowance}, nil
 }
 
+// grantHelper is the helper method to call the grant method on the msgServer, with a generic
+// authorization for the given msg type url.
+func (c *Contract) grantHelper(
+	ctx context.Context,
+	blocktime time.Time,
+	granter, grantee sdk.AccAddress,
+	msgTypeURL string,
+	expiration *big.Int,
+) ([]any, error) {
+	var (
+		grant authz.Grant
+		err   error
+	)
+
+	genericAuth := authz.NewGenericAuthorization(msgTypeURL)
+
+	// If the expiration is 0, then the grant is valid forever, and can be nil.
+	if expiration.Sign() == 0 {
+		grant, err = authz.NewGrant(blocktime, genericAuth, nil)
+	} else {
+		expirationTime := time.Unix(expiration.Int64(), 0)
+		grant, err = authz.NewGrant(blocktime, genericAuth, &expirationTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.msgServer.Grant(ctx, &authz.MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	})
+
+	return []any{err == nil}, err
+}
+
+// getGrantsHelper returns all grants from granter to grantee that have not expired at blocktime.
+func (c *Contract) getGrantsHelper(
+	ctx context.Context,
+	blocktime time.Time,
+	granter, grantee sdk.AccAddress,
+) ([]any, error) {
+	res, err := c.queryServer.Grants(ctx, &authz.QueryGrantsRequest{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]generated.IAuthModuleGrant, 0, len(res.Grants))
+	for _, grant := range res.Grants {
+		// Skip the grants that have already expired.
+		if grant.Expiration != nil && !grant.Expiration.After(blocktime) {
+			continue
+		}
+
+		authorization, ok := utils.GetAs[authz.Authorization](
+			grant.Authorization.GetCachedValue(),
+		)
+		if !ok {
+			return nil, errors.New("invalid authz authorization type")
+		}
+
+		expiration := big.NewInt(0)
+		if grant.Expiration != nil {
+			expiration = big.NewInt(grant.Expiration.Unix())
+		}
+
+		grants = append(grants, generated.IAuthModuleGrant{
+			MsgTypeUrl: authorization.MsgTypeURL(),
+			Expiration: expiration,
+		})
+	}
+
+	return []any{grants}, nil
+}
+
 // acc must be the bech32 encoded address.
 func (c *Contract) accountInfoHelper(
 	ctx context.Context,
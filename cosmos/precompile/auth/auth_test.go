@@ -32,6 +32,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/auth"
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
@@ -373,6 +374,187 @@ var _ = Describe("Address Precompile", func() {
 		})
 	})
 
+	When("Grant", func() {
+		var (
+			evm              *mock.PrecompileEVMMock
+			granter, grantee common.Address
+			msgTypeURL       string
+		)
+
+		BeforeEach(func() {
+			// Genereate an evm where the block time is 100.
+			evm = mock.NewPrecompileEVMMock()
+			evm.GetContextFunc = func() *vm.BlockContext {
+				blockCtx := vm.BlockContext{}
+				blockCtx.Time = 100
+				return &blockCtx
+			}
+
+			granter = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("granter")))
+			grantee = cosmlib.AccAddressToEthAddress(sdk.AccAddress([]byte("grantee")))
+			msgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+		})
+
+		It("should error if invalid grantee", func() {
+			_, err := contract.Grant(
+				ctx,
+				evm,
+				granter,
+				new(big.Int),
+				false,
+				"invalid address",
+				msgTypeURL,
+				new(big.Int),
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+		})
+
+		It("should error if invalid msg type url", func() {
+			_, err := contract.Grant(
+				ctx,
+				evm,
+				granter,
+				new(big.Int),
+				false,
+				grantee,
+				1,
+				new(big.Int),
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidString))
+		})
+
+		It("should error if the expiration is invalid", func() {
+			_, err := contract.Grant(
+				ctx,
+				evm,
+				granter,
+				new(big.Int),
+				false,
+				grantee,
+				msgTypeURL,
+				"invalid expiration",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidBigInt))
+		})
+
+		It("should error if the expiration is before the current block time", func() {
+			_, err := contract.Grant(
+				ctx,
+				evm,
+				granter,
+				new(big.Int),
+				false,
+				grantee,
+				msgTypeURL,
+				big.NewInt(1),
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
+		When("a grant exists", func() {
+			BeforeEach(func() {
+				res, err := contract.Grant(
+					ctx,
+					evm,
+					granter,
+					new(big.Int),
+					false,
+					grantee,
+					msgTypeURL,
+					big.NewInt(110),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]any{true}))
+			})
+
+			It("should get the grants", func() {
+				res, err := contract.GetGrants(
+					ctx,
+					evm,
+					common.Address{},
+					new(big.Int),
+					true,
+					granter,
+					grantee,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]any{[]generated.IAuthModuleGrant{
+					{MsgTypeUrl: msgTypeURL, Expiration: big.NewInt(110)},
+				}}))
+			})
+
+			It("should not get expired grants", func() {
+				evm.GetContextFunc = func() *vm.BlockContext {
+					blockCtx := vm.BlockContext{}
+					blockCtx.Time = 200
+					return &blockCtx
+				}
+				res, err := contract.GetGrants(
+					ctx,
+					evm,
+					common.Address{},
+					new(big.Int),
+					true,
+					granter,
+					grantee,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]any{[]generated.IAuthModuleGrant{}}))
+			})
+
+			It("should error if invalid granter", func() {
+				_, err := contract.GetGrants(
+					ctx,
+					evm,
+					common.Address{},
+					new(big.Int),
+					true,
+					"invalid address",
+					grantee,
+				)
+				Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+			})
+
+			It("should revoke the grant", func() {
+				res, err := contract.Revoke(
+					ctx,
+					evm,
+					granter,
+					new(big.Int),
+					false,
+					grantee,
+					msgTypeURL,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]any{true}))
+
+				res, err = contract.GetGrants(
+					ctx,
+					evm,
+					common.Address{},
+					new(big.Int),
+					true,
+					granter,
+					grantee,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(Equal([]any{[]generated.IAuthModuleGrant{}}))
+			})
+
+			It("should not revoke a grant made by another account", func() {
+				_, err := contract.Revoke(
+					ctx,
+					evm,
+					grantee,
+					new(big.Int),
+					false,
+					granter,
+					msgTypeURL,
+				)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
 
 // TODO: move to utils since also used by bank.
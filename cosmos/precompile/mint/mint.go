@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mint
+
+import (
+	"context"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	libgenerated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/mint"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+)
+
+// Contract is the precompile contract for the mint module. It is read-only.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	querier        minttypes.QueryServer
+	stakingQuerier stakingtypes.QueryServer
+	bankQuerier    banktypes.QueryServer
+}
+
+// NewPrecompileContract returns a new instance of the mint module precompile contract. The staking
+// and bank query servers are used to compute the bonded ratio, which drives the inflation rate.
+func NewPrecompileContract(
+	qs minttypes.QueryServer, sqs stakingtypes.QueryServer, bqs banktypes.QueryServer,
+) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.MintModuleMetaData.ABI,
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(minttypes.ModuleName)),
+		),
+		querier:        qs,
+		stakingQuerier: sqs,
+		bankQuerier:    bqs,
+	}
+}
+
+// PrecompileMethods implements StatefulImpl.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:  "getInflation()",
+			Execute: c.GetInflation,
+		},
+		{
+			AbiSig:  "getAnnualProvisions()",
+			Execute: c.GetAnnualProvisions,
+		},
+		{
+			AbiSig:  "getBondedRatio()",
+			Execute: c.GetBondedRatio,
+		},
+		{
+			AbiSig:  "getBlockProvision()",
+			Execute: c.GetBlockProvision,
+		},
+		{
+			AbiSig:  "getBlocksPerYear()",
+			Execute: c.GetBlocksPerYear,
+		},
+	}
+}
+
+// GetInflation implements `getInflation()` method.
+func (c *Contract) GetInflation(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	res, err := c.querier.Inflation(ctx, &minttypes.QueryInflationRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return []any{res.Inflation.BigInt()}, nil
+}
+
+// GetAnnualProvisions implements `getAnnualProvisions()` method.
+func (c *Contract) GetAnnualProvisions(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	res, err := c.querier.AnnualProvisions(ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return []any{res.AnnualProvisions.BigInt()}, nil
+}
+
+// GetBondedRatio implements `getBondedRatio()` method.
+func (c *Contract) GetBondedRatio(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	ratio, err := c.bondedRatio(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []any{ratio.BigInt()}, nil
+}
+
+// GetBlockProvision implements `getBlockProvision()` method.
+func (c *Contract) GetBlockProvision(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	paramsRes, err := c.querier.Params(ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	provisionsRes, err := c.querier.AnnualProvisions(ctx, &minttypes.QueryAnnualProvisionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the block provision is derived the same way the mint module derives it when minting.
+	minter := minttypes.Minter{AnnualProvisions: provisionsRes.AnnualProvisions}
+	provision := minter.BlockProvision(paramsRes.Params)
+	return []any{libgenerated.CosmosCoin{
+		Denom:  provision.Denom,
+		Amount: provision.Amount.BigInt(),
+	}}, nil
+}
+
+// GetBlocksPerYear implements `getBlocksPerYear()` method.
+func (c *Contract) GetBlocksPerYear(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	res, err := c.querier.Params(ctx, &minttypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return []any{res.Params.BlocksPerYear}, nil
+}
+
+// bondedRatio returns the fraction of the supply of the bond denom which is bonded, as computed
+// by the staking module for the inflation rate.
+func (c *Contract) bondedRatio(ctx context.Context) (sdkmath.LegacyDec, error) {
+	paramsRes, err := c.stakingQuerier.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	poolRes, err := c.stakingQuerier.Pool(ctx, &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	supplyRes, err := c.bankQuerier.SupplyOf(
+		ctx, &banktypes.QuerySupplyOfRequest{Denom: paramsRes.Params.BondDenom},
+	)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if !supplyRes.Amount.Amount.IsPositive() {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+	return sdkmath.LegacyNewDecFromInt(poolRes.Pool.BondedTokens).QuoInt(supplyRes.Amount.Amount), nil
+}
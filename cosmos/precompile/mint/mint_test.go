@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mint_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	libgenerated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/lib"
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/mint"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile/mint"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMintPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/mint")
+}
+
+// mintQuerier serves the given minter and params of the mint module.
+type mintQuerier struct {
+	minttypes.QueryServer
+	minter minttypes.Minter
+	params minttypes.Params
+}
+
+func (q *mintQuerier) Params(
+	context.Context, *minttypes.QueryParamsRequest,
+) (*minttypes.QueryParamsResponse, error) {
+	return &minttypes.QueryParamsResponse{Params: q.params}, nil
+}
+
+func (q *mintQuerier) Inflation(
+	context.Context, *minttypes.QueryInflationRequest,
+) (*minttypes.QueryInflationResponse, error) {
+	return &minttypes.QueryInflationResponse{Inflation: q.minter.Inflation}, nil
+}
+
+func (q *mintQuerier) AnnualProvisions(
+	context.Context, *minttypes.QueryAnnualProvisionsRequest,
+) (*minttypes.QueryAnnualProvisionsResponse, error) {
+	return &minttypes.QueryAnnualProvisionsResponse{
+		AnnualProvisions: q.minter.AnnualProvisions,
+	}, nil
+}
+
+var _ = Describe("Mint Precompile", func() {
+	var (
+		contract *mint.Contract
+		ctx      sdk.Context
+		bk       bankkeeper.BaseKeeper
+		params   minttypes.Params
+	)
+
+	BeforeEach(func() {
+		var sk stakingkeeper.Keeper
+		ctx, _, bk, sk = testutil.SetupMinimalKeepers()
+
+		stakingParams := stakingtypes.DefaultParams()
+		stakingParams.BondDenom = "stake"
+		Expect(sk.SetParams(ctx, stakingParams)).To(Succeed())
+
+		params = minttypes.DefaultParams()
+		params.MintDenom = "stake"
+		minter := minttypes.NewMinter(
+			sdkmath.LegacyMustNewDecFromStr("0.13"),
+			sdkmath.LegacyNewDec(int64(params.BlocksPerYear)*1000),
+		)
+		contract = mint.NewPrecompileContract(
+			&mintQuerier{minter: minter, params: params},
+			stakingkeeper.Querier{Keeper: &sk},
+			bk,
+		)
+	})
+
+	It("should have static registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(minttypes.ModuleName))),
+		)
+	})
+
+	It("should have correct ABI methods", func() {
+		var cAbi abi.ABI
+		err := cAbi.UnmarshalJSON([]byte(generated.MintModuleMetaData.ABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+	})
+
+	It("should match the precompile methods", func() {
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	It("should return the inflation and annual provisions scaled by 1e18", func() {
+		res, err := contract.GetInflation(ctx, nil, common.Address{}, new(big.Int), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res[0]).To(Equal(big.NewInt(130_000_000_000_000_000)))
+
+		res, err = contract.GetAnnualProvisions(ctx, nil, common.Address{}, new(big.Int), true)
+		Expect(err).ToNot(HaveOccurred())
+		expected := new(big.Int).Mul(
+			big.NewInt(int64(params.BlocksPerYear)*1000), big.NewInt(1_000_000_000_000_000_000),
+		)
+		Expect(res[0]).To(Equal(expected))
+	})
+
+	It("should return the block provision and the blocks per year", func() {
+		res, err := contract.GetBlockProvision(ctx, nil, common.Address{}, new(big.Int), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res[0]).To(Equal(libgenerated.CosmosCoin{Denom: "stake", Amount: big.NewInt(1000)}))
+
+		res, err = contract.GetBlocksPerYear(ctx, nil, common.Address{}, new(big.Int), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res[0]).To(Equal(params.BlocksPerYear))
+	})
+
+	When("Calling GetBondedRatio", func() {
+		It("should be zero without any supply", func() {
+			res, err := contract.GetBondedRatio(ctx, nil, common.Address{}, new(big.Int), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res[0]).To(Equal(sdkmath.LegacyZeroDec().BigInt()))
+		})
+
+		It("should return the bonded fraction of the supply", func() {
+			Expect(bk.MintCoins(
+				ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			)).To(Succeed())
+			Expect(bk.MintCoins(
+				ctx, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+			)).To(Succeed())
+
+			res, err := contract.GetBondedRatio(ctx, nil, common.Address{}, new(big.Int), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res[0]).To(Equal(big.NewInt(300_000_000_000_000_000)))
+		})
+	})
+})
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package msgrouter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/msgrouter"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// moduleName is the name whose module account address is the address of the precompile.
+const moduleName = "msgrouter"
+
+var (
+	// ErrMsgNotAllowed is returned if the type of the message is not on the allow-list.
+	ErrMsgNotAllowed = errors.New("message type is not allowed")
+	// ErrInvalidSigner is returned if the caller is not the only signer of the message.
+	ErrInvalidSigner = errors.New("caller must be the only signer of the message")
+	// ErrNoHandler is returned if the msg service router has no handler for the message.
+	ErrNoHandler = errors.New("no handler found for message")
+)
+
+// Contract is the precompile contract that executes allow-listed Cosmos messages via the msg
+// service router.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	cdc     codec.Codec
+	router  baseapp.MessageRouter
+	allowed map[string]struct{}
+}
+
+// NewPrecompileContract returns a new instance of the msg router precompile contract, which
+// executes the messages whose type urls are in `allowList`. The messages are decoded with the
+// interface registry of `cdc`, so the message types must be registered with it. It is not
+// registered by default: chains register it as a custom precompile with the allow-list they need.
+func NewPrecompileContract(
+	cdc codec.Codec, router baseapp.MessageRouter, allowList []string,
+) *Contract {
+	allowed := make(map[string]struct{}, len(allowList))
+	for _, msgTypeURL := range allowList {
+		allowed[msgTypeURL] = struct{}{}
+	}
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.MsgRouterModuleMetaData.ABI,
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(moduleName)),
+		),
+		cdc:     cdc,
+		router:  router,
+		allowed: allowed,
+	}
+}
+
+// PrecompileMethods implements StatefulImpl.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:  "execute(string,bytes)",
+			Execute: c.Execute,
+		},
+		{
+			AbiSig:  "isAllowed(string)",
+			Execute: c.IsAllowed,
+		},
+	}
+}
+
+// Execute implements `execute(string,bytes)` method. The message must have the caller as its
+// only signer. Events emitted while handling the message are not converted to Ethereum logs.
+func (c *Contract) Execute(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	caller common.Address,
+	_ *big.Int,
+	readonly bool,
+	args ...any,
+) ([]any, error) {
+	if readonly {
+		return nil, vm.ErrWriteProtection
+	}
+	msgTypeURL, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	msgBz, ok := utils.GetAs[[]byte](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidBytes
+	}
+	if _, allowed := c.allowed[msgTypeURL]; !allowed {
+		return nil, ErrMsgNotAllowed
+	}
+
+	// Decode the message as an Any, so that its concrete type is resolved by the registry.
+	var msg sdk.Msg
+	if err := c.cdc.InterfaceRegistry().UnpackAny(
+		&codectypes.Any{TypeUrl: msgTypeURL, Value: msgBz}, &msg,
+	); err != nil {
+		return nil, err
+	}
+
+	// The caller must be the only account that authorizes the message.
+	signers, _, err := c.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != 1 || !bytes.Equal(signers[0], cosmlib.AddressToAccAddress(caller)) {
+		return nil, ErrInvalidSigner
+	}
+
+	handler := c.router.Handler(msg)
+	if handler == nil {
+		return nil, ErrNoHandler
+	}
+	res, err := handler(sdk.UnwrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the encoded response of the message, which the handler wraps in an Any.
+	var resBz []byte
+	if msgResponses := res.GetMsgResponses(); len(msgResponses) > 0 {
+		resBz = msgResponses[0].Value
+	}
+	return []any{resBz}, nil
+}
+
+// IsAllowed implements `isAllowed(string)` method.
+func (c *Contract) IsAllowed(
+	_ context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	msgTypeURL, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	_, allowed := c.allowed[msgTypeURL]
+	return []any{allowed}, nil
+}
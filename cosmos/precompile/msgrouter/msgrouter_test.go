@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package msgrouter_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/msgrouter"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/msgrouter"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const denom = "abera"
+
+func TestMsgRouterPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/msgrouter")
+}
+
+var _ = Describe("MsgRouter Precompile", func() {
+	var (
+		contract            *msgrouter.Contract
+		ctx                 sdk.Context
+		bk                  bankkeeper.BaseKeeper
+		caller              common.Address
+		recipient           sdk.AccAddress
+		msgSendTypeURL      string
+		msgMultiSendTypeURL string
+		encodedMsgSend      []byte
+		sendAmount          sdk.Coins
+	)
+
+	BeforeEach(func() {
+		ctx, _, bk, _ = testutil.SetupMinimalKeepers()
+
+		encCfg := testutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+		msr := baseapp.NewMsgServiceRouter()
+		msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(bk))
+
+		msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+		msgMultiSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+		contract = msgrouter.NewPrecompileContract(
+			encCfg.Codec, msr, []string{msgSendTypeURL},
+		)
+
+		caller = common.BytesToAddress([]byte("caller"))
+		recipient = sdk.AccAddress([]byte("recipient"))
+		Expect(cosmlib.MintCoinsToAddress(
+			ctx, bk, govtypes.ModuleName, caller, denom, big.NewInt(100),
+		)).To(Succeed())
+
+		sendAmount = sdk.NewCoins(sdk.NewInt64Coin(denom, 40))
+		var err error
+		encodedMsgSend, err = (&banktypes.MsgSend{
+			FromAddress: cosmlib.AddressToAccAddress(caller).String(),
+			ToAddress:   recipient.String(),
+			Amount:      sendAmount,
+		}).Marshal()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should have static registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress("msgrouter"))),
+		)
+	})
+
+	It("should have correct ABI methods", func() {
+		var cAbi abi.ABI
+		err := cAbi.UnmarshalJSON([]byte(generated.MsgRouterModuleMetaData.ABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+	})
+
+	It("should match the precompile methods", func() {
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	When("calling IsAllowed", func() {
+		It("should fail on invalid inputs", func() {
+			res, err := contract.IsAllowed(ctx, nil, caller, new(big.Int), true, 1)
+			Expect(err).To(MatchError(precompile.ErrInvalidString))
+			Expect(res).To(BeNil())
+		})
+
+		It("should report the allow-listed message types", func() {
+			res, err := contract.IsAllowed(
+				ctx, nil, caller, new(big.Int), true, msgSendTypeURL,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{true}))
+
+			res, err = contract.IsAllowed(
+				ctx, nil, caller, new(big.Int), true, msgMultiSendTypeURL,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{false}))
+		})
+	})
+
+	When("calling Execute", func() {
+		It("should fail in a read-only call", func() {
+			_, err := contract.Execute(
+				ctx, nil, caller, new(big.Int), true, msgSendTypeURL, encodedMsgSend,
+			)
+			Expect(err).To(MatchError(vm.ErrWriteProtection))
+		})
+
+		It("should fail on invalid inputs", func() {
+			_, err := contract.Execute(
+				ctx, nil, caller, new(big.Int), false, 1, encodedMsgSend,
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidString))
+
+			_, err = contract.Execute(
+				ctx, nil, caller, new(big.Int), false, msgSendTypeURL, "invalid",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidBytes))
+		})
+
+		It("should fail if the message type is not allowed", func() {
+			_, err := contract.Execute(
+				ctx, nil, caller, new(big.Int), false, msgMultiSendTypeURL, encodedMsgSend,
+			)
+			Expect(err).To(MatchError(msgrouter.ErrMsgNotAllowed))
+		})
+
+		It("should fail if the message cannot be decoded", func() {
+			_, err := contract.Execute(
+				ctx, nil, caller, new(big.Int), false, msgSendTypeURL, []byte{0xff},
+			)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the caller is not the signer", func() {
+			_, err := contract.Execute(
+				ctx,
+				nil,
+				common.BytesToAddress([]byte("other")),
+				new(big.Int),
+				false,
+				msgSendTypeURL,
+				encodedMsgSend,
+			)
+			Expect(err).To(MatchError(msgrouter.ErrInvalidSigner))
+		})
+
+		It("should execute the message", func() {
+			res, err := contract.Execute(
+				ctx, nil, caller, new(big.Int), false, msgSendTypeURL, encodedMsgSend,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+
+			var msgRes banktypes.MsgSendResponse
+			Expect(msgRes.Unmarshal(res[0].([]byte))).To(Succeed())
+			Expect(bk.GetBalance(ctx, recipient, denom)).To(
+				Equal(sendAmount[0]),
+			)
+			Expect(bk.GetBalance(
+				ctx, cosmlib.AddressToAccAddress(caller), denom,
+			).Amount.Int64()).To(Equal(int64(60)))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package oracle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/oracle"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// moduleName is the name whose module account address is the address of the precompile. It does
+// not depend on the oracle module of the host chain, so that contracts find the precompile at the
+// same address on every chain.
+const moduleName = "oracle"
+
+// ErrNegativePrice is returned if the price feed returns a negative price.
+var ErrNegativePrice = errors.New("oracle price is negative")
+
+// Contract is the precompile contract for the oracle module.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	feed PriceFeed
+}
+
+// NewPrecompileContract returns a new instance of the oracle module precompile contract, which
+// reads the prices of the given feed. It is not registered by default: chains with an oracle
+// module register it as a custom precompile.
+func NewPrecompileContract(feed PriceFeed) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.OracleModuleMetaData.ABI,
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(moduleName)),
+		),
+		feed: feed,
+	}
+}
+
+// PrecompileMethods implements StatefulImpl.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:  "getPrice(string,string)",
+			Execute: c.GetPrice,
+		},
+	}
+}
+
+// GetPrice implements `getPrice(string,string)` method. The price is returned scaled by 1e18,
+// along with the unix time at which it was posted.
+func (c *Contract) GetPrice(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	base, ok := utils.GetAs[string](args[0])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+	quote, ok := utils.GetAs[string](args[1])
+	if !ok {
+		return nil, precompile.ErrInvalidString
+	}
+
+	price, timestamp, err := c.feed.GetPrice(sdk.UnwrapSDKContext(ctx), base, quote)
+	if err != nil {
+		return nil, err
+	}
+	if price.IsNegative() {
+		return nil, ErrNegativePrice
+	}
+
+	// the price is a decimal with 18 digits of precision, so its integer representation is the
+	// price scaled by 1e18.
+	return []any{price.BigInt(), uint64(timestamp.Unix())}, nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package oracle_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/oracle"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/oracle"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOraclePrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/oracle")
+}
+
+// priceFeed is a price feed of fixed prices, keyed by base and quote denom.
+type priceFeed struct {
+	prices map[[2]string]sdkmath.LegacyDec
+	time   time.Time
+}
+
+func (f *priceFeed) GetPrice(
+	_ sdk.Context, base, quote string,
+) (sdkmath.LegacyDec, time.Time, error) {
+	price, found := f.prices[[2]string{base, quote}]
+	if !found {
+		return sdkmath.LegacyDec{}, time.Time{}, errors.New("no price for pair")
+	}
+	return price, f.time, nil
+}
+
+var _ = Describe("Oracle Precompile", func() {
+	var (
+		contract *oracle.Contract
+		feed     *priceFeed
+		ctx      sdk.Context
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext()
+		feed = &priceFeed{
+			prices: map[[2]string]sdkmath.LegacyDec{
+				{"abera", "ausdc"}: sdkmath.LegacyMustNewDecFromStr("1.5"),
+				{"abera", "aneg"}:  sdkmath.LegacyMustNewDecFromStr("-1"),
+			},
+			time: time.Unix(1686700000, 0),
+		}
+		contract = oracle.NewPrecompileContract(feed)
+	})
+
+	It("should have static registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress("oracle"))),
+		)
+	})
+
+	It("should have correct ABI methods", func() {
+		var cAbi abi.ABI
+		err := cAbi.UnmarshalJSON([]byte(generated.OracleModuleMetaData.ABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+	})
+
+	It("should match the precompile methods", func() {
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	When("Calling GetPrice", func() {
+		It("should fail on invalid inputs", func() {
+			res, err := contract.GetPrice(
+				ctx, nil, common.Address{}, new(big.Int), true, 1, "ausdc",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidString))
+			Expect(res).To(BeNil())
+
+			res, err = contract.GetPrice(
+				ctx, nil, common.Address{}, new(big.Int), true, "abera", 1,
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidString))
+			Expect(res).To(BeNil())
+		})
+
+		It("should return the scaled price and its timestamp", func() {
+			res, err := contract.GetPrice(
+				ctx, nil, common.Address{}, new(big.Int), true, "abera", "ausdc",
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(2))
+			Expect(res[0]).To(Equal(big.NewInt(1_500_000_000_000_000_000)))
+			Expect(res[1]).To(Equal(uint64(1686700000)))
+		})
+
+		It("should fail if the pair has no price", func() {
+			res, err := contract.GetPrice(
+				ctx, nil, common.Address{}, new(big.Int), true, "ausdc", "abera",
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+
+		It("should fail on a negative price", func() {
+			res, err := contract.GetPrice(
+				ctx, nil, common.Address{}, new(big.Int), true, "abera", "aneg",
+			)
+			Expect(err).To(MatchError(oracle.ErrNegativePrice))
+			Expect(res).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package precompiletest provides a harness to unit test stateful precompiled contracts without
+// running a full application. The harness calls a precompile through the same container as the
+// EVM, so the inputs and outputs of every call go through the ABI encoding of the contract.
+package precompiletest
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	//nolint:stylecheck,revive // Gomega is the assertion library.
+	. "github.com/onsi/gomega"
+)
+
+// Harness runs the methods of a stateful precompile from ABI encoded inputs.
+type Harness struct {
+	// Ctx is the context the precompile is run with.
+	Ctx sdk.Context
+	// EVM is the EVM the precompile is run with.
+	EVM ethprecompile.EVM
+
+	container vm.PrecompileContainer
+	methods   map[string]abi.Method
+	gasUsed   uint64
+}
+
+// NewHarness builds the container of the given precompile, which must be valid, and returns a
+// harness that runs it with the given context and EVM.
+func NewHarness(
+	ctx sdk.Context, evm ethprecompile.EVM, impl ethprecompile.StatefulImpl,
+) (*Harness, error) {
+	container, err := ethprecompile.NewStatefulFactory().Build(impl, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Harness{
+		Ctx:       ctx,
+		EVM:       evm,
+		container: container,
+		methods:   impl.ABIMethods(),
+	}, nil
+}
+
+// Call ABI encodes the arguments of the method with the given name, runs the precompile with the
+// encoded input and returns the decoded outputs. View methods are run read-only. Overloaded
+// methods are named as in the generated bindings (e.g. `getAccountInfo0`).
+func (h *Harness) Call(caller common.Address, method string, args ...any) ([]any, error) {
+	return h.CallWithValue(caller, new(big.Int), method, args...)
+}
+
+// CallWithValue is Call with the given amount of the native token sent along.
+func (h *Harness) CallWithValue(
+	caller common.Address, value *big.Int, method string, args ...any,
+) ([]any, error) {
+	m, found := h.methods[method]
+	if !found {
+		return nil, fmt.Errorf("method %s is not in the ABI of the precompile", method)
+	}
+	packed, err := m.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	input := append(append([]byte{}, m.ID...), packed...)
+
+	// every call is metered separately, so that its gas consumption can be asserted
+	gm := storetypes.NewInfiniteGasMeter()
+	gm.ConsumeGas(h.container.RequiredGas(input), "RequiredGas")
+	ret, err := h.container.Run(
+		h.Ctx.WithGasMeter(gm), h.EVM, input, caller, value, m.IsConstant(),
+	)
+	h.gasUsed = gm.GasConsumed()
+	if err != nil {
+		return nil, err
+	}
+	return m.Outputs.Unpack(ret)
+}
+
+// GasUsed returns the gas consumed by the last call, including the static gas of the method.
+func (h *Harness) GasUsed() uint64 {
+	return h.gasUsed
+}
+
+// ExpectGasUsedBetween asserts that the last call consumed at least atLeast and at most atMost
+// gas.
+func (h *Harness) ExpectGasUsedBetween(atLeast, atMost uint64) {
+	ExpectWithOffset(1, h.gasUsed).To(
+		And(BeNumerically(">=", atLeast), BeNumerically("<=", atMost)),
+		"gas used by the last call",
+	)
+}
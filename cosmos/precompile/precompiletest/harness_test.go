@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompiletest_test
+
+import (
+	"math/big"
+	"testing"
+
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/bank"
+	"pkg.berachain.dev/polaris/cosmos/precompile/bank"
+	"pkg.berachain.dev/polaris/cosmos/precompile/precompiletest"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPrecompileTest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/precompiletest")
+}
+
+var _ = Describe("Harness", func() {
+	var (
+		h        *precompiletest.Harness
+		keepers  *precompiletest.Keepers
+		alice    = common.BytesToAddress([]byte("alice"))
+		bob      = common.BytesToAddress([]byte("bob"))
+		denom    = "abera"
+		initial  = big.NewInt(100)
+		transfer = big.NewInt(40)
+	)
+
+	BeforeEach(func() {
+		ctx, k := precompiletest.Setup()
+		keepers = k
+		Expect(keepers.FundAccount(ctx, alice, denom, initial)).To(Succeed())
+
+		var err error
+		h, err = precompiletest.NewHarness(
+			ctx,
+			precompiletest.NewEVM(1, 100),
+			bank.NewPrecompileContract(
+				bankkeeper.NewMsgServerImpl(keepers.BankKeeper), keepers.BankKeeper,
+			),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should round trip the inputs and outputs of a view method", func() {
+		res, err := h.Call(alice, "getBalance", alice, denom)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{initial}))
+		h.ExpectGasUsedBetween(1, 100000)
+	})
+
+	It("should run a method that changes state", func() {
+		res, err := h.Call(
+			alice, "send", alice, bob,
+			[]generated.CosmosCoin{{Amount: transfer, Denom: denom}},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{true}))
+		Expect(h.GasUsed()).To(BeNumerically(">", 0))
+
+		res, err = h.Call(bob, "getBalance", bob, denom)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{transfer}))
+	})
+
+	It("should return the error of the precompile", func() {
+		_, err := h.Call(
+			bob, "send", bob, alice,
+			[]generated.CosmosCoin{{Amount: transfer, Denom: denom}},
+		)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail on unknown methods and invalid arguments", func() {
+		_, err := h.Call(alice, "notAMethod")
+		Expect(err).To(HaveOccurred())
+
+		_, err = h.Call(alice, "getBalance", "not an address", denom)
+		Expect(err).To(HaveOccurred())
+	})
+})
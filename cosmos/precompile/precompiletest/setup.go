@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompiletest
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+)
+
+// Keepers are the keepers of a chain whose state is held in memory.
+type Keepers struct {
+	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+	StakingKeeper stakingkeeper.Keeper
+}
+
+// NewContext returns a context at block height 1 over an empty in-memory multistore, for the
+// precompiles that do not need any keeper.
+func NewContext() sdk.Context {
+	return testutil.NewContext().WithBlockHeight(1)
+}
+
+// Setup returns a context at block height 1 and the auth, bank and staking keepers of an in-memory
+// chain.
+func Setup() (sdk.Context, *Keepers) {
+	ctx, ak, bk, sk := testutil.SetupMinimalKeepers()
+	return ctx, &Keepers{
+		AccountKeeper: ak,
+		BankKeeper:    bk,
+		StakingKeeper: sk,
+	}
+}
+
+// FundAccount mints the given amount of denom to the account.
+func (k *Keepers) FundAccount(
+	ctx sdk.Context, account common.Address, denom string, amount *big.Int,
+) error {
+	return cosmlib.MintCoinsToAddress(
+		ctx, k.BankKeeper, evmtypes.ModuleName, account, denom, amount,
+	)
+}
+
+// evm is an EVM that only provides its block context, which is all the precompiles of this
+// repository read from it. Any other method panics.
+type evm struct {
+	ethprecompile.EVM
+
+	blockCtx *vm.BlockContext
+}
+
+// NewEVM returns an EVM at the given block number and time.
+func NewEVM(number, time uint64) ethprecompile.EVM {
+	blockCtx := &vm.BlockContext{}
+	blockCtx.BlockNumber = new(big.Int).SetUint64(number)
+	blockCtx.Time = time
+	return &evm{blockCtx: blockCtx}
+}
+
+// GetContext returns the block context of the EVM.
+func (e *evm) GetContext() *vm.BlockContext {
+	return e.blockCtx
+}
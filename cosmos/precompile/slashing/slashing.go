@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/slashing"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// ErrValidatorNotFound is returned if the requested validator does not exist.
+var ErrValidatorNotFound = errors.New("validator not found")
+
+// Contract is the precompile contract for the slashing module. It is read-only.
+type Contract struct {
+	ethprecompile.BaseContract
+
+	querier slashingtypes.QueryServer
+	sk      StakingKeeper
+}
+
+// NewPrecompileContract returns a new instance of the slashing module precompile contract. The
+// staking keeper is used to find the validators by their operator address.
+func NewPrecompileContract(qs slashingtypes.QueryServer, sk StakingKeeper) *Contract {
+	return &Contract{
+		BaseContract: ethprecompile.NewBaseContract(
+			generated.SlashingModuleMetaData.ABI,
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(slashingtypes.ModuleName)),
+		),
+		querier: qs,
+		sk:      sk,
+	}
+}
+
+// PrecompileMethods implements StatefulImpl.
+func (c *Contract) PrecompileMethods() ethprecompile.Methods {
+	return ethprecompile.Methods{
+		{
+			AbiSig:  "getSigningInfo(address)",
+			Execute: c.GetSigningInfo,
+		},
+		{
+			AbiSig:  "isJailed(address)",
+			Execute: c.IsJailed,
+		},
+		{
+			AbiSig:  "getSignedBlocksWindow()",
+			Execute: c.GetSignedBlocksWindow,
+		},
+	}
+}
+
+// GetSigningInfo implements the `getSigningInfo(address)` method.
+func (c *Contract) GetSigningInfo(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	val, err := c.validator(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.querier.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consAddr).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := res.ValSigningInfo
+	var jailedUntil uint64
+	if unix := info.JailedUntil.Unix(); unix > 0 {
+		jailedUntil = uint64(unix)
+	}
+	return []any{generated.ISlashingModuleSigningInfo{
+		ConsAddress:         cosmlib.ConsAddressToEthAddress(consAddr),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         jailedUntil,
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}}, nil
+}
+
+// IsJailed implements the `isJailed(address)` method.
+func (c *Contract) IsJailed(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	args ...any,
+) ([]any, error) {
+	val, err := c.validator(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return []any{val.IsJailed()}, nil
+}
+
+// GetSignedBlocksWindow implements the `getSignedBlocksWindow()` method.
+func (c *Contract) GetSignedBlocksWindow(
+	ctx context.Context,
+	_ ethprecompile.EVM,
+	_ common.Address,
+	_ *big.Int,
+	_ bool,
+	_ ...any,
+) ([]any, error) {
+	res, err := c.querier.Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return []any{res.Params.SignedBlocksWindow}, nil
+}
+
+// validator returns the validator whose operator address is the given argument.
+func (c *Contract) validator(ctx context.Context, arg any) (stakingtypes.Validator, error) {
+	addr, ok := utils.GetAs[common.Address](arg)
+	if !ok {
+		return stakingtypes.Validator{}, precompile.ErrInvalidHexAddress
+	}
+	valAddr := cosmlib.AddressToValAddress(addr)
+	val, found := c.sk.GetValidator(sdk.UnwrapSDKContext(ctx), valAddr)
+	if !found {
+		return stakingtypes.Validator{}, fmt.Errorf("%w: %s", ErrValidatorNotFound, valAddr)
+	}
+	return val, nil
+}
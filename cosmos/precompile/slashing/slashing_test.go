@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	generated "pkg.berachain.dev/polaris/contracts/bindings/cosmos/precompile/slashing"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/precompile"
+	"pkg.berachain.dev/polaris/cosmos/precompile/slashing"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSlashingPrecompile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/precompile/slashing")
+}
+
+// slashingQuerier serves the given signing infos, keyed by bech32 consensus address.
+type slashingQuerier struct {
+	slashingtypes.QueryServer
+	infos map[string]slashingtypes.ValidatorSigningInfo
+}
+
+func (q *slashingQuerier) SigningInfo(
+	_ context.Context, req *slashingtypes.QuerySigningInfoRequest,
+) (*slashingtypes.QuerySigningInfoResponse, error) {
+	info, found := q.infos[req.ConsAddress]
+	if !found {
+		return nil, errors.New("signing info not found")
+	}
+	return &slashingtypes.QuerySigningInfoResponse{ValSigningInfo: info}, nil
+}
+
+func (q *slashingQuerier) Params(
+	context.Context, *slashingtypes.QueryParamsRequest,
+) (*slashingtypes.QueryParamsResponse, error) {
+	return &slashingtypes.QueryParamsResponse{Params: slashingtypes.DefaultParams()}, nil
+}
+
+var _ = Describe("Slashing Precompile", func() {
+	var (
+		contract  *slashing.Contract
+		querier   *slashingQuerier
+		ctx       sdk.Context
+		sk        stakingkeeper.Keeper
+		validator stakingtypes.Validator
+		valAddr   common.Address
+		consAddr  sdk.ConsAddress
+	)
+
+	BeforeEach(func() {
+		ctx, _, _, sk = testutil.SetupMinimalKeepers()
+
+		pks := simtestutil.CreateTestPubKeys(1)
+		val := sdk.ValAddress(simtestutil.CreateIncrementalAccounts(1)[0])
+		var err error
+		validator, err = stakingtypes.NewValidator(val, pks[0], stakingtypes.Description{})
+		Expect(err).ToNot(HaveOccurred())
+		sk.SetValidator(ctx, validator)
+		valAddr = cosmlib.ValAddressToEthAddress(val)
+		consAddr = sdk.ConsAddress(pks[0].Address())
+
+		querier = &slashingQuerier{infos: map[string]slashingtypes.ValidatorSigningInfo{
+			consAddr.String(): {
+				Address:             consAddr.String(),
+				StartHeight:         5,
+				IndexOffset:         12,
+				JailedUntil:         time.Unix(1686700000, 0),
+				MissedBlocksCounter: 3,
+			},
+		}}
+		contract = slashing.NewPrecompileContract(querier, &sk)
+	})
+
+	It("should have static registry key", func() {
+		Expect(contract.RegistryKey()).To(Equal(
+			cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(slashingtypes.ModuleName))),
+		)
+	})
+
+	It("should have correct ABI methods", func() {
+		var cAbi abi.ABI
+		err := cAbi.UnmarshalJSON([]byte(generated.SlashingModuleMetaData.ABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contract.ABIMethods()).To(Equal(cAbi.Methods))
+	})
+
+	It("should match the precompile methods", func() {
+		Expect(contract.PrecompileMethods()).To(HaveLen(len(contract.ABIMethods())))
+	})
+
+	When("Calling GetSigningInfo", func() {
+		It("should fail on invalid inputs", func() {
+			res, err := contract.GetSigningInfo(
+				ctx, nil, common.Address{}, new(big.Int), true, "invalid",
+			)
+			Expect(err).To(MatchError(precompile.ErrInvalidHexAddress))
+			Expect(res).To(BeNil())
+		})
+
+		It("should fail for an unknown validator", func() {
+			res, err := contract.GetSigningInfo(
+				ctx, nil, common.Address{}, new(big.Int), true, common.Address{0x1},
+			)
+			Expect(err).To(MatchError(slashing.ErrValidatorNotFound))
+			Expect(res).To(BeNil())
+		})
+
+		It("should return the signing info of the validator", func() {
+			res, err := contract.GetSigningInfo(
+				ctx, nil, common.Address{}, new(big.Int), true, valAddr,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{generated.ISlashingModuleSigningInfo{
+				ConsAddress:         cosmlib.ConsAddressToEthAddress(consAddr),
+				StartHeight:         5,
+				IndexOffset:         12,
+				JailedUntil:         1686700000,
+				MissedBlocksCounter: 3,
+			}}))
+		})
+
+		It("should fail if the validator has no signing info", func() {
+			delete(querier.infos, consAddr.String())
+			res, err := contract.GetSigningInfo(
+				ctx, nil, common.Address{}, new(big.Int), true, valAddr,
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
+
+	When("Calling IsJailed", func() {
+		It("should return whether the validator is jailed", func() {
+			res, err := contract.IsJailed(ctx, nil, common.Address{}, new(big.Int), true, valAddr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{false}))
+
+			validator.Jailed = true
+			sk.SetValidator(ctx, validator)
+			res, err = contract.IsJailed(ctx, nil, common.Address{}, new(big.Int), true, valAddr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]any{true}))
+		})
+	})
+
+	It("should return the signed blocks window", func() {
+		res, err := contract.GetSignedBlocksWindow(ctx, nil, common.Address{}, new(big.Int), true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal([]any{slashingtypes.DefaultParams().SignedBlocksWindow}))
+	})
+})
@@ -21,6 +21,7 @@
 package simapp
 
 import (
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -62,9 +63,11 @@ import (
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
+	polarisconfig "pkg.berachain.dev/polaris/cosmos/config"
 	ethcryptocodec "pkg.berachain.dev/polaris/cosmos/crypto/codec"
 	erc20keeper "pkg.berachain.dev/polaris/cosmos/x/erc20/keeper"
 	evmante "pkg.berachain.dev/polaris/cosmos/x/evm/ante"
+	evmcli "pkg.berachain.dev/polaris/cosmos/x/evm/client/cli"
 	evmkeeper "pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
 	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
 )
@@ -75,6 +78,7 @@ var DefaultNodeHome string
 var (
 	_ runtime.AppI            = (*SimApp)(nil)
 	_ servertypes.Application = (*SimApp)(nil)
+	_ evmcli.EVMApp           = (*SimApp)(nil)
 )
 
 // SimApp extends an ABCI application, but with most of its parameters exported.
@@ -108,6 +112,9 @@ type SimApp struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// reloader reloads the runtime settings of the [polaris] section of app.toml.
+	reloader *polarisconfig.Reloader
 }
 
 //nolint:gochecknoinits // from sdk.
@@ -131,10 +138,20 @@ func NewPolarisApp(
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *SimApp {
+	// read the [polaris] section of app.toml, which configures the EVM of the node.
+	polarisCfg, err := polarisconfig.ReadConfig(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if err = polarisCfg.Validate(); err != nil {
+		panic(err)
+	}
+	minTip, _ := polarisCfg.MinTip()
+
 	var (
 		app          = &SimApp{}
 		appBuilder   *runtime.AppBuilder
-		ethTxMempool = evmmempool.NewPolarisEthereumTxPool()
+		ethTxMempool = evmmempool.NewPolarisEthereumTxPool(polarisCfg.MempoolConfig())
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
 			AppConfig,
@@ -234,20 +251,36 @@ func NewPolarisApp(
 		homePath = DefaultNodeHome
 	}
 	// setup evm keeper and all of its plugins.
-	app.EVMKeeper.Setup(
+	if err := app.EVMKeeper.Setup(
 		nil,
 		app.CreateQueryContext,
 		// TODO: clean this up.
 		homePath+"/config/polaris.toml",
 		homePath+"/data/polaris",
 		logger,
+	); err != nil {
+		panic(err)
+	}
+	app.EVMKeeper.SetGasAudit(polarisCfg.GasAudit)
+	if polarisCfg.CallTraces {
+		app.EVMKeeper.EnableCallTraces(polarisCfg.CallTracesRetention)
+	}
+	app.EVMKeeper.SetRPCLimits(polarisCfg.RPCLimits())
+	spamLimits := evmante.NewSpamLimits(polarisCfg.TxPool.MaxPendingTxs, minTip)
+	txSizeLimits := evmante.NewTxSizeLimits(
+		polarisCfg.TxPool.MaxTxSize, polarisCfg.TxPool.MaxCalldata,
 	)
-	opt := ante.HandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		SignModeHandler: app.TxConfig().SignModeHandler(),
-		FeegrantKeeper:  nil,
-		SigGasConsumer:  evmante.SigVerificationGasConsumer,
+	opt := evmante.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: app.TxConfig().SignModeHandler(),
+			FeegrantKeeper:  nil,
+			SigGasConsumer:  evmante.SigVerificationGasConsumer,
+		},
+		EVMKeeper:    app.EVMKeeper,
+		SpamLimits:   spamLimits,
+		TxSizeLimits: txSizeLimits,
 	}
 	ch, _ := evmante.NewAnteHandler(
 		opt,
@@ -257,6 +290,18 @@ func NewPolarisApp(
 	)
 	ethcryptocodec.RegisterInterfaces(app.interfaceRegistry)
 
+	// reload the runtime settings of the [polaris] section of app.toml on SIGHUP.
+	app.reloader = polarisconfig.NewReloader(homePath+"/config/app.toml", polarisCfg, logger)
+	app.reloader.OnReload(func(cfg polarisconfig.Config) {
+		reloadedMinTip, _ := cfg.MinTip()
+		spamLimits.Set(cfg.TxPool.MaxPendingTxs, reloadedMinTip)
+		txSizeLimits.Set(cfg.TxPool.MaxTxSize, cfg.TxPool.MaxCalldata)
+		ethTxMempool.SetConfig(cfg.MempoolConfig())
+		app.EVMKeeper.SetRPCLimits(cfg.RPCLimits())
+		app.EVMKeeper.SetGasAudit(cfg.GasAudit)
+	})
+	app.reloader.Start()
+
 	// ----- END EVM SETUP -------------------------------------------------
 
 	// register streaming services
@@ -290,9 +335,29 @@ func NewPolarisApp(
 		panic(err)
 	}
 
+	// validate the EVM state against the node config before serving it.
+	if loadLatest {
+		genesisFile := filepath.Join(homePath, "config", "genesis.json")
+		if err := app.selfCheck(polarisCfg, appOpts, genesisFile, logger); err != nil {
+			panic(err)
+		}
+	}
+
 	return app
 }
 
+// Close is called by the start command once CometBFT stopped. It drains and stops the EVM
+// JSON-RPC servers and waits for the off-chain index writes before closing the app databases.
+func (app *SimApp) Close() error {
+	app.reloader.Stop()
+	return errors.Join(app.EVMKeeper.Close(), app.App.Close())
+}
+
+// GetEVMKeeper returns the keeper of the evm module, used by the node commands of the module.
+func (app *SimApp) GetEVMKeeper() *evmkeeper.Keeper {
+	return app.EVMKeeper
+}
+
 // Name returns the name of the App.
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
@@ -21,8 +21,10 @@
 package cmd_test
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -86,3 +88,58 @@ var _ = Describe("Home flag registration", func() {
 		Expect(result).To(Equal(homeDir))
 	})
 })
+
+var _ = Describe("Keys command", func() {
+	It("should derive eth_secp256k1 keys on the Ethereum HD path by default", func() {
+		homeDir := GinkgoT().TempDir()
+		execute := func(in string, args ...string) string {
+			out := new(bytes.Buffer)
+			rootCmd := cmd.NewRootCmd()
+			rootCmd.SetIn(strings.NewReader(in))
+			rootCmd.SetOut(out)
+			rootCmd.SetErr(new(bytes.Buffer))
+			rootCmd.SetArgs(append(args,
+				fmt.Sprintf("--%s=%s", flags.FlagHome, homeDir),
+				fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, "test"),
+			))
+			Expect(svrcmd.Execute(rootCmd, "", simapp.DefaultNodeHome)).To(Succeed())
+			return out.String()
+		}
+
+		// The address of the first account of the mnemonic in MetaMask.
+		execute("absurd surge gather author blanket acquire proof struggle runway attract "+
+			"cereal quiz tattoo shed almost sudden survey boring film memory picnic favorite "+
+			"verb tank\n", "keys", "add", "alice", "--recover")
+		Expect(execute("", "keys", "eth", "show", "alice")).
+			To(ContainSubstring("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"))
+	})
+})
+
+var _ = Describe("Debug command", func() {
+	It("should convert addresses between bech32 and hex", func() {
+		convert := func(address string) (string, error) {
+			out := new(bytes.Buffer)
+			rootCmd := cmd.NewRootCmd()
+			rootCmd.SetOut(out)
+			rootCmd.SetErr(new(bytes.Buffer))
+			rootCmd.SetArgs([]string{
+				"debug", "convert-address", address,
+				fmt.Sprintf("--%s=%s", flags.FlagHome, GinkgoT().TempDir()),
+			})
+			return out.String(), svrcmd.Execute(rootCmd, "", simapp.DefaultNodeHome)
+		}
+
+		out, err := convert("0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("eth_address: 0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"))
+		bech32 := strings.Fields(out)[1]
+		Expect(bech32).To(HavePrefix(types.Bech32PrefixAccAddr))
+
+		out, err = convert(bech32)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("eth_address: 0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"))
+
+		_, err = convert("0x20F33CE90A13a4b5E7697E3544c3083B8F8A51D4")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -25,12 +25,17 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	authprecompile "pkg.berachain.dev/polaris/cosmos/precompile/auth"
 	bankprecompile "pkg.berachain.dev/polaris/cosmos/precompile/bank"
 	distrprecompile "pkg.berachain.dev/polaris/cosmos/precompile/distribution"
 	erc20precompile "pkg.berachain.dev/polaris/cosmos/precompile/erc20"
 	govprecompile "pkg.berachain.dev/polaris/cosmos/precompile/governance"
+	mintprecompile "pkg.berachain.dev/polaris/cosmos/precompile/mint"
+	slashingprecompile "pkg.berachain.dev/polaris/cosmos/precompile/slashing"
 	stakingprecompile "pkg.berachain.dev/polaris/cosmos/precompile/staking"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 )
@@ -59,6 +64,14 @@ func PrecompilesToInject(app *SimApp, customPcs ...ethprecompile.Registrable) fu
 				govkeeper.NewMsgServerImpl(app.GovKeeper),
 				govkeeper.NewQueryServer(app.GovKeeper),
 			),
+			mintprecompile.NewPrecompileContract(
+				mintkeeper.NewQueryServerImpl(app.MintKeeper),
+				stakingkeeper.Querier{Keeper: app.StakingKeeper},
+				app.BankKeeper,
+			),
+			slashingprecompile.NewPrecompileContract(
+				slashingkeeper.NewQuerier(app.SlashingKeeper), app.StakingKeeper,
+			),
 			stakingprecompile.NewPrecompileContract(app.StakingKeeper),
 		}...)
 
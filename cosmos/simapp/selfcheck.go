@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	polarisconfig "pkg.berachain.dev/polaris/cosmos/config"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/core"
+)
+
+// checkGenesis is the name of the check of the startup self-check that reads the genesis file.
+const checkGenesis = "genesis"
+
+// selfCheck runs the startup self-check of the EVM on the latest committed height, and logs its
+// findings. It returns the fatal ones in strict mode, so that the node refuses to start rather
+// than serve wrong data.
+func (app *SimApp) selfCheck(
+	cfg polarisconfig.Config, appOpts servertypes.AppOptions, genesisFile string, logger log.Logger,
+) error {
+	if cfg.SelfCheck == polarisconfig.SelfCheckOff {
+		return nil
+	}
+
+	ds := cfg.CheckPruning(appOpts)
+	// a new node has no state to check yet.
+	if height := app.LastBlockHeight(); height > 0 {
+		ctx, err := app.CreateQueryContext(height, false)
+		if err != nil {
+			return err
+		}
+		genesis, err := readEVMGenesis(genesisFile)
+		if err != nil {
+			ds = append(ds, evmtypes.Warning(checkGenesis, err))
+		}
+		ds = append(ds, app.EVMKeeper.SelfCheck(ctx, genesis)...)
+	}
+
+	for _, d := range ds {
+		if d.Severity == evmtypes.SeverityFatal {
+			logger.Error("self-check failed", "check", d.Check, "err", d.Err)
+		} else {
+			logger.Warn("self-check warning", "check", d.Check, "err", d.Err)
+		}
+	}
+	if cfg.SelfCheck == polarisconfig.SelfCheckWarn {
+		return nil
+	}
+	return ds.Err()
+}
+
+// readEVMGenesis returns the genesis of the EVM in the given genesis file.
+func readEVMGenesis(genesisFile string) (*core.Genesis, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+	var appState map[string]json.RawMessage
+	if err = json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, err
+	}
+	bz, ok := appState[evmtypes.ModuleName]
+	if !ok {
+		return nil, fmt.Errorf("no %s genesis in %s", evmtypes.ModuleName, genesisFile)
+	}
+	genesis := new(core.Genesis)
+	if err = genesis.UnmarshalJSON(bz); err != nil {
+		return nil, err
+	}
+	return genesis, nil
+}
@@ -24,7 +24,6 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -74,14 +73,17 @@ func NewTestFixture(t network.TestingT) *TestFixture {
 		t.Fatal(err)
 	}
 
-	apiAddr := strings.Split(net.Validators[0].APIAddress, ":")[1]
+	endpoints, err := network.EVMEndpointsOf(net.Validators[0])
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	// Dial the Ethereum HTTP Endpoint
-	httpAddr := "http:" + apiAddr + ":8545"
+	httpAddr := endpoints.HTTPAddr
 	client, _ := ethclient.DialContext(ctx, httpAddr)
 
 	// Dial the Ethereum WS Endpoint
-	wsaddr := "ws:" + apiAddr + ":8546"
+	wsaddr := endpoints.WsAddr
 	wsClient, _ := ethclient.DialContext(ctx, wsaddr)
 
 	// Build and return the Test Fixture.
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"pkg.berachain.dev/polaris/eth/polar"
+)
+
+// polarisConfigTemplate is the Polaris config written to the home of every validator. It serves
+// the JSON-RPC APIs of the validator on the given local ports, so that several validators of
+// the same process do not collide, and disables the IPC and P2P listeners.
+const polarisConfigTemplate = `[NodeConfig]
+HTTPHost = "127.0.0.1"
+HTTPPort = %d
+WSHost = "127.0.0.1"
+WSPort = %d
+IPCPath = ""
+
+[NodeConfig.P2P]
+ListenAddr = ""
+`
+
+// EVMEndpoints are the addresses of the Ethereum JSON-RPC endpoints served by a validator.
+type EVMEndpoints struct {
+	HTTPAddr string
+	WsAddr   string
+}
+
+// evmAppOptions writes the Polaris config of the validator to its home directory, and returns
+// the app options pointing the app to it.
+func evmAppOptions(val network.ValidatorI) (servertypes.AppOptions, error) {
+	home := val.GetCtx().Config.RootDir
+
+	httpPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	wsPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	config := fmt.Sprintf(polarisConfigTemplate, httpPort, wsPort)
+	if err = os.WriteFile(polarisConfigPath(home), []byte(config), 0o600); err != nil {
+		return nil, err
+	}
+	return sims.AppOptionsMap{flags.FlagHome: home}, nil
+}
+
+// EVMEndpointsOf returns the Ethereum JSON-RPC endpoints served by the given validator.
+func EVMEndpointsOf(val *network.Validator) (*EVMEndpoints, error) {
+	nodeCfg, err := polar.LoadNodeConfigFromFilePath(polarisConfigPath(val.Dir))
+	if err != nil {
+		return nil, err
+	}
+	return &EVMEndpoints{
+		HTTPAddr: fmt.Sprintf("http://%s:%d", nodeCfg.HTTPHost, nodeCfg.HTTPPort),
+		WsAddr:   fmt.Sprintf("ws://%s:%d", nodeCfg.WSHost, nodeCfg.WSPort),
+	}, nil
+}
+
+// DialEVM returns an Ethereum client connected to the HTTP JSON-RPC endpoint of every
+// validator of the network, in the order of its validators.
+func DialEVM(ctx context.Context, nw *network.Network) ([]*ethclient.Client, error) {
+	clients := make([]*ethclient.Client, len(nw.Validators))
+	for i, val := range nw.Validators {
+		endpoints, err := EVMEndpointsOf(val)
+		if err != nil {
+			return nil, err
+		}
+		if clients[i], err = ethclient.DialContext(ctx, endpoints.HTTPAddr); err != nil {
+			return nil, err
+		}
+	}
+	return clients, nil
+}
+
+// polarisConfigPath returns the path of the Polaris config in the given home directory.
+func polarisConfigPath(home string) string {
+	return filepath.Join(home, "config", "polaris.toml")
+}
+
+// freePort returns a local TCP port that is currently unused.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
@@ -35,7 +35,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
-	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -99,6 +98,15 @@ func New(t TestingT, configs ...network.Config) *network.Network {
 	return net
 }
 
+// NewWithValidators creates a network of the given number of validators, each of which serves the
+// Ethereum JSON-RPC APIs on its own endpoints. See EVMEndpointsOf and DialEVM.
+func NewWithValidators(t TestingT, numValidators int) *network.Network {
+	newKey, _ := ethsecp256k1.GenPrivKey()
+	cfg := DefaultConfig(map[string]*ethsecp256k1.PrivKey{"alice": newKey})
+	cfg.NumValidators = numValidators
+	return New(t, cfg)
+}
+
 // DefaultConfig will initialize config for the network with custom application,
 // genesis and single validator. All other parameters are inherited from cosmos-sdk/testutil/network.DefaultConfig.
 func DefaultConfig(keysMap map[string]*ethsecp256k1.PrivKey) network.Config {
@@ -111,8 +119,12 @@ func DefaultConfig(keysMap map[string]*ethsecp256k1.PrivKey) network.Config {
 		InterfaceRegistry: encoding.InterfaceRegistry,
 		AccountRetriever:  authtypes.AccountRetriever{},
 		AppConstructor: func(val network.ValidatorI) servertypes.Application {
+			appOpts, err := evmAppOptions(val)
+			if err != nil {
+				panic(err)
+			}
 			return simapp.NewPolarisApp(
-				val.GetCtx().Logger, cdb.NewMemDB(), nil, true, sims.EmptyAppOptions{},
+				val.GetCtx().Logger, cdb.NewMemDB(), nil, true, appOpts,
 				baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
 				baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
 				baseapp.SetChainID("polaris-2061"),
@@ -21,11 +21,13 @@
 package network_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
 	"pkg.berachain.dev/polaris/cosmos/testing/network"
+	"pkg.berachain.dev/polaris/eth/params"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -57,3 +59,37 @@ var _ = Describe("Network", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
+
+var _ = Describe("Network with multiple validators", func() {
+	const numValidators = 2
+	var net *network.Network
+	BeforeEach(func() {
+		net = network.NewWithValidators(GinkgoT(), numValidators)
+		_, err := net.WaitForHeightWithTimeout(3, defaultTimeout)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should serve the EVM JSON-RPC on every validator", func() {
+		clients, err := network.DialEVM(context.Background(), net)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clients).To(HaveLen(numValidators))
+
+		endpoints := make(map[string]bool)
+		for _, val := range net.Validators {
+			e, err := network.EVMEndpointsOf(val)
+			Expect(err).ToNot(HaveOccurred())
+			endpoints[e.HTTPAddr] = true
+		}
+		Expect(endpoints).To(HaveLen(numValidators))
+
+		for _, client := range clients {
+			chainID, err := client.ChainID(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(chainID.Uint64()).To(Equal(uint64(params.DefaultEIP155ChainID)))
+
+			number, err := client.BlockNumber(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(number).To(BeNumerically(">", 0))
+		}
+	})
+})
@@ -0,0 +1,408 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package statetests runs the `GeneralStateTests` of the ethereum/tests repository against the
+// Polaris EVM, backed by the Cosmos state plugin.
+//
+// The Cosmos state plugin does not maintain a Merkle-Patricia trie, so the post state roots of the
+// fixtures cannot be compared. Instead, a subtest is considered passing if the logs emitted match
+// the expected logs hash and the transaction is rejected if and only if the fixture expects an
+// exception.
+package statetests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethtests "github.com/ethereum/go-ethereum/tests"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/precompile"
+	ethstate "pkg.berachain.dev/polaris/eth/core/state"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+// Status is the outcome of running a single subtest.
+type Status string
+
+const (
+	// StatusPass is returned when the subtest matched the expected post state.
+	StatusPass Status = "pass"
+	// StatusFail is returned when the subtest did not match the expected post state.
+	StatusFail Status = "fail"
+	// StatusSkip is returned when the subtest targets a fork that is not supported.
+	StatusSkip Status = "skip"
+)
+
+// ErrUnsupportedFork is returned when a subtest targets a fork unknown to Go-Ethereum.
+var ErrUnsupportedFork = errors.New("unsupported fork")
+
+type (
+	// StateTest is a single state test fixture, which may expand into many subtests.
+	StateTest struct {
+		Name        string                   `json:"-"`
+		Env         stEnv                    `json:"env"`
+		Pre         core.GenesisAlloc        `json:"pre"`
+		Transaction stTransaction            `json:"transaction"`
+		Post        map[string][]stPostState `json:"post"`
+	}
+
+	// Subtest identifies a single (fork, data, gas, value) combination of a state test.
+	Subtest struct {
+		Fork  string
+		Index int
+	}
+
+	// Result is the outcome of running a single subtest.
+	Result struct {
+		Test   string
+		Fork   string
+		Index  int
+		Status Status
+		Err    error
+	}
+
+	stEnv struct {
+		Coinbase   common.Address        `json:"currentCoinbase"`
+		Difficulty *math.HexOrDecimal256 `json:"currentDifficulty"`
+		Random     *math.HexOrDecimal256 `json:"currentRandom"`
+		GasLimit   math.HexOrDecimal64   `json:"currentGasLimit"`
+		Number     math.HexOrDecimal64   `json:"currentNumber"`
+		Timestamp  math.HexOrDecimal64   `json:"currentTimestamp"`
+		BaseFee    *math.HexOrDecimal256 `json:"currentBaseFee"`
+	}
+
+	stTransaction struct {
+		Data                 []string                `json:"data"`
+		GasLimit             []math.HexOrDecimal64   `json:"gasLimit"`
+		Value                []string                `json:"value"`
+		GasPrice             *math.HexOrDecimal256   `json:"gasPrice"`
+		MaxFeePerGas         *math.HexOrDecimal256   `json:"maxFeePerGas"`
+		MaxPriorityFeePerGas *math.HexOrDecimal256   `json:"maxPriorityFeePerGas"`
+		Nonce                math.HexOrDecimal64     `json:"nonce"`
+		To                   string                  `json:"to"`
+		PrivateKey           hexutil.Bytes           `json:"secretKey"`
+		AccessLists          []*coretypes.AccessList `json:"accessLists"`
+	}
+
+	stPostState struct {
+		Root            common.Hash `json:"hash"`
+		Logs            common.Hash `json:"logs"`
+		ExpectException string      `json:"expectException"`
+		Indexes         struct {
+			Data  int `json:"data"`
+			Gas   int `json:"gas"`
+			Value int `json:"value"`
+		} `json:"indexes"`
+	}
+)
+
+// LoadFile reads all the state tests contained in the fixture file at the given path.
+func LoadFile(path string) ([]*StateTest, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fixtures map[string]*StateTest
+	if err = json.Unmarshal(bz, &fixtures); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	tests := make([]*StateTest, 0, len(fixtures))
+	for name, st := range fixtures {
+		st.Name = name
+		tests = append(tests, st)
+	}
+	sort.Slice(tests, func(i, j int) bool { return tests[i].Name < tests[j].Name })
+	return tests, nil
+}
+
+// LoadDir reads all the state tests contained in the fixture files under the given directory.
+func LoadDir(dir string) ([]*StateTest, error) {
+	var tests []*StateTest
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		fileTests, err := LoadFile(path)
+		if err != nil {
+			return err
+		}
+		tests = append(tests, fileTests...)
+		return nil
+	})
+	return tests, err
+}
+
+// Subtests returns all the subtests of the state test, sorted by fork.
+func (st *StateTest) Subtests() []Subtest {
+	var subtests []Subtest
+	for fork, posts := range st.Post {
+		for i := range posts {
+			subtests = append(subtests, Subtest{Fork: fork, Index: i})
+		}
+	}
+	sort.Slice(subtests, func(i, j int) bool {
+		if subtests[i].Fork != subtests[j].Fork {
+			return subtests[i].Fork < subtests[j].Fork
+		}
+		return subtests[i].Index < subtests[j].Index
+	})
+	return subtests
+}
+
+// Run executes the given subtest against a fresh Cosmos state plugin and returns its result.
+func (st *StateTest) Run(subtest Subtest) *Result {
+	res := &Result{Test: st.Name, Fork: subtest.Fork, Index: subtest.Index}
+
+	chainConfig, ok := gethtests.Forks[subtest.Fork]
+	if !ok {
+		res.Status, res.Err = StatusSkip, fmt.Errorf("%w: %s", ErrUnsupportedFork, subtest.Fork)
+		return res
+	}
+
+	post := st.Post[subtest.Fork][subtest.Index]
+	logs, err := st.execute(chainConfig, post)
+	switch {
+	case post.ExpectException != "" && err == nil:
+		res.Err = fmt.Errorf("expected exception %q, got none", post.ExpectException)
+	case post.ExpectException == "" && err != nil:
+		res.Err = fmt.Errorf("unexpected error: %w", err)
+	case post.ExpectException == "":
+		if got := rlpHash(logs); got != post.Logs {
+			res.Err = fmt.Errorf("logs hash mismatch: got %s, want %s", got, post.Logs)
+		}
+	}
+
+	res.Status = StatusPass
+	if res.Err != nil {
+		res.Status = StatusFail
+	}
+	return res
+}
+
+// execute applies the transaction of the given post state on top of the pre state, returning the
+// logs it emitted or the error if the transaction was rejected.
+func (st *StateTest) execute(
+	chainConfig *params.ChainConfig, post stPostState,
+) ([]*coretypes.Log, error) {
+	ctx, ak, _, _ := testutil.SetupMinimalKeepers()
+	sp := state.NewPlugin(ak, testutil.EvmKey, &logFactory{})
+	sp.Reset(ctx)
+	for addr, acc := range st.Pre {
+		sp.CreateAccount(addr)
+		sp.SetNonce(addr, acc.Nonce)
+		sp.SetBalance(addr, acc.Balance)
+		sp.SetCode(addr, acc.Code)
+		for key, value := range acc.Storage {
+			sp.SetState(addr, key, value)
+		}
+	}
+	sp.Finalize()
+
+	// Execute the transaction in a fresh context, so that it only sees the committed pre state.
+	sp.Reset(ctx)
+	statedb := ethstate.NewStateDB(sp)
+
+	blockCtx := st.blockContext()
+	rules := chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil, blockCtx.Time)
+	pp, err := st.precompiles(&rules)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := st.Transaction.toMessage(post, blockCtx.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	evm := vm.NewGethEVMWithPrecompiles(
+		blockCtx, core.NewEVMTxContext(msg), statedb, chainConfig, vm.Config{}, pp,
+	)
+
+	statedb.SetTxContext(common.Hash{}, 0)
+	snapshot := statedb.Snapshot()
+	gp := new(core.GasPool).AddGas(blockCtx.GasLimit)
+	if _, err = core.ApplyMessage(evm, msg, gp); err != nil {
+		statedb.RevertToSnapshot(snapshot)
+		return nil, err
+	}
+	statedb.Finalise(true)
+
+	return statedb.GetLogs(common.Hash{}, blockCtx.BlockNumber.Uint64(), common.Hash{}), nil
+}
+
+// blockContext builds the block context of the state test from its environment.
+func (st *StateTest) blockContext() vm.BlockContext {
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash: func(n uint64) common.Hash {
+			// Same convention as the reference implementation in Go-Ethereum.
+			return crypto.Keccak256Hash([]byte(new(big.Int).SetUint64(n).String()))
+		},
+		Coinbase:    st.Env.Coinbase,
+		GasLimit:    uint64(st.Env.GasLimit),
+		BlockNumber: new(big.Int).SetUint64(uint64(st.Env.Number)),
+		Time:        uint64(st.Env.Timestamp),
+		Difficulty:  new(big.Int),
+	}
+	if st.Env.Difficulty != nil {
+		blockCtx.Difficulty = (*big.Int)(st.Env.Difficulty)
+	}
+	if st.Env.BaseFee != nil {
+		blockCtx.BaseFee = (*big.Int)(st.Env.BaseFee)
+	}
+	if st.Env.Random != nil {
+		random := common.BigToHash((*big.Int)(st.Env.Random))
+		blockCtx.Random = &random
+		blockCtx.Difficulty = new(big.Int)
+	}
+	return blockCtx
+}
+
+// precompiles builds a precompile plugin with the default precompiles active under the given
+// rules registered.
+func (st *StateTest) precompiles(rules *params.Rules) (precompile.Plugin, error) {
+	pp := precompile.NewDefaultPlugin()
+	factory := precompile.NewStatelessFactory()
+	for _, pc := range precompile.GetDefaultPrecompiles(rules) {
+		container, err := factory.Build(pc, pp)
+		if err != nil {
+			return nil, err
+		}
+		if err = pp.Register(container); err != nil {
+			return nil, err
+		}
+	}
+	return pp, nil
+}
+
+// toMessage builds the message of the given post state, picking its data, gas limit and value.
+func (tx *stTransaction) toMessage(post stPostState, baseFee *big.Int) (*core.Message, error) {
+	idx := post.Indexes
+	if idx.Data >= len(tx.Data) || idx.Gas >= len(tx.GasLimit) || idx.Value >= len(tx.Value) {
+		return nil, fmt.Errorf("post state indexes out of bounds: %+v", idx)
+	}
+
+	key, err := crypto.ToECDSA(tx.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+
+	var to *common.Address
+	if tx.To != "" {
+		addr := common.HexToAddress(tx.To)
+		to = &addr
+	}
+
+	data, err := hexutil.Decode(tx.Data[idx.Data])
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx data %q: %w", tx.Data[idx.Data], err)
+	}
+
+	value := new(big.Int)
+	if raw := tx.Value[idx.Value]; raw != "0x" {
+		var ok bool
+		if value, ok = math.ParseBig256(raw); !ok {
+			return nil, fmt.Errorf("invalid tx value %q", raw)
+		}
+	}
+
+	var accessList coretypes.AccessList
+	if idx.Data < len(tx.AccessLists) && tx.AccessLists[idx.Data] != nil {
+		accessList = *tx.AccessLists[idx.Data]
+	}
+
+	gasPrice, gasFeeCap, gasTipCap, err := tx.prices(baseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.Message{
+		From:       crypto.PubkeyToAddress(key.PublicKey),
+		To:         to,
+		Nonce:      uint64(tx.Nonce),
+		Value:      value,
+		GasLimit:   uint64(tx.GasLimit[idx.Gas]),
+		GasPrice:   gasPrice,
+		GasFeeCap:  gasFeeCap,
+		GasTipCap:  gasTipCap,
+		Data:       data,
+		AccessList: accessList,
+	}, nil
+}
+
+// prices returns the effective gas price, fee cap and tip cap of the transaction.
+func (tx *stTransaction) prices(baseFee *big.Int) (*big.Int, *big.Int, *big.Int, error) {
+	gasPrice := (*big.Int)(tx.GasPrice)
+	if baseFee == nil || tx.MaxFeePerGas == nil {
+		if gasPrice == nil {
+			return nil, nil, nil, errors.New("no gas price provided")
+		}
+		return gasPrice, gasPrice, gasPrice, nil
+	}
+
+	gasFeeCap, gasTipCap := (*big.Int)(tx.MaxFeePerGas), (*big.Int)(tx.MaxFeePerGas)
+	if tx.MaxPriorityFeePerGas != nil {
+		gasTipCap = (*big.Int)(tx.MaxPriorityFeePerGas)
+	}
+	gasPrice = math.BigMin(new(big.Int).Add(gasTipCap, baseFee), gasFeeCap)
+	return gasPrice, gasFeeCap, gasTipCap, nil
+}
+
+// rlpHash returns the hash of the RLP encoding of the given value.
+func rlpHash(x any) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// logFactory rejects all precompile events, since only the stateless precompiles of Go-Ethereum
+// run in the state tests.
+type logFactory struct{}
+
+// Build implements `events.PrecompileLogFactory`.
+func (lf *logFactory) Build(event *sdk.Event) (*coretypes.Log, error) {
+	return nil, fmt.Errorf("unexpected precompile event %s", event.Type)
+}
+
+// String implements `fmt.Stringer`.
+func (r *Result) String() string {
+	return fmt.Sprintf("%s/%s/%d: %s", r.Test, r.Fork, r.Index, r.Status)
+}
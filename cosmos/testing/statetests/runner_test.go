@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package statetests_test
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"pkg.berachain.dev/polaris/cosmos/testing/statetests"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// stateTestsEnv is the environment variable pointing to the `GeneralStateTests` directory of a
+// checkout of ethereum/tests.
+const stateTestsEnv = "POLARIS_STATE_TESTS"
+
+func TestStateTests(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/testing/statetests")
+}
+
+// forkReport tallies the results of the subtests run for a fork.
+type forkReport struct {
+	Pass int
+	Fail int
+	Skip int
+}
+
+var _ = Describe("GeneralStateTests", func() {
+	It("should report the compatibility of the Polaris EVM per fork", func() {
+		dir := os.Getenv(stateTestsEnv)
+		if dir == "" {
+			Skip(stateTestsEnv + " is not set")
+		}
+
+		tests, err := statetests.LoadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tests).ToNot(BeEmpty())
+
+		reports := make(map[string]*forkReport)
+		for _, st := range tests {
+			for _, subtest := range st.Subtests() {
+				res := st.Run(subtest)
+				report, ok := reports[res.Fork]
+				if !ok {
+					report = &forkReport{}
+					reports[res.Fork] = report
+				}
+				switch res.Status {
+				case statetests.StatusPass:
+					report.Pass++
+				case statetests.StatusFail:
+					report.Fail++
+					GinkgoWriter.Printf("%s: %v\n", res, res.Err)
+				case statetests.StatusSkip:
+					report.Skip++
+				}
+			}
+		}
+
+		forks := make([]string, 0, len(reports))
+		for fork := range reports {
+			forks = append(forks, fork)
+		}
+		sort.Strings(forks)
+		for _, fork := range forks {
+			report := reports[fork]
+			GinkgoWriter.Printf(
+				"%-24s pass %6d  fail %6d  skip %6d\n", fork, report.Pass, report.Fail, report.Skip,
+			)
+			AddReportEntry(fork, *report)
+		}
+	})
+})
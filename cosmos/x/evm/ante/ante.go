@@ -30,10 +30,28 @@ import (
 	"pkg.berachain.dev/polaris/lib/errors"
 )
 
+// HandlerOptions are the options required for constructing the Polaris AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// EVMKeeper is used by the Ethereum transaction decorators.
+	EVMKeeper EVMKeeper
+
+	// SpamLimits are the maximum number of pending Ethereum transactions of a sender and the
+	// minimum effective tip of the Ethereum transactions accepted in CheckTx, or nil for no limits.
+	SpamLimits *SpamLimits
+
+	// TxSizeLimits are the maximum size of the Ethereum transactions and of their calldata
+	// accepted in CheckTx, or nil for no limits.
+	TxSizeLimits *TxSizeLimits
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// signer. Ethereum transactions are verified and charged by a dedicated set of
+// decorators instead of the generic Cosmos signature and fee decorators, and are
+// only revalidated against the new state when rechecked.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -46,9 +64,23 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.EVMKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "evm keeper is required for ante builder")
+	}
+
+	// Ethereum transactions are marked with the `ExtensionOptionsEthereumTx` extension option.
+	if options.ExtensionOptionChecker == nil {
+		options.ExtensionOptionChecker = EthereumTxExtensionOptionChecker
+	}
+
+	spamProtection := NewEthSpamProtectionDecorator(
+		options.AccountKeeper, options.EVMKeeper, options.SpamLimits,
+	)
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		NewEthTxValidationDecorator(),
+		NewEthTxSizeDecorator(options.TxSizeLimits),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
@@ -57,8 +89,15 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		antelib.NewIgnoreDecorator[ante.ConsumeTxSizeGasDecorator, *types.WrappedEthereumTransaction](
 			ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		),
-		// EthTransaction can skip deduct fee transactions as they are done in the
-		// StateTransition. // TODO: check to make sure this doesn't cause spam.
+		// EthTransactions have their signature verified once, their nonce checked, their pending
+		// count and tip limited in CheckTx, their intrinsic gas checked and their fee deducted at
+		// the EIP-1559 effective gas price by the decorators below.
+		NewEthSigVerificationDecorator(options.EVMKeeper),
+		NewEthNonceDecorator(options.AccountKeeper),
+		spamProtection,
+		NewEthIntrinsicGasDecorator(options.EVMKeeper),
+		NewEthDeductFeeDecorator(options.EVMKeeper),
+		// EthTransactions skip the generic fee deduction, as it is done above.
 		antelib.NewIgnoreDecorator[ante.DeductFeeDecorator, *types.WrappedEthereumTransaction](
 			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper,
 				options.FeegrantKeeper, options.TxFeeChecker),
@@ -70,8 +109,7 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		antelib.NewIgnoreDecorator[ante.SigGasConsumeDecorator, *types.WrappedEthereumTransaction](
 			ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		),
-		// EthTransaction can skip Signature Verification as we do this in the mempool.
-		// TODO: // check with Marko to make sure this is okay (ties into the one below)
+		// EthTransactions skip the generic signature verification, as it is done above.
 		antelib.NewIgnoreDecorator[ante.SigVerificationDecorator, *types.WrappedEthereumTransaction](
 			ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		),
@@ -82,10 +120,29 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		// By skipping this for Eth Transactions, the Account Seq of the sender does not get updated
 		// in checkState during checkTx, but only in DeliverTx, since we are upping in nonce during the
 		// actual execution of the block and not during the ante handler.
-		// TODO: // check with Marko to make sure this is okay.
 		antelib.NewIgnoreDecorator[ante.IncrementSequenceDecorator, *types.WrappedEthereumTransaction](
 			ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		),
 	}
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+
+	// After a block is committed, only the checks of the EthTransactions that depend on the state
+	// are rerun when rechecking them: their sender is recovered from the sender cache, and their
+	// nonce, tip and balance are checked against the new state. The context is still set up
+	// first, so that they are rechecked with their own gas meter against the block gas limit.
+	recheckDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewEthSigVerificationDecorator(options.EVMKeeper),
+		NewEthNonceDecorator(options.AccountKeeper),
+		spamProtection,
+		NewEthDeductFeeDecorator(options.EVMKeeper),
+	}
+
+	anteHandler := sdk.ChainAnteDecorators(anteDecorators...)
+	recheckHandler := sdk.ChainAnteDecorators(recheckDecorators...)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		if _, isEthTx := getWrappedEthTx(tx); isEthTx && ctx.IsReCheckTx() {
+			return recheckHandler(ctx, tx, simulate)
+		}
+		return anteHandler(ctx, tx, simulate)
+	}, nil
 }
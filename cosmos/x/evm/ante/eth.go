@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	polarcore "pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// EVMKeeper defines the expected EVM keeper used by the Ethereum ante decorators.
+type EVMKeeper interface {
+	GetChainConfig(ctx sdk.Context) *params.ChainConfig
+	GetBaseFee(ctx sdk.Context) (*big.Int, error)
+	GetParams(ctx sdk.Context) *types.Params
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress) *big.Int
+	SubBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error
+}
+
+// EthSigVerificationDecorator recovers the sender of an Ethereum transaction with the signer of
+// the block being built, which also verifies its secp256k1 signature. The decoded transaction,
+// along with its cached sender, is stored in the context for the decorators that follow and for
+// execution.
+type EthSigVerificationDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthSigVerificationDecorator returns a new EthSigVerificationDecorator.
+func NewEthSigVerificationDecorator(ek EVMKeeper) EthSigVerificationDecorator {
+	return EthSigVerificationDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (svd EthSigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	etr, ok := getWrappedEthTx(tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	ethTx := etr.AsTransaction()
+	if ethTx == nil {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "invalid ethereum transaction data")
+	}
+
+	chainConfig := svd.ek.GetChainConfig(ctx)
+	if chainConfig == nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, "chain config not found")
+	}
+
+	// Use the same signer as the state processor, so that the recovered sender is reused during
+	// execution, both from the decoded transaction and from the sender cache.
+	signer := coretypes.NewCachingSigner(coretypes.MakeSigner(
+		chainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()),
+	))
+	sender, err := coretypes.Sender(signer, ethTx)
+	if err != nil {
+		return ctx, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid ethereum signature: %v", err)
+	}
+
+	return next(types.WithVerifiedEthTx(ctx, &types.VerifiedEthTx{
+		Tx:     ethTx,
+		Sender: sender,
+	}), tx, simulate)
+}
+
+// EthIntrinsicGasDecorator ensures that the gas limit of an Ethereum transaction covers its
+// intrinsic gas, which includes the EIP-3860 init code charge once Shanghai is active, and that
+// the init code of a contract creation fits within the max init code size of the x/evm params. It
+// must run after the EthSigVerificationDecorator.
+type EthIntrinsicGasDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthIntrinsicGasDecorator returns a new EthIntrinsicGasDecorator.
+func NewEthIntrinsicGasDecorator(ek EVMKeeper) EthIntrinsicGasDecorator {
+	return EthIntrinsicGasDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (igd EthIntrinsicGasDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	vtx, ok := types.VerifiedEthTxFromContext(ctx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	rules := igd.ek.GetChainConfig(ctx).Rules(
+		big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix()),
+	)
+	ethTx := vtx.Tx
+	if limit := igd.ek.GetParams(ctx).InitCodeSizeLimit(); rules.IsShanghai &&
+		ethTx.To() == nil && len(ethTx.Data()) > limit {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "%v: code size %d limit %d",
+			core.ErrMaxInitCodeSizeExceeded, len(ethTx.Data()), limit,
+		)
+	}
+
+	intrinsicGas, err := core.IntrinsicGas(
+		ethTx.Data(), ethTx.AccessList(), ethTx.To() == nil,
+		rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai,
+	)
+	if err != nil {
+		return ctx, errors.Wrap(sdkerrors.ErrOutOfGas, err.Error())
+	}
+	if ethTx.Gas() < intrinsicGas {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrOutOfGas, "%v: have %d, want %d", core.ErrIntrinsicGas, ethTx.Gas(), intrinsicGas,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthNonceDecorator rejects the Ethereum transactions whose nonce was already used by their
+// sender. It must run after the EthSigVerificationDecorator.
+type EthNonceDecorator struct {
+	ak ante.AccountKeeper
+}
+
+// NewEthNonceDecorator returns a new EthNonceDecorator.
+func NewEthNonceDecorator(ak ante.AccountKeeper) EthNonceDecorator {
+	return EthNonceDecorator{ak: ak}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (nd EthNonceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	vtx, ok := types.VerifiedEthTxFromContext(ctx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	acc := nd.ak.GetAccount(ctx, cosmlib.AddressToAccAddress(vtx.Sender))
+	if acc != nil && vtx.Tx.Nonce() < acc.GetSequence() {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrWrongSequence, "%v: address %s, tx: %d state: %d",
+			core.ErrNonceTooLow, vtx.Sender.Hex(), vtx.Tx.Nonce(), acc.GetSequence(),
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthDeductFeeDecorator checks that the EVM balance of the sender of an Ethereum transaction
+// covers its value and its fee, priced at the EIP-1559 effective gas price of the block being
+// built. It must run after the EthSigVerificationDecorator.
+//
+// The fee is only deducted in CheckTx and ReCheckTx, so that the following transactions of the
+// sender are checked against its remaining balance. It is not deducted when the transaction is
+// executed, nor simulated, as the state transition buys the gas of the transaction itself: a
+// transaction that fails before its state transition, e.g. on a nonce gap, is not charged.
+type EthDeductFeeDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthDeductFeeDecorator returns a new EthDeductFeeDecorator.
+func NewEthDeductFeeDecorator(ek EVMKeeper) EthDeductFeeDecorator {
+	return EthDeductFeeDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (dfd EthDeductFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	vtx, ok := types.VerifiedEthTxFromContext(ctx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee, err := dfd.ek.GetBaseFee(ctx)
+	if err != nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	ethTx := vtx.Tx
+	if baseFee != nil && ethTx.GasFeeCapIntCmp(baseFee) < 0 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "%v: gasFeeCap %s, baseFee %s",
+			core.ErrFeeCapTooLow, ethTx.GasFeeCap(), baseFee,
+		)
+	}
+
+	fee := new(big.Int).Mul(polarcore.EffectiveGasPrice(ethTx, baseFee), new(big.Int).SetUint64(ethTx.Gas()))
+	cost := new(big.Int).Add(fee, ethTx.Value())
+	sender := cosmlib.AddressToAccAddress(vtx.Sender)
+	if balance := dfd.ek.GetBalance(ctx, sender); balance.Cmp(cost) < 0 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%v: address %s have %s want %s",
+			core.ErrInsufficientFunds, vtx.Sender.Hex(), balance, cost,
+		)
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		if err = dfd.ek.SubBalance(ctx, sender, fee); err != nil {
+			return ctx, errors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getWrappedEthTx returns the Ethereum transaction message of the given transaction, if any.
+func getWrappedEthTx(tx sdk.Tx) (*types.WrappedEthereumTransaction, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	return utils.GetAs[*types.WrappedEthereumTransaction](msgs[0])
+}
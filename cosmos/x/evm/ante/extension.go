@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// EthereumTxExtensionOptionChecker is an `ante.ExtensionOptionChecker` that only accepts the
+// `ExtensionOptionsEthereumTx` extension option.
+func EthereumTxExtensionOptionChecker(any *codectypes.Any) bool {
+	_, ok := any.GetCachedValue().(*types.ExtensionOptionsEthereumTx)
+	return ok
+}
+
+// EthTxValidationDecorator ensures that Cosmos transactions wrapping an Ethereum transaction
+// are well formed: they must carry the `ExtensionOptionsEthereumTx` extension option, contain
+// exactly one `WrappedEthereumTransaction` message and have no memo.
+type EthTxValidationDecorator struct{}
+
+// NewEthTxValidationDecorator returns a new EthTxValidationDecorator.
+func NewEthTxValidationDecorator() EthTxValidationDecorator {
+	return EthTxValidationDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (EthTxValidationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	isEthTx := hasEthereumTxExtensionOption(tx)
+	msgs := tx.GetMsgs()
+
+	// Ethereum transactions must never be mixed with other messages.
+	for _, msg := range msgs {
+		if _, ok := utils.GetAs[*types.WrappedEthereumTransaction](msg); ok && !isEthTx {
+			return ctx, errors.Wrap(
+				sdkerrors.ErrInvalidRequest, "ethereum transaction is missing its extension option",
+			)
+		}
+	}
+
+	if !isEthTx {
+		return next(ctx, tx, simulate)
+	}
+
+	if len(msgs) != 1 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "expected 1 ethereum transaction, got %d messages", len(msgs),
+		)
+	}
+	if _, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0]); !ok {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "expected ethereum transaction, got %T", msgs[0],
+		)
+	}
+	if memoTx, ok := utils.GetAs[sdk.TxWithMemo](tx); ok && memoTx.GetMemo() != "" {
+		return ctx, errors.Wrap(sdkerrors.ErrInvalidRequest, "ethereum transactions cannot have a memo")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// hasEthereumTxExtensionOption returns true if the given transaction carries the
+// `ExtensionOptionsEthereumTx` extension option.
+func hasEthereumTxExtensionOption(tx sdk.Tx) bool {
+	extTx, ok := utils.GetAs[ante.HasExtensionOptionsTx](tx)
+	if !ok {
+		return false
+	}
+	for _, opt := range extTx.GetExtensionOptions() {
+		if EthereumTxExtensionOptionChecker(opt) {
+			return true
+		}
+	}
+	return false
+}
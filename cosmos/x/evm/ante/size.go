@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// TxSizeLimits are the limits of the EthTxSizeDecorator, which can be updated while the node runs
+// as they are local to the node.
+type TxSizeLimits struct {
+	// maxTxSize is the maximum size of an encoded transaction, or zero for no limit.
+	maxTxSize atomic.Uint64
+	// maxCalldata is the maximum size of the calldata of a transaction, or zero for no limit.
+	maxCalldata atomic.Uint64
+}
+
+// NewTxSizeLimits returns the given limits of the size of the transactions and of their calldata.
+func NewTxSizeLimits(maxTxSize, maxCalldata uint64) *TxSizeLimits {
+	limits := &TxSizeLimits{}
+	limits.Set(maxTxSize, maxCalldata)
+	return limits
+}
+
+// Set updates the limits, which apply to the transactions checked afterwards.
+func (l *TxSizeLimits) Set(maxTxSize, maxCalldata uint64) {
+	l.maxTxSize.Store(maxTxSize)
+	l.maxCalldata.Store(maxCalldata)
+}
+
+// EthTxSizeDecorator rejects the Ethereum transactions whose encoding or calldata is larger than a
+// maximum with an "oversized data" error, before their signature is recovered. It only applies to
+// CheckTx, as the limits are local to the node, and must run after the EthTxValidationDecorator.
+type EthTxSizeDecorator struct {
+	limits *TxSizeLimits
+}
+
+// NewEthTxSizeDecorator returns a new EthTxSizeDecorator enforcing the given limits, or none if
+// they are nil.
+func NewEthTxSizeDecorator(limits *TxSizeLimits) EthTxSizeDecorator {
+	if limits == nil {
+		limits = NewTxSizeLimits(0, 0)
+	}
+	return EthTxSizeDecorator{limits: limits}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (tsd EthTxSizeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate || !hasEthereumTxExtensionOption(tx) {
+		return next(ctx, tx, simulate)
+	}
+	msg, ok := utils.GetAs[*types.WrappedEthereumTransaction](tx.GetMsgs()[0])
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if maxTxSize := tsd.limits.maxTxSize.Load(); maxTxSize > 0 {
+		if size := uint64(len(msg.Data)); size > maxTxSize {
+			return ctx, errors.Wrapf(
+				sdkerrors.ErrTxTooLarge,
+				"oversized data: transaction size %d, limit %d", size, maxTxSize,
+			)
+		}
+	}
+
+	if maxCalldata := tsd.limits.maxCalldata.Load(); maxCalldata > 0 {
+		ethTx := msg.AsTransaction()
+		if ethTx == nil {
+			return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "invalid ethereum transaction")
+		}
+		if size := uint64(len(ethTx.Data())); size > maxCalldata {
+			return ctx, errors.Wrapf(
+				sdkerrors.ErrTxTooLarge,
+				"oversized data: calldata size %d, limit %d", size, maxCalldata,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
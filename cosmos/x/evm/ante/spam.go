@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"math/big"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/lib/errors"
+)
+
+// SpamLimits are the limits of the EthSpamProtectionDecorator, which can be updated while the node
+// runs as they are local to the node.
+type SpamLimits struct {
+	// maxPendingTxs is the maximum number of pending transactions of a sender, or zero for no
+	// limit.
+	maxPendingTxs atomic.Uint64
+	// minTip is the minimum effective tip of the transactions, or nil for no minimum.
+	minTip atomic.Pointer[big.Int]
+}
+
+// NewSpamLimits returns the given limits of the pending transactions of a sender and of the
+// effective tip of the transactions.
+func NewSpamLimits(maxPendingTxs uint64, minTip *big.Int) *SpamLimits {
+	limits := &SpamLimits{}
+	limits.Set(maxPendingTxs, minTip)
+	return limits
+}
+
+// Set updates the limits, which apply to the transactions checked afterwards.
+func (l *SpamLimits) Set(maxPendingTxs uint64, minTip *big.Int) {
+	l.maxPendingTxs.Store(maxPendingTxs)
+	l.minTip.Store(minTip)
+}
+
+// EthSpamProtectionDecorator prevents a single sender from monopolizing the mempool with Ethereum
+// transactions. It rejects the transactions whose nonce is too far ahead of the nonce of their
+// sender, which bounds the number of its pending transactions, and the ones whose effective tip is
+// below a minimum. It only applies to CheckTx, as the limits are local to the node, and must run
+// after the EthSigVerificationDecorator.
+type EthSpamProtectionDecorator struct {
+	ak     ante.AccountKeeper
+	ek     EVMKeeper
+	limits *SpamLimits
+}
+
+// NewEthSpamProtectionDecorator returns a new EthSpamProtectionDecorator enforcing the given
+// limits, or none if they are nil.
+func NewEthSpamProtectionDecorator(
+	ak ante.AccountKeeper, ek EVMKeeper, limits *SpamLimits,
+) EthSpamProtectionDecorator {
+	if limits == nil {
+		limits = NewSpamLimits(0, nil)
+	}
+	return EthSpamProtectionDecorator{
+		ak:     ak,
+		ek:     ek,
+		limits: limits,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (spd EthSpamProtectionDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	vtx, ok := types.VerifiedEthTxFromContext(ctx)
+	if !ok || !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+	ethTx := vtx.Tx
+
+	if maxPendingTxs := spd.limits.maxPendingTxs.Load(); maxPendingTxs > 0 {
+		var nonce uint64
+		if acc := spd.ak.GetAccount(ctx, cosmlib.AddressToAccAddress(vtx.Sender)); acc != nil {
+			nonce = acc.GetSequence()
+		}
+		if ethTx.Nonce() >= nonce+maxPendingTxs {
+			return ctx, errors.Wrapf(
+				sdkerrors.ErrMempoolIsFull,
+				"address %s has too many pending transactions: nonce %d, max %d",
+				vtx.Sender.Hex(), ethTx.Nonce(), nonce+maxPendingTxs-1,
+			)
+		}
+	}
+
+	if minTip := spd.limits.minTip.Load(); minTip != nil && minTip.Sign() > 0 {
+		baseFee, err := spd.ek.GetBaseFee(ctx)
+		if err != nil {
+			return ctx, errors.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if tip := ethTx.EffectiveGasTipValue(baseFee); tip.Cmp(minTip) < 0 {
+			return ctx, errors.Wrapf(
+				sdkerrors.ErrInsufficientFee, "effective tip %s, minimum %s", tip, minTip,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
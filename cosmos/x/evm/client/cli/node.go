@@ -0,0 +1,523 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	polarisconfig "pkg.berachain.dev/polaris/cosmos/config"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+)
+
+const (
+	flagFrom      = "from"
+	flagTo        = "to"
+	flagDataDir   = "data-dir"
+	flagHeight    = "height"
+	flagAllocOnly = "alloc-only"
+
+	flagTracer       = "tracer"
+	flagTracerConfig = "tracer-config"
+	// structLogger is the value of the tracer flag given without a tracer, which traces the
+	// transactions with the struct logger, as `debug_traceTransaction` does.
+	structLogger = "structLogger"
+
+	// reindexBatchBlocks is the number of blocks reindexed between two progress reports.
+	reindexBatchBlocks = 1000
+	// verifyProgressBlocks is the number of blocks verified between two progress reports.
+	verifyProgressBlocks = 10000
+)
+
+// EVMApp is the application of a node whose EVM data is maintained offline by the node commands.
+type EVMApp interface {
+	servertypes.Application
+
+	// LastBlockHeight returns the height of the latest committed block.
+	LastBlockHeight() int64
+	// CreateQueryContext returns a context on the state committed at the given height.
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+	// GetEVMKeeper returns the keeper of the evm module.
+	GetEVMKeeper() *keeper.Keeper
+}
+
+// NodeCmd returns the commands that maintain the EVM data of the node offline. The node must be
+// stopped, as they open its databases.
+func NodeCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Maintain the EVM data of a stopped node",
+		SuggestionsMinimumDistance: 2, //nolint:gomnd // cosmos-sdk default.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		reindexCmd(appCreator),
+		verifyCmd(appCreator),
+		replayBlockCmd(appCreator),
+		exportStateCmd(appCreator),
+		snapshotCmd(),
+	)
+	return cmd
+}
+
+// reindexCmd returns the command that rebuilds the off-chain index of a range of blocks.
+func reindexCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "Rebuild the off-chain index of the receipts and transactions of a range of blocks",
+		Long: `Rebuild the receipts, logs, transaction lookup entries and contract creations of the
+blocks from --from to --to in the off-chain database, after it was corrupted or to index
+the blocks committed before indexing was enabled. The receipts are rebuilt by replaying
+each block on top of the state of its parent, which must not be pruned, and checked
+against the logs bloom of the block. The hashes of the Cosmos transactions and the call
+traces of the blocks are not rebuilt. The node must be stopped.`,
+		Example: fmt.Sprintf("%s evm reindex --from 1 --to 1000", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			app, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, app.Close()) }()
+
+			from, to, err := blockRange(cmd, app.LastBlockHeight())
+			if err != nil {
+				return err
+			}
+			ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+			if err != nil {
+				return err
+			}
+			for start := from; start <= to; start += reindexBatchBlocks {
+				end := to
+				if to-start >= reindexBatchBlocks {
+					end = start + reindexBatchBlocks - 1
+				}
+				if err = app.GetEVMKeeper().ReindexHistoricalData(ctx, start, end); err != nil {
+					return err
+				}
+				cmd.Printf("reindexed blocks %d to %d\n", start, end)
+				if end == to {
+					break
+				}
+			}
+			return nil
+		},
+	}
+	addBlockRangeFlags(cmd, "reindex")
+	return cmd
+}
+
+// verifyCmd returns the command that checks the integrity of the stored blocks and of their
+// historical data.
+func verifyCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check the integrity of the stored blocks and of their off-chain index",
+		Long: `Walk the blocks from --from to --to and check that each block is stored at its
+height and by its hash, that its parent hash is the hash of the previous block, that
+its transactions root, receipts root, logs bloom and gas used match its stored
+transactions and receipts, and that its transactions are indexed by their hash. The
+findings are reported by block, and the command fails if there is any, e.g. before
+publishing a snapshot of an archive node. The node must be stopped.`,
+		Example: fmt.Sprintf("%s evm verify --from 1", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			app, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, app.Close()) }()
+
+			from, to, err := blockRange(cmd, app.LastBlockHeight())
+			if err != nil {
+				return err
+			}
+			ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+			if err != nil {
+				return err
+			}
+
+			var blocks, findings int
+			if err = app.GetEVMKeeper().CheckBlocks(ctx, from, to,
+				func(number uint64, ds types.Diagnostics) {
+					for _, d := range ds {
+						cmd.Printf("block %d: %s: %v\n", number, d.Check, d.Err)
+					}
+					if len(ds) > 0 {
+						blocks++
+						findings += len(ds)
+					}
+					if (number-from+1)%verifyProgressBlocks == 0 {
+						cmd.PrintErrf("verified blocks %d to %d\n", from, number)
+					}
+				},
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf("verified blocks %d to %d: %d findings in %d blocks\n",
+				from, to, findings, blocks)
+			if findings > 0 {
+				return fmt.Errorf("%d blocks failed the integrity check", blocks)
+			}
+			return nil
+		},
+	}
+	addBlockRangeFlags(cmd, "verify")
+	return cmd
+}
+
+// replayBlockCmd returns the command that replays a block, optionally tracing its transactions.
+func replayBlockCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block <height>",
+		Short: "Replay a block and compare its receipts with the stored ones",
+		Long: `Re-execute the transactions of the block at the given height on top of the state of
+its parent, which must not be pruned, and print the status and gas used of each
+transaction, with its differences from the stored receipt. With --tracer, each
+transaction is traced with the given tracer, e.g. callTracer or prestateTracer, or with
+the struct logger if no tracer is named, and the trace is printed. It is meant to debug
+the blocks on which the nodes disagree, and fails if any receipt differs. The node must
+be stopped.`,
+		Example: fmt.Sprintf("%s evm replay-block 1000 --tracer callTracer", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			number, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[0], err)
+			}
+			traceCfg, err := traceConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			app, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, app.Close()) }()
+
+			ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+			if err != nil {
+				return err
+			}
+			txs, err := app.GetEVMKeeper().ReplayBlock(ctx, number, traceCfg)
+			if err != nil {
+				return err
+			}
+
+			var diverged int
+			for _, tx := range txs {
+				cmd.Printf("tx %d %s: status %d, gas used %d\n",
+					tx.Index, tx.Hash, tx.Receipt.Status, tx.Receipt.GasUsed)
+				for _, diff := range tx.Diffs {
+					cmd.Printf("  %s\n", diff)
+				}
+				if len(tx.Diffs) > 0 {
+					diverged++
+				}
+				if tx.Trace != nil {
+					cmd.Println(string(tx.Trace))
+				}
+			}
+
+			cmd.Printf("replayed block %d: %d of %d receipts differ\n", number, diverged, len(txs))
+			if diverged > 0 {
+				return fmt.Errorf("%d receipts of block %d differ", diverged, number)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(
+		flagTracer, "", "trace the transactions with the given tracer (default: the struct logger)",
+	)
+	cmd.Flags().Lookup(flagTracer).NoOptDefVal = structLogger
+	cmd.Flags().String(flagTracerConfig, "", "JSON config of the tracer")
+	return cmd
+}
+
+// traceConfig returns the trace config of the tracer flags of the given command, nil if the
+// transactions are not traced.
+func traceConfig(cmd *cobra.Command) (*tracers.TraceConfig, error) {
+	if !cmd.Flags().Changed(flagTracer) {
+		return nil, nil //nolint:nilnil // no tracing.
+	}
+	tracer, err := cmd.Flags().GetString(flagTracer)
+	if err != nil {
+		return nil, err
+	}
+	tracerConfig, err := cmd.Flags().GetString(flagTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := new(tracers.TraceConfig)
+	if tracer != structLogger {
+		cfg.Tracer = &tracer
+	}
+	if tracerConfig != "" {
+		if !json.Valid([]byte(tracerConfig)) {
+			return nil, fmt.Errorf("invalid tracer config %q", tracerConfig)
+		}
+		cfg.TracerConfig = json.RawMessage(tracerConfig)
+	}
+	return cfg, nil
+}
+
+// exportStateCmd returns the command that exports the EVM state as a go-ethereum genesis.
+func exportStateCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state <file>",
+		Short: "Export the EVM state at a height as a go-ethereum genesis",
+		Long: `Export the EVM accounts at the given height, with their balance, nonce, code and
+storage, to a go-ethereum genesis.json holding the chain config and the gas limit, base
+fee and time of the block, or with --alloc-only to the alloc of the accounts alone, for
+chain migrations, forks and analysis tools. The balances kept in x/bank are exported.
+The accounts that are empty as defined by EIP-161, or whose address is not an EVM
+address, are left out. The state at the height must not be pruned. The node must be
+stopped.`,
+		Example: fmt.Sprintf("%s evm export-state genesis.json --height 1000", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			app, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, app.Close()) }()
+
+			height := app.LastBlockHeight()
+			if cmd.Flags().Changed(flagHeight) {
+				if height, err = cmd.Flags().GetInt64(flagHeight); err != nil {
+					return err
+				}
+			}
+			if height <= 0 || height > app.LastBlockHeight() {
+				return fmt.Errorf("invalid height %d, the latest block is %d",
+					height, app.LastBlockHeight())
+			}
+			ctx, err := app.CreateQueryContext(height, false)
+			if err != nil {
+				return err
+			}
+			genesis, err := app.GetEVMKeeper().ExportState(ctx)
+			if err != nil {
+				return err
+			}
+
+			allocOnly, err := cmd.Flags().GetBool(flagAllocOnly)
+			if err != nil {
+				return err
+			}
+			var out any = genesis
+			if allocOnly {
+				out = genesis.Alloc
+			}
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err = os.WriteFile(args[0], bz, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("exported %d accounts at height %d to %s\n",
+				len(genesis.Alloc), height, args[0])
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "height of the state to export (default: the latest block)")
+	cmd.Flags().Bool(flagAllocOnly, false, "export the alloc of the accounts alone")
+	return cmd
+}
+
+// snapshotCmd returns the commands that export and import snapshots of the off-chain database.
+func snapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export and import snapshots of the off-chain database of a stopped node",
+		Long: `Export and import the off-chain database of the EVM, which holds the receipts, logs,
+transaction and contract indexes, call traces and bloom bits index, as a portable
+snapshot, so that a new RPC node bootstraps from a state sync or a snapshot of the app
+without reindexing all the blocks. The node must be stopped.`,
+		SuggestionsMinimumDistance: 2, //nolint:gomnd // cosmos-sdk default.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		snapshotExportCmd(),
+		snapshotImportCmd(),
+	)
+	cmd.PersistentFlags().String(
+		flagDataDir, "", "polaris data directory of the node (default: <home>/data/polaris)",
+	)
+	return cmd
+}
+
+// snapshotExportCmd returns the command that exports a snapshot of the off-chain database.
+func snapshotExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "export <file>",
+		Short:   "Export the off-chain database to a snapshot file",
+		Example: fmt.Sprintf("%s evm snapshot export offchain.snapshot.gz", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			db, err := openOffchainDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, db.Close()) }()
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			info, err := historical.ExportSnapshot(db, file)
+			if err = errors.Join(err, file.Close()); err != nil {
+				return errors.Join(err, os.Remove(args[0]))
+			}
+			cmd.Printf("exported %d entries to %s\n", info.Entries, args[0])
+			printIndexedHeight(cmd, info)
+			return nil
+		},
+	}
+}
+
+// snapshotImportCmd returns the command that imports a snapshot into an empty off-chain database.
+func snapshotImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a snapshot file into the empty off-chain database",
+		Long: `Import a snapshot exported by "evm snapshot export" into the off-chain database,
+which must be empty. The app of the node must be at or after the indexed height of the
+snapshot, e.g. restored from a snapshot taken at the same height, or the blocks after
+the indexed height must be reindexed with "evm reindex". If the snapshot is invalid, the
+off-chain database is left empty, so that the import can be retried. If the import is
+interrupted, the off-chain database must be deleted before it is retried.`,
+		Example: fmt.Sprintf("%s evm snapshot import offchain.snapshot.gz", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, file.Close()) }()
+
+			db, err := openOffchainDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() { err = errors.Join(err, db.Close()) }()
+
+			info, err := historical.ImportSnapshot(db, file)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("imported %d entries from %s\n", info.Entries, args[0])
+			printIndexedHeight(cmd, info)
+			return nil
+		},
+	}
+}
+
+// printIndexedHeight prints the indexed height of the given snapshot.
+func printIndexedHeight(cmd *cobra.Command, info historical.SnapshotInfo) {
+	if info.HasHeight {
+		cmd.Printf("indexed height: %d\n", info.IndexedHeight)
+	} else {
+		cmd.Println("indexed height: unknown")
+	}
+}
+
+// openOffchainDB opens the off-chain database of the node, in the data directory of the flags of
+// the given command.
+func openOffchainDB(cmd *cobra.Command) (dbm.DB, error) {
+	dataDir, err := cmd.Flags().GetString(flagDataDir)
+	if err != nil {
+		return nil, err
+	}
+	if dataDir == "" {
+		dataDir = filepath.Join(server.GetServerContextFromCmd(cmd).Config.RootDir, "data", "polaris")
+	}
+	return dbm.NewGoLevelDB(keeper.OffchainDBName, dataDir, nil)
+}
+
+// addBlockRangeFlags adds the flags of the range of blocks that the given command operates on.
+func addBlockRangeFlags(cmd *cobra.Command, verb string) {
+	cmd.Flags().Uint64(flagFrom, 0, fmt.Sprintf("first block to %s", verb))
+	cmd.Flags().Uint64(
+		flagTo, 0, fmt.Sprintf("last block to %s (default: the latest block)", verb),
+	)
+}
+
+// blockRange returns the range of blocks of the flags of the given command, which must be at or
+// before the given latest height.
+func blockRange(cmd *cobra.Command, height int64) (uint64, uint64, error) {
+	from, err := cmd.Flags().GetUint64(flagFrom)
+	if err != nil {
+		return 0, 0, err
+	}
+	to := uint64(height)
+	if cmd.Flags().Changed(flagTo) {
+		if to, err = cmd.Flags().GetUint64(flagTo); err != nil {
+			return 0, 0, err
+		}
+	}
+	if from > to || to > uint64(height) {
+		return 0, 0, fmt.Errorf("invalid blocks %d to %d, the latest block is %d", from, to, height)
+	}
+	return from, to, nil
+}
+
+// openApp opens the application of the node from its home directory, at its latest height.
+func openApp(cmd *cobra.Command, appCreator servertypes.AppCreator) (EVMApp, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	// the data being repaired may be what fails the startup self-check.
+	serverCtx.Viper.Set(polarisconfig.FlagSelfCheck, polarisconfig.SelfCheckWarn)
+
+	db, err := dbm.NewDB(
+		"application", server.GetAppDBBackend(serverCtx.Viper),
+		filepath.Join(serverCtx.Config.RootDir, "data"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	evmApp, ok := app.(EVMApp)
+	if !ok {
+		return nil, errors.Join(errors.New("the app has no evm module"), app.Close())
+	}
+	return evmApp, nil
+}
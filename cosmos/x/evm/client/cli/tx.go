@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"pkg.berachain.dev/polaris/cosmos/crypto/ledger"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/accounts"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+)
+
+const (
+	flagHDPath    = "hd-path"
+	flagData      = "data"
+	flagGasLimit  = "gas-limit"
+	flagGasTipCap = "gas-tip-cap"
+	flagGasFeeCap = "gas-fee-cap"
+
+	// defaultHDPath is the derivation path of the first account of the Ethereum app of Ledgers.
+	defaultHDPath = "m/44'/60'/0'/0/0"
+)
+
+// GetTxCmd returns the transaction commands of the evm module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "EVM transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2, //nolint:gomnd // cosmos-sdk default.
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		ledgerAddressCmd(),
+		sendCmd(),
+		signTypedDataCmd(),
+	)
+	return cmd
+}
+
+// ledgerAddressCmd returns the command that shows the address of a key of the Ledger.
+func ledgerAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger-address",
+		Short: "Show the address of a key of the Ethereum app of a Ledger",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, err := hdPath(cmd)
+			if err != nil {
+				return err
+			}
+			device, err := ledger.Open()
+			if err != nil {
+				return err
+			}
+			defer device.Close()
+
+			address, err := device.Address(path)
+			if err != nil {
+				return err
+			}
+			cmd.Printf(
+				"address: %s\neth_address: %s\n", cosmlib.AddressToAccAddress(address), address,
+			)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagHDPath, defaultHDPath, "derivation path of the key")
+	return cmd
+}
+
+// sendCmd returns the command that sends an Ethereum transaction signed with a Ledger.
+func sendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send <to> <value>",
+		Short: "Send an Ethereum transaction signed with the Ethereum app of a Ledger",
+		Long: `Send an EIP-1559 transaction of the given value in wei, with optional call data,
+signed with the Ethereum app of a Ledger device once confirmed on it. The nonce, the
+chain id, the gas limit and the fee caps are queried from the node unless given.`,
+		Args: cobra.ExactArgs(2), //nolint:gomnd // to and value.
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			to, err := cosmlib.AccAddressFromString(args[0])
+			if err != nil {
+				return err
+			}
+			value, ok := new(big.Int).SetString(args[1], 10) //nolint:gomnd // decimal.
+			if !ok {
+				return fmt.Errorf("invalid value: %s", args[1])
+			}
+			path, err := hdPath(cmd)
+			if err != nil {
+				return err
+			}
+			device, err := ledger.Open()
+			if err != nil {
+				return err
+			}
+			defer device.Close()
+			from, err := device.Address(path)
+			if err != nil {
+				return err
+			}
+
+			txData, chainID, err := buildTx(cmd, clientCtx, from, cosmlib.AccAddressToEthAddress(to), value)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrln("Confirm the transaction on the Ledger...")
+			tx, err := device.SignTx(path, coretypes.NewTx(txData), chainID)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := txpool.SerializeToBytes(clientCtx, tx)
+			if err != nil {
+				return err
+			}
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			cmd.PrintErrf("eth_tx_hash: %s\n", tx.Hash())
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagHDPath, defaultHDPath, "derivation path of the signing key")
+	cmd.Flags().String(flagData, "", "hex encoded call data of the transaction")
+	cmd.Flags().Uint64(flagGasLimit, 0, "gas limit of the transaction (estimated if 0)")
+	cmd.Flags().String(flagGasTipCap, "", "max priority fee per gas in wei (1 gwei if empty)")
+	cmd.Flags().String(
+		flagGasFeeCap, "", "max fee per gas in wei (twice the base fee plus the tip cap if empty)",
+	)
+	cmd.Flags().String(flags.FlagBroadcastMode, flags.BroadcastSync, "sync or async")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// signTypedDataCmd returns the command that signs EIP-712 typed data with a Ledger.
+func signTypedDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-typed-data <file>",
+		Short: "Sign EIP-712 typed data with the Ethereum app of a Ledger",
+		Long: `Sign the EIP-712 typed data of the given JSON file, as accepted by eth_signTypedData_v4,
+with the Ethereum app of a Ledger device once confirmed on it, and print the signature.
+The device shows the hashes of the domain and of the message, which must be checked
+against the ones printed before signing.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var typedData apitypes.TypedData
+			if err = json.Unmarshal(bz, &typedData); err != nil {
+				return err
+			}
+			domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+			if err != nil {
+				return err
+			}
+			messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+			if err != nil {
+				return err
+			}
+
+			path, err := hdPath(cmd)
+			if err != nil {
+				return err
+			}
+			device, err := ledger.Open()
+			if err != nil {
+				return err
+			}
+			defer device.Close()
+
+			cmd.PrintErrf(
+				"Confirm the domain hash %s and the message hash %s on the Ledger...\n",
+				domainSeparator, messageHash,
+			)
+			sig, err := device.SignTypedData(
+				path, common.BytesToHash(domainSeparator), common.BytesToHash(messageHash),
+			)
+			if err != nil {
+				return err
+			}
+			cmd.Println(hexutil.Encode(sig))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagHDPath, defaultHDPath, "derivation path of the signing key")
+	return cmd
+}
+
+// buildTx returns the unsigned EIP-1559 transaction sent by the send command, and the chain id
+// it is signed for, querying the node for the values that were not given by flags.
+func buildTx(
+	cmd *cobra.Command, clientCtx client.Context, from, to common.Address, value *big.Int,
+) (*coretypes.DynamicFeeTx, *big.Int, error) {
+	queryClient := types.NewQueryServiceClient(clientCtx)
+	ctx := cmd.Context()
+
+	paramsRes, err := queryClient.Params(ctx, &types.ParamsRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	var chainConfig params.ChainConfig
+	if err = json.Unmarshal(paramsRes.ChainConfig, &chainConfig); err != nil {
+		return nil, nil, err
+	}
+	if chainConfig.ChainID == nil {
+		return nil, nil, errors.New("the node did not return a chain id")
+	}
+
+	// The nonce of Ethereum transactions is the sequence of the account.
+	_, nonce, err := clientCtx.AccountRetriever.GetAccountNumberSequence(
+		clientCtx, cosmlib.AddressToAccAddress(from),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataHex, _ := cmd.Flags().GetString(flagData)
+	var data []byte
+	if dataHex != "" {
+		if data, err = hexutil.Decode(dataHex); err != nil {
+			return nil, nil, fmt.Errorf("invalid data: %w", err)
+		}
+	}
+
+	gas, _ := cmd.Flags().GetUint64(flagGasLimit)
+	if gas == 0 {
+		input := hexutil.Bytes(data)
+		args, err := json.Marshal(polarapi.TransactionArgs{
+			From: &from, To: &to, Value: (*hexutil.Big)(value), Input: &input,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		res, err := queryClient.EstimateGas(ctx, &types.EthCallRequest{Args: args})
+		if err != nil {
+			return nil, nil, err
+		}
+		gas = res.Gas
+	}
+
+	tip, err := weiFlag(cmd, flagGasTipCap, big.NewInt(params.GWei))
+	if err != nil {
+		return nil, nil, err
+	}
+	feeCap, err := weiFlag(cmd, flagGasFeeCap, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if feeCap == nil {
+		res, err := queryClient.BaseFee(ctx, &types.BaseFeeRequest{})
+		if err != nil {
+			return nil, nil, err
+		}
+		baseFee, ok := new(big.Int).SetString(res.BaseFee, 10) //nolint:gomnd // decimal.
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid base fee: %s", res.BaseFee)
+		}
+		feeCap = new(big.Int).Add(tip, new(big.Int).Lsh(baseFee, 1))
+	}
+
+	return &coretypes.DynamicFeeTx{
+		ChainID:   chainConfig.ChainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}, chainConfig.ChainID, nil
+}
+
+// hdPath returns the derivation path of the hd-path flag.
+func hdPath(cmd *cobra.Command) (accounts.DerivationPath, error) {
+	path, _ := cmd.Flags().GetString(flagHDPath)
+	return accounts.ParseDerivationPath(path)
+}
+
+// weiFlag returns the amount of wei of the given flag, or the given default if it is not set.
+func weiFlag(cmd *cobra.Command, name string, defaultValue *big.Int) (*big.Int, error) {
+	amount, _ := cmd.Flags().GetString(name)
+	if amount == "" {
+		return defaultValue, nil
+	}
+	wei, ok := new(big.Int).SetString(amount, 10) //nolint:gomnd // decimal.
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: %s", name, amount)
+	}
+	return wei, nil
+}
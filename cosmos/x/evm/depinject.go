@@ -52,8 +52,10 @@ type DepInjectInput struct {
 	Mempool           sdkmempool.Mempool
 	CustomPrecompiles func() *ethprecompile.Injector `optional:"true"`
 
-	AccountKeeper AccountKeeper
-	StakingKeeper StakingKeeper
+	AccountKeeper      AccountKeeper
+	StakingKeeper      StakingKeeper
+	BankKeeper         BankKeeper         `optional:"true"`
+	DistributionKeeper DistributionKeeper `optional:"true"`
 }
 
 // DepInjectOutput is the output for the dep inject framework.
@@ -86,6 +88,13 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.CustomPrecompiles,
 	)
 
+	if in.BankKeeper != nil {
+		k.SetHoldingsKeeper(in.BankKeeper)
+	}
+	if in.DistributionKeeper != nil {
+		k.SetCommunityPoolKeeper(in.DistributionKeeper)
+	}
+
 	m := NewAppModule(k, in.AccountKeeper)
 
 	return DepInjectOutput{Keeper: k, Module: m}
@@ -38,6 +38,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 
@@ -66,12 +67,14 @@ var _ = Describe("", func() {
 			ak, sk,
 			storetypes.NewKVStoreKey("evm"),
 			"authority",
-			evmmempool.NewPolarisEthereumTxPool(),
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
 			},
 		)
-		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		Expect(k.Setup(
+			storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger(),
+		)).To(Succeed())
 
 		am = evm.NewAppModule(k, ak)
 	})
@@ -145,6 +148,31 @@ var _ = Describe("", func() {
 		})
 	})
 
+	Context("On InitGenesis with a chain ID registry", func() {
+		It("should accept the evm chain ID of the cosmos chain-id", func() {
+			k.SetChainIDRegistry(types.ChainIDRegistry{"polaris-2061": 2061})
+			Expect(k.InitGenesis(ctx.WithChainID("polaris-2061"), ethGen)).To(Succeed())
+		})
+
+		It("should reject the evm chain ID of another cosmos chain-id", func() {
+			k.SetChainIDRegistry(types.ChainIDRegistry{"polaris-2061": 2061, "polaris-2062": 2062})
+			Expect(k.InitGenesis(ctx.WithChainID("polaris-2062"), ethGen)).
+				To(MatchError(types.ErrChainIDMismatch))
+		})
+
+		It("should reject a cosmos chain-id that is not in the registry", func() {
+			k.SetChainIDRegistry(types.ChainIDRegistry{"polaris-2061": 2061})
+			Expect(k.InitGenesis(ctx.WithChainID("polaris-1"), ethGen)).
+				To(MatchError(types.ErrUnknownChainID))
+		})
+
+		It("should panic on an invalid registry", func() {
+			Expect(func() {
+				k.SetChainIDRegistry(types.ChainIDRegistry{"polaris-1": 1})
+			}).To(Panic())
+		})
+	})
+
 	Context("On ExportGenesis", func() {
 		var (
 			actualGenesis core.Genesis
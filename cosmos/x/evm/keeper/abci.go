@@ -24,10 +24,43 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+const (
+	// maxPrunedSlotsPerBlock is the maximum number of storage slots of destroyed contracts that
+	// are scanned for pruning at the end of every block.
+	maxPrunedSlotsPerBlock = 1000
+	// maxPrunedAccountsPerBlock is the maximum number of destroyed contracts whose storage is
+	// pruned at the end of every block.
+	maxPrunedAccountsPerBlock = 100
 )
 
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	sCtx := sdk.UnwrapSDKContext(ctx)
+	// On the first block after startup, write the historical data that was lost when the node
+	// stopped. It is off-chain data only, so a block that cannot be replayed, e.g. because its
+	// state was pruned, must not halt consensus: it is left pending for `reindex` to rebuild.
+	if !k.historyReplayed {
+		if err := k.replayHistoricalData(ctx); err != nil {
+			k.Logger(sCtx).Error("failed to replay the historical data lost on shutdown", "err", err)
+		}
+		k.historyReplayed = true
+	}
+	// On the first block after startup, ensure that the chain config uses the EVM chain ID of the
+	// Cosmos chain-id.
+	if k.chainIDs != nil && !k.chainIDValidated {
+		cp := k.host.GetConfigurationPlugin()
+		cp.Prepare(ctx)
+		if err := k.chainIDs.ValidateChainConfig(sCtx.ChainID(), cp.ChainConfig()); err != nil {
+			return err
+		}
+		k.chainIDValidated = true
+	}
 	// Prepare the Polaris Ethereum block.
 	k.polaris.Prepare(ctx, uint64(sCtx.BlockHeight()))
 	return nil
@@ -35,5 +68,25 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
 	// Finalize the Polaris Ethereum block.
-	return k.polaris.Finalize(ctx)
+	if err := k.polaris.Finalize(ctx); err != nil {
+		return err
+	}
+
+	// Prune some of the storage left behind by destroyed contracts, bounding the work done per
+	// block regardless of the size of their storage.
+	state.PruneStorage(
+		sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey),
+		maxPrunedSlotsPerBlock, maxPrunedAccountsPerBlock,
+	)
+	return nil
+}
+
+// replayHistoricalData replays the blocks whose receipts and transaction lookup entries were not
+// written to the off-chain database before the node stopped.
+func (k *Keeper) replayHistoricalData(ctx context.Context) error {
+	hp := utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin())
+	hp.Prepare(ctx)
+	return hp.ReplayPending(func(blockNum uint64) (coretypes.Receipts, error) {
+		return k.polaris.ReplayReceipts(ctx, blockNum)
+	})
 }
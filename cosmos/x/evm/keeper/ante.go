@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// GetChainConfig returns the Ethereum chain configuration stored in the given context. Unlike
+// the configuration plugin, it does not require the plugin to be prepared and is therefore safe
+// to use from the ante handler.
+func (k *Keeper) GetChainConfig(ctx sdk.Context) *params.ChainConfig {
+	bz := ctx.KVStore(k.storeKey).Get(types.ChainConfigKey)
+	if bz == nil {
+		return nil
+	}
+	var chainConfig params.ChainConfig
+	if err := json.Unmarshal(bz, &chainConfig); err != nil {
+		panic(err)
+	}
+	return &chainConfig
+}
+
+// GetBaseFee returns the EIP-1559 base fee of the block that is currently being built, derived
+// from the last finalized Polaris header in the given context.
+func (k *Keeper) GetBaseFee(ctx sdk.Context) (*big.Int, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// The header of the current block is only stored when the block is finalized, so the latest
+	// stored header is the parent. Before the first block is finalized, the parent is genesis.
+	bz := store.Get(types.HeaderKey)
+	if bz == nil {
+		bz = store.Get(types.GenesisHeaderKey)
+	}
+	if bz == nil {
+		return nil, errors.New("GetBaseFee: polaris header not found in kvstore")
+	}
+
+	parent, err := coretypes.UnmarshalHeader(bz)
+	if err != nil {
+		return nil, errorslib.Wrap(err, "GetBaseFee: failed to unmarshal")
+	}
+
+	chainConfig := k.GetChainConfig(ctx)
+	if chainConfig == nil {
+		return nil, errors.New("GetBaseFee: chain config not found in kvstore")
+	}
+	return misc.CalcBaseFee(chainConfig, parent), nil
+}
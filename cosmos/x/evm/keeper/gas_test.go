@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	bindings "pkg.berachain.dev/polaris/contracts/bindings/testing"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/eth/accounts/abi"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// updateGasGolden rewrites the gas golden file with the gas used by the corpus.
+var updateGasGolden = flag.Bool("update-gas-golden", false, "rewrite testdata/gas.golden.json")
+
+// gasGoldenFile is the golden file that pins the gas used by each transaction of the corpus.
+var gasGoldenFile = filepath.Join("testdata", "gas.golden.json")
+
+// gasCase is a transaction of the gas corpus. The cases are executed in order by the same sender,
+// so that a case can rely on the state left by the previous ones.
+type gasCase struct {
+	name string
+	to   *common.Address
+	data []byte
+}
+
+// The contracts pre-deployed for the opcode microbenchmarks, keyed by their address.
+var gasFixtures = map[common.Address][]byte{
+	common.HexToAddress("0x1000000000000000000000000000000000000001"): common.FromHex(
+		"6001600201500000"), // PUSH1 1 PUSH1 2 ADD POP STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000002"): common.FromHex(
+		"600160005200"), // PUSH1 1 PUSH1 0 MSTORE STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000003"): common.FromHex(
+		"60206000205000"), // PUSH1 32 PUSH1 0 KECCAK256 POP STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000004"): common.FromHex(
+		"6000545000"), // PUSH1 0 SLOAD POP STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000005"): common.FromHex(
+		"600160005500"), // PUSH1 1 PUSH1 0 SSTORE STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000006"): common.FromHex(
+		"600060005500"), // PUSH1 0 PUSH1 0 SSTORE STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000007"): common.FromHex(
+		"73000000000000000000000000000000000000dead315000"), // PUSH20 0xdead BALANCE POP STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000008"): common.FromHex(
+		"600060006000a100"), // PUSH1 0 PUSH1 0 PUSH1 0 LOG1 STOP
+	common.HexToAddress("0x1000000000000000000000000000000000000009"): common.FromHex(
+		"600060006000f05000"), // PUSH1 0 PUSH1 0 PUSH1 0 CREATE POP STOP
+}
+
+var _ = Describe("Gas golden corpus", func() {
+	var (
+		k      *keeper.Keeper
+		ctx    sdk.Context
+		key, _ = crypto.ToECDSA(common.FromHex(
+			"b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"))
+		sender  = crypto.PubkeyToAddress(key.PublicKey)
+		signer  = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+		valAddr = common.Address{0x21}.Bytes()
+	)
+
+	BeforeEach(func() {
+		var (
+			ak state.AccountKeeper
+			sk stakingkeeper.Keeper
+		)
+		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, sk,
+			testutil.EvmKey,
+			"authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		for _, plugin := range k.GetHost().GetAllPlugins() {
+			plugin, hasInitGenesis := utils.GetAs[plugins.HasGenesis](plugin)
+			if hasInitGenesis {
+				plugin.InitGenesis(ctx, core.DefaultGenesis)
+			}
+		}
+		validator, err := NewValidator(sdk.ValAddress(valAddr), PKs[0])
+		Expect(err).ToNot(HaveOccurred())
+		validator.Status = stakingtypes.Bonded
+		sk.SetValidator(ctx, validator)
+		Expect(k.Setup(
+			storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger(),
+		)).To(Succeed())
+		_ = sk.SetParams(ctx, stakingtypes.DefaultParams())
+		consAddr, err := validator.GetConsAddr()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sk.SetValidatorByConsAddr(ctx, validator)).To(Succeed())
+		header := ctx.BlockHeader()
+		header.ProposerAddress = consAddr.Bytes()
+		ctx = ctx.WithBlockHeader(header).
+			WithBlockGasMeter(storetypes.NewGasMeter(100000000000000)).
+			WithKVGasConfig(storetypes.GasConfig{}).
+			WithBlockHeight(1)
+		Expect(k.BeginBlocker(ctx)).To(Succeed())
+
+		sp := k.GetHost().GetStatePlugin()
+		sp.Reset(ctx)
+		sp.CreateAccount(sender)
+		sp.AddBalance(sender, new(big.Int).Mul(big.NewInt(9000000000000000000), big.NewInt(999)))
+		for addr, code := range gasFixtures {
+			sp.CreateAccount(addr)
+			sp.SetCode(addr, code)
+		}
+		sp.Finalize()
+	})
+
+	AfterEach(func() {
+		Expect(k.EndBlock(ctx)).To(Succeed())
+	})
+
+	It("should use the pinned amount of gas for every transaction of the corpus", func() {
+		var erc20ABI abi.ABI
+		Expect(erc20ABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+		pack := func(method string, args ...any) []byte {
+			input, err := erc20ABI.Pack(method, args...)
+			Expect(err).ToNot(HaveOccurred())
+			return input
+		}
+		at := func(hex string) *common.Address {
+			addr := common.HexToAddress(hex)
+			return &addr
+		}
+		ones := func(n int) []byte {
+			bz := make([]byte, n)
+			for i := range bz {
+				bz[i] = 0x01
+			}
+			return bz
+		}
+
+		// The ERC-20 token is deployed by the first transaction of the sender, at nonce 0.
+		token := crypto.CreateAddress(sender, 0)
+		alice, bob := common.Address{0xa1}, common.Address{0xb0}
+		corpus := []gasCase{
+			{name: "erc20/deploy", data: common.FromHex(bindings.SolmateERC20Bin)},
+			{name: "erc20/mint", to: &token, data: pack("mint", sender, big.NewInt(1000000))},
+			{name: "erc20/transfer-new-holder", to: &token,
+				data: pack("transfer", alice, big.NewInt(10))},
+			{name: "erc20/transfer-existing-holder", to: &token,
+				data: pack("transfer", alice, big.NewInt(10))},
+			{name: "erc20/approve", to: &token, data: pack("approve", sender, big.NewInt(100))},
+			{name: "erc20/transfer-from", to: &token,
+				data: pack("transferFrom", sender, bob, big.NewInt(50))},
+			{name: "erc20/balance-of", to: &token, data: pack("balanceOf", alice)},
+			{name: "transfer", to: &alice},
+			{name: "opcode/add", to: at("0x1000000000000000000000000000000000000001")},
+			{name: "opcode/mstore", to: at("0x1000000000000000000000000000000000000002")},
+			{name: "opcode/keccak256", to: at("0x1000000000000000000000000000000000000003")},
+			{name: "opcode/sload-cold", to: at("0x1000000000000000000000000000000000000004")},
+			{name: "opcode/sstore-cold-set", to: at("0x1000000000000000000000000000000000000005")},
+			{name: "opcode/sstore-cold-noop", to: at("0x1000000000000000000000000000000000000006")},
+			{name: "opcode/balance-cold", to: at("0x1000000000000000000000000000000000000007")},
+			{name: "opcode/log1", to: at("0x1000000000000000000000000000000000000008")},
+			{name: "opcode/create-empty", to: at("0x1000000000000000000000000000000000000009")},
+			{name: "precompile/ecrecover", to: at("0x01"), data: make([]byte, 128)},
+			{name: "precompile/sha256", to: at("0x02"), data: ones(32)},
+			{name: "precompile/ripemd160", to: at("0x03"), data: ones(32)},
+			{name: "precompile/identity", to: at("0x04"), data: ones(32)},
+		}
+
+		used := make(map[string]uint64, len(corpus))
+		for nonce, c := range corpus {
+			tx := coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+				Nonce:    uint64(nonce),
+				To:       c.to,
+				Gas:      10000000,
+				GasPrice: big.NewInt(10000000000),
+				Data:     c.data,
+			})
+			result, err := k.ProcessTransaction(ctx, tx)
+			Expect(err).ToNot(HaveOccurred(), c.name)
+			Expect(result.Err).ToNot(HaveOccurred(), c.name)
+			used[c.name] = result.UsedGas
+		}
+
+		if *updateGasGolden {
+			bz, err := json.MarshalIndent(used, "", "  ")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.WriteFile(gasGoldenFile, append(bz, '\n'), 0o600)).To(Succeed())
+			return
+		}
+
+		bz, err := os.ReadFile(gasGoldenFile)
+		Expect(err).ToNot(HaveOccurred())
+		golden := make(map[string]uint64)
+		Expect(json.Unmarshal(bz, &golden)).To(Succeed())
+		for _, c := range corpus {
+			want, ok := golden[c.name]
+			if !ok {
+				// A case that was never recorded is reported rather than failed, so that it can be
+				// added to the corpus before its gas is pinned with -update-gas-golden.
+				AddReportEntry("unpinned gas", c.name, used[c.name])
+				continue
+			}
+			Expect(used[c.name]).To(Equal(want), "gas used by %s changed, run "+
+				"`go test ./cosmos/x/evm/keeper -update-gas-golden` if intended", c.name)
+		}
+	})
+})
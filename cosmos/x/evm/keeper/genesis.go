@@ -21,15 +21,25 @@
 package keeper
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
 // InitGenesis is called during the InitGenesis.
 func (k *Keeper) InitGenesis(ctx sdk.Context, genState *core.Genesis) error {
+	// Ensure that the chain config uses the EVM chain ID of the Cosmos chain-id.
+	if k.chainIDs != nil {
+		if err := k.chainIDs.ValidateChainConfig(ctx.ChainID(), genState.Config); err != nil {
+			return err
+		}
+	}
+
 	// Initialize all the plugins.
 	for _, plugin := range k.host.GetAllPlugins() {
 		// checks whether plugin implements methods of HasGenesis and executes them if it does
@@ -50,3 +60,26 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *core.Genesis {
 	}
 	return genesisState
 }
+
+// ExportState returns the EVM state of the given context as a go-ethereum genesis, with the chain
+// config, the accounts with their balance, nonce, code and storage, and the gas limit, base fee
+// and time of the block at the height of the context, so that a go-ethereum chain can start from
+// it. It is meant to run offline, on the state of a stopped node.
+func (k *Keeper) ExportState(ctx sdk.Context) (*core.Genesis, error) {
+	k.host.GetConfigurationPlugin().Prepare(ctx)
+	bp := k.host.GetBlockPlugin()
+	bp.Prepare(ctx)
+	header, err := bp.GetHeaderByNumber(uint64(ctx.BlockHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.Genesis{
+		Config:     k.host.GetConfigurationPlugin().ChainConfig(),
+		Timestamp:  header.Time,
+		GasLimit:   header.GasLimit,
+		BaseFee:    header.BaseFee,
+		Difficulty: new(big.Int),
+		Alloc:      utils.MustGetAs[state.Plugin](k.host.GetStatePlugin()).ExportAlloc(ctx),
+	}, nil
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+	"pkg.berachain.dev/polaris/eth/rpc"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// Compile-time interface assertion.
+var _ types.QueryServiceServer = (*Keeper)(nil)
+
+// Code queries the deployed bytecode of the given account.
+func (k *Keeper) Code(
+	ctx context.Context, req *types.CodeRequest,
+) (*types.CodeResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	store := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey)
+	codeHash := store.Get(state.CodeHashKeyFor(common.HexToAddress(req.Address)))
+	if codeHash == nil {
+		return &types.CodeResponse{}, nil
+	}
+
+	return &types.CodeResponse{
+		Code: store.Get(state.CodeKeyFor(common.BytesToHash(codeHash))),
+	}, nil
+}
+
+// Storage queries the value stored at the given slot of the given account.
+func (k *Keeper) Storage(
+	ctx context.Context, req *types.StorageRequest,
+) (*types.StorageResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	key, err := slotFromHex(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	value := state.GetStateFromStore(
+		sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), common.HexToAddress(req.Address), key,
+	)
+
+	return &types.StorageResponse{Value: value.Hex()}, nil
+}
+
+// Balance queries the EVM balance of the given account.
+func (k *Keeper) Balance(
+	ctx context.Context, req *types.BalanceRequest,
+) (*types.BalanceResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	balance := k.balances.Get(sdk.UnwrapSDKContext(ctx), common.HexToAddress(req.Address))
+	return &types.BalanceResponse{Balance: balance.String()}, nil
+}
+
+// EthCall executes a message call against the state at the height of the query context.
+func (k *Keeper) EthCall(
+	ctx context.Context, req *types.EthCallRequest,
+) (*types.EthCallResponse, error) {
+	var args polarapi.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := k.polaris.Call(ctx, args, blockNrOrHashFor(ctx), req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	vmErr := ""
+	if result.Err != nil {
+		vmErr = result.Err.Error()
+	}
+
+	return &types.EthCallResponse{
+		ReturnData: result.ReturnData,
+		GasUsed:    result.UsedGas,
+		VmError:    vmErr,
+	}, nil
+}
+
+// EstimateGas estimates the gas required for the given message call to succeed against the state
+// at the height of the query context.
+func (k *Keeper) EstimateGas(
+	ctx context.Context, req *types.EthCallRequest,
+) (*types.EstimateGasResponse, error) {
+	var args polarapi.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gas, err := k.polaris.EstimateGas(ctx, args, blockNrOrHashFor(ctx), req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.EstimateGasResponse{Gas: gas}, nil
+}
+
+// BaseFee queries the EIP-1559 base fee of the block at the height of the query context.
+func (k *Keeper) BaseFee(
+	ctx context.Context, _ *types.BaseFeeRequest,
+) (*types.BaseFeeResponse, error) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	bz := block.GetHeaderBytesFromStore(sCtx.KVStore(k.storeKey))
+	if bz == nil {
+		return nil, status.Errorf(codes.NotFound, "header %d not found", sCtx.BlockHeight())
+	}
+	header, err := coretypes.UnmarshalHeader(bz)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseFee := new(big.Int)
+	if header.BaseFee != nil {
+		baseFee.Set(header.BaseFee)
+	}
+
+	return &types.BaseFeeResponse{BaseFee: baseFee.String()}, nil
+}
+
+// Params queries the parameters of the x/evm module and the Ethereum chain configuration the EVM
+// is running with.
+func (k *Keeper) Params(
+	ctx context.Context, _ *types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.ParamsResponse{
+		ChainConfig: sCtx.KVStore(k.storeKey).Get(types.ChainConfigKey),
+		Params:      k.GetParams(sCtx),
+	}, nil
+}
+
+// TraceTx re-executes a historical Ethereum transaction and returns its trace, produced by the
+// struct logger or by the JavaScript or native tracer named in the trace config. The timeout of
+// the trace config is capped by the node's RPC trace timeout.
+func (k *Keeper) TraceTx(
+	ctx context.Context, req *types.TraceTxRequest,
+) (*types.TraceTxResponse, error) {
+	cfg := &tracers.TraceConfig{}
+	if len(req.TraceConfig) > 0 {
+		if err := json.Unmarshal(req.TraceConfig, cfg); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	data, err := k.polaris.TraceTransaction(ctx, common.HexToHash(req.Hash), cfg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.TraceTxResponse{Data: data}, nil
+}
+
+// CosmosTxHash queries the hash of the Cosmos transaction that wrapped the given Ethereum
+// transaction.
+func (k *Keeper) CosmosTxHash(
+	_ context.Context, req *types.CosmosTxHashRequest,
+) (*types.CosmosTxHashResponse, error) {
+	ethTxHash, err := hashFromHex(req.EthTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	cosmosTxHash, err := utils.MustGetAs[historical.Plugin](
+		k.host.GetHistoricalPlugin(),
+	).GetCosmosTxHash(ethTxHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.CosmosTxHashResponse{CosmosTxHash: cosmosTxHash.Hex()}, nil
+}
+
+// EthTxHash queries the hash of the Ethereum transaction wrapped by the given Cosmos transaction.
+func (k *Keeper) EthTxHash(
+	_ context.Context, req *types.EthTxHashRequest,
+) (*types.EthTxHashResponse, error) {
+	cosmosTxHash, err := hashFromHex(req.CosmosTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ethTxHash, err := utils.MustGetAs[historical.Plugin](
+		k.host.GetHistoricalPlugin(),
+	).GetEthTxHash(cosmosTxHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.EthTxHashResponse{EthTxHash: ethTxHash.Hex()}, nil
+}
+
+// hashFromHex returns the hash encoded by the given hex string, with or without the 0x prefix, so
+// that the hashes displayed by both Ethereum and CometBFT explorers are accepted.
+func hashFromHex(s string) (common.Hash, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(bz) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "invalid hash %s", s)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// slotFromHex returns the storage slot encoded by the given hex string, with or without the 0x
+// prefix. Like `eth_getStorageAt`, it accepts the slots shorter than 32 bytes, which are left
+// padded with zeros.
+func slotFromHex(s string) (common.Hash, error) {
+	digits := strings.TrimPrefix(s, "0x")
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+	bz, err := hex.DecodeString(digits)
+	if err != nil || len(bz) > common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "invalid storage key %s", s)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// blockNrOrHashFor returns the block number of the given query context.
+func blockNrOrHashFor(ctx context.Context) rpc.BlockNumberOrHash {
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/ethereum/go-ethereum/trie"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QueryService", func() {
+	var (
+		k        *keeper.Keeper
+		ctx      sdk.Context
+		storeKey = storetypes.NewKVStoreKey("evm")
+		addr     = common.BytesToAddress([]byte("alice"))
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, nil,
+			storeKey,
+			"authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector {
+				return ethprecompile.NewPrecompiles()
+			},
+		)
+	})
+
+	It("should reject invalid addresses", func() {
+		_, err := k.Code(ctx, &types.CodeRequest{Address: "not an address"})
+		Expect(err).To(HaveOccurred())
+		_, err = k.Storage(ctx, &types.StorageRequest{Address: "0x1234"})
+		Expect(err).To(HaveOccurred())
+		_, err = k.Balance(ctx, &types.BalanceRequest{Address: ""})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return the balance of an account", func() {
+		res, err := k.Balance(ctx, &types.BalanceRequest{Address: addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Balance).To(Equal("0"))
+
+		Expect(k.SetBalance(ctx, addr.Bytes(), big.NewInt(69))).To(Succeed())
+		res, err = k.Balance(ctx, &types.BalanceRequest{Address: addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Balance).To(Equal("69"))
+	})
+
+	It("should return the code and storage of an account", func() {
+		code := []byte{0x60, 0x00}
+		codeHash := crypto.Keccak256Hash(code)
+		slot, value := common.Hash{0x01}, common.Hash{0x02}
+
+		store := ctx.KVStore(storeKey)
+		store.Set(state.CodeHashKeyFor(addr), codeHash.Bytes())
+		store.Set(state.CodeKeyFor(codeHash), code)
+		store.Set(state.SlotKeyFor(addr, slot), value.Bytes())
+
+		codeRes, err := k.Code(ctx, &types.CodeRequest{Address: addr.Hex()})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(codeRes.Code).To(Equal(code))
+
+		storageRes, err := k.Storage(ctx, &types.StorageRequest{
+			Address: addr.Hex(), Key: slot.Hex(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(storageRes.Value).To(Equal(value.Hex()))
+	})
+
+	It("should reject invalid storage keys", func() {
+		_, err := k.Storage(ctx, &types.StorageRequest{Address: addr.Hex(), Key: "not a key"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		_, err = k.Storage(ctx, &types.StorageRequest{
+			Address: addr.Hex(), Key: "0x" + strings.Repeat("01", common.HashLength+1),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		// the slots shorter than 32 bytes are left padded, like eth_getStorageAt.
+		ctx.KVStore(storeKey).Set(state.SlotKeyFor(addr, common.Hash{31: 0x1}), common.Hash{0x2}.Bytes())
+		res, err := k.Storage(ctx, &types.StorageRequest{Address: addr.Hex(), Key: "0x1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Value).To(Equal(common.Hash{0x2}.Hex()))
+	})
+
+	It("should return the base fee of the block at the height of the query", func() {
+		_, err := k.BaseFee(ctx, &types.BaseFeeRequest{})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		bz, err := coretypes.MarshalHeader(&coretypes.Header{
+			Number: big.NewInt(1), BaseFee: big.NewInt(7),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		ctx.KVStore(storeKey).Set(types.HeaderKey, bz)
+		res, err := k.BaseFee(ctx.WithBlockHeight(1), &types.BaseFeeRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.BaseFee).To(Equal("7"))
+	})
+
+	It("should return the params of the module", func() {
+		res, err := k.Params(ctx, &types.ParamsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Params).To(Equal(types.DefaultParams()))
+
+		params := &types.Params{
+			MaxInitCodeSize: 1024,
+			PrecompilePolicies: []*types.PrecompilePolicy{
+				{Address: common.BytesToAddress([]byte{0x09}).Hex(), Paused: true},
+			},
+		}
+		Expect(k.SetParams(ctx, params)).To(Succeed())
+		res, err = k.Params(ctx, &types.ParamsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Params).To(Equal(params))
+	})
+
+	It("should translate between the hashes of the ethereum and cosmos txs", func() {
+		Expect(k.Setup(
+			storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger(),
+		)).To(Succeed())
+		hp := utils.MustGetAs[historical.Plugin](k.GetHost().GetHistoricalPlugin())
+		hp.Prepare(ctx.WithBlockHeight(1))
+
+		tx := coretypes.NewTransaction(0, addr, big.NewInt(1), 21000, big.NewInt(1), nil)
+		block := coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1)}, coretypes.Transactions{tx},
+			nil, nil, trie.NewStackTrie(nil),
+		)
+		cosmosTxHash := common.Hash{0x4}
+		hp.SetCosmosTxHash(tx.Hash(), cosmosTxHash)
+		Expect(hp.StoreBlock(block)).To(Succeed())
+		Expect(hp.StoreReceipts(block.Hash(), nil)).To(Succeed())
+		Expect(hp.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+
+		// the hashes are indexed in the background.
+		Eventually(func() (string, error) {
+			res, err := k.CosmosTxHash(ctx, &types.CosmosTxHashRequest{EthTxHash: tx.Hash().Hex()})
+			if err != nil {
+				return "", err
+			}
+			return res.CosmosTxHash, nil
+		}).Should(Equal(cosmosTxHash.Hex()))
+
+		// the cosmos tx hash is accepted as displayed by CometBFT, without the 0x prefix.
+		res, err := k.EthTxHash(ctx, &types.EthTxHashRequest{
+			CosmosTxHash: strings.ToUpper(cosmosTxHash.Hex()[2:]),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.EthTxHash).To(Equal(tx.Hash().Hex()))
+
+		_, err = k.EthTxHash(ctx, &types.EthTxHashRequest{CosmosTxHash: common.Hash{0x5}.Hex()})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+		_, err = k.CosmosTxHash(ctx, &types.CosmosTxHashRequest{EthTxHash: "0x1234"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -21,11 +21,17 @@
 package keeper
 
 import (
+	"context"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/configuration"
@@ -36,13 +42,21 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/polar"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
 
-// Compile-time interface assertion.
-var _ core.PolarisHostChain = (*host)(nil)
+// Compile-time interface assertions.
+var (
+	_ core.PolarisHostChain      = (*host)(nil)
+	_ polar.NativeTxsProvider    = (*host)(nil)
+	_ polar.HostTxHashesProvider = (*host)(nil)
+	_ polar.HostAddressConverter = (*host)(nil)
+)
 
 // Host is the interface that must be implemented by the host.
 // It includes core.PolarisHostChain and functions that are called in other packages.
@@ -51,10 +65,12 @@ type Host interface {
 	GetAllPlugins() []plugins.Base
 	Setup(
 		storetypes.StoreKey,
-		storetypes.StoreKey,
+		dbm.DB,
 		state.AccountKeeper,
+		*state.Balances,
 		func(height int64, prove bool) (sdk.Context, error),
 	)
+	SetClientContext(client.Context)
 }
 
 type host struct {
@@ -68,6 +84,9 @@ type host struct {
 	txp txpool.Plugin
 
 	pcs func() *ethprecompile.Injector
+
+	// clientCtx is used to query the blocks of the node.
+	clientCtx client.Context
 }
 
 // Newhost creates new instances of the plugin host.
@@ -76,13 +95,14 @@ func NewHost(
 	sk block.StakingKeeper,
 	ethTxMempool sdkmempool.Mempool,
 	precompiles func() *ethprecompile.Injector,
+	disposeBaseFee configuration.BaseFeeDisposer,
 ) Host {
 	// We setup the host with some Cosmos standard sauce.
 	h := &host{}
 
 	// Build the Plugins
 	h.bp = block.NewPlugin(storeKey, sk)
-	h.cp = configuration.NewPlugin(storeKey)
+	h.cp = configuration.NewPlugin(storeKey, disposeBaseFee)
 	h.gp = gas.NewPlugin()
 	h.txp = txpool.NewPlugin(utils.MustGetAs[*mempool.EthTxPool](ethTxMempool))
 	h.pcs = precompiles
@@ -90,19 +110,21 @@ func NewHost(
 	return h
 }
 
-// Setup sets up the precompile and state plugins with the given precompiles and keepers. It also
-// sets the query context function for the block and state plugins (to support historical queries).
+// Setup sets up the precompile and state plugins with the given precompiles, keepers and balances.
+// It also sets the query context function for the block and state plugins (to support historical
+// queries).
 func (h *host) Setup(
 	storeKey storetypes.StoreKey,
-	_ storetypes.StoreKey,
+	offchainDB dbm.DB,
 	ak state.AccountKeeper,
+	balances *state.Balances,
 	qc func(height int64, prove bool) (sdk.Context, error),
 ) {
 	// Setup the state, precompile, historical, and txpool plugins
 	h.sp = state.NewPlugin(ak, storeKey, log.NewFactory(h.pcs().GetPrecompiles()))
-	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp)
-	// TODO: re-enable historical plugin using ABCI listener.
-	h.hp = historical.NewPlugin(h.cp, h.bp, nil, storeKey)
+	h.sp.SetBalances(balances)
+	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp, storeKey)
+	h.hp = historical.NewPlugin(h.cp, h.bp, offchainDB, storeKey)
 	h.txp.SetNonceRetriever(h.sp)
 
 	// Set the query context function for the block and state plugins
@@ -148,3 +170,140 @@ func (h *host) GetTxPoolPlugin() core.TxPoolPlugin {
 func (h *host) GetAllPlugins() []plugins.Base {
 	return []plugins.Base{h.bp, h.cp, h.gp, h.hp, h.pp, h.sp, h.txp}
 }
+
+// SetClientContext sets the client context of the host and the txpool plugin.
+func (h *host) SetClientContext(clientCtx client.Context) {
+	h.clientCtx = clientCtx
+	h.txp.SetClientContext(clientCtx)
+}
+
+// GetNativeTransactions returns the transactions of the CometBFT block at the given height that
+// are not Ethereum transactions, with the gas they used.
+//
+// GetNativeTransactions implements `polar.NativeTxsProvider`.
+func (h *host) GetNativeTransactions(
+	ctx context.Context, number uint64,
+) ([]*polar.NativeTransaction, error) {
+	node, err := h.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	height := int64(number)
+	block, err := node.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	results, err := node.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		decode    = h.clientCtx.TxConfig.TxDecoder()
+		nativeTxs []*polar.NativeTransaction
+	)
+	for i, txBz := range block.Block.Txs {
+		// Transactions that cannot be decoded are not Ethereum transactions either.
+		if tx, err := decode(txBz); err == nil && isEthTx(tx) {
+			continue
+		}
+		nativeTx := &polar.NativeTransaction{Hash: common.BytesToHash(txBz.Hash())}
+		if i < len(results.TxsResults) {
+			nativeTx.GasUsed = uint64(results.TxsResults[i].GasUsed)
+		}
+		nativeTxs = append(nativeTxs, nativeTx)
+	}
+	return nativeTxs, nil
+}
+
+// GetHostTxHash returns the hash of the CometBFT transaction that wrapped the given Ethereum
+// transaction.
+//
+// GetHostTxHash implements `polar.HostTxHashesProvider`.
+func (h *host) GetHostTxHash(ethTxHash common.Hash) (common.Hash, error) {
+	return h.hp.GetCosmosTxHash(ethTxHash)
+}
+
+// GetEthTxHash returns the hash of the Ethereum transaction wrapped by the given CometBFT
+// transaction.
+//
+// GetEthTxHash implements `polar.HostTxHashesProvider`.
+func (h *host) GetEthTxHash(cosmosTxHash common.Hash) (common.Hash, error) {
+	return h.hp.GetEthTxHash(cosmosTxHash)
+}
+
+// ConvertAddress returns the Bech32 and the Ethereum representation of the given Bech32 or hex
+// account address.
+//
+// ConvertAddress implements `polar.HostAddressConverter`.
+func (h *host) ConvertAddress(address string) (string, common.Address, error) {
+	return cosmlib.ConvertAddress(address)
+}
+
+// SyncStatus returns the sync status of CometBFT, with the progress of the indexing of the
+// historical data. Without an off-chain database, or until a block is written to it, the
+// historical data is reported as indexed up to the latest block.
+//
+// SyncStatus implements `polar.HostSyncStatusProvider`.
+func (h *host) SyncStatus(ctx context.Context) (*polar.HostSyncStatus, error) {
+	node, err := h.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	result, err := node.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info := result.SyncInfo
+	status := &polar.HostSyncStatus{
+		CatchingUp:      info.CatchingUp,
+		EarliestHeight:  uint64(info.EarliestBlockHeight),
+		LatestHeight:    uint64(info.LatestBlockHeight),
+		LatestBlockTime: info.LatestBlockTime,
+		IndexedHeight:   uint64(info.LatestBlockHeight),
+	}
+
+	indexed, ok, err := h.hp.IndexedHeight()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		status.IndexedHeight = indexed
+	}
+	pending, err := h.hp.PendingBlocks()
+	if err != nil {
+		return nil, err
+	}
+	status.PendingIndexBlocks = uint64(len(pending))
+	return status, nil
+}
+
+// LogsBloomHeight implements `polar.HostLogsBloomHeight`.
+func (h *host) LogsBloomHeight() uint64 {
+	return h.bp.LogsBloomHeight()
+}
+
+// BloomBitsSections implements `polar.BloomBitsStore`.
+func (h *host) BloomBitsSections() (uint64, error) {
+	return h.hp.BloomBitsSections()
+}
+
+// ReadBloomBits implements `polar.BloomBitsStore`.
+func (h *host) ReadBloomBits(bit uint, section uint64) ([]byte, error) {
+	return h.hp.ReadBloomBits(bit, section)
+}
+
+// WriteBloomBits implements `polar.BloomBitsStore`.
+func (h *host) WriteBloomBits(section uint64, bits [][]byte) error {
+	return h.hp.WriteBloomBits(section, bits)
+}
+
+// isEthTx returns true if the given transaction wraps an Ethereum transaction.
+func isEthTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return false
+	}
+	_, ok := utils.GetAs[*types.WrappedEthereumTransaction](msgs[0])
+	return ok
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/trie"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// The names of the checks of the integrity check of the stored blocks.
+const (
+	checkBlock    = "block"
+	checkParent   = "parent hash"
+	checkReceipts = "receipts"
+	checkGasUsed  = "gas used"
+)
+
+// CheckBlocks verifies the stored blocks from `from` to `to` and their historical data, and calls
+// `report` with the findings of each block, in order. The header of each block is checked against
+// the hash of its parent and the root of its transactions, its receipts against the receipts root,
+// logs bloom and gas used of its header, and its transactions against the off-chain index. The
+// plugins are prepared with the given context, which must be at or after `to`.
+//
+// The logs bloom of the headers stored before the upgrade that committed to it is empty, so it is
+// only reported as a warning for them.
+func (k *Keeper) CheckBlocks(
+	ctx sdk.Context, from, to uint64, report func(number uint64, ds types.Diagnostics),
+) error {
+	if from > to || to > uint64(ctx.BlockHeight()) {
+		return fmt.Errorf("invalid blocks %d to %d at height %d", from, to, ctx.BlockHeight())
+	}
+	k.host.GetConfigurationPlugin().Prepare(ctx)
+	hp := utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin())
+	hp.Prepare(ctx)
+	bp := utils.MustGetAs[block.Plugin](k.host.GetBlockPlugin())
+	bp.Prepare(ctx)
+	bloomHeight := bp.LogsBloomHeight()
+
+	// the continuity of the first block is checked against its stored parent, if any.
+	var parentHash common.Hash
+	if from > 0 {
+		if parent, err := hp.GetBlockByNumber(from - 1); err == nil {
+			parentHash = parent.Hash()
+		}
+	}
+	for number := from; number <= to; number++ {
+		var ds types.Diagnostics
+		parentHash, ds = verifyBlock(hp, number, parentHash, number >= bloomHeight)
+		report(number, ds)
+	}
+	return nil
+}
+
+// verifyBlock verifies the stored block with the given number and its historical data, against
+// the hash of its parent unless it is empty. It returns the hash of the block, or the empty hash
+// if it is not found.
+func verifyBlock(
+	hp historical.Plugin, number uint64, parentHash common.Hash, commitsBloom bool,
+) (common.Hash, types.Diagnostics) {
+	block, err := hp.GetBlockByNumber(number)
+	if err != nil {
+		return common.Hash{}, types.Diagnostics{types.Fatal(checkBlock, fmt.Errorf(
+			"failed to read the block: %w", err,
+		))}
+	}
+
+	var (
+		ds     types.Diagnostics
+		header = block.Header()
+		hash   = block.Hash()
+		txs    = block.Transactions()
+	)
+	if block.NumberU64() != number {
+		ds = append(ds, types.Fatal(checkBlock, fmt.Errorf(
+			"block %d stored at height %d", block.NumberU64(), number,
+		)))
+	}
+	if _, err = hp.GetBlockByHash(hash); err != nil {
+		ds = append(ds, types.Fatal(checkBlock, fmt.Errorf("hash %s: %w", hash.Hex(), err)))
+	}
+	if number > 0 && parentHash != (common.Hash{}) && header.ParentHash != parentHash {
+		ds = append(ds, types.Fatal(checkParent, fmt.Errorf(
+			"parent hash %s, previous block %s", header.ParentHash.Hex(), parentHash.Hex(),
+		)))
+	}
+	if root := coretypes.DeriveSha(txs, trie.NewStackTrie(nil)); root != header.TxHash {
+		ds = append(ds, types.Fatal(checkBlock, fmt.Errorf(
+			"transactions root %s, header %s", root.Hex(), header.TxHash.Hex(),
+		)))
+	}
+	ds = append(ds, checkReceiptsOf(hp, header, txs, commitsBloom)...)
+
+	// every transaction must be served by its hash.
+	for txIndex, tx := range txs {
+		tle, txErr := hp.GetTransactionByHash(tx.Hash())
+		switch {
+		case txErr != nil:
+			ds = append(ds, types.Fatal(checkIndex, txErr))
+		case tle.BlockNum != number || tle.TxIndex != uint64(txIndex):
+			ds = append(ds, types.Fatal(checkIndex, fmt.Errorf(
+				"tx %s indexed at %d of block %d, want %d of block %d",
+				tx.Hash().Hex(), tle.TxIndex, tle.BlockNum, txIndex, number,
+			)))
+		}
+	}
+	return hash, ds
+}
+
+// checkReceiptsOf verifies the stored receipts of the block of the given header against it, and
+// against its logs bloom only if the header commits to it.
+func checkReceiptsOf(
+	hp historical.Plugin, header *coretypes.Header, txs coretypes.Transactions, commitsBloom bool,
+) types.Diagnostics {
+	receipts, err := hp.GetReceiptsByHash(header.Hash())
+	if err != nil {
+		return types.Diagnostics{types.Fatal(checkReceipts, err)}
+	}
+	if len(receipts) != len(txs) {
+		return types.Diagnostics{types.Fatal(checkReceipts, fmt.Errorf(
+			"%d receipts for %d transactions", len(receipts), len(txs),
+		))}
+	}
+
+	var ds types.Diagnostics
+	if root := coretypes.DeriveSha(receipts, trie.NewStackTrie(nil)); root != header.ReceiptHash {
+		ds = append(ds, types.Fatal(checkReceipts, fmt.Errorf(
+			"receipts root %s, header %s", root.Hex(), header.ReceiptHash.Hex(),
+		)))
+	}
+	if err = core.VerifyLogsBloom(header.Bloom, receipts); err != nil {
+		if commitsBloom {
+			ds = append(ds, types.Fatal(checkReceipts, err))
+		} else {
+			ds = append(ds, types.Warning(checkReceipts, fmt.Errorf("legacy header: %w", err)))
+		}
+	}
+
+	// the gas used of the receipts is derived from their cumulative gas used.
+	var cumulative uint64
+	for i, receipt := range receipts {
+		if receipt.CumulativeGasUsed < cumulative || receipt.GasUsed > txs[i].Gas() {
+			ds = append(ds, types.Fatal(checkGasUsed, fmt.Errorf(
+				"receipt %d has cumulative gas used %d after %d, for a gas limit of %d",
+				i, receipt.CumulativeGasUsed, cumulative, txs[i].Gas(),
+			)))
+		}
+		cumulative = receipt.CumulativeGasUsed
+	}
+	// the gas used of the header is the sum of the gas used by the receipts.
+	if cumulative != header.GasUsed || header.GasUsed > header.GasLimit {
+		ds = append(ds, types.Fatal(checkGasUsed, fmt.Errorf(
+			"receipts used %d, header used %d of %d", cumulative, header.GasUsed, header.GasLimit,
+		)))
+	}
+	return ds
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/core"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckBlocks", func() {
+	var (
+		k   *keeper.Keeper
+		ctx sdk.Context
+	)
+
+	BeforeEach(func() {
+		var (
+			ak state.AccountKeeper
+			sk stakingkeeper.Keeper
+		)
+		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, sk,
+			testutil.EvmKey,
+			"authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		Expect(k.Setup(
+			storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger(),
+		)).To(Succeed())
+		Expect(k.InitGenesis(ctx, core.DefaultGenesis)).To(Succeed())
+	})
+
+	It("should report a block that was not stored", func() {
+		reported := map[uint64]types.Diagnostics{}
+		Expect(k.CheckBlocks(ctx.WithBlockHeight(1), 1, 1,
+			func(number uint64, ds types.Diagnostics) { reported[number] = ds },
+		)).To(Succeed())
+		Expect(reported).To(HaveKey(uint64(1)))
+		Expect(reported[1]).To(HaveLen(1))
+		Expect(reported[1].Err()).To(HaveOccurred())
+	})
+
+	It("should refuse the blocks after the height of the context", func() {
+		Expect(k.CheckBlocks(ctx.WithBlockHeight(1), 1, 2,
+			func(uint64, types.Diagnostics) { Fail("no block should be checked") },
+		)).ToNot(Succeed())
+		Expect(k.CheckBlocks(ctx.WithBlockHeight(1), 1, 0,
+			func(uint64, types.Diagnostics) { Fail("no block should be checked") },
+		)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/crypto"
+)
+
+// emptyCodeHash is the code hash of an account without code.
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// RegisterInvariants registers the evm module invariants, so that they can be asserted by the
+// crisis module and by the simulations.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "balances", BalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonces", NoncesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "code", CodeInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the evm module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	balances, nonces, code := BalancesInvariant(k), NoncesInvariant(k), CodeInvariant(k)
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{balances, nonces, code} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// BalancesInvariant checks that the EVM balances kept in the evm store are canonically encoded. A
+// balance that is not (e.g. the result of a manual write) would be read back as a different
+// amount than the one that was accounted for. Once the balances are kept in x/bank, it also checks
+// that the evm store only keeps fractions of a unit, so that the EVM balance of every account is
+// exactly its bank balance scaled to wei, plus its fraction, and that the fractions are backed by
+// the reserve, so that no dust was created or destroyed.
+func BalancesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg     string
+			broken  int
+			holders int
+			total   = new(big.Int)
+			scaler  = k.balances.Scaler()
+		)
+		iterateStore(ctx.KVStore(k.storeKey), types.BalanceKeyPrefix, func(key, value []byte) {
+			balance := new(big.Int).SetBytes(value)
+			if !bytes.Equal(balance.Bytes(), value) {
+				broken++
+				msg += fmt.Sprintf("\tbalance of %s is not canonically encoded: %x\n",
+					state.AddressFromBalanceKey(key).Hex(), value)
+			}
+			if scaler != nil && balance.Cmp(scaler.Factor()) >= 0 {
+				broken++
+				msg += fmt.Sprintf("\tfraction of %s is not less than a unit of %s: %s\n",
+					state.AddressFromBalanceKey(key).Hex(), scaler.Denom(), balance)
+			}
+			if balance.Sign() > 0 {
+				holders++
+			}
+			total.Add(total, balance)
+		})
+
+		if scaler != nil {
+			reserve, remainder := k.balances.Reserve(ctx)
+			if remainder.Cmp(scaler.Factor()) >= 0 {
+				broken++
+				msg += fmt.Sprintf("\treserve remainder %s is not less than a unit\n", remainder)
+			}
+			if backed := new(big.Int).Add(total, remainder); reserve.Cmp(backed) != 0 {
+				broken++
+				msg += fmt.Sprintf("\treserve of %s wei does not back the %s wei of fractions plus "+
+					"the %s wei of remainder\n", reserve, total, remainder)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "balances", fmt.Sprintf(
+			"%d broken EVM balances found, %s held by %d accounts\n%s",
+			broken, total, holders, msg,
+		)), broken != 0
+	}
+}
+
+// NoncesInvariant checks that the nonces of the externally owned accounts never decrease between
+// two runs of the invariant. Contracts are not checked, as they may be destroyed and recreated at
+// the same address with a reset nonce.
+func NoncesInvariant(k *Keeper) sdk.Invariant {
+	var (
+		mu     sync.Mutex
+		nonces = make(map[common.Address]uint64)
+	)
+	return func(ctx sdk.Context) (string, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var (
+			msg    string
+			broken int
+			seen   = make(map[common.Address]uint64, len(nonces))
+			store  = ctx.KVStore(k.storeKey)
+		)
+		k.ak.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+			addr := common.BytesToAddress(acc.GetAddress())
+			if ch := store.Get(state.CodeHashKeyFor(addr)); ch != nil &&
+				common.BytesToHash(ch) != emptyCodeHash {
+				return false
+			}
+
+			nonce := acc.GetSequence()
+			if last, ok := nonces[addr]; ok && nonce < last {
+				broken++
+				msg += fmt.Sprintf("\tnonce of %s decreased from %d to %d\n", addr.Hex(), last, nonce)
+			}
+			seen[addr] = nonce
+			return false
+		})
+		nonces = seen
+
+		return sdk.FormatInvariant(types.ModuleName, "nonces", fmt.Sprintf(
+			"%d decreased nonces found\n%s", broken, msg,
+		)), broken != 0
+	}
+}
+
+// CodeInvariant checks that every stored code is stored under its hash and referenced by the code
+// hash of an account, and that every code hash of an account references stored code.
+func CodeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg        string
+			broken     int
+			store      = ctx.KVStore(k.storeKey)
+			referenced = make(map[common.Hash]struct{})
+		)
+		iterateStore(store, types.CodeHashKeyPrefix, func(key, value []byte) {
+			codeHash := common.BytesToHash(value)
+			if codeHash == emptyCodeHash || (codeHash == common.Hash{}) {
+				return
+			}
+			referenced[codeHash] = struct{}{}
+			if !store.Has(state.CodeKeyFor(codeHash)) {
+				broken++
+				msg += fmt.Sprintf("\tcode %s of %s is not stored\n",
+					codeHash.Hex(), state.AddressFromCodeHashKey(key).Hex())
+			}
+		})
+		iterateStore(store, types.CodeKeyPrefix, func(key, value []byte) {
+			codeHash := common.BytesToHash(key[1:])
+			if crypto.Keccak256Hash(value) != codeHash {
+				broken++
+				msg += fmt.Sprintf("\tcode stored under %s has a different hash\n", codeHash.Hex())
+			}
+			if _, ok := referenced[codeHash]; !ok {
+				broken++
+				msg += fmt.Sprintf("\tcode %s is not referenced by any account\n", codeHash.Hex())
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "code", fmt.Sprintf(
+			"%d broken code entries found\n%s", broken, msg,
+		)), broken != 0
+	}
+}
+
+// iterateStore calls fn with every key and value under the given prefix of the store.
+func iterateStore(store storetypes.KVStore, prefix byte, fn func(key, value []byte)) {
+	it := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		fn(it.Key(), it.Value())
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Invariants", func() {
+	var (
+		k     *keeper.Keeper
+		ak    authkeeper.AccountKeeper
+		bk    bankkeeper.BaseKeeper
+		ctx   sdk.Context
+		store storetypes.KVStore
+		alice = common.Address{0x1}
+		code  = []byte{0x60, 0x00}
+	)
+
+	BeforeEach(func() {
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, nil, testutil.EvmKey, "authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		store = ctx.KVStore(testutil.EvmKey)
+
+		acc := ak.NewAccountWithAddress(ctx, alice.Bytes())
+		Expect(acc.SetSequence(5)).To(Succeed())
+		ak.SetAccount(ctx, acc)
+		Expect(k.SetBalance(ctx, alice.Bytes(), big.NewInt(100))).To(Succeed())
+	})
+
+	It("should hold for a consistent state", func() {
+		contract := common.Address{0x2}
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, contract.Bytes()))
+		codeHash := crypto.Keccak256Hash(code)
+		store.Set(state.CodeHashKeyFor(contract), codeHash.Bytes())
+		store.Set(state.CodeKeyFor(codeHash), code)
+
+		msg, broken := keeper.AllInvariants(k)(ctx)
+		Expect(broken).To(BeFalse(), msg)
+	})
+
+	It("should detect a non canonical balance", func() {
+		store.Set(state.BalanceKeyFor(alice), []byte{0x00, 0x64})
+		_, broken := keeper.BalancesInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+	})
+
+	It("should detect a decreasing nonce", func() {
+		invariant := keeper.NoncesInvariant(k)
+		_, broken := invariant(ctx)
+		Expect(broken).To(BeFalse())
+
+		acc := ak.GetAccount(ctx, alice.Bytes())
+		Expect(acc.SetSequence(4)).To(Succeed())
+		ak.SetAccount(ctx, acc)
+		_, broken = invariant(ctx)
+		Expect(broken).To(BeTrue())
+	})
+
+	It("should detect orphaned and dangling code", func() {
+		codeHash := crypto.Keccak256Hash(code)
+		store.Set(state.CodeKeyFor(codeHash), code)
+		_, broken := keeper.CodeInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+
+		store.Delete(state.CodeKeyFor(codeHash))
+		store.Set(state.CodeHashKeyFor(alice), codeHash.Bytes())
+		_, broken = keeper.CodeInvariant(k)(ctx)
+		Expect(broken).To(BeTrue())
+	})
+
+	Context("with the balances kept in x/bank", func() {
+		BeforeEach(func() {
+			// the balances must be backed by x/bank before any balance is set.
+			store.Delete(state.BalanceKeyFor(alice))
+			k.EnableBankBalances(bk, cosmlib.NewDenomScaler("ubera", 6))
+		})
+
+		It("should keep the EVM balances equal to the bank balances", func() {
+			Expect(k.AddBalance(ctx, alice.Bytes(), big.NewInt(3e12+5))).To(Succeed())
+			Expect(k.SubBalance(ctx, alice.Bytes(), big.NewInt(1e12+10))).To(Succeed())
+
+			Expect(k.GetBalance(ctx, alice.Bytes())).To(Equal(big.NewInt(2e12 - 5)))
+			Expect(bk.GetBalance(ctx, alice.Bytes(), "ubera").Amount).To(Equal(sdkmath.NewInt(1)))
+			msg, broken := keeper.BalancesInvariant(k)(ctx)
+			Expect(broken).To(BeFalse(), msg)
+		})
+
+		It("should detect a fraction of a unit or more", func() {
+			store.Set(state.BalanceKeyFor(alice), big.NewInt(1e12).Bytes())
+			_, broken := keeper.BalancesInvariant(k)(ctx)
+			Expect(broken).To(BeTrue())
+		})
+
+		It("should detect a fraction that is not backed by the reserve", func() {
+			Expect(k.AddBalance(ctx, alice.Bytes(), big.NewInt(5))).To(Succeed())
+			msg, broken := keeper.BalancesInvariant(k)(ctx)
+			Expect(broken).To(BeFalse(), msg)
+
+			store.Set(state.BalanceKeyFor(alice), big.NewInt(6).Bytes())
+			_, broken = keeper.BalancesInvariant(k)(ctx)
+			Expect(broken).To(BeTrue())
+		})
+	})
+})
@@ -21,7 +21,11 @@
 package keeper
 
 import (
+	"errors"
 	"math/big"
+	"sync/atomic"
+
+	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -32,17 +36,28 @@ import (
 
 	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
-	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 	ethlog "pkg.berachain.dev/polaris/eth/log"
 	"pkg.berachain.dev/polaris/eth/polar"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+	"pkg.berachain.dev/polaris/lib/utils"
 )
 
+// OffchainDBName is the name of the off-chain database in the polaris data directory.
+const OffchainDBName = "historical"
+
 type Keeper struct {
 	// ak is the reference to the AccountKeeper.
 	ak state.AccountKeeper
+	// balances keeps the EVM balances, it is shared with the state plugin.
+	balances *state.Balances
+	// bk is the bank keeper backing the EVM balances, it is nil if they are kept in the evm store.
+	bk state.BankKeeper
+	// communityPool receives the base fee sent to the community pool, it is nil if not set.
+	communityPool CommunityPoolKeeper
 	// provider is the struct that houses the Polaris EVM.
 	polaris *polar.Polaris
 	// The (unexposed) key used to access the store from the Context.
@@ -51,6 +66,15 @@ type Keeper struct {
 	authority string
 	// The host contains various plugins that are are used to implement `core.PolarisHostChain`.
 	host Host
+	// historyReplayed is set once the historical data lost on the last shutdown is replayed.
+	historyReplayed bool
+	// gasAudit is set if the gas consumed by each transaction is audited, it can be toggled while
+	// the node runs.
+	gasAudit atomic.Bool
+	// chainIDs maps the Cosmos chain-ids to the EVM chain IDs, it is nil if not enforced.
+	chainIDs types.ChainIDRegistry
+	// chainIDValidated is set once the EVM chain ID is validated against chainIDs on startup.
+	chainIDValidated bool
 }
 
 // NewKeeper creates new instances of the polaris Keeper.
@@ -65,6 +89,7 @@ func NewKeeper(
 	// We setup the keeper with some Cosmos standard sauce.
 	k := &Keeper{
 		ak:        ak,
+		balances:  state.NewBalances(storeKey),
 		authority: authority,
 		storeKey:  storeKey,
 	}
@@ -74,35 +99,73 @@ func NewKeeper(
 		sk,
 		ethTxMempool,
 		pcs,
+		k.DisposeBaseFee,
 	)
 	return k
 }
 
-// Setup sets up the plugins in the Host. It also build the Polaris EVM Provider.
+// Setup sets up the plugins in the Host. It also build the Polaris EVM Provider. It returns an
+// error if the off-chain database or the networking stack cannot be opened.
 func (k *Keeper) Setup(
 	_ *storetypes.KVStoreKey,
 	qc func(height int64, prove bool) (sdk.Context, error),
 	polarisConfigPath string,
 	polarisDataDir string,
 	logger log.Logger,
-) {
+) error {
+	return k.setup(qc, polarisConfigPath, polarisDataDir, logger, polar.NewGethNetworkingStack)
+}
+
+// SetupOnMux sets up the keeper like `Setup`, but serves the JSON-RPC APIs of its Polaris EVM
+// under the path of the given name on the networking stack shared through the mux, for the
+// app-chains that embed several EVMs. The networking settings of its node config are ignored.
+func (k *Keeper) SetupOnMux(
+	mux *polar.Mux,
+	name string,
+	qc func(height int64, prove bool) (sdk.Context, error),
+	polarisConfigPath string,
+	polarisDataDir string,
+	logger log.Logger,
+) error {
+	return k.setup(qc, polarisConfigPath, polarisDataDir, logger,
+		func(*polar.NodeConfig) (polar.NetworkingStack, error) { return mux.Stack(name), nil },
+	)
+}
+
+// setup sets up the keeper, serving the JSON-RPC APIs on the networking stack built from the node
+// config.
+func (k *Keeper) setup(
+	qc func(height int64, prove bool) (sdk.Context, error),
+	polarisConfigPath string,
+	polarisDataDir string,
+	logger log.Logger,
+	newStack func(*polar.NodeConfig) (polar.NetworkingStack, error),
+) error {
+	// Open the off-chain database, to which historical data is written asynchronously.
+	offchainDB, err := dbm.NewGoLevelDB(OffchainDBName, polarisDataDir, nil)
+	if err != nil {
+		return errorslib.Wrapf(err, "failed to open the %s database", OffchainDBName)
+	}
+
 	// Setup plugins in the Host
-	k.host.Setup(k.storeKey, nil, k.ak, qc)
+	k.host.Setup(k.storeKey, offchainDB, k.ak, k.balances, qc)
 
 	// Build the Polaris EVM Provider
 	cfg, err := polar.LoadConfigFromFilePath(polarisConfigPath)
-	// TODO: fix properly
-	if err != nil || cfg.GPO == nil {
-		logger.Error("failed to load polaris config", "falling back to defaults")
+	if err != nil {
+		logger.Error("failed to load polaris config", "falling back to defaults", "err", err)
 		cfg = polar.DefaultConfig()
 	}
 
-	// TODO: PARSE POLARIS.TOML CORRECT AGAIN
-	nodeCfg := polar.DefaultGethNodeConfig()
+	nodeCfg, err := polar.LoadNodeConfigFromFilePath(polarisConfigPath)
+	if err != nil {
+		logger.Error("failed to load polaris node config", "falling back to defaults", "err", err)
+		nodeCfg = polar.DefaultNodeConfig()
+	}
 	nodeCfg.DataDir = polarisDataDir
-	node, err := polar.NewGethNetworkingStack(nodeCfg)
+	node, err := newStack(nodeCfg)
 	if err != nil {
-		panic(err)
+		return errors.Join(err, offchainDB.Close())
 	}
 
 	k.polaris = polar.NewWithNetworkingStack(cfg, k.host, node, ethlog.FuncHandler(
@@ -119,6 +182,7 @@ func (k *Keeper) Setup(
 			return nil
 		}),
 	)
+	return nil
 }
 
 // Logger returns a module-specific logger.
@@ -126,43 +190,99 @@ func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(types.ModuleName)
 }
 
+// SetGasAudit toggles the gas audit mode, in which the gas consumed on the Cosmos gas meter by
+// each Ethereum transaction is checked against the gas used by the EVM, and any discrepancy is
+// logged. It is meant to catch metering drift between the two, e.g. a store access that is charged
+// on the gas meter, at the cost of the extra logging.
+func (k *Keeper) SetGasAudit(enabled bool) {
+	k.gasAudit.Store(enabled)
+}
+
+// SetRPCLimits updates the caps of the rpc calls served by the Polaris EVM. It must be called
+// after `Setup`.
+func (k *Keeper) SetRPCLimits(limits polar.RPCLimits) {
+	k.polaris.SetRPCLimits(limits)
+}
+
+// EnableCallTraces persists the call traces of the Ethereum transactions to the off-chain database
+// as they are executed, keeping the ones of the last `retention` blocks, or all of them if it is
+// 0. It must be called after `Setup`.
+func (k *Keeper) EnableCallTraces(retention uint64) {
+	utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin()).EnableCallTraces(retention)
+}
+
+// SetChainIDRegistry sets the registry that maps the Cosmos chain-id of each environment of the
+// chain to its EVM chain ID. The EVM chain ID of the chain config is then validated against it at
+// genesis and on startup. It panics if the registry is invalid.
+func (k *Keeper) SetChainIDRegistry(registry types.ChainIDRegistry) {
+	if err := registry.Validate(); err != nil {
+		panic(err)
+	}
+	k.chainIDs = registry
+}
+
 // GetHost returns the Host that contains all plugins.
 func (k *Keeper) GetHost() Host {
 	return k.host
 }
 
 func (k *Keeper) SetClientCtx(clientContext client.Context) {
-	k.host.GetTxPoolPlugin().(txpool.Plugin).SetClientContext(clientContext)
+	k.host.SetClientContext(clientContext)
 	// TODO: move this
 	if err := k.polaris.StartServices(); err != nil {
 		panic(err)
 	}
 }
 
-// TODO: Remove these, because they're hacky af.
-// Required temporarily for BGT plugin.
+// Close shuts the Polaris EVM down once the node stopped finalizing blocks: the JSON-RPC servers
+// are drained and stopped, then the historical data of the finalized blocks is written to the
+// off-chain database, which is closed. It has no effect if `Setup` was not called.
+func (k *Keeper) Close() error {
+	if k.polaris == nil {
+		return nil
+	}
+	return errors.Join(
+		k.polaris.Close(),
+		utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin()).Close(),
+	)
+}
+
+// EnableBankBalances keeps the EVM balances in x/bank, in the denom of the given scaler, so that
+// the native token balance of an account is the same to the EVM and to x/bank. It must be enabled
+// before any EVM balance is set, including by the genesis of the module.
+func (k *Keeper) EnableBankBalances(bk state.BankKeeper, scaler cosmlib.DenomScaler) {
+	k.bk = bk
+	k.balances.SetBank(bk, scaler)
+}
+
+// SetCommunityPoolKeeper sets the keeper of the community pool, to which the base fee can be sent
+// once the EVM balances are kept in x/bank.
+func (k *Keeper) SetCommunityPoolKeeper(cpk CommunityPoolKeeper) {
+	k.communityPool = cpk
+}
+
+// SetHoldingsKeeper sets the keeper of the coins of every denom held by the accounts in x/bank, so
+// that the accounts deleted by the EVM are kept in x/auth while they hold any.
+func (k *Keeper) SetHoldingsKeeper(hk state.HoldingsKeeper) {
+	k.balances.SetHoldings(hk)
+}
+
+// GetBalance returns the EVM balance of the given account.
 func (k *Keeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress) *big.Int {
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	return new(big.Int).SetBytes(ctx.KVStore(k.storeKey).Get(state.BalanceKeyFor(ethAddr)))
+	return k.balances.Get(ctx, cosmlib.AccAddressToEthAddress(addr))
 }
 
-func (k *Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), amount.Bytes())
+// SetBalance sets the EVM balance of the given account.
+func (k *Keeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	return k.balances.Set(ctx, cosmlib.AccAddressToEthAddress(addr), amount)
 }
 
-func (k *Keeper) AddBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
-	}
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), new(big.Int).Add(k.GetBalance(ctx, addr), amount).Bytes())
+// AddBalance adds the given amount to the EVM balance of the given account.
+func (k *Keeper) AddBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	return k.balances.Add(ctx, cosmlib.AccAddressToEthAddress(addr), amount)
 }
 
-func (k *Keeper) SubBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
-	}
-	ethAddr := cosmlib.AccAddressToEthAddress(addr)
-	ctx.KVStore(k.storeKey).Set(state.BalanceKeyFor(ethAddr), new(big.Int).Sub(k.GetBalance(ctx, addr), amount).Bytes())
+// SubBalance subtracts the given amount from the EVM balance of the given account.
+func (k *Keeper) SubBalance(ctx sdk.Context, addr sdk.AccAddress, amount *big.Int) error {
+	return k.balances.Sub(ctx, cosmlib.AccAddressToEthAddress(addr), amount)
 }
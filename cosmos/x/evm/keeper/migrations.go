@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/rlp"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	errorslib "pkg.berachain.dev/polaris/lib/errors"
+)
+
+// migrationBatchSize is the number of keys moved at once by the migration of the store layout,
+// which bounds the memory used to collect them.
+const migrationBatchSize = 10_000
+
+// Migrate1to2 migrates the evm store from consensus version 1, the layout of the released
+// versions, to 2. Every key of the legacy single byte layout is moved to its namespace in the
+// current layout (see `types.Namespaces`), the headers and the receipts are re-encoded with their
+// current versioned encodings, and the metadata of every stored block is written. The upgrade
+// height is recorded as the number of the first block whose header commits to the logs bloom of
+// its receipts, as the headers of the blocks before it were stored with an empty logs bloom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.k.storeKey)
+
+	// the singletons are moved as is.
+	for _, singleton := range []struct {
+		legacy byte
+		key    []byte
+	}{
+		{types.LegacyChainConfigPrefix, types.ChainConfigKey},
+		{types.LegacyHeaderKey, types.HeaderKey},
+		{types.LegacyGenesisHeaderKey, types.GenesisHeaderKey},
+		{types.LegacyVersionKey, types.VersionKey},
+	} {
+		if bz := store.Get([]byte{singleton.legacy}); bz != nil {
+			store.Set(singleton.key, bz)
+			store.Delete([]byte{singleton.legacy})
+		}
+	}
+
+	// the keys of the keyed namespaces are rebuilt from their legacy suffix.
+	addressKey := func(keyFor func(common.Address) []byte) func([]byte) []byte {
+		return func(suffix []byte) []byte { return keyFor(common.BytesToAddress(suffix)) }
+	}
+	prefixed := func(prefix byte) func([]byte) []byte {
+		return func(suffix []byte) []byte { return append([]byte{prefix}, suffix...) }
+	}
+	for _, ns := range []struct {
+		legacy byte
+		keyFor func(suffix []byte) []byte
+	}{
+		{types.LegacyCodeKeyPrefix, prefixed(types.CodeKeyPrefix)},
+		{types.LegacyCodeHashKeyPrefix, addressKey(state.CodeHashKeyFor)},
+		{types.LegacyBalanceKeyPrefix, addressKey(state.BalanceKeyFor)},
+		{types.LegacyStorageKeyPrefix, func(suffix []byte) []byte {
+			return state.SlotKeyFor(
+				common.BytesToAddress(suffix[:common.AddressLength]),
+				common.BytesToHash(suffix[common.AddressLength:]),
+			)
+		}},
+		{types.LegacyBlockHashKeyToNumPrefix, prefixed(types.BlockHashKeyToNumPrefix)},
+		{types.LegacyBlockNumKeyToBlockPrefix, prefixed(types.BlockNumKeyToBlockPrefix)},
+		{types.LegacyBlockHashKeyToReceiptsPrefix, prefixed(types.BlockHashKeyToReceiptsPrefix)},
+		{types.LegacyTxHashKeyToTxPrefix, prefixed(types.TxHashKeyToTxPrefix)},
+	} {
+		moveNamespace(store, ns.legacy, ns.keyFor)
+	}
+
+	// the moved headers and receipts are re-encoded, and the block metadata is written.
+	if err := migrateHeaders(store, types.GenesisHeaderKey, types.HeaderKey); err != nil {
+		return err
+	}
+	if err := migrateReceipts(store, types.BlockHashKeyToReceiptsPrefix); err != nil {
+		return err
+	}
+	if err := migrateBlockMetadata(
+		store, types.BlockNumKeyToBlockPrefix, types.BlockNumKeyToMetadataPrefix,
+	); err != nil {
+		return err
+	}
+
+	store.Set(types.LogsBloomHeightKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+	return nil
+}
+
+// moveNamespace moves every key under the given legacy namespace to the key returned by keyFor
+// for its suffix, in batches, as the store must not be written while it is iterated.
+func moveNamespace(store storetypes.KVStore, legacy byte, keyFor func(suffix []byte) []byte) {
+	for {
+		var keys, values [][]byte
+		it := storetypes.KVStorePrefixIterator(store, []byte{legacy})
+		for ; it.Valid() && len(keys) < migrationBatchSize; it.Next() {
+			keys, values = append(keys, it.Key()), append(values, it.Value())
+		}
+		it.Close()
+
+		for i, key := range keys {
+			store.Set(keyFor(key[1:]), values[i])
+			store.Delete(key)
+		}
+		if len(keys) < migrationBatchSize {
+			return
+		}
+	}
+}
+
+// MigrateHeaders re-encodes the stored Polaris headers that were written with an older header
+// encoding using the current one. Headers of every known version are readable without it, so it
+// is meant to be run by an upgrade handler before an old version's decoder is dropped.
+func (k *Keeper) MigrateHeaders(ctx sdk.Context) error {
+	return migrateHeaders(ctx.KVStore(k.storeKey), types.GenesisHeaderKey, types.HeaderKey)
+}
+
+// migrateHeaders re-encodes the headers stored under the given keys with the current encoding.
+func migrateHeaders(store storetypes.KVStore, keys ...[]byte) error {
+	for _, key := range keys {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		migrated, ok, err := coretypes.MigrateHeader(bz)
+		if err != nil {
+			return errorslib.Wrapf(err, "MigrateHeaders: failed to migrate header %x", key)
+		}
+		if ok {
+			store.Set(key, migrated)
+		}
+	}
+	return nil
+}
+
+// migrateReceipts re-encodes the receipts kept under the given namespace that were written with
+// an older receipts encoding using the current one.
+func migrateReceipts(store storetypes.KVStore, prefix byte) error {
+	// collect the receipts first, as the store must not be written while it is iterated.
+	var keys, values [][]byte
+	it := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	for ; it.Valid(); it.Next() {
+		bz, ok, err := types.MigrateReceipts(it.Value())
+		if err != nil {
+			it.Close()
+			return errorslib.Wrapf(err, "MigrateReceipts: failed to migrate receipts %x", it.Key())
+		}
+		if ok {
+			keys, values = append(keys, it.Key()), append(values, bz)
+		}
+	}
+	it.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+	return nil
+}
+
+// migrateBlockMetadata writes, under the given metadata namespace, the metadata of the blocks
+// kept under the given block namespace that were stored before their metadata was.
+func migrateBlockMetadata(store storetypes.KVStore, blockPrefix, metadataPrefix byte) error {
+	var keys, values [][]byte
+	it := storetypes.KVStorePrefixIterator(store, []byte{blockPrefix})
+	for ; it.Valid(); it.Next() {
+		key := append([]byte{metadataPrefix}, it.Key()[1:]...)
+		if store.Has(key) {
+			continue
+		}
+		block := &coretypes.Block{}
+		if err := rlp.DecodeBytes(it.Value(), block); err != nil {
+			it.Close()
+			return errorslib.Wrapf(err, "MigrateBlockMetadata: failed to decode block %x", key[1:])
+		}
+		bz, err := types.NewBlockMetadata(block).Marshal()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		keys, values = append(keys, key), append(values, bz)
+	}
+	it.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrations", func() {
+	var (
+		k     *keeper.Keeper
+		ctx   sdk.Context
+		store storetypes.KVStore
+		alice = common.Address{0x1}
+		block = coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1), BaseFee: big.NewInt(7)}, nil, nil, nil, nil,
+		)
+		receiptsHash = common.Hash{0x1}
+	)
+
+	// legacyKey returns a key of the store layout of consensus version 1.
+	legacyKey := func(prefix byte, suffix ...[]byte) []byte {
+		key := []byte{prefix}
+		for _, bz := range suffix {
+			key = append(key, bz...)
+		}
+		return key
+	}
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, nil, testutil.EvmKey, "authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		store = ctx.KVStore(testutil.EvmKey)
+
+		// write the store of a chain at consensus version 1.
+		blockBz, err := rlp.EncodeToBytes(block)
+		Expect(err).ToNot(HaveOccurred())
+		store.Set(legacyKey(types.LegacyBlockNumKeyToBlockPrefix, sdk.Uint64ToBigEndian(1)), blockBz)
+
+		receipts := coretypes.Receipts{{Status: 1, CumulativeGasUsed: 21000}}
+		legacy, err := coretypes.MarshalReceipts(receipts)
+		Expect(err).ToNot(HaveOccurred())
+		store.Set(legacyKey(types.LegacyBlockHashKeyToReceiptsPrefix, receiptsHash.Bytes()), legacy)
+
+		store.Set([]byte{types.LegacyChainConfigPrefix}, []byte{0x2a})
+		store.Set(legacyKey(types.LegacyBalanceKeyPrefix, alice.Bytes()), []byte{0x64})
+		store.Set(
+			legacyKey(types.LegacyStorageKeyPrefix, alice.Bytes(), common.Hash{0x2}.Bytes()),
+			common.Hash{0x3}.Bytes(),
+		)
+	})
+
+	It("should move every key to the current layout", func() {
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+
+		Expect(store.Get(types.ChainConfigKey)).To(Equal([]byte{0x2a}))
+		Expect(k.GetBalance(ctx, alice.Bytes())).To(Equal(big.NewInt(100)))
+		Expect(state.GetStateFromStore(store, alice, common.Hash{0x2})).To(Equal(common.Hash{0x3}))
+		Expect(store.Get(append(
+			[]byte{types.BlockNumKeyToBlockPrefix}, sdk.Uint64ToBigEndian(1)...,
+		))).ToNot(BeNil())
+
+		// nothing is left under the legacy namespaces.
+		it := store.Iterator(nil, []byte{types.SingletonKeyPrefix})
+		defer it.Close()
+		Expect(it.Valid()).To(BeFalse())
+	})
+
+	It("should re-encode the receipts and write the block metadata", func() {
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+
+		migrated := store.Get(append(
+			[]byte{types.BlockHashKeyToReceiptsPrefix}, receiptsHash.Bytes()...,
+		))
+		Expect(types.ReceiptsVersion(migrated)).To(Equal(types.CurrentReceiptsVersion))
+		decoded, err := types.UnmarshalReceipts(migrated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded[0].CumulativeGasUsed).To(Equal(uint64(21000)))
+
+		md := &types.BlockMetadata{}
+		Expect(md.Unmarshal(store.Get(append(
+			[]byte{types.BlockNumKeyToMetadataPrefix}, sdk.Uint64ToBigEndian(1)...,
+		)))).To(Succeed())
+		Expect(md.Hash).To(Equal(block.Hash().Bytes()))
+		Expect(md.BaseFeeInt()).To(Equal(big.NewInt(7)))
+	})
+
+	It("should record the height from which the headers commit to their logs bloom", func() {
+		ctx = ctx.WithBlockHeight(42)
+		Expect(keeper.NewMigrator(k).Migrate1to2(ctx)).To(Succeed())
+		Expect(sdk.BigEndianToUint64(store.Get(types.LogsBloomHeightKey))).To(Equal(uint64(42)))
+	})
+})
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+)
+
+// Migrator runs the in-place store migrations of the evm module. Every change to the layout or to
+// the encoding of the evm store bumps the consensus version of the module and comes with a handler
+// migrating the store from the previous version, listed in `Migrations`, so that live networks
+// upgrade with `module.Manager.RunMigrations` from an upgrade handler.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a Migrator of the store of the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrations returns the migration handlers, keyed by the consensus version they migrate from.
+func (m Migrator) Migrations() map[uint64]module.MigrationHandler {
+	return map[uint64]module.MigrationHandler{
+		1: m.Migrate1to2,
+	}
+}
+
+// Register registers with the given configurator the handlers migrating the store from version 1
+// up to the given consensus version. It fails if a handler is missing, or if there is a handler
+// beyond the consensus version, so that the consensus version cannot be bumped without a
+// migration nor a migration be added without bumping it.
+func (m Migrator) Register(cfg module.Configurator, consensusVersion uint64) error {
+	migrations := m.Migrations()
+	if uint64(len(migrations)) >= consensusVersion {
+		return fmt.Errorf(
+			"%d %s migrations are registered for consensus version %d",
+			len(migrations), types.ModuleName, consensusVersion,
+		)
+	}
+	for from := uint64(1); from < consensusVersion; from++ {
+		handler, ok := migrations[from]
+		if !ok {
+			return fmt.Errorf(
+				"missing %s migration from version %d to %d", types.ModuleName, from, from+1,
+			)
+		}
+		if err := cfg.RegisterMigration(types.ModuleName, from, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingConfigurator records the migrations registered with it.
+type recordingConfigurator struct {
+	module.Configurator
+	migrations map[uint64]module.MigrationHandler
+}
+
+func (c *recordingConfigurator) RegisterMigration(
+	moduleName string, fromVersion uint64, handler module.MigrationHandler,
+) error {
+	Expect(moduleName).To(Equal(types.ModuleName))
+	c.migrations[fromVersion] = handler
+	return nil
+}
+
+var _ = Describe("Migrator", func() {
+	var (
+		m   keeper.Migrator
+		cfg *recordingConfigurator
+	)
+
+	BeforeEach(func() {
+		_, ak, _, _ := testutil.SetupMinimalKeepers()
+		m = keeper.NewMigrator(keeper.NewKeeper(
+			ak, nil, testutil.EvmKey, "authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		))
+		cfg = &recordingConfigurator{migrations: make(map[uint64]module.MigrationHandler)}
+	})
+
+	It("should register a migration from every version prior to the consensus version", func() {
+		Expect(m.Register(cfg, evm.ConsensusVersion)).To(Succeed())
+		Expect(cfg.migrations).To(HaveLen(int(evm.ConsensusVersion) - 1))
+		for from := uint64(1); from < evm.ConsensusVersion; from++ {
+			Expect(cfg.migrations).To(HaveKey(from))
+		}
+	})
+
+	It("should fail when a migration is missing", func() {
+		Expect(m.Register(cfg, evm.ConsensusVersion+1)).ToNot(Succeed())
+	})
+
+	It("should fail when a migration is beyond the consensus version", func() {
+		Expect(m.Register(cfg, evm.ConsensusVersion-1)).ToNot(Succeed())
+	})
+})
@@ -22,10 +22,19 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/block"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/lib/utils"
 )
 
 // Compile-time check to ensure `Keeper` implements the `MsgServiceServer` interface.
@@ -36,8 +45,15 @@ var _ types.MsgServiceServer = &Keeper{}
 func (k *Keeper) EthTransaction(
 	ctx context.Context, msg *types.WrappedEthereumTransaction,
 ) (*types.WrappedEthereumTransactionResult, error) {
+	tx := msg.AsTransaction()
+
+	// Reuse the transaction verified by the ante handler, which carries its recovered sender.
+	if vtx, ok := types.VerifiedEthTxFromContext(ctx); ok && vtx.Tx.Hash() == tx.Hash() {
+		tx = vtx.Tx
+	}
+
 	// Process the transaction and return the result.
-	result, err := k.ProcessTransaction(ctx, msg.AsTransaction())
+	result, err := k.ProcessTransaction(ctx, tx)
 	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to process transaction")
 	}
@@ -48,9 +64,100 @@ func (k *Keeper) EthTransaction(
 		vmErr = result.Err.Error()
 	}
 
+	// Emit the Ethereum transaction event so that the transaction can be found by its Ethereum
+	// hash through the CometBFT RPC, followed by an event for each of its logs.
+	receipt := k.polaris.LastReceipt()
+	if receipt != nil && receipt.TxHash != tx.Hash() {
+		receipt = nil
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, tx.Hash().Hex()),
+		sdk.NewAttribute(types.AttributeKeyEthereumTxSender, coretypes.GetSender(tx).Hex()),
+		sdk.NewAttribute(types.AttributeKeyEthereumTxRecipient, txRecipient(tx, receipt)),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(result.UsedGas, 10)),
+	}
+	if vmErr != "" {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, vmErr))
+	}
+	events := sdk.Events{sdk.NewEvent(types.EventTypeEthereumTx, attrs...)}
+	if receipt != nil {
+		for _, log := range receipt.Logs {
+			events = append(events, txLogEvent(log))
+		}
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(events)
+
 	return &types.WrappedEthereumTransactionResult{
 		GasUsed:    result.UsedGas,
 		VmError:    vmErr,
 		ReturnData: result.ReturnData,
 	}, nil
 }
+
+// RegisterCoinbase implements the MsgServiceServer interface. It registers the EVM address used as
+// the coinbase of the blocks proposed by the validator of the message's operator, which receives
+// the priority fees of the transactions in those blocks.
+func (k *Keeper) RegisterCoinbase(
+	ctx context.Context, msg *types.MsgRegisterCoinbase,
+) (*types.MsgRegisterCoinbaseResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid coinbase registration")
+	}
+
+	bp := utils.MustGetAs[block.Plugin](k.host.GetBlockPlugin())
+	if err := bp.SetCoinbase(
+		sdk.UnwrapSDKContext(ctx), msg.ValAddress(), msg.CoinbaseAddress(),
+	); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to register coinbase")
+	}
+	return &types.MsgRegisterCoinbaseResponse{}, nil
+}
+
+// UpdateParams implements the MsgServiceServer interface. It updates the parameters of the x/evm
+// module, and can only be executed by the module authority.
+func (k *Keeper) UpdateParams(
+	ctx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority,
+		)
+	}
+	if msg.Params == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "params must be supplied")
+	}
+
+	if err := k.SetParams(sdk.UnwrapSDKContext(ctx), msg.Params); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to update params")
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// txRecipient returns the hex address of the recipient of the given transaction, which is the
+// address of the created contract for contract creations. It is empty if the contract address is
+// not known.
+func txRecipient(tx *coretypes.Transaction, receipt *coretypes.Receipt) string {
+	if to := tx.To(); to != nil {
+		return to.Hex()
+	}
+	if receipt != nil {
+		return receipt.ContractAddress.Hex()
+	}
+	return ""
+}
+
+// txLogEvent returns the event of the given log of an Ethereum transaction.
+func txLogEvent(log *coretypes.Log) sdk.Event {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+	return sdk.NewEvent(
+		types.EventTypeTxLog,
+		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, log.TxHash.Hex()),
+		sdk.NewAttribute(types.AttributeKeyLogIndex, strconv.FormatUint(uint64(log.Index), 10)),
+		sdk.NewAttribute(types.AttributeKeyLogAddress, log.Address.Hex()),
+		sdk.NewAttribute(types.AttributeKeyLogTopics, strings.Join(topics, ",")),
+		sdk.NewAttribute(types.AttributeKeyLogData, hexutil.Encode(log.Data)),
+	)
+}
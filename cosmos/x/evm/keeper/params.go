@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+)
+
+// CommunityPoolKeeper defines the expected keeper of the community pool.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// GetParams returns the parameters of the x/evm module, or the default parameters if none are
+// stored.
+func (k *Keeper) GetParams(ctx sdk.Context) *types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	params := &types.Params{}
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams validates and stores the parameters of the x/evm module.
+func (k *Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if params.BaseFeeDisposition == types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL &&
+		(k.bk == nil || k.communityPool == nil) {
+		return errors.New(
+			"the base fee can only be sent to the community pool if the EVM balances are kept in " +
+				"x/bank and the community pool keeper is set",
+		)
+	}
+	bz, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, bz)
+	return nil
+}
+
+// DisposeBaseFee disposes of the given base fee, which the state transition burns, as set by the
+// module parameters: it is either credited to the treasury, sent to the community pool or left
+// burned. The disposition is atomic, nothing is written if it fails.
+func (k *Keeper) DisposeBaseFee(ctx sdk.Context, fee *big.Int) error {
+	params := k.GetParams(ctx)
+	cacheCtx, write := ctx.CacheContext()
+	var err error
+	switch params.BaseFeeDisposition {
+	case types.BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY:
+		err = k.AddBalance(cacheCtx, cosmlib.AddressToAccAddress(*params.BaseFeeRecipient()), fee)
+	case types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL:
+		err = k.fundCommunityPool(cacheCtx, fee)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// fundCommunityPool sends the whole units of the bank denom in the given wei to the community
+// pool, by minting them to the evm module account, which funds the community pool with them. The
+// wei that do not add up to a whole unit stay burned.
+func (k *Keeper) fundCommunityPool(ctx sdk.Context, fee *big.Int) error {
+	scaler := k.balances.Scaler()
+	if k.bk == nil || scaler == nil || k.communityPool == nil {
+		return errors.New("the community pool is not available")
+	}
+	units, _ := scaler.FromWei(fee)
+	if !units.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(scaler.Denom(), units))
+	if err := k.bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.communityPool.FundCommunityPool(
+		ctx, coins, authtypes.NewModuleAddress(types.ModuleName),
+	)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// communityPool is a community pool that keeps the coins it is funded with in its account.
+type communityPool struct {
+	bk   bankkeeper.BaseKeeper
+	addr sdk.AccAddress
+}
+
+func (cp *communityPool) FundCommunityPool(
+	ctx context.Context, amount sdk.Coins, sender sdk.AccAddress,
+) error {
+	return cp.bk.SendCoins(ctx, sender, cp.addr, amount)
+}
+
+// failingCommunityPool is a community pool that cannot be funded.
+type failingCommunityPool struct{}
+
+func (failingCommunityPool) FundCommunityPool(context.Context, sdk.Coins, sdk.AccAddress) error {
+	return errors.New("community pool unavailable")
+}
+
+var _ = Describe("Base fee disposition", func() {
+	var (
+		k        *keeper.Keeper
+		bk       bankkeeper.BaseKeeper
+		ctx      sdk.Context
+		pool     *communityPool
+		treasury = common.Address{0x1}
+		fee      = big.NewInt(3e12 + 5)
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		k = keeper.NewKeeper(
+			ak, nil, testutil.EvmKey, "authority",
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
+			func() *ethprecompile.Injector { return ethprecompile.NewPrecompiles() },
+		)
+		pool = &communityPool{bk: bk, addr: common.Address{0x2}.Bytes()}
+	})
+
+	It("should leave the base fee burned by default", func() {
+		Expect(k.DisposeBaseFee(ctx, fee)).To(Succeed())
+		Expect(k.GetBalance(ctx, treasury.Bytes())).To(Equal(new(big.Int)))
+	})
+
+	It("should credit the base fee to the treasury", func() {
+		Expect(k.SetParams(ctx, &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY,
+			Treasury:           treasury.Hex(),
+		})).To(Succeed())
+		Expect(k.DisposeBaseFee(ctx, fee)).To(Succeed())
+		Expect(k.GetBalance(ctx, treasury.Bytes())).To(Equal(fee))
+	})
+
+	It("should require the balances in x/bank to send the base fee to the community pool", func() {
+		params := &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL,
+		}
+		Expect(k.SetParams(ctx, params)).ToNot(Succeed())
+		k.SetCommunityPoolKeeper(pool)
+		Expect(k.SetParams(ctx, params)).ToNot(Succeed())
+	})
+
+	It("should send the whole units of the base fee to the community pool", func() {
+		k.EnableBankBalances(bk, cosmlib.NewDenomScaler("ubera", 6))
+		k.SetCommunityPoolKeeper(pool)
+		Expect(k.SetParams(ctx, &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL,
+		})).To(Succeed())
+
+		Expect(k.DisposeBaseFee(ctx, fee)).To(Succeed())
+		Expect(bk.GetBalance(ctx, pool.addr, "ubera").Amount).To(Equal(sdkmath.NewInt(3)))
+		msg, broken := keeper.BalancesInvariant(k)(ctx)
+		Expect(broken).To(BeFalse(), msg)
+	})
+
+	It("should not write anything if the base fee cannot be disposed of", func() {
+		k.EnableBankBalances(bk, cosmlib.NewDenomScaler("ubera", 6))
+		k.SetCommunityPoolKeeper(failingCommunityPool{})
+		Expect(k.SetParams(ctx, &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL,
+		})).To(Succeed())
+
+		supply := bk.GetSupply(ctx, "ubera")
+		Expect(k.DisposeBaseFee(ctx, fee)).ToNot(Succeed())
+		Expect(bk.GetSupply(ctx, "ubera")).To(Equal(supply))
+	})
+})
@@ -23,10 +23,15 @@ package keeper
 import (
 	"context"
 
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/lib/utils"
 )
 
 // ProcessTransaction is called during the DeliverTx processing of the ABCI lifecycle.
@@ -60,6 +65,32 @@ func (k *Keeper) ProcessTransaction(ctx context.Context, tx *coretypes.Transacti
 		)
 	}
 
+	if k.gasAudit.Load() {
+		k.auditGas(sCtx, tx, execResult)
+	}
+
+	// Index the hash of the Cosmos transaction wrapping the Ethereum transaction, unless it is
+	// only simulated.
+	if !sCtx.IsCheckTx() && len(sCtx.TxBytes()) > 0 {
+		utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin()).SetCosmosTxHash(
+			tx.Hash(), common.BytesToHash(cmttypes.Tx(sCtx.TxBytes()).Hash()),
+		)
+	}
+
 	// Return the execution result.
 	return execResult, err
 }
+
+// auditGas logs the transaction if the gas consumed on the gas meter of the context, which was
+// reset before the state transition, differs from the gas used by the EVM.
+func (k *Keeper) auditGas(ctx sdk.Context, tx *coretypes.Transaction, result *core.ExecutionResult) {
+	if consumed := ctx.GasMeter().GasConsumed(); consumed != result.UsedGas {
+		k.Logger(ctx).Error(
+			"gas audit: cosmos gas consumed differs from evm gas used",
+			"tx_hash", tx.Hash(),
+			"gas_consumed", consumed,
+			"gas_used", result.UsedGas,
+			"drift", int64(consumed)-int64(result.UsedGas),
+		)
+	}
+}
@@ -27,19 +27,27 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	bindings "pkg.berachain.dev/polaris/contracts/bindings/testing"
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
 	"pkg.berachain.dev/polaris/cosmos/precompile/staking"
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/ante"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/accounts/abi"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
@@ -65,6 +73,7 @@ var _ = Describe("Processor", func() {
 	var (
 		k            *keeper.Keeper
 		ak           state.AccountKeeper
+		bk           bankkeeper.BaseKeeper
 		sk           stakingkeeper.Keeper
 		ctx          sdk.Context
 		sc           ethprecompile.StatefulImpl
@@ -86,12 +95,12 @@ var _ = Describe("Processor", func() {
 		}
 
 		// before chain, init genesis state
-		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		ctx, ak, bk, sk = testutil.SetupMinimalKeepers()
 		k = keeper.NewKeeper(
 			ak, sk,
 			storetypes.NewKVStoreKey("evm"),
 			"authority",
-			evmmempool.NewPolarisEthereumTxPool(),
+			evmmempool.NewPolarisEthereumTxPool(evmmempool.DefaultConfig()),
 			func() *ethprecompile.Injector {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
 			},
@@ -107,7 +116,9 @@ var _ = Describe("Processor", func() {
 		validator.Status = stakingtypes.Bonded
 		sk.SetValidator(ctx, validator)
 		sc = staking.NewPrecompileContract(&sk)
-		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		Expect(k.Setup(
+			storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger(),
+		)).To(Succeed())
 		_ = sk.SetParams(ctx, stakingtypes.DefaultParams())
 
 		// Set validator with consensus address.
@@ -190,5 +201,176 @@ var _ = Describe("Processor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Err).ToNot(HaveOccurred())
 		})
+
+		It("should emit events for an ethereum transaction and its logs", func() {
+			legacyTxData.Data = common.FromHex(bindings.SolmateERC20Bin)
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			addr, err := signer.Sender(tx)
+			Expect(err).ToNot(HaveOccurred())
+			k.GetHost().GetStatePlugin().Reset(ctx)
+			k.GetHost().GetStatePlugin().CreateAccount(addr)
+			k.GetHost().GetStatePlugin().AddBalance(addr, (&big.Int{}).Mul(big.NewInt(9000000000000000000), big.NewInt(999)))
+			k.GetHost().GetStatePlugin().Finalize()
+
+			// create the contract, the recipient is the created contract
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err = k.EthTransaction(ctx, types.NewFromTransaction(tx))
+			Expect(err).ToNot(HaveOccurred())
+			deployAddress := crypto.CreateAddress(addr, 0)
+			events := eventsOfType(ctx.EventManager().Events(), types.EventTypeEthereumTx)
+			Expect(events).To(HaveLen(1))
+			Expect(eventsOfType(ctx.EventManager().Events(), types.EventTypeTxLog)).To(BeEmpty())
+			Expect(eventAttribute(events[0], types.AttributeKeyEthereumTxSender)).To(Equal(addr.Hex()))
+			Expect(eventAttribute(events[0], types.AttributeKeyEthereumTxRecipient)).
+				To(Equal(deployAddress.Hex()))
+
+			// mint tokens, which emits a Transfer log
+			var solmateABI abi.ABI
+			err = solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))
+			Expect(err).ToNot(HaveOccurred())
+			input, err := solmateABI.Pack("mint", common.BytesToAddress([]byte{0x88}), big.NewInt(8888888))
+			Expect(err).ToNot(HaveOccurred())
+			legacyTxData.To = &deployAddress
+			legacyTxData.Data = input
+			legacyTxData.Nonce++
+			tx = coretypes.MustSignNewTx(key, signer, legacyTxData)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			_, err = k.EthTransaction(ctx, types.NewFromTransaction(tx))
+			Expect(err).ToNot(HaveOccurred())
+			events = eventsOfType(ctx.EventManager().Events(), types.EventTypeEthereumTx)
+			Expect(events).To(HaveLen(1))
+			Expect(eventAttribute(events[0], types.AttributeKeyEthereumTxHash)).To(Equal(tx.Hash().Hex()))
+			Expect(eventAttribute(events[0], types.AttributeKeyEthereumTxRecipient)).
+				To(Equal(deployAddress.Hex()))
+			logs := eventsOfType(ctx.EventManager().Events(), types.EventTypeTxLog)
+			Expect(logs).To(HaveLen(1))
+			Expect(eventAttribute(logs[0], types.AttributeKeyEthereumTxHash)).To(Equal(tx.Hash().Hex()))
+			Expect(eventAttribute(logs[0], types.AttributeKeyLogAddress)).To(Equal(deployAddress.Hex()))
+			Expect(eventAttribute(logs[0], types.AttributeKeyLogTopics)).To(HavePrefix(
+				solmateABI.Events["Transfer"].ID.Hex() + ",",
+			))
+		})
+
+		It("should not charge the fee of a transaction that fails before its state transition", func() {
+			// a transaction whose nonce is ahead of the one of its sender.
+			legacyTxData.Nonce = 5
+			legacyTxData.Gas = 100000
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			addr, err := signer.Sender(tx)
+			Expect(err).ToNot(HaveOccurred())
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			sp.CreateAccount(addr)
+			sp.AddBalance(addr, big.NewInt(1e18))
+			sp.Finalize()
+			sender := cosmlib.AddressToAccAddress(addr)
+			balance := k.GetBalance(ctx, sender)
+
+			// anteHandle runs the signature verification and the fee deduction of the transaction.
+			anteHandle := func(ctx sdk.Context) sdk.Context {
+				deductFee := ante.NewEthDeductFeeDecorator(k)
+				newCtx, err := ante.NewEthSigVerificationDecorator(k).AnteHandle(
+					ctx, ethSDKTx{msg: types.NewFromTransaction(tx)}, false,
+					func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+						return deductFee.AnteHandle(ctx, tx, simulate,
+							func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+								return ctx, nil
+							},
+						)
+					},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return newCtx
+			}
+
+			// the fee is deducted in CheckTx.
+			checkCtx, _ := ctx.CacheContext()
+			checkCtx = anteHandle(checkCtx.WithIsCheckTx(true))
+			fee := new(big.Int).Mul(legacyTxData.GasPrice, big.NewInt(int64(legacyTxData.Gas)))
+			Expect(k.GetBalance(checkCtx, sender)).To(Equal(new(big.Int).Sub(balance, fee)))
+
+			// but neither when the transaction is executed, nor once it failed.
+			deliverCtx := anteHandle(ctx.WithIsCheckTx(false))
+			Expect(k.GetBalance(deliverCtx, sender)).To(Equal(balance))
+			_, err = k.EthTransaction(deliverCtx, types.NewFromTransaction(tx))
+			Expect(err).To(HaveOccurred())
+			Expect(k.GetBalance(deliverCtx, sender)).To(Equal(balance))
+		})
+
+		It("should set up the gas meter of a rechecked transaction", func() {
+			legacyTxData.Gas = 100000
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			addr, err := signer.Sender(tx)
+			Expect(err).ToNot(HaveOccurred())
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			sp.CreateAccount(addr)
+			sp.AddBalance(addr, big.NewInt(1e18))
+			sp.Finalize()
+
+			anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+				HandlerOptions: authante.HandlerOptions{
+					AccountKeeper:   utils.MustGetAs[authante.AccountKeeper](ak),
+					BankKeeper:      bk,
+					SignModeHandler: testutil.GetEncodingConfig().TxConfig.SignModeHandler(),
+				},
+				EVMKeeper: k,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			sdkTx := ethSDKTx{msg: types.NewFromTransaction(tx), gas: tx.Gas()}
+
+			// the transaction is rechecked with its own gas meter.
+			recheckCtx, _ := ctx.CacheContext()
+			recheckCtx, err = anteHandler(recheckCtx.WithIsReCheckTx(true), sdkTx, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recheckCtx.GasMeter().Limit()).To(Equal(tx.Gas()))
+
+			// and rejected once its gas limit exceeds the one of the block.
+			recheckCtx, _ = ctx.CacheContext()
+			recheckCtx = recheckCtx.WithIsReCheckTx(true).WithConsensusParams(cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxGas: int64(tx.Gas()) - 1},
+			})
+			_, err = anteHandler(recheckCtx, sdkTx, false)
+			Expect(err).To(MatchError(sdkerrors.ErrInvalidGasLimit))
+		})
 	})
 })
+
+// ethSDKTx is a Cosmos transaction whose only message is the given Ethereum transaction.
+type ethSDKTx struct {
+	sdk.Tx
+	msg sdk.Msg
+	gas uint64
+}
+
+func (tx ethSDKTx) GetMsgs() []sdk.Msg {
+	return []sdk.Msg{tx.msg}
+}
+
+func (tx ethSDKTx) GetGas() uint64 {
+	return tx.gas
+}
+
+// eventsOfType returns the events of the given type.
+func eventsOfType(events sdk.Events, typ string) sdk.Events {
+	var matching sdk.Events
+	for _, event := range events {
+		if event.Type == typ {
+			matching = append(matching, event)
+		}
+	}
+	return matching
+}
+
+// eventAttribute returns the value of the attribute of the given event with the given key.
+func eventAttribute(event sdk.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return ""
+}
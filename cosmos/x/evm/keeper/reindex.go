@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/polar"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// ReindexHistoricalData rewrites the receipts, transaction lookup entries and contract creations
+// of the blocks from `from` to `to` to the off-chain database, replaying each block on top of the
+// state of its parent, which must not be pruned. It is meant to run offline, with the plugins
+// prepared with the given context, which must be at or after `to`.
+func (k *Keeper) ReindexHistoricalData(ctx sdk.Context, from, to uint64) error {
+	if from > to || to > uint64(ctx.BlockHeight()) {
+		return fmt.Errorf("invalid blocks %d to %d at height %d", from, to, ctx.BlockHeight())
+	}
+	k.host.GetStatePlugin().Prepare(ctx)
+	k.host.GetBlockPlugin().Prepare(ctx)
+	k.host.GetConfigurationPlugin().Prepare(ctx)
+	hp := utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin())
+	hp.Prepare(ctx)
+	return hp.Reindex(from, to, func(blockNum uint64) (coretypes.Receipts, error) {
+		return k.polaris.ReplayReceipts(ctx, blockNum)
+	})
+}
+
+// ReplayBlock re-executes the block with the given number on top of the state of its parent,
+// which must not be pruned, tracing its transactions with the tracer requested by the given config
+// if it is not nil, and compares their receipts with the stored ones. It is meant to run offline,
+// with the plugins prepared with the given context, which must be at or after the block.
+func (k *Keeper) ReplayBlock(
+	ctx sdk.Context, number uint64, cfg *tracers.TraceConfig,
+) ([]*polar.ReplayedTx, error) {
+	if number > uint64(ctx.BlockHeight()) {
+		return nil, fmt.Errorf("invalid block %d at height %d", number, ctx.BlockHeight())
+	}
+	k.host.GetStatePlugin().Prepare(ctx)
+	k.host.GetBlockPlugin().Prepare(ctx)
+	k.host.GetConfigurationPlugin().Prepare(ctx)
+	k.host.GetHistoricalPlugin().Prepare(ctx)
+	return k.polaris.ReplayBlock(ctx, number, cfg)
+}
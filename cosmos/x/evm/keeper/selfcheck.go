@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/historical"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// The names of the checks of the startup self-check.
+const (
+	checkChainConfig = "chain config"
+	checkHeight      = "height"
+	checkIndex       = "off-chain index"
+)
+
+// SelfCheck validates the EVM state at the latest height of the given context before the node
+// starts: the chain config and genesis header against the given genesis, if not nil, the height
+// of the evm store against the app height, and the off-chain database against the app height. The
+// plugins are prepared with the given context.
+func (k *Keeper) SelfCheck(ctx sdk.Context, genesis *core.Genesis) types.Diagnostics {
+	var ds types.Diagnostics
+	ds = append(ds, k.checkChainConfig(ctx, genesis)...)
+	ds = append(ds, k.checkHeight(ctx)...)
+	return append(ds, k.checkIndex(ctx)...)
+}
+
+// checkChainConfig ensures that the chain started from the given genesis, and that its chain
+// config uses the chain ID of the genesis and the EVM chain ID of the Cosmos chain-id. A fork of
+// the chain config that differs from the genesis before the latest block is only a warning, as an
+// upgrade may reschedule it.
+func (k *Keeper) checkChainConfig(ctx sdk.Context, genesis *core.Genesis) types.Diagnostics {
+	var ds types.Diagnostics
+	cp := k.host.GetConfigurationPlugin()
+	cp.Prepare(ctx)
+	cc := cp.ChainConfig()
+	if k.chainIDs != nil {
+		if err := k.chainIDs.ValidateChainConfig(ctx.ChainID(), cc); err != nil {
+			ds = append(ds, types.Fatal(checkChainConfig, err))
+		}
+	}
+	if genesis == nil {
+		return ds
+	}
+
+	bp := k.host.GetBlockPlugin()
+	bp.Prepare(ctx)
+	header, err := bp.GetHeaderByNumber(0)
+	if err != nil {
+		return append(ds, types.Warning(checkChainConfig, fmt.Errorf(
+			"failed to read the genesis header: %w", err,
+		)))
+	}
+	if want := genesis.ToBlock().Hash(); header.Hash() != want {
+		ds = append(ds, types.Fatal(checkChainConfig, fmt.Errorf(
+			"%w: genesis block %s, have %s", types.ErrGenesisMismatch, want.Hex(), header.Hash().Hex(),
+		)))
+	}
+
+	if genesis.Config == nil || cc == nil {
+		return ds
+	}
+	if cc.ChainID == nil || genesis.Config.ChainID == nil ||
+		cc.ChainID.Cmp(genesis.Config.ChainID) != 0 {
+		ds = append(ds, types.Fatal(checkChainConfig, fmt.Errorf(
+			"%w: genesis chain ID %v, have %v", types.ErrGenesisMismatch,
+			genesis.Config.ChainID, cc.ChainID,
+		)))
+	}
+	height, timestamp := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())
+	if compatErr := genesis.Config.CheckCompatible(cc, height, timestamp); compatErr != nil {
+		ds = append(ds, types.Warning(checkChainConfig, fmt.Errorf(
+			"chain config differs from the genesis: %w", compatErr,
+		)))
+	}
+	return ds
+}
+
+// checkHeight ensures that the latest block of the evm store is at the app height.
+func (k *Keeper) checkHeight(ctx sdk.Context) types.Diagnostics {
+	bz := ctx.KVStore(k.storeKey).Get(types.VersionKey)
+	if bz == nil {
+		return nil
+	}
+	if version := sdk.BigEndianToUint64(bz); version != uint64(ctx.BlockHeight()) {
+		return types.Diagnostics{types.Fatal(checkHeight, fmt.Errorf(
+			"%w: evm block %d, app height %d", types.ErrHeightMismatch, version, ctx.BlockHeight(),
+		))}
+	}
+	return nil
+}
+
+// checkIndex ensures that the off-chain database has the historical data of all the blocks up to
+// the app height, once the journaled blocks are replayed. An off-chain database ahead of the app,
+// e.g. after a rollback, is only a warning, as the data of the blocks that are no longer
+// canonical is not served.
+func (k *Keeper) checkIndex(ctx sdk.Context) types.Diagnostics {
+	hp := utils.MustGetAs[historical.Plugin](k.host.GetHistoricalPlugin())
+	hp.Prepare(ctx)
+	indexed, ok, err := hp.IndexedHeight()
+	if err != nil {
+		return types.Diagnostics{types.Fatal(checkIndex, err)}
+	}
+	if !ok {
+		return types.Diagnostics{types.Warning(checkIndex, errors.New(
+			"height unknown, it is recorded once the next block is written",
+		))}
+	}
+	pending, err := hp.PendingBlocks()
+	if err != nil {
+		return types.Diagnostics{types.Fatal(checkIndex, err)}
+	}
+
+	// the journaled blocks are written on the first block after startup.
+	replayed := indexed
+	for _, blockNum := range pending {
+		if blockNum > replayed {
+			replayed = blockNum
+		}
+	}
+	switch appHeight := uint64(ctx.BlockHeight()); {
+	case replayed < appHeight:
+		return types.Diagnostics{types.Fatal(checkIndex, fmt.Errorf(
+			"%w: blocks %d to %d are missing", types.ErrIndexBehind, replayed+1, appHeight,
+		))}
+	case indexed > appHeight:
+		return types.Diagnostics{types.Warning(checkIndex, fmt.Errorf(
+			"at block %d, ahead of the app height %d", indexed, appHeight,
+		))}
+	}
+	return nil
+}
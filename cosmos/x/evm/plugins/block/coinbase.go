@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// ===========================================================================
+// Validator Coinbase Registration
+// ===========================================================================.
+
+// SetCoinbase registers the EVM address used as the coinbase of the blocks proposed by the given
+// validator.
+func (p *plugin) SetCoinbase(ctx sdk.Context, val sdk.ValAddress, coinbase common.Address) error {
+	if _, found := p.sk.GetValidator(ctx, val); !found {
+		return fmt.Errorf("validator not found: %s", val)
+	}
+	coinbaseStore(ctx, p.storekey).Set(address.MustLengthPrefix(val), coinbase.Bytes())
+	return nil
+}
+
+// getCoinbase returns the coinbase registered by the given validator. If the validator has not
+// registered one, the address of its operator is used.
+func (p *plugin) getCoinbase(val sdk.ValAddress) common.Address {
+	if bz := coinbaseStore(p.ctx, p.storekey).Get(address.MustLengthPrefix(val)); bz != nil {
+		return common.BytesToAddress(bz)
+	}
+	return common.BytesToAddress(val)
+}
+
+// coinbaseStore returns the store of the registered coinbases, keyed by length-prefixed validator
+// address.
+func coinbaseStore(ctx sdk.Context, storekey storetypes.StoreKey) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(storekey), []byte{types.CoinbaseKeyPrefix})
+}
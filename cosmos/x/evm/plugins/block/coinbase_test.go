@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Coinbase", func() {
+	var ctx sdk.Context
+	var sk stakingkeeper.Keeper
+	var p *plugin
+	val := sdk.ValAddress(common.BytesToAddress([]byte{0x01}).Bytes())
+	coinbase := common.BytesToAddress([]byte{0x02})
+
+	BeforeEach(func() {
+		ctx, _, _, sk = testutil.SetupMinimalKeepers()
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, sk))
+		p.Prepare(ctx)
+	})
+
+	It("should default to the operator address", func() {
+		Expect(p.getCoinbase(val)).To(Equal(common.BytesToAddress(val)))
+	})
+
+	It("should not register the coinbase of an unknown validator", func() {
+		Expect(p.SetCoinbase(ctx, val, coinbase)).ToNot(Succeed())
+		Expect(p.getCoinbase(val)).To(Equal(common.BytesToAddress(val)))
+	})
+
+	It("should use the registered coinbase", func() {
+		sk.SetValidator(ctx, stakingtypes.Validator{OperatorAddress: val.String()})
+
+		Expect(p.SetCoinbase(ctx, val, coinbase)).To(Succeed())
+		Expect(p.getCoinbase(val)).To(Equal(coinbase))
+	})
+})
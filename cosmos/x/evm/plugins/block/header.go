@@ -23,13 +23,20 @@ package block
 import (
 	"errors"
 
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	errorslib "pkg.berachain.dev/polaris/lib/errors"
 )
 
+// prevHeaderHashes is the number of previous header hashes kept for the BLOCKHASH opcode.
+const prevHeaderHashes = 256
+
 // ===========================================================================
 // Polaris Block Header Tracking
 // ===========================================================================.
@@ -71,18 +78,81 @@ func (p *plugin) StoreHeader(header *coretypes.Header) error {
 	if err != nil {
 		return errorslib.Wrap(err, "SetHeader: failed to marshal header")
 	}
-	p.ctx.KVStore(p.storekey).Set(p.getKeyForBlockNumber(header.Number.Uint64()), bz)
+	number := header.Number.Uint64()
+	p.ctx.KVStore(p.storekey).Set(p.getKeyForBlockNumber(number), bz)
+
+	// Mark the header as the canonical one at its height, replacing the header of a block that was
+	// rolled back.
+	p.canonicalHashStore().Set(sdk.Uint64ToBigEndian(number), header.Hash().Bytes())
+
+	// Track the hash of the header and drop the one that is no longer accessible by BLOCKHASH.
+	hashStore := p.headerHashStore()
+	hashStore.Set(sdk.Uint64ToBigEndian(number), header.Hash().Bytes())
+	if number >= prevHeaderHashes {
+		hashStore.Delete(sdk.Uint64ToBigEndian(number - prevHeaderHashes))
+	}
 	return nil
 }
 
+// GetHeaderHash returns the hash of the header at the given height, or the empty hash if the
+// header is not found.
+//
+// GetHeaderHash implements core.BlockPlugin.
+func (p *plugin) GetHeaderHash(number uint64) common.Hash {
+	if bz := p.headerHashStore().Get(sdk.Uint64ToBigEndian(number)); bz != nil {
+		return common.BytesToHash(bz)
+	}
+
+	// The hash is not tracked if it is too old or was stored before hashes were tracked, so we
+	// fall back to reading the header at its height.
+	header, err := p.GetHeaderByNumber(number)
+	if err != nil || header == nil {
+		return common.Hash{}
+	}
+	return header.Hash()
+}
+
+// GetCanonicalHash returns the hash of the canonical header at the given height, or the empty hash
+// if there is none.
+//
+// GetCanonicalHash implements core.BlockPlugin.
+func (p *plugin) GetCanonicalHash(number uint64) common.Hash {
+	if bz := p.canonicalHashStore().Get(sdk.Uint64ToBigEndian(number)); bz != nil {
+		return common.BytesToHash(bz)
+	}
+
+	// The headers stored before the canonical hashes were tracked are only known by their height.
+	return p.GetHeaderHash(number)
+}
+
+// LogsBloomHeight returns the number of the first block whose header commits to the logs bloom of
+// its receipts, which is recorded by the upgrade to consensus version 2 along with the migration
+// of the store layout. It is 0 on the chains that started at or after that version, whose headers
+// all commit to their logs bloom and are all stored under the current layout.
+func (p *plugin) LogsBloomHeight() uint64 {
+	if bz := p.ctx.KVStore(p.storekey).Get(types.LogsBloomHeightKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
+
+// canonicalHashStore returns the store of the hashes of the canonical headers, keyed by number.
+func (p *plugin) canonicalHashStore() prefix.Store {
+	return prefix.NewStore(p.ctx.KVStore(p.storekey), []byte{types.CanonicalHashKeyPrefix})
+}
+
+// headerHashStore returns the store of the hashes of the previous headers, keyed by number.
+func (p *plugin) headerHashStore() prefix.Store {
+	return prefix.NewStore(p.ctx.KVStore(p.storekey), []byte{types.HeaderHashKeyPrefix})
+}
+
 // getKeyForBlockNumber returns the genesis header key if the requested block number is 0. In all
 // other cases, the regular header key is returned.
 func (p *plugin) getKeyForBlockNumber(number uint64) []byte {
-	key := types.HeaderKey
 	if number == 0 {
-		key = types.GenesisHeaderKey
+		return types.GenesisHeaderKey
 	}
-	return []byte{key}
+	return types.HeaderKey
 }
 
 // readHeaderBytes reads the header at the given height, using the plugin's query context for
@@ -111,11 +181,23 @@ func (p *plugin) readHeaderBytes(number uint64) ([]byte, error) {
 		return nil, errorslib.Wrap(err, "GetHeader: failed to use query context")
 	}
 
+	// The heights prior to the migration of the store layout keep the header under its legacy
+	// key.
+	if number < p.LogsBloomHeight() {
+		return ctx.KVStore(p.storekey).Get([]byte{types.LegacyHeaderKey}), nil
+	}
+
 	// Unmarshal the header at IAVL height from its context kv store.
-	return ctx.KVStore(p.storekey).Get([]byte{types.HeaderKey}), nil
+	return GetHeaderBytesFromStore(ctx.KVStore(p.storekey)), nil
+}
+
+// GetHeaderBytesFromStore returns the bytes of the header of the block at the height of the given
+// store, or nil if there is none.
+func GetHeaderBytesFromStore(store storetypes.KVStore) []byte {
+	return store.Get(types.HeaderKey)
 }
 
 // readGenesisHeaderBytes returns the header bytes at the genesis key.
 func (p *plugin) readGenesisHeaderBytes() []byte {
-	return p.ctx.KVStore(p.storekey).Get([]byte{types.GenesisHeaderKey})
+	return p.ctx.KVStore(p.storekey).Get(types.GenesisHeaderKey)
 }
@@ -21,14 +21,19 @@
 package block
 
 import (
+	"math/big"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("Header", func() {
@@ -42,6 +47,51 @@ var _ = Describe("Header", func() {
 		p.Prepare(ctx)
 	})
 
+	It("should track the hashes of the last 256 headers", func() {
+		hashes := make(map[uint64]common.Hash)
+		for i := uint64(1); i <= 300; i++ {
+			header := &types.Header{Number: new(big.Int).SetUint64(i), ParentHash: hashes[i-1]}
+			Expect(p.StoreHeader(header)).To(Succeed())
+			hashes[i] = header.Hash()
+		}
+
+		for i := uint64(300 - prevHeaderHashes + 1); i <= 300; i++ {
+			Expect(p.GetHeaderHash(i)).To(Equal(hashes[i]))
+		}
+
+		// the hash of an older header is no longer tracked and cannot be read without a query
+		// context.
+		Expect(p.GetHeaderHash(300 - prevHeaderHashes)).To(Equal(common.Hash{}))
+
+		// the canonical hashes are kept for all the headers.
+		for i := uint64(1); i <= 300; i++ {
+			Expect(p.GetCanonicalHash(i)).To(Equal(hashes[i]))
+		}
+	})
+
+	It("should replace the canonical hash of a header that was rolled back", func() {
+		hashes := make(map[uint64]common.Hash)
+		for i := uint64(1); i <= 3; i++ {
+			header := &types.Header{Number: new(big.Int).SetUint64(i), ParentHash: hashes[i-1]}
+			Expect(p.StoreHeader(header)).To(Succeed())
+			hashes[i] = header.Hash()
+		}
+
+		// the host chain rolls back the block at height 3 and commits another one in its place.
+		reorged := &types.Header{
+			Number: big.NewInt(3), ParentHash: hashes[2], GasLimit: 1,
+		}
+		Expect(reorged.Hash()).ToNot(Equal(hashes[3]))
+		Expect(p.StoreHeader(reorged)).To(Succeed())
+
+		Expect(p.GetCanonicalHash(3)).To(Equal(reorged.Hash()))
+		Expect(p.GetHeaderHash(3)).To(Equal(reorged.Hash()))
+		Expect(p.GetCanonicalHash(2)).To(Equal(hashes[2]))
+
+		// no header is canonical at a height that was never reached.
+		Expect(p.GetCanonicalHash(4)).To(Equal(common.Hash{}))
+	})
+
 	// It("set and get header", func() {
 	// 	header := &types.Header{
 	// 		ParentHash:  common.Hash{0x01},
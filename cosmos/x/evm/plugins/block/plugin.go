@@ -32,6 +32,7 @@ import (
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/crypto"
 )
 
 type Plugin interface {
@@ -41,6 +42,11 @@ type Plugin interface {
 
 	// SetQueryContextFn sets the function used for querying historical block headers.
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
+	// SetCoinbase registers the coinbase of the blocks proposed by the given validator.
+	SetCoinbase(ctx sdk.Context, val sdk.ValAddress, coinbase common.Address) error
+	// LogsBloomHeight returns the number of the first block whose header commits to its logs
+	// bloom.
+	LogsBloomHeight() uint64
 }
 
 type plugin struct {
@@ -72,7 +78,8 @@ func (p *plugin) BaseFee() *big.Int {
 }
 
 // GetNewBlockMetadata returns the host chain block metadata for the given block height. It returns
-// the coinbase address, the timestamp of the block.
+// the coinbase address, which is the one registered by the proposer, and the timestamp of the
+// block.
 func (p *plugin) GetNewBlockMetadata(number uint64) (common.Address, uint64) {
 	cometHeader := p.ctx.BlockHeader()
 	if uint64(cometHeader.Height) != number {
@@ -83,7 +90,28 @@ func (p *plugin) GetNewBlockMetadata(number uint64) (common.Address, uint64) {
 	if !found {
 		panic(fmt.Errorf("validator not found: %s", cometHeader.ProposerAddress))
 	}
-	return common.BytesToAddress(val.GetOperator()), uint64(cometHeader.Time.UTC().Unix())
+	return p.getCoinbase(val.GetOperator()), uint64(cometHeader.Time.UTC().Unix())
+}
+
+// GetNewBlockRandom returns the entropy of the block at the given height, which is exposed by the
+// PREVRANDAO opcode. It is the hash of the last block id, the last commit hash and the proposer of
+// the CometBFT block header, along with the height.
+//
+// This value is deterministic and known to the proposer before the block is proposed, and the
+// proposer can influence it by choosing which commit signatures to include. Hence, it must not be
+// used as a source of randomness where the proposer has an incentive to bias the outcome.
+func (p *plugin) GetNewBlockRandom(number uint64) common.Hash {
+	cometHeader := p.ctx.BlockHeader()
+	if uint64(cometHeader.Height) != number {
+		panic(fmt.Errorf("block height mismatch. got: %d, expected %d", cometHeader.Height, number))
+	}
+
+	return crypto.Keccak256Hash(
+		cometHeader.LastBlockId.Hash,
+		cometHeader.LastCommitHash,
+		cometHeader.ProposerAddress,
+		sdk.Uint64ToBigEndian(number),
+	)
 }
 
 func (p *plugin) IsPlugin() {}
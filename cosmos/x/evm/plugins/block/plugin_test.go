@@ -21,8 +21,42 @@
 package block
 
 import (
+	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/lib/utils"
+
 	. "github.com/onsi/ginkgo/v2"
-	// . "github.com/onsi/gomega".
+	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("Block Plugin", func() {})
+var _ = Describe("Block Plugin", func() {
+	var ctx sdk.Context
+	var p *plugin
+
+	BeforeEach(func() {
+		_, _, _, sk := testutil.SetupMinimalKeepers()
+		ctx = testutil.NewContext()
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, sk))
+	})
+
+	It("should derive the block randomness from the comet header", func() {
+		header := cometproto.Header{
+			Height:          5,
+			LastCommitHash:  []byte{0x01},
+			ProposerAddress: []byte{0x02},
+		}
+		p.Prepare(ctx.WithBlockHeader(header))
+		random := p.GetNewBlockRandom(5)
+		Expect(random).ToNot(Equal(common.Hash{}))
+		Expect(p.GetNewBlockRandom(5)).To(Equal(random))
+		Expect(func() { p.GetNewBlockRandom(6) }).To(Panic())
+
+		header.LastCommitHash = []byte{0x03}
+		p.Prepare(ctx.WithBlockHeader(header))
+		Expect(p.GetNewBlockRandom(5)).ToNot(Equal(random))
+	})
+})
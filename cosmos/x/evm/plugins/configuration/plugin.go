@@ -22,6 +22,7 @@ package configuration
 
 import (
 	"context"
+	"math/big"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -39,19 +40,26 @@ type Plugin interface {
 	plugins.Base
 	plugins.HasGenesis
 	core.ConfigurationPlugin
+	core.BaseFeePlugin
 	SetChainConfig(*params.ChainConfig)
 }
 
+// BaseFeeDisposer disposes of the given base fee paid by a transaction.
+type BaseFeeDisposer func(ctx sdk.Context, fee *big.Int) error
+
 // plugin implements the core.ConfigurationPlugin interface.
 type plugin struct {
 	storeKey    storetypes.StoreKey
 	paramsStore storetypes.KVStore
+	// disposeBaseFee disposes of the base fee, which stays burned if it is nil.
+	disposeBaseFee BaseFeeDisposer
 }
 
-// NewPlugin returns a new plugin instance.
-func NewPlugin(storeKey storetypes.StoreKey) Plugin {
+// NewPlugin returns a new plugin instance, which disposes of the base fee with the given disposer.
+func NewPlugin(storeKey storetypes.StoreKey, disposeBaseFee BaseFeeDisposer) Plugin {
 	return &plugin{
-		storeKey: storeKey,
+		storeKey:       storeKey,
+		disposeBaseFee: disposeBaseFee,
 	}
 }
 
@@ -68,4 +76,12 @@ func (p *plugin) FeeCollector() *common.Address {
 	return &addr
 }
 
+// DisposeBaseFee implements the core.BaseFeePlugin interface.
+func (p *plugin) DisposeBaseFee(ctx context.Context, fee *big.Int) error {
+	if p.disposeBaseFee == nil {
+		return nil
+	}
+	return p.disposeBaseFee(sdk.UnwrapSDKContext(ctx), fee)
+}
+
 func (p *plugin) IsPlugin() {}
@@ -29,7 +29,7 @@ import (
 
 // GetChainConfig is used to get the genesis info of the Ethereum chain.
 func (p *plugin) ChainConfig() *params.ChainConfig {
-	bz := p.paramsStore.Get([]byte{types.ChainConfigPrefix})
+	bz := p.paramsStore.Get(types.ChainConfigKey)
 	if bz == nil {
 		return nil
 	}
@@ -46,5 +46,5 @@ func (p *plugin) SetChainConfig(chainConfig *params.ChainConfig) {
 	if err != nil {
 		panic(err)
 	}
-	p.paramsStore.Set([]byte{types.ChainConfigPrefix}, bz)
+	p.paramsStore.Set(types.ChainConfigKey, bz)
 }
@@ -43,7 +43,9 @@ type Plugin interface {
 }
 
 // plugin wraps a Cosmos context and utilize's the underlying `GasMeter` and `BlockGasMeter`
-// to implement the core.GasPlugin interface.
+// to implement the core.GasPlugin interface. The EVM is the source of truth for the gas of a
+// transaction: the state transition runs on an infinite gas meter, and the gas it used is then
+// consumed on the gas meter of the transaction through `ConsumeGas`, which never panics.
 type plugin struct {
 	gasMeter        storetypes.GasMeter
 	blockGasMeter   storetypes.GasMeter
@@ -83,11 +85,15 @@ func (p *plugin) ConsumeGas(amount uint64) error {
 	// We don't want to panic if we overflow so we do some safety checks.
 	// TODO: probably faster / cleaner to just wrap .ConsumeGas in a panic handler, or write our
 	// own custom gas meter that doesn't panic on overflow.
-	if newConsumed, overflow := addUint64Overflow(p.gasMeter.GasConsumed(), amount); overflow {
+	newConsumed, overflow := addUint64Overflow(p.gasMeter.GasConsumed(), amount)
+	if overflow {
 		return core.ErrGasUintOverflow
 	} else if newConsumed > p.gasMeter.Limit() {
 		return vm.ErrOutOfGas
-	} else if p.blockGasMeter.GasConsumed()+newConsumed > p.blockGasMeter.Limit() {
+	}
+	if blockConsumed, blockOverflow := addUint64Overflow(
+		p.blockGasMeter.GasConsumed(), newConsumed,
+	); blockOverflow || blockConsumed > p.blockGasMeter.Limit() {
 		return core.ErrBlockOutOfGas
 	}
 
@@ -99,6 +99,37 @@ var _ = Describe("plugin", func() {
 		Expect(err.Error()).To(Equal("out of gas"))
 	})
 
+	It("should consume exactly the gas limit of the tx", func() {
+		p.gasMeter = storetypes.NewGasMeter(1000)
+		Expect(p.ConsumeGas(999)).To(Succeed())
+		Expect(p.ConsumeGas(2)).To(MatchError("out of gas"))
+		Expect(p.gasMeter.GasConsumed()).To(Equal(uint64(999)))
+		Expect(p.ConsumeGas(1)).To(Succeed())
+		Expect(p.gasMeter.GasRemaining()).To(BeZero())
+		Expect(p.ConsumeGas(0)).To(Succeed())
+		Expect(p.ConsumeGas(1)).To(MatchError("out of gas"))
+		Expect(p.gasMeter.GasConsumed()).To(Equal(uint64(1000)))
+	})
+
+	It("should consume exactly the gas remaining in the block", func() {
+		blockGasMeter.ConsumeGas(500, "") // finalize tx 1
+		p.gasMeter = storetypes.NewGasMeter(blockGasLimit)
+		Expect(p.ConsumeGas(blockGasLimit - 500 + 1)).To(MatchError("block is out of gas"))
+		Expect(p.gasMeter.GasConsumed()).To(BeZero())
+		Expect(p.ConsumeGas(blockGasLimit - 500)).To(Succeed())
+		Expect(p.ConsumeGas(1)).To(MatchError("block is out of gas"))
+		Expect(p.gasMeter.GasConsumed()).To(Equal(blockGasLimit - 500))
+	})
+
+	It("should error on block gas overflow", func() {
+		p.gasMeter = storetypes.NewInfiniteGasMeter()
+		blockGasMeter = storetypes.NewInfiniteGasMeter()
+		blockGasMeter.ConsumeGas(1, "")
+		p.blockGasMeter = blockGasMeter
+		Expect(p.ConsumeGas(math.MaxUint64)).To(MatchError("block is out of gas"))
+		Expect(p.gasMeter.GasConsumed()).To(BeZero())
+	})
+
 	It("should error on uint64 overflow", func() {
 		p.blockGasMeter = storetypes.NewInfiniteGasMeter()
 		err := p.ConsumeGas(math.MaxUint64)
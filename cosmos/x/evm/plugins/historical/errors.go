@@ -24,4 +24,9 @@ import "errors"
 
 var (
 	ErrBlockNotFound = errors.New("block not found, is your node pruned?")
+	// ErrCallTracesDisabled is returned when reading call traces that are not persisted.
+	ErrCallTracesDisabled = errors.New("call traces are not persisted")
+	// ErrNoOffchainDB is returned when accessing the data only written to the off-chain database
+	// without one.
+	ErrNoOffchainDB = errors.New("no off-chain database")
 )
@@ -21,6 +21,8 @@
 package historical
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/store/prefix"
@@ -52,11 +54,18 @@ func (p *plugin) StoreBlock(block *coretypes.Block) error {
 	}
 	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Set(numBz, blockBz)
 
+	// store block num to block metadata.
+	mdBz, err := types.NewBlockMetadata(block).Marshal()
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(store, []byte{types.BlockNumKeyToMetadataPrefix}).Set(numBz, mdBz)
+
 	// store block hash to block number.
 	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Set(block.Hash().Bytes(), numBz)
 
 	// store the version offchain for consistency.
-	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
+	offChainNum := sdk.BigEndianToUint64(store.Get(types.VersionKey))
 	if blockNum > 0 && offChainNum != blockNum-1 {
 		panic(
 			fmt.Errorf(
@@ -66,14 +75,20 @@ func (p *plugin) StoreBlock(block *coretypes.Block) error {
 			),
 		)
 	}
-	store.Set([]byte{types.VersionKey}, numBz)
+	store.Set(types.VersionKey, numBz)
 	return nil
 }
 
 // StoreReceipts implements `core.HistoricalPlugin`.
 func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipts) error {
+	// the receipts are written along with the transactions of the block.
+	if p.indexer != nil {
+		p.indexer.pending = &indexJob{blockHash: blockHash, receipts: receipts}
+		return nil
+	}
+
 	// store block hash to receipts.
-	receiptsBz, err := coretypes.MarshalReceipts(receipts)
+	receiptsBz, err := types.MarshalReceipts(receipts)
 	if err != nil {
 		p.ctx.Logger().Error(
 			"UpdateOffChainStorage: failed to marshal receipts at block hash %s", blockHash.Hex(),
@@ -83,6 +98,27 @@ func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipt
 	prefix.NewStore(p.ctx.KVStore(p.storeKey),
 		[]byte{types.BlockHashKeyToReceiptsPrefix}).Set(blockHash.Bytes(), receiptsBz)
 
+	// the contract creations are indexed along with the transactions of the block.
+	p.receipts = receipts
+	return nil
+}
+
+// TraceCalls implements `core.CallTracesPlugin`.
+func (p *plugin) TraceCalls() bool {
+	return p.indexer != nil && p.indexer.traceCalls
+}
+
+// StoreCallTraces implements `core.CallTracesPlugin`.
+func (p *plugin) StoreCallTraces(blockHash common.Hash, traces []json.RawMessage) error {
+	if !p.TraceCalls() {
+		return nil
+	}
+
+	// the traces are written along with the transactions of the block.
+	if p.indexer.pending == nil || p.indexer.pending.blockHash != blockHash {
+		p.indexer.pending = &indexJob{blockHash: blockHash}
+	}
+	p.indexer.pending.traces = traces
 	return nil
 }
 
@@ -90,6 +126,18 @@ func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipt
 func (p *plugin) StoreTransactions(
 	blockNum uint64, blockHash common.Hash, txs coretypes.Transactions,
 ) error {
+	// queue the transactions, along with the receipts of the block, to be written off-chain.
+	if p.indexer != nil {
+		job := p.indexer.pending
+		if job == nil || job.blockHash != blockHash {
+			job = &indexJob{blockHash: blockHash}
+		}
+		p.indexer.pending = nil
+		job.blockNum, job.txs = blockNum, txs
+		job.cosmosTxHashes = p.takeCosmosTxHashes(txs)
+		return p.indexer.enqueue(job)
+	}
+
 	// store all txns in the block.
 	txStore := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix})
 	for txIndex, tx := range txs {
@@ -111,6 +159,86 @@ func (p *plugin) StoreTransactions(
 		txStore.Set(tx.Hash().Bytes(), tleBz)
 	}
 
+	// store the hashes of the txs that deployed contracts.
+	receipts := p.receipts
+	p.receipts = nil
+	store := p.ctx.KVStore(p.storeKey)
+	if err := forEachContractCreation(txs, receipts,
+		func(address common.Address, txHash common.Hash) error {
+			store.Set(contractKey(address), txHash.Bytes())
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	// store the hashes of the cosmos txs that wrapped the txs, in both directions.
+	return forEachCosmosTxHash(txs, p.takeCosmosTxHashes(txs),
+		func(ethTxHash, cosmosTxHash common.Hash) error {
+			store.Set(cosmosTxHashKey(ethTxHash), cosmosTxHash.Bytes())
+			store.Set(ethTxHashKey(cosmosTxHash), ethTxHash.Bytes())
+			return nil
+		},
+	)
+}
+
+// SetCosmosTxHash implements `Plugin`.
+func (p *plugin) SetCosmosTxHash(ethTxHash, cosmosTxHash common.Hash) {
+	p.cosmosTxHashes[ethTxHash] = cosmosTxHash
+}
+
+// takeCosmosTxHashes returns the hashes of the Cosmos transactions that wrapped the given
+// transactions, in the same order, and forgets the hashes recorded for the block.
+func (p *plugin) takeCosmosTxHashes(txs coretypes.Transactions) []common.Hash {
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = p.cosmosTxHashes[tx.Hash()]
+	}
+	p.cosmosTxHashes = make(map[common.Hash]common.Hash)
+	return hashes
+}
+
+// forEachCosmosTxHash calls `fn` with the hash of each of the given transactions and the hash of
+// the Cosmos transaction that wrapped it, skipping the transactions whose Cosmos transaction hash
+// was not recorded.
+func forEachCosmosTxHash(
+	txs coretypes.Transactions, cosmosTxHashes []common.Hash,
+	fn func(ethTxHash, cosmosTxHash common.Hash) error,
+) error {
+	if len(cosmosTxHashes) != len(txs) {
+		return nil
+	}
+	for i, tx := range txs {
+		if cosmosTxHashes[i] == (common.Hash{}) {
+			continue
+		}
+		if err := fn(tx.Hash(), cosmosTxHashes[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachContractCreation calls `fn` with the address of each contract deployed by the given
+// transactions and the hash of the transaction that deployed it. Only the contract creation
+// transactions that succeeded are included, not the contracts created by other contracts.
+func forEachContractCreation(
+	txs coretypes.Transactions, receipts coretypes.Receipts,
+	fn func(address common.Address, txHash common.Hash) error,
+) error {
+	if len(receipts) != len(txs) {
+		return nil
+	}
+	for i, tx := range txs {
+		receipt := receipts[i]
+		if tx.To() != nil || receipt.Status != coretypes.ReceiptStatusSuccessful ||
+			receipt.ContractAddress == (common.Address{}) {
+			continue
+		}
+		if err := fn(receipt.ContractAddress, tx.Hash()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -127,43 +255,144 @@ func (p *plugin) GetBlockByNumber(number uint64) (*coretypes.Block, error) {
 	return block, nil
 }
 
-// GetBlockByHash returns the block at the given hash.
+// GetBlockByHash returns the block at the given hash. A block that was rolled back is not found,
+// even if its hash is still indexed, as another block is stored at its height.
 func (p *plugin) GetBlockByHash(blockHash common.Hash) (*coretypes.Block, error) {
 	store := p.ctx.KVStore(p.storeKey)
 	numBz := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Get(blockHash.Bytes())
+	if numBz == nil {
+		return nil, fmt.Errorf("failed to find block %s", blockHash.Hex())
+	}
 	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
 	block := &coretypes.Block{}
 	err := rlp.DecodeBytes(blockBz, block)
 	if err != nil {
 		return nil, err
 	}
+	if block.Hash() != blockHash {
+		return nil, fmt.Errorf("block %s is not canonical", blockHash.Hex())
+	}
 	return block, nil
 }
 
+// GetBlockMetadata returns the metadata of the block at the given height. It is derived from the
+// block if it was stored before the metadata was.
+func (p *plugin) GetBlockMetadata(number uint64) (*types.BlockMetadata, error) {
+	numBz := sdk.Uint64ToBigEndian(number)
+	mdBz := prefix.NewStore(
+		p.ctx.KVStore(p.storeKey), []byte{types.BlockNumKeyToMetadataPrefix},
+	).Get(numBz)
+	if mdBz == nil {
+		block, err := p.GetBlockByNumber(number)
+		if err != nil {
+			return nil, errorslib.Wrapf(ErrBlockNotFound, "block %d", number)
+		}
+		return types.NewBlockMetadata(block), nil
+	}
+
+	md := &types.BlockMetadata{}
+	if err := md.Unmarshal(mdBz); err != nil {
+		return nil, errorslib.Wrapf(err, "failed to unmarshal metadata of block %d", number)
+	}
+	return md, nil
+}
+
 // GetTransactionByHash returns the transaction lookup entry with the given hash.
 func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
 	// get tx from off chain.
-	tleBz := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
+	tleBz, err := p.getIndexed(txKey(txHash))
+	if err != nil {
+		return nil, err
+	}
 	if tleBz == nil {
 		return nil, fmt.Errorf("failed to find tx %s", txHash.Hex())
 	}
 	tle := &coretypes.TxLookupEntry{}
-	err := tle.UnmarshalBinary(tleBz)
-	if err != nil {
+	if err = tle.UnmarshalBinary(tleBz); err != nil {
 		return nil, errorslib.Wrapf(err, "failed to unmarshal tx %s", txHash.Hex())
 	}
+
+	// the off-chain database is not rolled back along with the evm store, so the tx may have been
+	// included in a block that is no longer canonical.
+	if !p.isCanonical(tle.BlockNum, tle.BlockHash) {
+		return nil, fmt.Errorf("tx %s is not in a canonical block", txHash.Hex())
+	}
 	return tle, nil
 }
 
+// GetContractCreationTx implements `core.ContractCreationsPlugin`.
+func (p *plugin) GetContractCreationTx(address common.Address) (common.Hash, error) {
+	txHashBz, err := p.getIndexed(contractKey(address))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if txHashBz == nil {
+		return common.Hash{}, fmt.Errorf("failed to find creation of contract %s", address.Hex())
+	}
+	return common.BytesToHash(txHashBz), nil
+}
+
+// GetCosmosTxHash implements `Plugin`.
+func (p *plugin) GetCosmosTxHash(ethTxHash common.Hash) (common.Hash, error) {
+	cosmosTxHashBz, err := p.getIndexed(cosmosTxHashKey(ethTxHash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if cosmosTxHashBz == nil {
+		return common.Hash{}, fmt.Errorf("failed to find cosmos tx of tx %s", ethTxHash.Hex())
+	}
+
+	// the tx must still be part of the chain, as the index is not rolled back.
+	if _, err = p.GetTransactionByHash(ethTxHash); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(cosmosTxHashBz), nil
+}
+
+// GetEthTxHash implements `Plugin`.
+func (p *plugin) GetEthTxHash(cosmosTxHash common.Hash) (common.Hash, error) {
+	ethTxHashBz, err := p.getIndexed(ethTxHashKey(cosmosTxHash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if ethTxHashBz == nil {
+		return common.Hash{}, fmt.Errorf("failed to find tx of cosmos tx %s", cosmosTxHash.Hex())
+	}
+
+	// the tx must still be part of the chain, as the index is not rolled back.
+	ethTxHash := common.BytesToHash(ethTxHashBz)
+	if _, err = p.GetTransactionByHash(ethTxHash); err != nil {
+		return common.Hash{}, err
+	}
+	return ethTxHash, nil
+}
+
+// GetCallTraces implements `core.CallTracesPlugin`.
+func (p *plugin) GetCallTraces(blockNum, txIndex uint64) (json.RawMessage, error) {
+	if !p.TraceCalls() {
+		return nil, ErrCallTracesDisabled
+	}
+	traces, err := p.indexer.db.Get(callTracesKey(blockNum, txIndex))
+	if err != nil {
+		return nil, err
+	}
+	if traces == nil {
+		return nil, fmt.Errorf("failed to find call traces of tx %d of block %d", txIndex, blockNum)
+	}
+	return traces, nil
+}
+
 // GetReceiptsByHash returns the receipts with the given block hash.
 func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, error) {
 	// get receipts from off chain.
-	receiptsBz := prefix.NewStore(p.ctx.KVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Get(blockHash.Bytes())
+	receiptsBz, err := p.getIndexed(receiptsKey(blockHash))
+	if err != nil {
+		return nil, err
+	}
 	if receiptsBz == nil {
 		return nil, fmt.Errorf("failed to find receipts for block hash %s", blockHash.Hex())
 	}
-	receipts, err := coretypes.UnmarshalReceipts(receiptsBz)
+	receipts, err := types.UnmarshalReceipts(receiptsBz)
 	if err != nil {
 		return nil, errorslib.Wrapf(err, "failed to unmarshal receipts for block hash %s", blockHash.Hex())
 	}
@@ -181,3 +410,149 @@ func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, e
 
 	return receipts, nil
 }
+
+// isCanonical returns whether the block with the given hash is the one stored at the given
+// height.
+func (p *plugin) isCanonical(number uint64, blockHash common.Hash) bool {
+	md, err := p.GetBlockMetadata(number)
+	return err == nil && common.BytesToHash(md.Hash) == blockHash
+}
+
+// ReplayPending implements `Plugin`.
+func (p *plugin) ReplayPending(replay func(blockNum uint64) (coretypes.Receipts, error)) error {
+	if p.indexer == nil {
+		return nil
+	}
+
+	blockNums, err := p.indexer.pendingBlocks()
+	if err != nil {
+		return err
+	}
+	// a block that cannot be replayed stays pending, without holding back the ones after it.
+	var errs []error
+	for _, blockNum := range blockNums {
+		if err = p.rewrite(blockNum, replay); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Reindex implements `Plugin`.
+func (p *plugin) Reindex(
+	from, to uint64, replay func(blockNum uint64) (coretypes.Receipts, error),
+) error {
+	if p.indexer == nil {
+		return ErrNoOffchainDB
+	}
+	// the queued blocks must not overwrite the rewritten ones.
+	p.indexer.flush()
+	for blockNum := from; blockNum <= to; blockNum++ {
+		if err := p.rewrite(blockNum, replay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewrite writes the historical data of the stored block with the given number to the off-chain
+// database, rebuilding its receipts with the given replay function.
+func (p *plugin) rewrite(
+	blockNum uint64, replay func(blockNum uint64) (coretypes.Receipts, error),
+) error {
+	block, err := p.GetBlockByNumber(blockNum)
+	if err != nil {
+		return errorslib.Wrapf(err, "failed to replay block %d", blockNum)
+	}
+	receipts, err := replay(blockNum)
+	if err != nil {
+		return errorslib.Wrapf(err, "failed to replay receipts of block %d", blockNum)
+	}
+	return p.indexer.write(&indexJob{
+		blockNum:  blockNum,
+		blockHash: block.Hash(),
+		receipts:  receipts,
+		txs:       block.Transactions(),
+	})
+}
+
+// IndexedHeight implements `Plugin`.
+func (p *plugin) IndexedHeight() (uint64, bool, error) {
+	if p.indexer == nil {
+		return 0, false, nil
+	}
+	return p.indexer.indexedHeight()
+}
+
+// PendingBlocks implements `Plugin`.
+func (p *plugin) PendingBlocks() ([]uint64, error) {
+	if p.indexer == nil {
+		return nil, nil
+	}
+	return p.indexer.pendingBlocks()
+}
+
+// BloomBitsSections implements `Plugin`.
+func (p *plugin) BloomBitsSections() (uint64, error) {
+	if p.indexer == nil {
+		return 0, ErrNoOffchainDB
+	}
+	bz, err := p.indexer.db.Get(types.BloomSectionsKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// ReadBloomBits implements `Plugin`.
+func (p *plugin) ReadBloomBits(bit uint, section uint64) ([]byte, error) {
+	if p.indexer == nil {
+		return nil, ErrNoOffchainDB
+	}
+	bz, err := p.indexer.db.Get(bloomBitsKey(bit, section))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("failed to find bloom bits of bit %d of section %d", bit, section)
+	}
+	return bz, nil
+}
+
+// WriteBloomBits implements `Plugin`.
+func (p *plugin) WriteBloomBits(section uint64, bits [][]byte) error {
+	if p.indexer == nil {
+		return ErrNoOffchainDB
+	}
+	batch := p.indexer.db.NewBatch()
+	defer batch.Close()
+	for bit, bz := range bits {
+		if err := batch.Set(bloomBitsKey(uint(bit), section), bz); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(types.BloomSectionsKey, sdk.Uint64ToBigEndian(section+1)); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Close implements `Plugin`.
+func (p *plugin) Close() error {
+	if p.indexer == nil {
+		return nil
+	}
+	return p.indexer.close()
+}
+
+// getIndexed returns the value of the given key from the off-chain database, falling back to the
+// evm store for data written before the off-chain database was used.
+func (p *plugin) getIndexed(key []byte) ([]byte, error) {
+	if p.indexer != nil {
+		bz, err := p.indexer.db.Get(key)
+		if err != nil || bz != nil {
+			return bz, err
+		}
+	}
+	return p.ctx.KVStore(p.storeKey).Get(key), nil
+}
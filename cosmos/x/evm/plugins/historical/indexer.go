@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+const (
+	// indexQueueSize is the number of blocks that can wait to be indexed before the consensus
+	// path blocks on the indexer.
+	indexQueueSize = 16
+	// pendingKeyPrefix prefixes the journal entries of the blocks that are yet to be indexed.
+	pendingKeyPrefix byte = 0xff
+)
+
+// indexJob is the historical data of a finalized block, waiting to be written.
+type indexJob struct {
+	blockNum  uint64
+	blockHash common.Hash
+	receipts  coretypes.Receipts
+	txs       coretypes.Transactions
+	// traces are the call traces of the transactions, if recorded.
+	traces []json.RawMessage
+	// cosmosTxHashes are the hashes of the Cosmos transactions that wrapped the transactions.
+	cosmosTxHashes []common.Hash
+}
+
+// indexer writes receipts and transaction lookup entries to the off-chain database in the
+// background, so that marshaling and writing them is not part of the consensus critical path.
+//
+// Before a block is queued, an entry is written to a journal in the off-chain database. The
+// entry is removed in the same batch that writes the block's data, so the journal holds exactly
+// the blocks whose data was lost if the node stopped, which are replayed on startup.
+type indexer struct {
+	db     dbm.DB
+	jobs   chan *indexJob
+	logger log.Logger
+	// loggerOnce ensures the logger is only replaced before the first job is queued.
+	loggerOnce sync.Once
+	// pending holds the receipts of the block being finalized until its transactions are stored.
+	pending *indexJob
+	// wg tracks the jobs that are queued or being written.
+	wg sync.WaitGroup
+	// closeOnce stops the worker and closes the database on the first close.
+	closeOnce sync.Once
+	closeErr  error
+
+	// traceCalls persists the call traces of the transactions, of the last `callTracesRetention`
+	// blocks if it is not 0.
+	traceCalls          bool
+	callTracesRetention uint64
+}
+
+// newIndexer returns an indexer writing to the given database and starts its worker.
+func newIndexer(db dbm.DB) *indexer {
+	idx := &indexer{
+		db:     db,
+		jobs:   make(chan *indexJob, indexQueueSize),
+		logger: log.NewNopLogger(),
+	}
+	go idx.run()
+	return idx
+}
+
+// setLogger sets the logger of the worker, only the first call has an effect.
+func (idx *indexer) setLogger(logger log.Logger) {
+	idx.loggerOnce.Do(func() {
+		idx.logger = logger
+	})
+}
+
+// enqueue journals the given block and queues it to be written.
+func (idx *indexer) enqueue(job *indexJob) error {
+	if err := idx.db.SetSync(pendingKey(job.blockNum), job.blockHash.Bytes()); err != nil {
+		return err
+	}
+	idx.wg.Add(1)
+	idx.jobs <- job
+	return nil
+}
+
+// run writes the queued blocks until the queue is closed.
+func (idx *indexer) run() {
+	for job := range idx.jobs {
+		if err := idx.write(job); err != nil {
+			// The block stays in the journal and is replayed on the next startup.
+			idx.logger.Error("failed to index block", "number", job.blockNum, "err", err)
+		}
+		idx.wg.Done()
+	}
+}
+
+// write marshals and writes the receipts and transaction lookup entries of a block, and removes
+// it from the journal, in a single batch.
+func (idx *indexer) write(job *indexJob) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	if job.receipts != nil {
+		receiptsBz, err := types.MarshalReceipts(job.receipts)
+		if err != nil {
+			return err
+		}
+		if err = batch.Set(receiptsKey(job.blockHash), receiptsBz); err != nil {
+			return err
+		}
+	}
+
+	for txIndex, tx := range job.txs {
+		tleBz, err := (&coretypes.TxLookupEntry{
+			Tx:        tx,
+			TxIndex:   uint64(txIndex),
+			BlockHash: job.blockHash,
+			BlockNum:  job.blockNum,
+		}).MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err = batch.Set(txKey(tx.Hash()), tleBz); err != nil {
+			return err
+		}
+	}
+
+	if err := forEachContractCreation(job.txs, job.receipts,
+		func(address common.Address, txHash common.Hash) error {
+			return batch.Set(contractKey(address), txHash.Bytes())
+		},
+	); err != nil {
+		return err
+	}
+
+	if err := forEachCosmosTxHash(job.txs, job.cosmosTxHashes,
+		func(ethTxHash, cosmosTxHash common.Hash) error {
+			if err := batch.Set(cosmosTxHashKey(ethTxHash), cosmosTxHash.Bytes()); err != nil {
+				return err
+			}
+			return batch.Set(ethTxHashKey(cosmosTxHash), ethTxHash.Bytes())
+		},
+	); err != nil {
+		return err
+	}
+
+	for txIndex, trace := range job.traces {
+		if trace == nil {
+			continue
+		}
+		if err := batch.Set(callTracesKey(job.blockNum, uint64(txIndex)), trace); err != nil {
+			return err
+		}
+	}
+	if err := idx.pruneCallTraces(batch, job.blockNum); err != nil {
+		return err
+	}
+
+	// the journaled blocks replayed on startup are older than the ones written before them.
+	height, ok, err := idx.indexedHeight()
+	if err != nil {
+		return err
+	}
+	if !ok || job.blockNum > height {
+		if err = batch.Set(types.IndexedHeightKey, sdk.Uint64ToBigEndian(job.blockNum)); err != nil {
+			return err
+		}
+	}
+
+	if err = batch.Delete(pendingKey(job.blockNum)); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// indexedHeight returns the number of the latest block written, or false if no block was written
+// since the height is recorded.
+func (idx *indexer) indexedHeight() (uint64, bool, error) {
+	bz, err := idx.db.Get(types.IndexedHeightKey)
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
+// pruneCallTraces deletes, in the given batch, the call traces of the blocks that are older than
+// the retention when the given block is written.
+func (idx *indexer) pruneCallTraces(batch dbm.Batch, blockNum uint64) error {
+	if !idx.traceCalls || idx.callTracesRetention == 0 || blockNum < idx.callTracesRetention {
+		return nil
+	}
+	it, err := idx.db.Iterator(
+		callTracesKey(0, 0), callTracesKey(blockNum-idx.callTracesRetention+1, 0),
+	)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err = batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// flush waits until all queued blocks are written.
+func (idx *indexer) flush() {
+	idx.wg.Wait()
+}
+
+// close waits until all queued blocks are written, then stops the worker and closes the database.
+// No block may be queued afterwards.
+func (idx *indexer) close() error {
+	idx.closeOnce.Do(func() {
+		idx.flush()
+		close(idx.jobs)
+		idx.closeErr = idx.db.Close()
+	})
+	return idx.closeErr
+}
+
+// pendingBlocks returns the numbers of the journaled blocks that were never written.
+func (idx *indexer) pendingBlocks() ([]uint64, error) {
+	it, err := dbm.IteratePrefix(idx.db, []byte{pendingKeyPrefix})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var nums []uint64
+	for ; it.Valid(); it.Next() {
+		nums = append(nums, binary.BigEndian.Uint64(it.Key()[1:]))
+	}
+	return nums, it.Error()
+}
+
+// pendingKey returns the journal key of the given block number.
+func pendingKey(blockNum uint64) []byte {
+	return append([]byte{pendingKeyPrefix}, sdk.Uint64ToBigEndian(blockNum)...)
+}
+
+// receiptsKey returns the key of the receipts of the given block hash.
+func receiptsKey(blockHash common.Hash) []byte {
+	return append([]byte{types.BlockHashKeyToReceiptsPrefix}, blockHash.Bytes()...)
+}
+
+// txKey returns the key of the lookup entry of the given transaction hash.
+func txKey(txHash common.Hash) []byte {
+	return append([]byte{types.TxHashKeyToTxPrefix}, txHash.Bytes()...)
+}
+
+// contractKey returns the key of the hash of the transaction that deployed the contract at the
+// given address.
+func contractKey(address common.Address) []byte {
+	return append([]byte{types.ContractCreationKeyPrefix, common.AddressLength}, address.Bytes()...)
+}
+
+// callTracesKey returns the key of the call traces of the transaction at the given index of the
+// given block number.
+func callTracesKey(blockNum, txIndex uint64) []byte {
+	bz := append([]byte{types.CallTracesKeyPrefix}, sdk.Uint64ToBigEndian(blockNum)...)
+	return append(bz, sdk.Uint64ToBigEndian(txIndex)...)
+}
+
+// bloomBitsKey returns the key of the compressed bit vector of the given bit of the given section
+// of the bloom bits index.
+func bloomBitsKey(bit uint, section uint64) []byte {
+	bz := append([]byte{types.BloomBitsKeyPrefix}, byte(bit>>8), byte(bit)) //nolint:gomnd // bit.
+	return append(bz, sdk.Uint64ToBigEndian(section)...)
+}
+
+// cosmosTxHashKey returns the key of the hash of the Cosmos transaction that wrapped the given
+// Ethereum transaction.
+func cosmosTxHashKey(ethTxHash common.Hash) []byte {
+	return append([]byte{types.CosmosTxHashKeyPrefix}, ethTxHash.Bytes()...)
+}
+
+// ethTxHashKey returns the key of the hash of the Ethereum transaction wrapped by the given Cosmos
+// transaction.
+func ethTxHashKey(cosmosTxHash common.Hash) []byte {
+	return append([]byte{types.EthTxHashKeyPrefix}, cosmosTxHash.Bytes()...)
+}
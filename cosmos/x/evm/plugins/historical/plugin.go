@@ -23,19 +23,74 @@ package historical
 import (
 	"context"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
 // Plugin is the interface that must be implemented by the plugin.
 type Plugin interface {
 	plugins.Base
 	core.HistoricalPlugin
+	core.ContractCreationsPlugin
+	core.CallTracesPlugin
 	plugins.HasGenesis
+
+	// ReplayPending writes the historical data of the blocks that were finalized but not written
+	// before the node stopped, rebuilding their receipts with the given replay function. The
+	// blocks that fail to be replayed are left pending and their errors are joined.
+	ReplayPending(replay func(blockNum uint64) (coretypes.Receipts, error)) error
+	// Reindex rewrites the historical data of the stored blocks from `from` to `to` to the
+	// off-chain database, rebuilding their receipts with the given replay function. The hashes of
+	// the Cosmos transactions and the call traces of the blocks are not rebuilt.
+	Reindex(from, to uint64, replay func(blockNum uint64) (coretypes.Receipts, error)) error
+
+	// IndexedHeight returns the number of the latest block written to the off-chain database, or
+	// false if there is no off-chain database or no block was written to it.
+	IndexedHeight() (uint64, bool, error)
+	// PendingBlocks returns the numbers of the blocks that were finalized but not written to the
+	// off-chain database, which `ReplayPending` writes.
+	PendingBlocks() ([]uint64, error)
+	// BloomBitsSections returns the number of sections of the bloom bits index written to the
+	// off-chain database.
+	BloomBitsSections() (uint64, error)
+	// ReadBloomBits returns the compressed bit vector of the given bit of the given section of
+	// the bloom bits index.
+	ReadBloomBits(bit uint, section uint64) ([]byte, error)
+	// WriteBloomBits writes the compressed bit vectors of the given section of the bloom bits
+	// index to the off-chain database, and marks it as indexed.
+	WriteBloomBits(section uint64, bits [][]byte) error
+
+	// Close waits until the historical data of the finalized blocks is written to the off-chain
+	// database, then closes it. It is called once the node stopped finalizing blocks.
+	Close() error
+
+	// GetBlockMetadata returns the metadata of the block at the given height, such as its base
+	// fee, without decoding the whole block.
+	GetBlockMetadata(number uint64) (*types.BlockMetadata, error)
+
+	// EnableCallTraces persists the call traces of the transactions to the off-chain database,
+	// keeping the ones of the last `retention` blocks, or all of them if it is 0. It has no effect
+	// if there is no off-chain database, as the traces must not be part of the consensus state.
+	EnableCallTraces(retention uint64)
+
+	// SetCosmosTxHash records the hash of the Cosmos transaction that wrapped the given Ethereum
+	// transaction of the current block, which is indexed along with its transactions.
+	SetCosmosTxHash(ethTxHash, cosmosTxHash common.Hash)
+	// GetCosmosTxHash returns the hash of the Cosmos transaction that wrapped the given Ethereum
+	// transaction.
+	GetCosmosTxHash(ethTxHash common.Hash) (common.Hash, error)
+	// GetEthTxHash returns the hash of the Ethereum transaction wrapped by the given Cosmos
+	// transaction.
+	GetEthTxHash(cosmosTxHash common.Hash) (common.Hash, error)
 }
 
 // plugin keeps track of polaris blocks via headers.
@@ -48,26 +103,49 @@ type plugin struct {
 	bp core.BlockPlugin
 	// storekey is the store key for the header store.
 	storeKey storetypes.StoreKey
-	// TODO: reenable offchain
-	//  `offchainStore` is the offchain store, used for accessing offchain data.
-	// offchainStoreKey storetypes.StoreKey
+	// receipts holds the receipts of the block being finalized until its transactions are stored,
+	// when they are not written by the indexer.
+	receipts coretypes.Receipts
+	// cosmosTxHashes holds the hashes of the Cosmos transactions that wrapped the Ethereum
+	// transactions of the current block, keyed by Ethereum transaction hash, until they are stored.
+	cosmosTxHashes map[common.Hash]common.Hash
+	// indexer writes receipts and transaction lookup entries to the off-chain database in the
+	// background. If nil, they are written to the evm store.
+	indexer *indexer
 }
 
-// NewPlugin creates a new instance of the block plugin from the given context.
+// NewPlugin creates a new instance of the block plugin from the given context. If `offchainDB` is
+// not nil, receipts and transaction lookup entries are written to it asynchronously.
 func NewPlugin(
 	cp core.ConfigurationPlugin, bp core.BlockPlugin,
-	_ storetypes.StoreKey, storekey storetypes.StoreKey,
+	offchainDB dbm.DB, storekey storetypes.StoreKey,
 ) Plugin {
-	return &plugin{
-		cp:       cp,
-		bp:       bp,
-		storeKey: storekey,
+	p := &plugin{
+		cp:             cp,
+		bp:             bp,
+		storeKey:       storekey,
+		cosmosTxHashes: make(map[common.Hash]common.Hash),
+	}
+	if offchainDB != nil {
+		p.indexer = newIndexer(offchainDB)
 	}
+	return p
 }
 
 // Prepare implements core.HistoricalPlugin.
 func (p *plugin) Prepare(ctx context.Context) {
 	p.ctx = sdk.UnwrapSDKContext(ctx)
+	if p.indexer != nil {
+		p.indexer.setLogger(p.ctx.Logger())
+	}
+}
+
+// EnableCallTraces implements `Plugin`.
+func (p *plugin) EnableCallTraces(retention uint64) {
+	if p.indexer != nil {
+		p.indexer.traceCalls = true
+		p.indexer.callTracesRetention = retention
+	}
 }
 
 func (p *plugin) IsPlugin() {}
@@ -21,13 +21,18 @@
 package historical
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/trie"
 
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	"pkg.berachain.dev/polaris/eth/core/mock"
@@ -114,7 +119,386 @@ var _ = Describe("Historical Data", func() {
 			Expect(tleByHash.BlockHash).To(Equal(blockHash))
 			Expect(tleByHash.BlockNum).To(Equal(uint64(1)))
 			Expect(tleByHash.Tx.Hash()).To(Equal(txHash))
+
+			md, err := p.GetBlockMetadata(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(md.Hash).To(Equal(blockHash.Bytes()))
+			Expect(md.GasLimit).To(Equal(uint64(1000)))
+		})
+
+		It("should index the transactions that deployed contracts", func() {
+			ctx = ctx.WithBlockHeight(1)
+			create := coretypes.NewContractCreation(0, big.NewInt(0), 100000, big.NewInt(1), []byte{0x60})
+			failed := coretypes.NewContractCreation(1, big.NewInt(0), 100000, big.NewInt(1), []byte{0x61})
+			contract, failedContract := common.Address{0x2}, common.Address{0x3}
+			txs := coretypes.Transactions{create, failed}
+			receipts := coretypes.Receipts{
+				{Status: 1, CumulativeGasUsed: 500, TxHash: create.Hash(), ContractAddress: contract},
+				{Status: 0, CumulativeGasUsed: 1000, TxHash: failed.Hash(), ContractAddress: failedContract},
+			}
+			block := coretypes.NewBlock(
+				&coretypes.Header{Number: big.NewInt(1)}, txs, nil, receipts, trie.NewStackTrie(nil),
+			)
+
+			Expect(p.StoreBlock(block)).To(Succeed())
+			Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+			Expect(p.StoreTransactions(1, block.Hash(), txs)).To(Succeed())
+
+			txHash, err := p.GetContractCreationTx(contract)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(txHash).To(Equal(create.Hash()))
+			_, err = p.GetContractCreationTx(failedContract)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should index the hashes of the cosmos txs that wrapped the txs", func() {
+			ctx = ctx.WithBlockHeight(1)
+			tx := coretypes.NewTransaction(0, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+			unwrapped := coretypes.NewTransaction(1, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(1), nil)
+			txs := coretypes.Transactions{tx, unwrapped}
+			block := coretypes.NewBlock(
+				&coretypes.Header{Number: big.NewInt(1)}, txs, nil, nil, trie.NewStackTrie(nil),
+			)
+			cosmosTxHash := common.Hash{0x4}
+
+			p.SetCosmosTxHash(tx.Hash(), cosmosTxHash)
+			Expect(p.StoreBlock(block)).To(Succeed())
+			Expect(p.StoreReceipts(block.Hash(), nil)).To(Succeed())
+			Expect(p.StoreTransactions(1, block.Hash(), txs)).To(Succeed())
+
+			hash, err := p.GetCosmosTxHash(tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal(cosmosTxHash))
+			hash, err = p.GetEthTxHash(cosmosTxHash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash).To(Equal(tx.Hash()))
+			_, err = p.GetCosmosTxHash(unwrapped.Hash())
+			Expect(err).To(HaveOccurred())
+			Expect(p.cosmosTxHashes).To(BeEmpty())
+		})
+
+		It("should read receipts and blocks stored before their proto encoding", func() {
+			ctx = ctx.WithBlockHeight(1)
+			header := &coretypes.Header{Number: big.NewInt(1), BaseFee: big.NewInt(7)}
+			tx := coretypes.NewTransaction(0, common.Address{0x1}, big.NewInt(1), 21000, big.NewInt(7), nil)
+			receipts := coretypes.Receipts{{Status: 1, CumulativeGasUsed: 21000}}
+			block := coretypes.NewBlock(
+				header, coretypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+			)
+			Expect(p.StoreBlock(block)).To(Succeed())
+
+			legacy, err := coretypes.MarshalReceipts(receipts)
+			Expect(err).ToNot(HaveOccurred())
+			store := ctx.KVStore(testutil.EvmKey)
+			store.Set(receiptsKey(block.Hash()), legacy)
+			store.Delete(append([]byte{types.BlockNumKeyToMetadataPrefix}, sdk.Uint64ToBigEndian(1)...))
+
+			receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receiptsByHash[0].CumulativeGasUsed).To(Equal(uint64(21000)))
+
+			md, err := p.GetBlockMetadata(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(md.BaseFeeInt()).To(Equal(big.NewInt(7)))
 		})
 	})
 
 })
+
+var _ = Describe("Off-chain Historical Data", func() {
+	var (
+		p        *plugin
+		ctx      sdk.Context
+		block    *coretypes.Block
+		receipts coretypes.Receipts
+		tx       *coretypes.Transaction
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext().WithBlockHeight(0)
+		cp := mock.NewConfigurationPluginMock()
+		bp := mock.NewBlockPluginMock()
+
+		p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), testutil.EvmKey))
+		p.InitGenesis(ctx, core.DefaultGenesis)
+
+		ctx = ctx.WithBlockHeight(1)
+		p.Prepare(ctx)
+		tx = coretypes.NewTransaction(0, common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), []byte{0x12})
+		receipts = coretypes.Receipts{
+			{
+				Type:              2,
+				Status:            1,
+				CumulativeGasUsed: 500,
+				TxHash:            tx.Hash(),
+				GasUsed:           500,
+				BlockNumber:       big.NewInt(1),
+			},
+		}
+		block = coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1), GasLimit: 1000},
+			coretypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+		)
+		receipts[0].BlockHash = block.Hash()
+		Expect(p.StoreBlock(block)).To(Succeed())
+	})
+
+	It("should write receipts and transactions in the background", func() {
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		p.indexer.flush()
+
+		receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receiptsByHash[0].TxHash).To(Equal(tx.Hash()))
+
+		tle, err := p.GetTransactionByHash(tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tle.BlockHash).To(Equal(block.Hash()))
+
+		pending, err := p.indexer.pendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+	})
+
+	It("should index the logs of the stored receipts across the block", func() {
+		// the receipts stored before the logs were indexed per block.
+		receipts[0].Logs = []*coretypes.Log{{Index: 0}, {Index: 0}}
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		p.indexer.flush()
+
+		receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receiptsByHash[0].Logs).To(HaveLen(2))
+		Expect(core.VerifyLogIndices(receiptsByHash[0].Logs)).To(Succeed())
+	})
+
+	It("should index contract creations in the background", func() {
+		create := coretypes.NewContractCreation(0, big.NewInt(0), 100000, big.NewInt(1), []byte{0x60})
+		contract := common.Address{0x2}
+		receipts = coretypes.Receipts{{Status: 1, TxHash: create.Hash(), ContractAddress: contract}}
+		block = coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1)}, coretypes.Transactions{create},
+			nil, receipts, trie.NewStackTrie(nil),
+		)
+		Expect(p.StoreBlock(block)).To(Succeed())
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		p.indexer.flush()
+
+		txHash, err := p.GetContractCreationTx(contract)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(txHash).To(Equal(create.Hash()))
+	})
+
+	It("should index the hashes of the cosmos txs in the background", func() {
+		cosmosTxHash := common.Hash{0x4}
+		p.SetCosmosTxHash(tx.Hash(), cosmosTxHash)
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		p.indexer.flush()
+
+		hash, err := p.GetCosmosTxHash(tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hash).To(Equal(cosmosTxHash))
+		hash, err = p.GetEthTxHash(cosmosTxHash)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hash).To(Equal(tx.Hash()))
+
+		// the hashes are not served once the block of the tx is rolled back.
+		Expect(p.StoreBlock(coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1), GasLimit: 2000}, nil, nil, nil, nil,
+		))).To(Succeed())
+		_, err = p.GetEthTxHash(cosmosTxHash)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should persist the call traces of the transactions if enabled", func() {
+		trace := []byte(`[{"type":"call"}]`)
+		Expect(p.TraceCalls()).To(BeFalse())
+		Expect(p.StoreCallTraces(block.Hash(), []json.RawMessage{trace})).To(Succeed())
+		_, err := p.GetCallTraces(1, 0)
+		Expect(err).To(MatchError(ErrCallTracesDisabled))
+
+		p.EnableCallTraces(0)
+		Expect(p.TraceCalls()).To(BeTrue())
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreCallTraces(block.Hash(), []json.RawMessage{trace})).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		p.indexer.flush()
+
+		traces, err := p.GetCallTraces(1, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(traces).To(MatchJSON(trace))
+		_, err = p.GetCallTraces(1, 1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should prune the call traces older than the retention", func() {
+		p.EnableCallTraces(2)
+		for blockNum := uint64(1); blockNum <= 3; blockNum++ {
+			Expect(p.indexer.write(&indexJob{
+				blockNum: blockNum,
+				traces:   []json.RawMessage{[]byte(`[]`), nil},
+			})).To(Succeed())
+		}
+
+		_, err := p.GetCallTraces(1, 0)
+		Expect(err).To(HaveOccurred())
+		for _, blockNum := range []uint64{2, 3} {
+			_, err = p.GetCallTraces(blockNum, 0)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = p.GetCallTraces(blockNum, 1)
+			Expect(err).To(HaveOccurred())
+		}
+	})
+
+	It("should not return the data of a block that was rolled back", func() {
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		p.indexer.flush()
+
+		// the host chain rolls back the block and commits an empty one in its place, while the
+		// off-chain database still has the data of the rolled back block.
+		reorged := coretypes.NewBlock(
+			&coretypes.Header{Number: big.NewInt(1), GasLimit: 2000}, nil, nil, nil, nil,
+		)
+		Expect(p.StoreBlock(reorged)).To(Succeed())
+
+		_, err := p.GetBlockByHash(block.Hash())
+		Expect(err).To(HaveOccurred())
+		_, err = p.GetReceiptsByHash(block.Hash())
+		Expect(err).To(HaveOccurred())
+		_, err = p.GetTransactionByHash(tx.Hash())
+		Expect(err).To(HaveOccurred())
+
+		blockByHash, err := p.GetBlockByHash(reorged.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blockByHash.NumberU64()).To(Equal(uint64(1)))
+	})
+
+	It("should not find a block hash that was never stored", func() {
+		_, err := p.GetBlockByHash(common.Hash{0x1})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should record the height of the latest block written", func() {
+		_, ok, err := p.IndexedHeight()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+
+		Expect(p.indexer.db.SetSync(pendingKey(2), common.Hash{0x2}.Bytes())).To(Succeed())
+		Expect(p.indexer.write(&indexJob{blockNum: 3})).To(Succeed())
+		pending, err := p.PendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(Equal([]uint64{2}))
+
+		// a replayed block does not lower the height.
+		Expect(p.indexer.write(&indexJob{blockNum: 2})).To(Succeed())
+		height, ok, err := p.IndexedHeight()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(height).To(Equal(uint64(3)))
+		pending, err = p.PendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+	})
+
+	It("should store the sections of the bloom bits index", func() {
+		sections, err := p.BloomBitsSections()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sections).To(BeZero())
+
+		Expect(p.WriteBloomBits(0, [][]byte{{0x1}, {0x2, 0x3}})).To(Succeed())
+		sections, err = p.BloomBitsSections()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sections).To(Equal(uint64(1)))
+
+		bits, err := p.ReadBloomBits(1, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bits).To(Equal([]byte{0x2, 0x3}))
+		_, err = p.ReadBloomBits(1, 1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should write the queued blocks on close", func() {
+		Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+		Expect(p.StoreTransactions(1, block.Hash(), block.Transactions())).To(Succeed())
+		Expect(p.Close()).To(Succeed())
+		Expect(p.Close()).To(Succeed())
+
+		pending, err := p.PendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+		height, ok, err := p.IndexedHeight()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(height).To(Equal(uint64(1)))
+	})
+
+	It("should replay the journaled blocks that were never written", func() {
+		Expect(p.indexer.db.SetSync(pendingKey(1), block.Hash().Bytes())).To(Succeed())
+
+		Expect(p.ReplayPending(func(blockNum uint64) (coretypes.Receipts, error) {
+			Expect(blockNum).To(Equal(uint64(1)))
+			return receipts, nil
+		})).To(Succeed())
+
+		receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receiptsByHash[0].TxHash).To(Equal(tx.Hash()))
+
+		tle, err := p.GetTransactionByHash(tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tle.BlockNum).To(Equal(uint64(1)))
+
+		pending, err := p.indexer.pendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(BeEmpty())
+	})
+
+	It("should leave the blocks that cannot be replayed pending", func() {
+		genesis, err := p.GetBlockByNumber(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.indexer.db.SetSync(pendingKey(0), genesis.Hash().Bytes())).To(Succeed())
+		Expect(p.indexer.db.SetSync(pendingKey(1), block.Hash().Bytes())).To(Succeed())
+
+		// the state of the first pending block was pruned.
+		Expect(p.ReplayPending(func(blockNum uint64) (coretypes.Receipts, error) {
+			if blockNum == 0 {
+				return nil, errors.New("state pruned")
+			}
+			return receipts, nil
+		})).ToNot(Succeed())
+
+		_, err = p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		pending, err := p.indexer.pendingBlocks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pending).To(Equal([]uint64{0}))
+	})
+
+	It("should reindex the stored blocks", func() {
+		_, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).To(HaveOccurred())
+
+		var replayed []uint64
+		Expect(p.Reindex(1, 1, func(blockNum uint64) (coretypes.Receipts, error) {
+			replayed = append(replayed, blockNum)
+			return receipts, nil
+		})).To(Succeed())
+		Expect(replayed).To(Equal([]uint64{1}))
+
+		receiptsByHash, err := p.GetReceiptsByHash(block.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(receiptsByHash[0].TxHash).To(Equal(tx.Hash()))
+		tle, err := p.GetTransactionByHash(tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tle.BlockNum).To(Equal(uint64(1)))
+
+		// a block that was not stored cannot be reindexed.
+		Expect(p.Reindex(2, 2, func(uint64) (coretypes.Receipts, error) {
+			return nil, nil
+		})).ToNot(Succeed())
+	})
+})
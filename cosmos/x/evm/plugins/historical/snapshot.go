@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+)
+
+const (
+	// snapshotBatchSize is the number of entries written per batch when importing a snapshot.
+	snapshotBatchSize = 10000
+	// maxSnapshotEntrySize is the maximum size of a key or value of a snapshot, which bounds the
+	// memory used to import a corrupted snapshot.
+	maxSnapshotEntrySize = 1 << 30
+)
+
+var (
+	// snapshotMagic starts the snapshots of the off-chain database, followed by their version.
+	snapshotMagic = []byte("polaris-offchain-snapshot")
+	// snapshotVersion is the version of the format of the snapshots.
+	snapshotVersion byte = 1
+
+	// ErrInvalidSnapshot is returned when importing a file that is not a complete snapshot of the
+	// off-chain database.
+	ErrInvalidSnapshot = errors.New("invalid off-chain database snapshot")
+	// ErrDBNotEmpty is returned when importing a snapshot into an off-chain database with data.
+	ErrDBNotEmpty = errors.New("off-chain database is not empty")
+)
+
+// SnapshotInfo describes a snapshot of the off-chain database.
+type SnapshotInfo struct {
+	// Entries is the number of keys of the snapshot.
+	Entries uint64
+	// IndexedHeight is the number of the latest block written to the database, if known.
+	IndexedHeight uint64
+	HasHeight     bool
+}
+
+// ExportSnapshot writes all the entries of the given off-chain database to the given writer, as a
+// gzipped stream that `ImportSnapshot` reads. The database must not be written to meanwhile.
+//
+// The stream is the magic and the version of the format, then each entry as the uvarint length
+// of its key, its key, the uvarint length of its value and its value, then an empty key and the
+// uvarint number of entries, so that a truncated snapshot is detected.
+func ExportSnapshot(db dbm.DB, w io.Writer) (SnapshotInfo, error) {
+	var info SnapshotInfo
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+	if _, err := bw.Write(append(append([]byte{}, snapshotMagic...), snapshotVersion)); err != nil {
+		return info, err
+	}
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return info, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err = writeSnapshotEntry(bw, it.Key()); err != nil {
+			return info, err
+		}
+		if err = writeSnapshotEntry(bw, it.Value()); err != nil {
+			return info, err
+		}
+		if bytes.Equal(it.Key(), types.IndexedHeightKey) {
+			info.IndexedHeight, info.HasHeight = sdk.BigEndianToUint64(it.Value()), true
+		}
+		info.Entries++
+	}
+	if err = it.Error(); err != nil {
+		return info, err
+	}
+
+	if err = writeSnapshotEntry(bw, nil); err != nil {
+		return info, err
+	}
+	if _, err = bw.Write(binary.AppendUvarint(nil, info.Entries)); err != nil {
+		return info, err
+	}
+	if err = bw.Flush(); err != nil {
+		return info, err
+	}
+	return info, zw.Close()
+}
+
+// ImportSnapshot writes the entries of the snapshot read from the given reader, written by
+// `ExportSnapshot`, to the given off-chain database, which must be empty. The entries are
+// written in batches as the snapshot is read, so the database is cleared if the snapshot turns
+// out to be invalid, and the indexed height is written last, so that a database left partially
+// imported by a crash is never taken for a complete one.
+func ImportSnapshot(db dbm.DB, r io.Reader) (SnapshotInfo, error) {
+	var info SnapshotInfo
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return info, err
+	}
+	empty := !it.Valid()
+	if err = errors.Join(it.Error(), it.Close()); err != nil {
+		return info, err
+	}
+	if !empty {
+		return info, ErrDBNotEmpty
+	}
+
+	if err = importSnapshot(db, r, &info); err != nil {
+		return info, errors.Join(err, clearDB(db))
+	}
+	return info, nil
+}
+
+// importSnapshot writes the entries of the snapshot read from the given reader to the given
+// database, stopping at the first invalid entry.
+func importSnapshot(db dbm.DB, r io.Reader, info *SnapshotInfo) error {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	defer zr.Close()
+	br := bufio.NewReader(zr)
+	header := make([]byte, len(snapshotMagic)+1)
+	if _, err = io.ReadFull(br, header); err != nil ||
+		!bytes.Equal(header[:len(snapshotMagic)], snapshotMagic) {
+		return ErrInvalidSnapshot
+	}
+	if version := header[len(snapshotMagic)]; version != snapshotVersion {
+		return fmt.Errorf("%w: unknown version %d", ErrInvalidSnapshot, version)
+	}
+
+	var height []byte
+	batch := db.NewBatch()
+	defer func() { batch.Close() }()
+	for {
+		key, readErr := readSnapshotEntry(br)
+		if readErr != nil {
+			return readErr
+		}
+		if len(key) == 0 {
+			break
+		}
+		value, readErr := readSnapshotEntry(br)
+		if readErr != nil {
+			return readErr
+		}
+		info.Entries++
+		if bytes.Equal(key, types.IndexedHeightKey) {
+			height = value
+			info.IndexedHeight, info.HasHeight = sdk.BigEndianToUint64(value), true
+			continue
+		}
+		if err = batch.Set(key, value); err != nil {
+			return err
+		}
+
+		if info.Entries%snapshotBatchSize == 0 {
+			if err = batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = db.NewBatch()
+		}
+	}
+
+	entries, err := binary.ReadUvarint(br)
+	if err != nil || entries != info.Entries {
+		return fmt.Errorf(
+			"%w: read %d entries, snapshot has %d", ErrInvalidSnapshot, info.Entries, entries,
+		)
+	}
+	// reading to the end verifies the checksum of the gzipped stream.
+	if _, err = br.ReadByte(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: data after the entries or bad checksum", ErrInvalidSnapshot)
+	}
+	if height != nil {
+		if err = batch.Set(types.IndexedHeightKey, height); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// clearDB deletes all the entries of the given database.
+func clearDB(db dbm.DB) error {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err = errors.Join(it.Error(), it.Close()); err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err = batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// writeSnapshotEntry writes the given key or value, prefixed with its length.
+func writeSnapshotEntry(w io.Writer, bz []byte) error {
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(len(bz)))); err != nil {
+		return err
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+// readSnapshotEntry reads a key or value prefixed with its length.
+func readSnapshotEntry(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	if size > maxSnapshotEntrySize {
+		return nil, fmt.Errorf("%w: entry of %d bytes", ErrInvalidSnapshot, size)
+	}
+	bz := make([]byte, size)
+	if _, err = io.ReadFull(r, bz); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return bz, nil
+}
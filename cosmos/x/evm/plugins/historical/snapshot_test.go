@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"bytes"
+	"math/big"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Off-chain Database Snapshots", func() {
+	var db dbm.DB
+
+	BeforeEach(func() {
+		db = dbm.NewMemDB()
+		Expect(db.Set(types.IndexedHeightKey, sdk.Uint64ToBigEndian(7))).To(Succeed())
+		Expect(db.Set(txKey(common.Hash{0x1}), []byte{0x1, 0x2})).To(Succeed())
+		Expect(db.Set(receiptsKey(common.Hash{0x2}), []byte{})).To(Succeed())
+	})
+
+	It("should import an exported snapshot", func() {
+		var buf bytes.Buffer
+		info, err := ExportSnapshot(db, &buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info).To(Equal(SnapshotInfo{Entries: 3, IndexedHeight: 7, HasHeight: true}))
+
+		imported := dbm.NewMemDB()
+		info, err = ImportSnapshot(imported, &buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info).To(Equal(SnapshotInfo{Entries: 3, IndexedHeight: 7, HasHeight: true}))
+		Expect(imported.Get(txKey(common.Hash{0x1}))).To(Equal([]byte{0x1, 0x2}))
+		Expect(imported.Has(receiptsKey(common.Hash{0x2}))).To(BeTrue())
+	})
+
+	It("should refuse a truncated snapshot", func() {
+		var buf bytes.Buffer
+		_, err := ExportSnapshot(db, &buf)
+		Expect(err).ToNot(HaveOccurred())
+
+		truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-10])
+		_, err = ImportSnapshot(dbm.NewMemDB(), truncated)
+		Expect(err).To(MatchError(ErrInvalidSnapshot))
+	})
+
+	It("should leave the database empty when a snapshot is truncated after a batch", func() {
+		for i := uint64(0); i < snapshotBatchSize; i++ {
+			Expect(db.Set(txKey(common.BigToHash(new(big.Int).SetUint64(i))), []byte{1})).
+				To(Succeed())
+		}
+		var buf bytes.Buffer
+		_, err := ExportSnapshot(db, &buf)
+		Expect(err).ToNot(HaveOccurred())
+
+		imported := dbm.NewMemDB()
+		truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-10])
+		_, err = ImportSnapshot(imported, truncated)
+		Expect(err).To(MatchError(ErrInvalidSnapshot))
+		it, err := imported.Iterator(nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(it.Valid()).To(BeFalse())
+		Expect(it.Close()).To(Succeed())
+
+		info, err := ImportSnapshot(imported, &buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Entries).To(Equal(uint64(snapshotBatchSize + 3)))
+		Expect(imported.Get(types.IndexedHeightKey)).To(Equal(sdk.Uint64ToBigEndian(7)))
+	})
+
+	It("should only import into an empty database", func() {
+		var buf bytes.Buffer
+		_, err := ExportSnapshot(db, &buf)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = ImportSnapshot(db, &buf)
+		Expect(err).To(MatchError(ErrDBNotEmpty))
+	})
+})
@@ -29,11 +29,13 @@ import (
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	ethprecompile "pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/registry"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
 	"pkg.berachain.dev/polaris/lib/utils"
@@ -61,16 +63,21 @@ type plugin struct {
 	transientKVGasConfig storetypes.GasConfig
 	// sp allows resetting the context for the reentrancy into the EVM.
 	sp StatePlugin
+	// storeKey is the key of the x/evm store, which holds the precompile policies in its params.
+	storeKey storetypes.StoreKey
 }
 
 // NewPlugin creates and returns a plugin with the default KV store gas configs.
-func NewPlugin(precompiles []ethprecompile.Registrable, sp StatePlugin) Plugin {
+func NewPlugin(
+	precompiles []ethprecompile.Registrable, sp StatePlugin, storeKey storetypes.StoreKey,
+) Plugin {
 	return &plugin{
 		Registry:             registry.NewMap[common.Address, vm.PrecompileContainer](),
 		precompiles:          precompiles,
 		kvGasConfig:          storetypes.KVGasConfig(),
 		transientKVGasConfig: storetypes.TransientGasConfig(),
 		sp:                   sp,
+		storeKey:             storeKey,
 	}
 }
 
@@ -130,6 +137,13 @@ func (p *plugin) Run(
 	sdb := utils.MustGetAs[vm.PolarisStateDB](evm.GetStateDB())
 	ctx := sdk.UnwrapSDKContext(sdb.GetContext())
 
+	// enforce the policy of the precompile, if it has one, before running it
+	if err := p.authorize(ctx, sdb, pc.RegistryKey(), caller); err != nil {
+		return nil, suppliedGas, errors.Wrapf(
+			vm.ErrExecutionReverted, "call to precompile %s rejected: %v", pc.RegistryKey(), err,
+		)
+	}
+
 	// disable reentrancy into the EVM
 	p.disableReentrancy(sdb)
 
@@ -157,6 +171,32 @@ func (p *plugin) Run(
 	return ret, suppliedGas - gm.GasConsumed(), err
 }
 
+// authorize returns an error if the policy of the precompile at addr, set in the x/evm params,
+// does not allow the caller.
+func (p *plugin) authorize(
+	ctx sdk.Context, sdb vm.PolarisStateDB, addr, caller common.Address,
+) error {
+	bz := ctx.KVStore(p.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	var evmParams types.Params
+	if err := evmParams.Unmarshal(bz); err != nil {
+		return err
+	}
+	policy := evmParams.PrecompilePolicy(addr)
+	if policy == nil {
+		return nil
+	}
+
+	// without a known transaction sender, the caller is treated as a contract
+	var isOrigin bool
+	if odb, ok := sdb.(vm.OriginStateDB); ok {
+		isOrigin = odb.TxOrigin() == caller
+	}
+	return policy.Authorize(caller, isOrigin)
+}
+
 // EnableReentrancy sets the state so that execution can enter the EVM again.
 //
 // EnableReentrancy implements core.PrecompilePlugin.
@@ -31,6 +31,7 @@ import (
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events/mock"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
 	"pkg.berachain.dev/polaris/eth/core/vm"
@@ -50,8 +51,8 @@ var _ = Describe("plugin", func() {
 		ctx = ctx.WithEventManager(
 			events.NewManagerFrom(ctx.EventManager(), mock.NewPrecompileLogFactory()),
 		)
-		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}))
-		e = &mockEVM{nil, ctx}
+		p = utils.MustGetAs[*plugin](NewPlugin(nil, &mockSP{ctx}, storeKey))
+		e = &mockEVM{nil, ctx, common.Address{}}
 	})
 
 	It("should use correctly consume gas", func() {
@@ -65,6 +66,43 @@ var _ = Describe("plugin", func() {
 		Expect(err.Error()).To(Equal("out of gas"))
 	})
 
+	When("the precompile has a policy", func() {
+		setPolicy := func(policy *types.PrecompilePolicy) {
+			bz, err := (&types.Params{
+				PrecompilePolicies: []*types.PrecompilePolicy{policy},
+			}).Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+		}
+
+		It("should reject calls to a paused precompile", func() {
+			setPolicy(&types.PrecompilePolicy{Address: addr.Hex(), Paused: true})
+			_, remainingGas, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+			Expect(err).To(MatchError(vm.ErrExecutionReverted))
+			Expect(remainingGas).To(Equal(uint64(30)))
+		})
+
+		It("should only allow the transaction sender to call an EOA only precompile", func() {
+			setPolicy(&types.PrecompilePolicy{Address: addr.Hex(), EoaOnly: true})
+			caller := common.BytesToAddress([]byte{2})
+
+			_, _, err := p.Run(e, &mockStateless{}, []byte{}, caller, new(big.Int), 30, false)
+			Expect(err).To(MatchError(vm.ErrExecutionReverted))
+
+			e = &mockEVM{nil, ctx, caller}
+			_, _, err = p.Run(e, &mockStateless{}, []byte{}, caller, new(big.Int), 30, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not restrict other precompiles", func() {
+			setPolicy(&types.PrecompilePolicy{
+				Address: common.BytesToAddress([]byte{3}).Hex(), Paused: true,
+			})
+			_, _, err := p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 30, false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	It("should plug in custom gas configs", func() {
 		Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(1000)))
 		Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(100)))
@@ -92,25 +130,34 @@ func (msp *mockSP) SetGasConfig(kvg storetypes.GasConfig, tkvg storetypes.GasCon
 
 type mockEVM struct {
 	precompile.EVM
-	ctx sdk.Context
+	ctx    sdk.Context
+	origin common.Address
 }
 
 func (me *mockEVM) GetStateDB() vm.GethStateDB {
-	return &mockSDB{nil, me.ctx}
+	return &mockSDB{nil, me.ctx, me.origin}
 }
 
 type mockSDB struct {
 	vm.PolarisStateDB
-	ctx sdk.Context
+	ctx    sdk.Context
+	origin common.Address
 }
 
 func (ms *mockSDB) GetContext() context.Context {
 	return ms.ctx
 }
 
+func (ms *mockSDB) TxOrigin() common.Address {
+	return ms.origin
+}
+
 type mockStateless struct{}
 
-var addr = common.BytesToAddress([]byte{1})
+var (
+	addr     = common.BytesToAddress([]byte{1})
+	storeKey = storetypes.NewKVStoreKey("evm")
+)
 
 func (ms *mockStateless) RegistryKey() common.Address {
 	return addr
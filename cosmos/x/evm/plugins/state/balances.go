@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// Balances keeps the wei balances of the EVM accounts. By default, they are kept in the evm
+// store. Once backed by x/bank, the whole units of the bank denom are kept in x/bank, so that the
+// native token balance of an account is the same to the EVM and to x/bank, and the evm store only
+// keeps the wei of each account that do not add up to a whole unit of the denom.
+//
+// The fractions of a unit are backed by whole units held in reserve by the evm module account, so
+// that dust is never created or destroyed: the units in reserve, scaled to wei, always equal the
+// sum of the fractions plus a remainder of less than a unit, kept in the evm store. The EVM wei
+// in circulation are therefore always the bank supply of the denom, scaled to wei, minus the
+// remainder.
+type Balances struct {
+	storeKey storetypes.StoreKey
+
+	// bk and scaler are nil until the balances are backed by x/bank.
+	bk     BankKeeper
+	scaler *cosmlib.DenomScaler
+
+	// holdings reads the coins of every denom held by the accounts in x/bank. It is nil until set.
+	holdings HoldingsKeeper
+}
+
+// NewBalances returns Balances kept in the evm store under the given key.
+func NewBalances(storeKey storetypes.StoreKey) *Balances {
+	return &Balances{storeKey: storeKey}
+}
+
+// SetBank backs the balances with the given bank keeper, in the denom of the given scaler. The
+// wei already kept in the evm store are not moved to x/bank, so it must be set before any
+// balance is.
+func (b *Balances) SetBank(bk BankKeeper, scaler cosmlib.DenomScaler) {
+	b.bk = bk
+	b.scaler = &scaler
+	b.holdings = bk
+}
+
+// SetHoldings sets the keeper of the coins of every denom held by the accounts in x/bank.
+func (b *Balances) SetHoldings(hk HoldingsKeeper) {
+	b.holdings = hk
+}
+
+// HoldsCoins returns whether the given account holds coins of any denom in x/bank. It is always
+// false until the keeper of the holdings is set.
+func (b *Balances) HoldsCoins(ctx sdk.Context, addr common.Address) bool {
+	return b.holdings != nil && !b.holdings.GetAllBalances(ctx, addr.Bytes()).IsZero()
+}
+
+// Scaler returns the scaler of the bank denom backing the balances, or nil if they are kept in
+// the evm store.
+func (b *Balances) Scaler() *cosmlib.DenomScaler {
+	return b.scaler
+}
+
+// Get returns the balance of the given account.
+func (b *Balances) Get(ctx sdk.Context, addr common.Address) *big.Int {
+	balance := b.fraction(ctx, addr)
+	if b.bk == nil {
+		return balance
+	}
+	units := b.bk.GetBalance(ctx, addr.Bytes(), b.scaler.Denom()).Amount
+	return balance.Add(balance, b.scaler.ToWei(units))
+}
+
+// Set sets the balance of the given account. When backed by x/bank, the difference in whole
+// units with the bank balance of the account is minted to or burned from it.
+func (b *Balances) Set(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	if b.bk == nil {
+		ctx.KVStore(b.storeKey).Set(BalanceKeyFor(addr), amount.Bytes())
+		return nil
+	}
+
+	units, fraction := b.scaler.FromWei(amount)
+	held := b.bk.GetBalance(ctx, addr.Bytes(), b.scaler.Denom()).Amount
+	if err := b.adjust(ctx, addr, units.Sub(held)); err != nil {
+		return err
+	}
+	delta := new(big.Int).Sub(fraction, b.fraction(ctx, addr))
+	b.setFraction(ctx, addr, fraction)
+	return b.backFractions(ctx, delta)
+}
+
+// Clear burns the whole balance of the given account.
+func (b *Balances) Clear(ctx sdk.Context, addr common.Address) error {
+	if b.bk == nil {
+		ctx.KVStore(b.storeKey).Delete(BalanceKeyFor(addr))
+		return nil
+	}
+	return b.Set(ctx, addr, new(big.Int))
+}
+
+// Add adds the given amount to the balance of the given account.
+func (b *Balances) Add(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	return b.Set(ctx, addr, new(big.Int).Add(b.Get(ctx, addr), amount))
+}
+
+// Sub subtracts the given amount from the balance of the given account.
+func (b *Balances) Sub(ctx sdk.Context, addr common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	return b.Set(ctx, addr, new(big.Int).Sub(b.Get(ctx, addr), amount))
+}
+
+// Transfer moves the given amount from the balance of `from` to the balance of `to`. When backed
+// by x/bank, the whole units are sent with the bank keeper. The wei that do not add up to a whole
+// unit are moved in the evm store, which may borrow a unit from the sender, that goes to the
+// reserve, or carry a unit over to the recipient, that comes from the reserve.
+func (b *Balances) Transfer(ctx sdk.Context, from, to common.Address, amount *big.Int) error {
+	if amount.Sign() == 0 || from == to {
+		return nil
+	}
+	if b.bk == nil {
+		if err := b.Sub(ctx, from, amount); err != nil {
+			return err
+		}
+		return b.Add(ctx, to, amount)
+	}
+
+	var (
+		factor          = b.scaler.Factor()
+		units, fraction = b.scaler.FromWei(amount)
+		sent, received  = units, units
+		fromFraction    = new(big.Int).Sub(b.fraction(ctx, from), fraction)
+		toFraction      = new(big.Int).Add(b.fraction(ctx, to), fraction)
+	)
+	if fromFraction.Sign() < 0 {
+		fromFraction.Add(fromFraction, factor)
+		sent = sent.AddRaw(1)
+	}
+	if toFraction.Cmp(factor) >= 0 {
+		toFraction.Sub(toFraction, factor)
+		received = received.AddRaw(1)
+	}
+
+	shared := sdkmath.MinInt(sent, received)
+	if shared.IsPositive() {
+		if err := b.bk.SendCoins(
+			ctx, from.Bytes(), to.Bytes(), b.unitCoins(shared),
+		); err != nil {
+			return err
+		}
+	}
+	if sent.GT(shared) {
+		if err := b.bk.SendCoinsFromAccountToModule(
+			ctx, from.Bytes(), types.ModuleName, b.unitCoins(sent.Sub(shared)),
+		); err != nil {
+			return err
+		}
+	}
+	if received.GT(shared) {
+		if err := b.bk.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, to.Bytes(), b.unitCoins(received.Sub(shared)),
+		); err != nil {
+			return err
+		}
+	}
+
+	b.setFraction(ctx, from, fromFraction)
+	b.setFraction(ctx, to, toFraction)
+	return nil
+}
+
+// Reserve returns the units held in reserve to back the fractions, scaled to wei, and the
+// remainder of the reserve that backs no fraction.
+func (b *Balances) Reserve(ctx sdk.Context) (*big.Int, *big.Int) {
+	if b.bk == nil {
+		return new(big.Int), new(big.Int)
+	}
+	reserve := b.bk.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), b.scaler.Denom())
+	return b.scaler.ToWei(reserve.Amount), b.remainder(ctx)
+}
+
+// backFractions keeps the reserve backing the fractions after their sum changed by the given
+// delta, of less than a unit, by minting a unit to the reserve or burning one from it.
+func (b *Balances) backFractions(ctx sdk.Context, delta *big.Int) error {
+	var (
+		factor    = b.scaler.Factor()
+		remainder = new(big.Int).Sub(b.remainder(ctx), delta)
+	)
+	switch {
+	case remainder.Sign() < 0:
+		if err := b.bk.MintCoins(ctx, types.ModuleName, b.unitCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+		remainder.Add(remainder, factor)
+	case remainder.Cmp(factor) >= 0:
+		if err := b.bk.BurnCoins(ctx, types.ModuleName, b.unitCoins(sdkmath.OneInt())); err != nil {
+			return err
+		}
+		remainder.Sub(remainder, factor)
+	}
+
+	if remainder.Sign() == 0 {
+		ctx.KVStore(b.storeKey).Delete(types.BalanceRemainderKey)
+	} else {
+		ctx.KVStore(b.storeKey).Set(types.BalanceRemainderKey, remainder.Bytes())
+	}
+	return nil
+}
+
+// remainder returns the wei of the reserve that back no fraction.
+func (b *Balances) remainder(ctx sdk.Context) *big.Int {
+	return new(big.Int).SetBytes(ctx.KVStore(b.storeKey).Get(types.BalanceRemainderKey))
+}
+
+// unitCoins returns the given number of whole units of the denom as coins.
+func (b *Balances) unitCoins(units sdkmath.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(b.scaler.Denom(), units))
+}
+
+// fraction returns the wei of the given account kept in the evm store.
+func (b *Balances) fraction(ctx sdk.Context, addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(ctx.KVStore(b.storeKey).Get(BalanceKeyFor(addr)))
+}
+
+// setFraction sets the wei of the given account kept in the evm store, which are deleted once they
+// add up to zero.
+func (b *Balances) setFraction(ctx sdk.Context, addr common.Address, fraction *big.Int) {
+	if fraction.Sign() == 0 {
+		ctx.KVStore(b.storeKey).Delete(BalanceKeyFor(addr))
+		return
+	}
+	ctx.KVStore(b.storeKey).Set(BalanceKeyFor(addr), fraction.Bytes())
+}
+
+// adjust mints the given number of whole units to the given account if it is positive, or burns
+// them from it if it is negative.
+func (b *Balances) adjust(ctx sdk.Context, addr common.Address, units sdkmath.Int) error {
+	switch units.Sign() {
+	case 1:
+		return cosmlib.MintCoinsToAddress(
+			ctx, b.bk, types.ModuleName, addr, b.scaler.Denom(), units.BigInt(),
+		)
+	case -1:
+		return cosmlib.BurnCoinsFromAddress(
+			ctx, b.bk, types.ModuleName, addr, b.scaler.Denom(), units.Neg().BigInt(),
+		)
+	default:
+		return nil
+	}
+}
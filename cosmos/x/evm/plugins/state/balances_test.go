@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	cosmlib "pkg.berachain.dev/polaris/cosmos/lib"
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Balances kept in x/bank", func() {
+	const denom = "ubera"
+
+	var (
+		ctx    sdk.Context
+		bk     bankkeeper.BaseKeeper
+		sp     state.Plugin
+		carol  = common.Address{0xca}
+		scaler = cosmlib.NewDenomScaler(denom, 6)
+	)
+
+	// spCtx returns the context of the plugin, which sees the changes of the state transition.
+	spCtx := func() sdk.Context {
+		return sdk.UnwrapSDKContext(sp.GetContext())
+	}
+
+	units := func(addr common.Address) sdkmath.Int {
+		return bk.GetBalance(spCtx(), addr.Bytes(), denom).Amount
+	}
+
+	supply := func() sdkmath.Int {
+		return bk.GetSupply(spCtx(), denom).Amount
+	}
+
+	// expectParity checks that the EVM balance of each account is its bank balance scaled to wei,
+	// plus a fraction of a unit kept in the evm store, that the fractions are backed by the reserve
+	// and that the given accounts hold all the wei in circulation, so that no dust was created or
+	// destroyed.
+	expectParity := func(addrs ...common.Address) {
+		var (
+			fractions  = new(big.Int)
+			circulated = new(big.Int)
+		)
+		for _, addr := range addrs {
+			fraction := new(big.Int).SetBytes(
+				spCtx().KVStore(testutil.EvmKey).Get(state.BalanceKeyFor(addr)),
+			)
+			Expect(fraction.Cmp(scaler.Factor())).To(BeNumerically("<", 0))
+			fractions.Add(fractions, fraction)
+			balance := sp.GetBalance(addr)
+			Expect(balance).To(Equal(new(big.Int).Add(fraction, scaler.ToWei(units(addr)))))
+			circulated.Add(circulated, balance)
+		}
+
+		reserve, remainder := sp.Balances().Reserve(spCtx())
+		Expect(remainder.Cmp(scaler.Factor())).To(BeNumerically("<", 0))
+		Expect(reserve).To(Equal(fractions.Add(fractions, remainder)))
+		Expect(circulated).To(Equal(new(big.Int).Sub(scaler.ToWei(supply()), remainder)))
+	}
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.Balances().SetBank(bk, scaler)
+		sp.Reset(ctx)
+	})
+
+	AfterEach(func() {
+		Expect(sp.Error()).ToNot(HaveOccurred())
+	})
+
+	It("should mint and burn whole units", func() {
+		sp.AddBalance(alice, big.NewInt(2e12+1))
+		Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(2e12 + 1)))
+		Expect(units(alice)).To(Equal(sdkmath.NewInt(2)))
+
+		sp.SubBalance(alice, big.NewInt(1e12+2))
+		Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(1e12 - 1)))
+		Expect(units(alice).IsZero()).To(BeTrue())
+		expectParity(alice)
+	})
+
+	It("should transfer fractions of a unit", func() {
+		sp.AddBalance(alice, big.NewInt(14e11))
+		sp.AddBalance(bob, big.NewInt(8e11))
+		// a unit is minted to alice, and one is minted to the reserve for each fraction.
+		Expect(supply()).To(Equal(sdkmath.NewInt(3)))
+		expectParity(alice, bob)
+
+		// alice borrows a unit and bob carries one over, so a single unit is sent.
+		sp.Transfer(alice, bob, big.NewInt(6e11))
+		Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(8e11)))
+		Expect(sp.GetBalance(bob)).To(Equal(big.NewInt(14e11)))
+		Expect(units(bob)).To(Equal(sdkmath.NewInt(1)))
+		expectParity(alice, bob)
+
+		// bob borrows a unit that carol does not carry over, so it goes to the reserve.
+		sp.Transfer(bob, carol, big.NewInt(5e11))
+		Expect(sp.GetBalance(bob)).To(Equal(big.NewInt(9e11)))
+		Expect(sp.GetBalance(carol)).To(Equal(big.NewInt(5e11)))
+		Expect(units(bob).IsZero()).To(BeTrue())
+		expectParity(alice, bob, carol)
+
+		// carol carries a unit over that alice does not borrow, so it comes from the reserve.
+		sp.Transfer(alice, carol, big.NewInt(7e11))
+		Expect(sp.GetBalance(alice)).To(Equal(big.NewInt(1e11)))
+		Expect(sp.GetBalance(carol)).To(Equal(big.NewInt(12e11)))
+		Expect(units(carol)).To(Equal(sdkmath.NewInt(1)))
+		expectParity(alice, bob, carol)
+
+		// transfers never mint nor burn.
+		Expect(supply()).To(Equal(sdkmath.NewInt(3)))
+	})
+
+	It("should revert transfers made through x/bank", func() {
+		sp.AddBalance(alice, big.NewInt(3e12))
+		id := sp.Snapshot()
+		sp.Transfer(alice, bob, big.NewInt(2e12))
+		Expect(units(bob)).To(Equal(sdkmath.NewInt(2)))
+
+		sp.RevertToSnapshot(id)
+		Expect(units(alice)).To(Equal(sdkmath.NewInt(3)))
+		Expect(units(bob).IsZero()).To(BeTrue())
+		expectParity(alice, bob)
+	})
+
+	It("should burn the balance of a deleted account", func() {
+		sp.CreateAccount(alice)
+		sp.AddBalance(alice, big.NewInt(2e12+3))
+		sp.DeleteAccounts([]common.Address{alice})
+		Expect(sp.GetBalance(alice).Sign()).To(BeZero())
+		Expect(supply().IsZero()).To(BeTrue())
+	})
+})
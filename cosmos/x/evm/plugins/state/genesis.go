@@ -85,3 +85,42 @@ func (p *plugin) ExportGenesis(ctx sdk.Context, ethGen *core.Genesis) {
 		return false
 	})
 }
+
+// ExportAlloc returns the accounts of the state of the given context with their balance, nonce,
+// code and storage, as the alloc of a go-ethereum genesis. Unlike `ExportGenesis`, it exports the
+// balances kept in x/bank and the nonces, to migrate the state to another EVM chain. The accounts
+// that are empty as defined by EIP-161, or whose address is not an EVM address, are left out.
+func (p *plugin) ExportAlloc(ctx sdk.Context) core.GenesisAlloc {
+	p.Reset(ctx)
+	alloc := make(core.GenesisAlloc)
+
+	p.ak.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		if len(acc.GetAddress()) != common.AddressLength {
+			return false
+		}
+		address := common.BytesToAddress(acc.GetAddress())
+		if p.Empty(address) {
+			return false
+		}
+		alloc[address] = core.GenesisAccount{
+			Balance: p.GetBalance(address),
+			Nonce:   acc.GetSequence(),
+			Code:    p.GetCode(address),
+		}
+		return false
+	})
+
+	p.IterateState(func(address common.Address, key common.Hash, value common.Hash) bool {
+		account, ok := alloc[address]
+		if !ok || (value == common.Hash{}) {
+			return false
+		}
+		if account.Storage == nil {
+			account.Storage = make(map[common.Hash]common.Hash)
+		}
+		account.Storage[key] = value
+		alloc[address] = account
+		return false
+	})
+	return alloc
+}
@@ -83,4 +83,26 @@ var _ = Describe("Genesis", func() {
 		sp.ExportGenesis(ctx, &exportedGenesis)
 		Expect(exportedGenesis.Alloc).To(Equal(genesis.Alloc))
 	})
+
+	It("should export the alloc of the non-empty accounts", func() {
+		storage := map[common.Hash]common.Hash{
+			common.BytesToHash([]byte("key")): common.BytesToHash([]byte("value")),
+		}
+		sp.CreateAccount(alice)
+		sp.SetBalance(alice, big.NewInt(5e18))
+		sp.SetNonce(alice, 3)
+		sp.SetCode(alice, code)
+		sp.SetStorage(alice, storage)
+		sp.CreateAccount(bob)
+		sp.Finalize()
+
+		alloc := sp.ExportAlloc(ctx)
+		Expect(alloc).NotTo(HaveKey(bob))
+		Expect(alloc[alice]).To(Equal(core.GenesisAccount{
+			Balance: big.NewInt(5e18),
+			Nonce:   3,
+			Code:    code,
+			Storage: storage,
+		}))
+	})
 })
@@ -27,51 +27,77 @@ import (
 
 // NOTE: we use copy to build keys for max performance: https://github.com/golang/go/issues/55905
 
-func BalanceKeyFor(address common.Address) []byte {
-	bz := make([]byte, 1+common.AddressLength)
-	copy(bz, []byte{types.BalanceKeyPrefix})
-	copy(bz[1:], address[:])
+// addressKeyLength is the length of a key made of a namespace and a length-prefixed address.
+const addressKeyLength = 2 + common.AddressLength
+
+// addressKeyFor returns the key of the given address in the given namespace, in which the address
+// is length-prefixed.
+func addressKeyFor(prefix byte, address common.Address) []byte {
+	bz := make([]byte, addressKeyLength)
+	bz[0], bz[1] = prefix, common.AddressLength
+	copy(bz[2:], address[:])
 	return bz
 }
 
+// addressFromKey returns the length-prefixed address that follows the namespace of a key.
+func addressFromKey(key []byte) common.Address {
+	return common.BytesToAddress(key[2:addressKeyLength])
+}
+
+// BalanceKeyFor defines the full key under which an address balance is stored.
+func BalanceKeyFor(address common.Address) []byte {
+	return addressKeyFor(types.BalanceKeyPrefix, address)
+}
+
 // StorageKeyFor returns a prefix to iterate over a given account storage (multiple slots).
 func StorageKeyFor(address common.Address) []byte {
-	bz := make([]byte, 1+common.AddressLength)
-	copy(bz, []byte{types.StorageKeyPrefix})
-	copy(bz[1:], address[:])
-	return bz
+	return addressKeyFor(types.StorageKeyPrefix, address)
 }
 
 // AddressFromStorageKey returns the address from a storage key.
 func AddressFromStorageKey(key []byte) common.Address {
-	return common.BytesToAddress(key[1:])
+	return addressFromKey(key)
 }
 
 // SlotKeyFor defines the full key under which an account storage slot is stored.
 func SlotKeyFor(address common.Address, slot common.Hash) []byte {
-	bz := make([]byte, 1+common.AddressLength+common.HashLength)
-	copy(bz, []byte{types.StorageKeyPrefix})
-	copy(bz[1:], address[:])
-	copy(bz[1+common.AddressLength:], slot[:])
+	bz := make([]byte, addressKeyLength+common.HashLength)
+	bz[0], bz[1] = types.StorageKeyPrefix, common.AddressLength
+	copy(bz[2:], address[:])
+	copy(bz[addressKeyLength:], slot[:])
 	return bz
 }
 
 // SlotFromSlotKeyFor returns the slot from a slot key.
 func SlotFromSlotKey(key []byte) common.Hash {
-	return common.BytesToHash(key[1+common.AddressLength:])
+	return common.BytesToHash(key[addressKeyLength:])
 }
 
 // AddressFromSlotKey returns the address from a slot key.
 func AddressFromSlotKey(key []byte) common.Address {
-	return common.BytesToAddress(key[1 : 1+common.AddressLength])
+	return addressFromKey(key)
+}
+
+// StorageGenerationKeyFor defines the full key under which the storage generation of an account
+// is stored.
+func StorageGenerationKeyFor(address common.Address) []byte {
+	return addressKeyFor(types.StorageGenerationKeyPrefix, address)
+}
+
+// StorageTombstoneKeyFor defines the full key under which the tombstone of a destroyed account,
+// whose stale storage has yet to be pruned, is stored.
+func StorageTombstoneKeyFor(address common.Address) []byte {
+	return addressKeyFor(types.StorageTombstoneKeyPrefix, address)
+}
+
+// AddressFromStorageTombstoneKey returns the address from a storage tombstone key.
+func AddressFromStorageTombstoneKey(key []byte) common.Address {
+	return addressFromKey(key)
 }
 
 // CodeHashKeyFor defines the full key under which an addreses codehash is stored.
 func CodeHashKeyFor(address common.Address) []byte {
-	bz := make([]byte, 1+common.AddressLength)
-	copy(bz, []byte{types.CodeHashKeyPrefix})
-	copy(bz[1:], address[:])
-	return bz
+	return addressKeyFor(types.CodeHashKeyPrefix, address)
 }
 
 // CodeKeyFor defines the full key under which an addreses code is stored.
@@ -84,10 +110,10 @@ func CodeKeyFor(codeHash common.Hash) []byte {
 
 // AddressFromCodeHashKey returns the address from a code hash key.
 func AddressFromCodeHashKey(key []byte) common.Address {
-	return common.BytesToAddress(key[1:])
+	return addressFromKey(key)
 }
 
 // AddressFromBalanceKey returns the address from a balance key.
 func AddressFromBalanceKey(key []byte) common.Address {
-	return common.BytesToAddress(key[1:])
+	return addressFromKey(key)
 }
@@ -32,9 +32,10 @@ var _ = Describe("StorageKeyFor", func() {
 	It("returns a prefix to iterate over a given account storage", func() {
 		address := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
 		prefix := StorageKeyFor(address)
-		Expect(prefix).To(HaveLen(1 + common.AddressLength))
+		Expect(prefix).To(HaveLen(2 + common.AddressLength))
 		Expect(prefix[0]).To(Equal(types.StorageKeyPrefix))
-		Expect(prefix[1:]).To(Equal(address.Bytes()))
+		Expect(prefix[1]).To(Equal(byte(common.AddressLength)))
+		Expect(prefix[2:]).To(Equal(address.Bytes()))
 	})
 })
 
@@ -53,10 +54,11 @@ var _ = Describe("SlotKeyFor", func() {
 		address := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
 		slot := common.HexToHash("0x1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcdef")
 		key := SlotKeyFor(address, slot)
-		Expect(key).To(HaveLen(1 + common.AddressLength + common.HashLength))
+		Expect(key).To(HaveLen(2 + common.AddressLength + common.HashLength))
 		Expect(key[0]).To(Equal(types.StorageKeyPrefix))
-		Expect(key[1 : 1+common.AddressLength]).To(Equal(address.Bytes()))
-		Expect(key[1+common.AddressLength:]).To(Equal(slot.Bytes()))
+		Expect(key[1]).To(Equal(byte(common.AddressLength)))
+		Expect(key[2 : 2+common.AddressLength]).To(Equal(address.Bytes()))
+		Expect(key[2+common.AddressLength:]).To(Equal(slot.Bytes()))
 	})
 })
 
@@ -76,9 +78,9 @@ var _ = Describe("SlotFromSlotKey", func() {
 var _ = Describe("CodeHashKeyFor or a given account", func() {
 	address := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
 	key := CodeHashKeyFor(address)
-	Expect(key).To(HaveLen(1 + common.AddressLength))
+	Expect(key).To(HaveLen(2 + common.AddressLength))
 	Expect(key[0]).To(Equal(types.CodeHashKeyPrefix))
-	Expect(key[1:]).To(Equal(address.Bytes()))
+	Expect(key[2:]).To(Equal(address.Bytes()))
 })
 
 var _ = Describe("AddressFromCodeHashKey", func() {
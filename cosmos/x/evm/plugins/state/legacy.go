@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+)
+
+// The heights prior to the migration of the store layout to consensus version 2 keep the state
+// under the keys of the legacy single byte layout (see `types.Namespaces`). The state at these
+// heights is read through a store which falls back to the legacy key of every missing key. Only
+// the reads by key fall back: iterating the state at these heights finds nothing.
+
+// legacyNamespaces maps the namespaces of the state to their legacy namespace, and whether their
+// keys are followed by a length-prefixed address.
+var legacyNamespaces = map[byte]struct {
+	legacy    byte
+	addressed bool
+}{
+	types.CodeKeyPrefix:     {types.LegacyCodeKeyPrefix, false},
+	types.CodeHashKeyPrefix: {types.LegacyCodeHashKeyPrefix, true},
+	types.BalanceKeyPrefix:  {types.LegacyBalanceKeyPrefix, true},
+	types.StorageKeyPrefix:  {types.LegacyStorageKeyPrefix, true},
+}
+
+// legacyKeyFor returns the key of the legacy layout under which the given key of the state was
+// stored, or nil if it was not part of the state.
+func legacyKeyFor(key []byte) []byte {
+	if len(key) < addressKeyLength {
+		return nil
+	}
+	ns, found := legacyNamespaces[key[0]]
+	if !found {
+		return nil
+	}
+	if ns.addressed {
+		// the legacy keys were not length-prefixed.
+		return append([]byte{ns.legacy}, key[2:]...)
+	}
+	return append([]byte{ns.legacy}, key[1:]...)
+}
+
+// legacyMultiStore is a multi store whose x/evm store falls back to the legacy layout.
+type legacyMultiStore struct {
+	storetypes.MultiStore
+	storeKey storetypes.StoreKey
+}
+
+// GetKVStore implements `storetypes.MultiStore`.
+func (ms legacyMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if key == ms.storeKey {
+		return legacyStore{ms.MultiStore.GetKVStore(key)}
+	}
+	return ms.MultiStore.GetKVStore(key)
+}
+
+// legacyStore is a store whose reads fall back to the legacy key of every missing key.
+type legacyStore struct {
+	storetypes.KVStore
+}
+
+// Get implements `storetypes.KVStore`.
+func (s legacyStore) Get(key []byte) []byte {
+	if bz := s.KVStore.Get(key); bz != nil {
+		return bz
+	}
+	if legacy := legacyKeyFor(key); legacy != nil {
+		return s.KVStore.Get(legacy)
+	}
+	return nil
+}
+
+// Has implements `storetypes.KVStore`.
+func (s legacyStore) Has(key []byte) bool {
+	if s.KVStore.Has(key) {
+		return true
+	}
+	legacy := legacyKeyFor(key)
+	return legacy != nil && s.KVStore.Has(legacy)
+}
+
+// migrationHeight returns the height at which the store layout was migrated to consensus version
+// 2, which is recorded along with the first block whose header commits to its logs bloom. It is 0
+// on the chains that started at or after that version, which never used the legacy layout.
+func (p *plugin) migrationHeight() uint64 {
+	if bz := p.ctx.KVStore(p.storeKey).Get(types.LogsBloomHeightKey); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State of the legacy layout", func() {
+	var (
+		ctx  sdk.Context
+		sp   state.Plugin
+		code = []byte{0x60, 0x00}
+		slot = common.Hash{0x1}
+	)
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		ctx = ctx.WithBlockHeight(10)
+		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.Reset(ctx)
+		sp.CreateAccount(alice)
+		sp.Finalize()
+		sp.SetQueryContextFn(func(int64, bool) (sdk.Context, error) { return ctx, nil })
+
+		// the state of alice is written under the keys of the legacy layout.
+		codeHash := crypto.Keccak256Hash(code)
+		store := ctx.KVStore(testutil.EvmKey)
+		store.Delete(state.CodeHashKeyFor(alice))
+		store.Set(append([]byte{types.LegacyCodeHashKeyPrefix}, alice[:]...), codeHash[:])
+		store.Set(append([]byte{types.LegacyCodeKeyPrefix}, codeHash[:]...), code)
+		store.Set(
+			append(append([]byte{types.LegacyStorageKeyPrefix}, alice[:]...), slot[:]...),
+			common.Hash{0xaa}.Bytes(),
+		)
+		// the store layout was migrated at height 8.
+		store.Set(types.LogsBloomHeightKey, sdk.Uint64ToBigEndian(8))
+	})
+
+	It("should read the legacy layout at the heights prior to its migration", func() {
+		historical, err := sp.StateAtBlockNumber(5)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(historical.GetCodeHash(alice)).To(Equal(crypto.Keccak256Hash(code)))
+		Expect(historical.GetCode(alice)).To(Equal(code))
+		Expect(historical.GetState(alice, slot)).To(Equal(common.Hash{0xaa}))
+		Expect(historical.GetState(bob, slot)).To(Equal(common.Hash{}))
+	})
+
+	It("should not read the legacy layout at the heights after its migration", func() {
+		historical, err := sp.StateAtBlockNumber(8)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(historical.GetCode(alice)).To(BeNil())
+		Expect(historical.GetState(alice, slot)).To(Equal(common.Hash{}))
+	})
+
+	It("should not read the legacy layout at the latest height", func() {
+		Expect(sp.GetCode(alice)).To(BeNil())
+		Expect(sp.GetState(alice, slot)).To(Equal(common.Hash{}))
+	})
+})
@@ -28,6 +28,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state/events"
@@ -59,8 +60,14 @@ type Plugin interface {
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
 	// IterateBalances iterates over the balances of all accounts and calls the given callback function.
 	IterateBalances(fn func(common.Address, *big.Int) bool)
+	// Balances returns the balances of the accounts.
+	Balances() *Balances
+	// SetBalances sets the balances of the accounts.
+	SetBalances(*Balances)
 	// IterateState iterates over the state of all accounts and calls the given callback function.
 	IterateState(fn func(addr common.Address, key common.Hash, value common.Hash) bool)
+	// ExportAlloc returns the accounts of the given state as the alloc of a go-ethereum genesis.
+	ExportAlloc(sdk.Context) core.GenesisAlloc
 	// SetGasConfig sets the gas config for the plugin.
 	SetGasConfig(storetypes.GasConfig, storetypes.GasConfig)
 }
@@ -105,6 +112,9 @@ type plugin struct {
 	// keepers used for balance and account information.
 	ak AccountKeeper
 
+	// balances keeps the balances of the accounts, in the evm store or in x/bank.
+	balances *Balances
+
 	// getQueryContext allows for querying state a historical height.
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
 
@@ -122,6 +132,7 @@ func NewPlugin(
 	return &plugin{
 		storeKey: storeKey,
 		ak:       ak,
+		balances: NewBalances(storeKey),
 		plf:      plf,
 	}
 }
@@ -137,7 +148,8 @@ func (p *plugin) Prepare(ctx context.Context) {
 }
 
 // Reset sets up the state plugin for execution of a new transaction. It sets up the snapshottable
-// multi store so that Cosmos KV store changes can revert according to the EVM.
+// multi store so that Cosmos KV store changes can revert according to the EVM, and an infinite gas
+// meter so that the EVM is the only source of gas during the state transition.
 //
 // Reset implements `core.StatePlugin`.
 func (p *plugin) Reset(ctx context.Context) {
@@ -156,6 +168,13 @@ func (p *plugin) Reset(ctx context.Context) {
 	// and proper gas consumption.
 	p.ctx = sdkCtx.WithMultiStore(p.cms).WithEventManager(cem)
 
+	// The gas used by the EVM is consumed on the gas meter of the transaction by the gas plugin
+	// once the state transition is over, so the Cosmos SDK operations made during the state
+	// transition run on an infinite gas meter. Otherwise the gas meter of the transaction could
+	// panic with an out of gas in the middle of an opcode, which the EVM would not classify as
+	// such, nor revert the state of.
+	p.ctx = p.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
 	// We also remove the KVStore gas metering from the context prior to entering the EVM
 	// state transition. This is because the EVM is not aware of the Cosmos SDK's gas metering
 	// and is designed to be used in a standalone manner, as each of the EVM's opcodes are priced
@@ -221,7 +240,10 @@ func (p *plugin) Empty(addr common.Address) bool {
 		p.GetBalance(addr).Sign() == 0
 }
 
-// `DeleteAccounts` manually deletes the given accounts.
+// `DeleteAccounts` manually deletes the given accounts. The EVM state of the accounts is always
+// cleared, but only the plain accounts that hold no coins of any denom in x/bank are removed from
+// x/auth: vesting accounts, and the accounts holding coins outside of the EVM, may be empty by the
+// definition of EIP-161 while still being in use, so only their sequence is reset.
 func (p *plugin) DeleteAccounts(accounts []common.Address) {
 	for _, account := range accounts {
 		acct := p.ak.GetAccount(p.ctx, account[:])
@@ -229,18 +251,31 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 			// handles the double suicide case
 			continue
 		}
+		if _, ok := acct.(sdk.ModuleAccountI); ok {
+			// module accounts may be empty by the definition of EIP-161, but must never be deleted
+			continue
+		}
 
-		// clear storage
-		_ = p.ForEachStorage(account,
-			func(key, _ common.Hash) bool {
-				p.SetState(account, key, common.Hash{})
-				return true
-			})
+		// clear storage, without iterating over the slots of the account
+		destroyStorage(p.cms.GetKVStore(p.storeKey), account)
 
 		// clear the codehash from this account
 		p.cms.GetKVStore(p.storeKey).Delete(CodeHashKeyFor(account))
 
-		// remove auth account
+		// burn any balance sent to the account after it suicided, as go-ethereum does.
+		// STATE-MACHINE BREAKING: see UPGRADING.md.
+		if err := p.balances.Clear(p.ctx, account); err != nil {
+			p.savedErr = err
+		}
+
+		// remove auth account, unless it is still in use outside of the EVM
+		if _, ok := acct.(*authtypes.BaseAccount); !ok || p.balances.HoldsCoins(p.ctx, account) {
+			if err := acct.SetSequence(0); err != nil {
+				p.savedErr = err
+			}
+			p.ak.SetAccount(p.ctx, acct)
+			continue
+		}
 		p.ak.RemoveAccount(p.ctx, acct)
 	}
 }
@@ -251,31 +286,50 @@ func (p *plugin) DeleteAccounts(accounts []common.Address) {
 
 // GetBalance implements `StatePlugin` interface.
 func (p *plugin) GetBalance(addr common.Address) *big.Int {
-	return new(big.Int).SetBytes(p.ctx.KVStore(p.storeKey).Get(BalanceKeyFor(addr)))
+	return p.balances.Get(p.ctx, addr)
 }
 
 // SetBalance implements `StatePlugin` interface.
 func (p *plugin) SetBalance(addr common.Address, amount *big.Int) {
-	p.ctx.KVStore(p.storeKey).Set(BalanceKeyFor(addr), amount.Bytes())
+	if err := p.balances.Set(p.ctx, addr, amount); err != nil {
+		p.savedErr = err
+	}
 }
 
 // AddBalance implements the `StatePlugin` interface by adding the given amount
 // from thew account associated with addr. If the account does not exist, it will be
 // created.
 func (p *plugin) AddBalance(addr common.Address, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
+	if err := p.balances.Add(p.ctx, addr, amount); err != nil {
+		p.savedErr = err
 	}
-	p.ctx.KVStore(p.storeKey).Set(BalanceKeyFor(addr), new(big.Int).Add(p.GetBalance(addr), amount).Bytes())
 }
 
 // SubBalance implements the `StatePlugin` interface by subtracting the given amount
 // from the account associated with addr.
 func (p *plugin) SubBalance(addr common.Address, amount *big.Int) {
-	if amount.Sign() == 0 {
-		return
+	if err := p.balances.Sub(p.ctx, addr, amount); err != nil {
+		p.savedErr = err
+	}
+}
+
+// Transfer implements the `ethstate.TransferPlugin` interface by moving the given amount from
+// the account associated with from to the account associated with to, through x/bank if the
+// balances are kept there.
+func (p *plugin) Transfer(from, to common.Address, amount *big.Int) {
+	if err := p.balances.Transfer(p.ctx, from, to, amount); err != nil {
+		p.savedErr = err
 	}
-	p.ctx.KVStore(p.storeKey).Set(BalanceKeyFor(addr), new(big.Int).Sub(p.GetBalance(addr), amount).Bytes())
+}
+
+// Balances returns the balances of the accounts.
+func (p *plugin) Balances() *Balances {
+	return p.balances
+}
+
+// SetBalances sets the balances of the accounts, which are shared with the clones of the plugin.
+func (p *plugin) SetBalances(balances *Balances) {
+	p.balances = balances
 }
 
 // =============================================================================
@@ -365,13 +419,13 @@ func (p *plugin) GetCommittedState(
 	addr common.Address,
 	slot common.Hash,
 ) common.Hash {
-	return getStateFromStore(p.cms.GetCommittedKVStore(p.storeKey), addr, slot)
+	return GetStateFromStore(p.cms.GetCommittedKVStore(p.storeKey), addr, slot)
 }
 
 // GetState implements the `StatePlugin` interface by returning the current state
 // of slot in the given address.
 func (p *plugin) GetState(addr common.Address, slot common.Hash) common.Hash {
-	return getStateFromStore(p.cms.GetKVStore(p.storeKey), addr, slot)
+	return GetStateFromStore(p.cms.GetKVStore(p.storeKey), addr, slot)
 }
 
 // SetState sets the state of an address.
@@ -384,14 +438,16 @@ func (p *plugin) SetState(addr common.Address, key, value common.Hash) {
 	//
 	// CONTRACT: never manually call SetState outside of `opSstore`, or InitGenesis.
 
+	store := p.cms.GetKVStore(p.storeKey)
+
 	// If empty value is given, delete the state entry.
 	if len(value) == 0 || (value == common.Hash{}) {
-		p.cms.GetKVStore(p.storeKey).Delete(SlotKeyFor(addr, key))
+		store.Delete(SlotKeyFor(addr, key))
 		return
 	}
 
-	// Set the state entry.
-	p.cms.GetKVStore(p.storeKey).Set(SlotKeyFor(addr, key), value[:])
+	// Set the state entry, under the current storage generation of the account.
+	store.Set(SlotKeyFor(addr, key), encodeSlotValue(storageGeneration(store, addr), value))
 }
 
 // SetStorage sets the storage of an address.
@@ -403,17 +459,18 @@ func (p *plugin) SetStorage(addr common.Address, storage map[common.Hash]common.
 
 // IterateState iterates over all the contract state, and calls the given function.
 func (p *plugin) IterateState(cb func(addr common.Address, key, value common.Hash) bool) {
-	it := storetypes.KVStorePrefixIterator(
-		p.cms.GetCommittedKVStore(p.storeKey),
-		[]byte{types.StorageKeyPrefix},
-	)
+	store := p.cms.GetCommittedKVStore(p.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, []byte{types.StorageKeyPrefix})
 	defer it.Close()
 
 	for ; it.Valid(); it.Next() {
-		k, v := it.Key(), it.Value()
+		k := it.Key()
 		addr := AddressFromSlotKey(k)
-		slot := SlotFromSlotKey(k)
-		if cb(addr, slot, common.BytesToHash(v)) {
+		generation, value := decodeSlotValue(it.Value())
+		if generation != storageGeneration(store, addr) {
+			continue // stale slot of a destroyed account
+		}
+		if cb(addr, SlotFromSlotKey(k), value) {
 			break
 		}
 	}
@@ -428,16 +485,19 @@ func (p *plugin) ForEachStorage(
 	addr common.Address,
 	cb func(key, value common.Hash) bool,
 ) error {
-	it := storetypes.KVStorePrefixIterator(
-		p.cms.GetKVStore(p.storeKey),
-		StorageKeyFor(addr),
-	)
+	store := p.cms.GetKVStore(p.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, StorageKeyFor(addr))
 	defer it.Close()
 
+	generation := storageGeneration(store, addr)
 	for ; it.Valid(); it.Next() {
 		committedValue := it.Value()
 		if len(committedValue) > 0 {
-			if !cb(common.BytesToHash(it.Key()), common.BytesToHash(committedValue)) {
+			g, value := decodeSlotValue(committedValue)
+			if g != generation {
+				continue // stale slot of a previous incarnation of the account
+			}
+			if !cb(common.BytesToHash(it.Key()), value) {
 				return nil // stop iteration
 			}
 		}
@@ -446,17 +506,8 @@ func (p *plugin) ForEachStorage(
 	return nil
 }
 
-// getStateFromStore returns the current state of the slot in the given address.
-func getStateFromStore(
-	store storetypes.KVStore,
-	addr common.Address, slot common.Hash,
-) common.Hash {
-	if value := store.Get(SlotKeyFor(addr, slot)); value != nil {
-		return common.BytesToHash(value)
-	}
-	return common.Hash{}
-}
-
+// IterateBalances iterates over the accounts with a balance in the evm store, which are only those
+// holding a fraction of a unit of the bank denom once the balances are kept in x/bank.
 func (p *plugin) IterateBalances(fn func(common.Address, *big.Int) bool) {
 	it := storetypes.KVStorePrefixIterator(
 		p.cms.GetKVStore(p.storeKey),
@@ -502,10 +553,16 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 		if err != nil {
 			return nil, err
 		}
+		// The state at the heights prior to the migration of the store layout is kept under the
+		// legacy keys.
+		if number < p.migrationHeight() {
+			ctx = ctx.WithMultiStore(legacyMultiStore{ctx.MultiStore(), p.storeKey})
+		}
 	}
 
 	// Create a State Plugin with the requested chain height.
 	sp := NewPlugin(p.ak, p.storeKey, p.plf)
+	sp.SetBalances(p.balances)
 	sp.Reset(ctx)
 	return sp, nil
 }
@@ -517,6 +574,7 @@ func (p *plugin) StateAtBlockNumber(number uint64) (core.StatePlugin, error) {
 // Clone implements libtypes.Cloneable.
 func (p *plugin) Clone() ethstate.Plugin {
 	sp := NewPlugin(p.ak, p.storeKey, p.plf)
+	sp.SetBalances(p.balances)
 	cacheCtx, _ := p.ctx.CacheContext()
 	sp.Reset(cacheCtx)
 	return sp
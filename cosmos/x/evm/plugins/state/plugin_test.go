@@ -23,10 +23,16 @@ package state_test
 import (
 	"math/big"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
 	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	evmtypes "pkg.berachain.dev/polaris/cosmos/x/evm/types"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
@@ -44,12 +50,14 @@ var (
 
 var _ = Describe("State Plugin", func() {
 	var ak state.AccountKeeper
+	var bk bankkeeper.BaseKeeper
 	var ctx sdk.Context
 	var sp core.StatePlugin
 
 	BeforeEach(func() {
-		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
 		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.(state.Plugin).Balances().SetHoldings(bk)
 		sp.Reset(ctx)
 	})
 
@@ -73,6 +81,22 @@ var _ = Describe("State Plugin", func() {
 		})
 	})
 
+	Describe("TestGasMetering", func() {
+		It("should not consume gas on the gas meter of the transaction", func() {
+			meter := storetypes.NewGasMeter(1)
+			p := state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+			p.Reset(ctx.WithGasMeter(meter))
+			p.SetGasConfig(storetypes.KVGasConfig(), storetypes.TransientGasConfig())
+
+			Expect(func() {
+				p.CreateAccount(alice)
+				p.AddBalance(alice, big.NewInt(50))
+				p.SetState(alice, common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2}))
+			}).ToNot(Panic())
+			Expect(meter.GasConsumed()).To(BeZero())
+		})
+	})
+
 	Describe("TestCreateAccount", func() {
 		It("should create account", func() {
 			sp.CreateAccount(alice)
@@ -317,6 +341,38 @@ var _ = Describe("State Plugin", func() {
 				Expect(sp.GetCode(alice)).To(BeNil())
 				Expect(sp.GetState(alice, common.BytesToHash([]byte{1}))).To(Equal(common.Hash{}))
 			})
+
+			It("should keep the accounts holding coins of other denoms", func() {
+				spCtx := sdk.UnwrapSDKContext(sp.GetContext())
+				coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5))
+				Expect(bk.MintCoins(spCtx, evmtypes.ModuleName, coins)).To(Succeed())
+				Expect(bk.SendCoinsFromModuleToAccount(
+					spCtx, evmtypes.ModuleName, alice[:], coins,
+				)).To(Succeed())
+				sp.SetNonce(alice, 3)
+
+				sp.DeleteAccounts([]common.Address{alice})
+				Expect(ak.HasAccount(spCtx, alice[:])).To(BeTrue())
+				Expect(bk.GetAllBalances(spCtx, alice[:])).To(Equal(coins))
+				Expect(sp.GetNonce(alice)).To(BeZero())
+				Expect(sp.GetCode(alice)).To(BeNil())
+				Expect(sp.GetState(alice, common.BytesToHash([]byte{1}))).To(Equal(common.Hash{}))
+			})
+
+			It("should keep the vesting accounts", func() {
+				spCtx := sdk.UnwrapSDKContext(sp.GetContext())
+				base, ok := ak.NewAccountWithAddress(spCtx, bob[:]).(*authtypes.BaseAccount)
+				Expect(ok).To(BeTrue())
+				vesting, err := vestingtypes.NewContinuousVestingAccount(
+					base, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 0, 100,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				ak.SetAccount(spCtx, vesting)
+				Expect(sp.Empty(bob)).To(BeTrue())
+
+				sp.DeleteAccounts([]common.Address{bob})
+				Expect(ak.GetAccount(spCtx, bob[:])).To(BeAssignableToTypeOf(vesting))
+			})
 		})
 
 		Describe("TestAccount", func() {
@@ -0,0 +1,387 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+	gethvm "github.com/ethereum/go-ethereum/core/vm"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/eth/common"
+	ethstate "pkg.berachain.dev/polaris/eth/core/state"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+// fuzzStateDB is the subset of the StateDB API exercised by the differential fuzzer, which is
+// implemented by both the Polaris StateDB and the Go-Ethereum reference StateDB.
+type fuzzStateDB interface {
+	gethvm.StateDB
+	Finalise(deleteEmptyObjects bool)
+	SetTxContext(thash common.Hash, ti int)
+	GetLogs(hash common.Hash, blockNumber uint64, blockHash common.Hash) []*coretypes.Log
+}
+
+// StateDB operations applied by the fuzzer, selected by the first byte of every operation.
+const (
+	opCreateAccount = iota
+	opAddBalance
+	opSubBalance
+	opSetNonce
+	opSetCode
+	opSetState
+	opSuicide
+	opAddRefund
+	opSubRefund
+	opSetTransientState
+	opAddAddressToAccessList
+	opAddSlotToAccessList
+	opAddLog
+	opSnapshot
+	opRevertToSnapshot
+	opEndTx
+	numOps
+)
+
+var (
+	fuzzAddrs = []common.Address{
+		common.HexToAddress("0x01"), common.HexToAddress("0x02"),
+		common.HexToAddress("0x03"), common.HexToAddress("0x04"),
+	}
+	fuzzSlots = []common.Hash{
+		common.HexToHash("0x00"), common.HexToHash("0x01"),
+		common.HexToHash("0x02"), common.HexToHash("0xff"),
+	}
+	fuzzRules = params.Rules{IsBerlin: true, IsLondon: true, IsShanghai: true}
+)
+
+// FuzzStateDBDifferential applies the same random sequence of operations to the Polaris StateDB,
+// backed by the Cosmos state plugin, and to the Go-Ethereum in-memory StateDB, and fails on the
+// first observable difference between the two.
+//
+// Operations are only applied when their preconditions, which the EVM guarantees during a state
+// transition, hold. For example, storage is only written to existing accounts and only contracts
+// may suicide, at most once per call frame.
+func FuzzStateDBDifferential(f *testing.F) {
+	f.Add([]byte{opCreateAccount, 0, opSetCode, 0, 2, 0x60, 0x00, opSnapshot, opSuicide, 0})
+	f.Add([]byte{
+		opCreateAccount, 1, opAddBalance, 1, 9, opSnapshot, opSetState, 1, 1, 7,
+		opAddRefund, 5, opRevertToSnapshot, 0, opEndTx, opSetState, 1, 2, 3,
+	})
+	f.Add([]byte{
+		opCreateAccount, 2, opSetCode, 2, 1, 0xfe, opSetTransientState, 2, 0, 4,
+		opSnapshot, opAddSlotToAccessList, 2, 3, opAddLog, 2, 1, opSuicide, 2,
+		opAddBalance, 2, 3, opEndTx, opCreateAccount, 2,
+	})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ctx, ak, _, _ := testutil.SetupMinimalKeepers()
+		sp := state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.Reset(ctx)
+		polaris := ethstate.NewStateDB(sp).(fuzzStateDB)
+
+		geth, err := gethstate.New(
+			coretypes.EmptyRootHash, gethstate.NewDatabase(rawdb.NewMemoryDatabase()), nil,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		runDifferential(t, &fuzzInput{data: data}, polaris, geth)
+	})
+}
+
+// runDifferential decodes and applies the operations of the fuzz input to both StateDBs.
+func runDifferential(t *testing.T, in *fuzzInput, polaris, geth fuzzStateDB) {
+	t.Helper()
+	dbs := []fuzzStateDB{polaris, geth}
+
+	var (
+		txIndex     int
+		snapshots   [][2]int // snapshot ids of the polaris and geth StateDBs, respectively
+		canSuicide  bool     // whether a call frame has been entered without suiciding
+		description string
+	)
+	beginTx := func() {
+		for _, db := range dbs {
+			db.Prepare(fuzzRules, fuzzAddrs[0], fuzzAddrs[1], nil, nil, nil)
+			db.SetTxContext(common.BigToHash(big.NewInt(int64(txIndex))), txIndex)
+		}
+		snapshots, canSuicide = nil, false
+	}
+	beginTx()
+
+	for step := 0; in.remaining(); step++ {
+		addr, slot := fuzzAddrs[0], fuzzSlots[0]
+		switch op := in.byte() % numOps; op {
+		case opCreateAccount:
+			addr = in.addr()
+			description = fmt.Sprintf("CreateAccount(%s)", addr)
+			if geth.Exist(addr) {
+				continue
+			}
+			for _, db := range dbs {
+				db.CreateAccount(addr)
+			}
+		case opAddBalance:
+			addr, amount := in.addr(), big.NewInt(int64(in.byte()))
+			description = fmt.Sprintf("AddBalance(%s, %s)", addr, amount)
+			if !geth.Exist(addr) {
+				continue
+			}
+			for _, db := range dbs {
+				db.AddBalance(addr, amount)
+			}
+		case opSubBalance:
+			addr, amount := in.addr(), big.NewInt(int64(in.byte()))
+			description = fmt.Sprintf("SubBalance(%s, %s)", addr, amount)
+			if !geth.Exist(addr) || geth.GetBalance(addr).Cmp(amount) < 0 {
+				continue
+			}
+			for _, db := range dbs {
+				db.SubBalance(addr, amount)
+			}
+		case opSetNonce:
+			addr, nonce := in.addr(), uint64(in.byte())
+			description = fmt.Sprintf("SetNonce(%s, %d)", addr, nonce)
+			if !geth.Exist(addr) {
+				continue
+			}
+			for _, db := range dbs {
+				db.SetNonce(addr, nonce)
+			}
+		case opSetCode:
+			addr, code := in.addr(), in.bytes(4)
+			description = fmt.Sprintf("SetCode(%s, %x)", addr, code)
+			if !geth.Exist(addr) {
+				continue
+			}
+			for _, db := range dbs {
+				db.SetCode(addr, code)
+			}
+		case opSetState:
+			addr, slot = in.addr(), in.slot()
+			value := common.BigToHash(big.NewInt(int64(in.byte())))
+			description = fmt.Sprintf("SetState(%s, %s, %s)", addr, slot, value)
+			if !geth.Exist(addr) {
+				continue
+			}
+			for _, db := range dbs {
+				db.SetState(addr, slot, value)
+			}
+		case opSuicide:
+			addr = in.addr()
+			description = fmt.Sprintf("Suicide(%s)", addr)
+			if !canSuicide || geth.GetCodeSize(addr) == 0 {
+				continue
+			}
+			for _, db := range dbs {
+				if !db.Suicide(addr) {
+					t.Fatalf("step %d: %s: suicide rejected", step, description)
+				}
+			}
+			canSuicide = false
+		case opAddRefund:
+			gas := uint64(in.byte())
+			description = fmt.Sprintf("AddRefund(%d)", gas)
+			for _, db := range dbs {
+				db.AddRefund(gas)
+			}
+		case opSubRefund:
+			gas := uint64(in.byte())
+			description = fmt.Sprintf("SubRefund(%d)", gas)
+			if geth.GetRefund() < gas {
+				continue
+			}
+			for _, db := range dbs {
+				db.SubRefund(gas)
+			}
+		case opSetTransientState:
+			addr, slot = in.addr(), in.slot()
+			value := common.BigToHash(big.NewInt(int64(in.byte())))
+			description = fmt.Sprintf("SetTransientState(%s, %s, %s)", addr, slot, value)
+			for _, db := range dbs {
+				db.SetTransientState(addr, slot, value)
+			}
+		case opAddAddressToAccessList:
+			addr = in.addr()
+			description = fmt.Sprintf("AddAddressToAccessList(%s)", addr)
+			for _, db := range dbs {
+				db.AddAddressToAccessList(addr)
+			}
+		case opAddSlotToAccessList:
+			addr, slot = in.addr(), in.slot()
+			description = fmt.Sprintf("AddSlotToAccessList(%s, %s)", addr, slot)
+			for _, db := range dbs {
+				db.AddSlotToAccessList(addr, slot)
+			}
+		case opAddLog:
+			addr, topic := in.addr(), in.slot()
+			description = fmt.Sprintf("AddLog(%s, %s)", addr, topic)
+			for _, db := range dbs {
+				db.AddLog(&coretypes.Log{Address: addr, Topics: []common.Hash{topic}})
+			}
+		case opSnapshot:
+			description = "Snapshot()"
+			snapshots = append(snapshots, [2]int{polaris.Snapshot(), geth.Snapshot()})
+			canSuicide = true
+		case opRevertToSnapshot:
+			idx := int(in.byte())
+			description = fmt.Sprintf("RevertToSnapshot(%d)", idx)
+			if len(snapshots) == 0 {
+				continue
+			}
+			idx %= len(snapshots)
+			polaris.RevertToSnapshot(snapshots[idx][0])
+			geth.RevertToSnapshot(snapshots[idx][1])
+			snapshots = snapshots[:idx]
+			canSuicide = true
+		case opEndTx:
+			description = "Finalise()"
+			for _, db := range dbs {
+				db.Finalise(true)
+			}
+			txIndex++
+			beginTx()
+		}
+
+		if diff := diffStateDBs(polaris, geth, txIndex); diff != "" {
+			t.Fatalf("step %d: %s: %s", step, description, diff)
+		}
+	}
+}
+
+// diffStateDBs returns a description of the first observable difference between the Polaris
+// StateDB and the Go-Ethereum StateDB, or the empty string if there is none.
+func diffStateDBs(polaris, geth fuzzStateDB, txIndex int) string {
+	for _, addr := range fuzzAddrs {
+		checks := []struct {
+			name          string
+			polaris, geth any
+		}{
+			{"Exist", polaris.Exist(addr), geth.Exist(addr)},
+			{"Empty", polaris.Empty(addr), geth.Empty(addr)},
+			{"GetBalance", polaris.GetBalance(addr).String(), geth.GetBalance(addr).String()},
+			{"GetNonce", polaris.GetNonce(addr), geth.GetNonce(addr)},
+			{"GetCodeHash", polaris.GetCodeHash(addr), geth.GetCodeHash(addr)},
+			{"GetCodeSize", polaris.GetCodeSize(addr), geth.GetCodeSize(addr)},
+			{"HasSuicided", polaris.HasSuicided(addr), geth.HasSuicided(addr)},
+			{"AddressInAccessList", polaris.AddressInAccessList(addr), geth.AddressInAccessList(addr)},
+		}
+		for _, c := range checks {
+			if c.polaris != c.geth {
+				return fmt.Sprintf("%s(%s): polaris %v, geth %v", c.name, addr, c.polaris, c.geth)
+			}
+		}
+		if !bytes.Equal(polaris.GetCode(addr), geth.GetCode(addr)) {
+			return fmt.Sprintf("GetCode(%s): polaris %x, geth %x",
+				addr, polaris.GetCode(addr), geth.GetCode(addr))
+		}
+
+		for _, slot := range fuzzSlots {
+			_, polarisSlotOk := polaris.SlotInAccessList(addr, slot)
+			_, gethSlotOk := geth.SlotInAccessList(addr, slot)
+			checks = []struct {
+				name          string
+				polaris, geth any
+			}{
+				{"GetState", polaris.GetState(addr, slot), geth.GetState(addr, slot)},
+				{
+					"GetCommittedState",
+					polaris.GetCommittedState(addr, slot), geth.GetCommittedState(addr, slot),
+				},
+				{
+					"GetTransientState",
+					polaris.GetTransientState(addr, slot), geth.GetTransientState(addr, slot),
+				},
+				{"SlotInAccessList", polarisSlotOk, gethSlotOk},
+			}
+			for _, c := range checks {
+				if c.polaris != c.geth {
+					return fmt.Sprintf("%s(%s, %s): polaris %v, geth %v",
+						c.name, addr, slot, c.polaris, c.geth)
+				}
+			}
+		}
+	}
+
+	if polaris.GetRefund() != geth.GetRefund() {
+		return fmt.Sprintf("GetRefund: polaris %d, geth %d", polaris.GetRefund(), geth.GetRefund())
+	}
+
+	thash := common.BigToHash(big.NewInt(int64(txIndex)))
+	polarisLogs := polaris.GetLogs(thash, 0, common.Hash{})
+	gethLogs := geth.GetLogs(thash, 0, common.Hash{})
+	if len(polarisLogs) != len(gethLogs) {
+		return fmt.Sprintf("GetLogs: polaris %d logs, geth %d logs", len(polarisLogs), len(gethLogs))
+	}
+	for i := range polarisLogs {
+		if polarisLogs[i].Address != gethLogs[i].Address ||
+			polarisLogs[i].Topics[0] != gethLogs[i].Topics[0] ||
+			polarisLogs[i].TxHash != gethLogs[i].TxHash {
+			return fmt.Sprintf("GetLogs[%d]: polaris %+v, geth %+v", i, polarisLogs[i], gethLogs[i])
+		}
+	}
+	return ""
+}
+
+// fuzzInput decodes the operands of the fuzzed operations, returning zero values once the input
+// is exhausted.
+type fuzzInput struct {
+	data []byte
+	pos  int
+}
+
+func (in *fuzzInput) remaining() bool {
+	return in.pos < len(in.data)
+}
+
+func (in *fuzzInput) byte() byte {
+	if !in.remaining() {
+		return 0
+	}
+	b := in.data[in.pos]
+	in.pos++
+	return b
+}
+
+func (in *fuzzInput) bytes(maxLen int) []byte {
+	bz := make([]byte, int(in.byte())%(maxLen+1))
+	for i := range bz {
+		bz[i] = in.byte()
+	}
+	return bz
+}
+
+func (in *fuzzInput) addr() common.Address {
+	return fuzzAddrs[int(in.byte())%len(fuzzAddrs)]
+}
+
+func (in *fuzzInput) slot() common.Hash {
+	return fuzzSlots[int(in.byte())%len(fuzzSlots)]
+}
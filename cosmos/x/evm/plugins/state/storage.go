@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// Destroying an account must make all of its storage slots read as empty, but deleting them
+// inline is O(slots) in the Cosmos store. Instead, every account has a storage generation, which
+// is bumped when the account is destroyed, and every slot value is stored along with the
+// generation it was written under. Slots of a previous generation are stale: they are read as
+// empty and are deleted in the background by `PruneStorage`.
+//
+// Slot values written under the initial generation (0) are stored as is, so that the storage
+// written before the introduction of generations remains valid.
+
+// generationLength is the length of the storage generation prefixed to the slot values written
+// under a non-initial generation.
+const generationLength = 8
+
+// storageGeneration returns the current storage generation of the given account.
+func storageGeneration(store storetypes.KVStore, addr common.Address) uint64 {
+	bz := store.Get(StorageGenerationKeyFor(addr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// destroyStorage marks all the storage slots of the given account as stale by bumping its storage
+// generation, and tombstones the account so that its stale slots are eventually pruned.
+func destroyStorage(store storetypes.KVStore, addr common.Address) {
+	bz := make([]byte, generationLength)
+	binary.BigEndian.PutUint64(bz, storageGeneration(store, addr)+1)
+	store.Set(StorageGenerationKeyFor(addr), bz)
+	store.Set(StorageTombstoneKeyFor(addr), []byte{})
+	// The slots already scanned by an ongoing pruning of the account may have just become stale.
+	if cursor := store.Get(types.PruneCursorKey); cursor != nil &&
+		AddressFromSlotKey(cursor) == addr {
+		store.Delete(types.PruneCursorKey)
+	}
+}
+
+// encodeSlotValue encodes the given slot value, written under the given storage generation.
+func encodeSlotValue(generation uint64, value common.Hash) []byte {
+	if generation == 0 {
+		return value[:]
+	}
+	bz := make([]byte, generationLength+common.HashLength)
+	binary.BigEndian.PutUint64(bz, generation)
+	copy(bz[generationLength:], value[:])
+	return bz
+}
+
+// decodeSlotValue decodes the given slot value, returning the storage generation it was written
+// under.
+func decodeSlotValue(bz []byte) (uint64, common.Hash) {
+	if len(bz) == generationLength+common.HashLength {
+		return binary.BigEndian.Uint64(bz), common.BytesToHash(bz[generationLength:])
+	}
+	return 0, common.BytesToHash(bz)
+}
+
+// GetStateFromStore returns the current state of the slot in the given address, which is empty if
+// the slot was written before the account was last destroyed.
+func GetStateFromStore(
+	store storetypes.KVStore,
+	addr common.Address, slot common.Hash,
+) common.Hash {
+	bz := store.Get(SlotKeyFor(addr, slot))
+	if bz == nil {
+		return common.Hash{}
+	}
+	if generation, value := decodeSlotValue(bz); generation == storageGeneration(store, addr) {
+		return value
+	}
+	return common.Hash{}
+}
+
+// PruneStorage deletes the stale storage slots of up to maxAccounts destroyed accounts, scanning
+// up to maxSlots storage slots, and returns the number of slots deleted. The tombstone of an
+// account is removed once all its slots have been scanned. As the generation of a slot is stored
+// in its value, the live slots of a recreated account are scanned as well: the slot from which
+// the scan resumes is persisted, so that no slot is scanned twice across blocks.
+func PruneStorage(store storetypes.KVStore, maxSlots, maxAccounts int) int {
+	cursor := store.Get(types.PruneCursorKey)
+	start := []byte{types.StorageTombstoneKeyPrefix}
+	if cursor != nil {
+		start = StorageTombstoneKeyFor(AddressFromSlotKey(cursor))
+	}
+
+	tombstoned := make([]common.Address, 0, maxAccounts)
+	it := store.Iterator(start, storetypes.PrefixEndBytes([]byte{types.StorageTombstoneKeyPrefix}))
+	for ; it.Valid() && len(tombstoned) < maxAccounts; it.Next() {
+		tombstoned = append(tombstoned, AddressFromStorageTombstoneKey(it.Key()))
+	}
+	it.Close()
+
+	pruned, scanned := 0, 0
+	for _, addr := range tombstoned {
+		from := StorageKeyFor(addr)
+		if cursor != nil && AddressFromSlotKey(cursor) == addr {
+			from = cursor
+		}
+		stale, n, next := staleSlotKeys(store, addr, from, maxSlots-scanned)
+		for _, key := range stale {
+			store.Delete(key)
+		}
+		pruned += len(stale)
+		scanned += n
+
+		if next != nil {
+			store.Set(types.PruneCursorKey, next)
+			return pruned
+		}
+		store.Delete(StorageTombstoneKeyFor(addr))
+	}
+	store.Delete(types.PruneCursorKey)
+	return pruned
+}
+
+// staleSlotKeys scans up to limit storage slots of the given account, starting from the given
+// key, and returns the keys of the stale ones, the number of slots scanned and the key of the
+// next slot to scan, which is nil if all the slots of the account have been scanned.
+func staleSlotKeys(
+	store storetypes.KVStore, addr common.Address, from []byte, limit int,
+) ([][]byte, int, []byte) {
+	generation := storageGeneration(store, addr)
+
+	var (
+		stale   [][]byte
+		scanned int
+	)
+	it := store.Iterator(from, storetypes.PrefixEndBytes(StorageKeyFor(addr)))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if scanned == limit {
+			return stale, scanned, it.Key()
+		}
+		scanned++
+		if g, _ := decodeSlotValue(it.Value()); g != generation {
+			stale = append(stale, it.Key())
+		}
+	}
+	return stale, scanned, nil
+}
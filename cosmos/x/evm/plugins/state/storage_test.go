@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/polaris/cosmos/testing/utils"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Storage of destroyed accounts", func() {
+	var (
+		ctx   sdk.Context
+		sp    state.Plugin
+		store storetypes.KVStore
+		slots = []common.Hash{{1}, {2}, {3}}
+		value = common.Hash{0xaa}
+	)
+
+	storage := func(addr common.Address) map[common.Hash]common.Hash {
+		res := make(map[common.Hash]common.Hash)
+		Expect(sp.ForEachStorage(addr, func(key, value common.Hash) bool {
+			res[key] = value
+			return true
+		})).To(Succeed())
+		return res
+	}
+
+	BeforeEach(func() {
+		var ak state.AccountKeeper
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		sp = state.NewPlugin(ak, testutil.EvmKey, &mockPLF{})
+		sp.Reset(ctx)
+		sp.CreateAccount(alice)
+		sp.SetCode(alice, []byte{1})
+		for _, slot := range slots {
+			sp.SetState(alice, slot, value)
+		}
+		sp.SetState(bob, slots[0], value)
+		sp.DeleteAccounts([]common.Address{alice})
+		sp.Finalize()
+		store = ctx.KVStore(testutil.EvmKey)
+	})
+
+	It("should read the storage of the destroyed account as empty", func() {
+		for _, slot := range slots {
+			Expect(sp.GetState(alice, slot)).To(Equal(common.Hash{}))
+			Expect(sp.GetCommittedState(alice, slot)).To(Equal(common.Hash{}))
+			Expect(store.Has(state.SlotKeyFor(alice, slot))).To(BeTrue())
+		}
+		Expect(storage(alice)).To(BeEmpty())
+		Expect(sp.GetState(bob, slots[0])).To(Equal(value))
+	})
+
+	It("should not expose stale storage to a recreated account", func() {
+		sp.CreateAccount(alice)
+		sp.SetState(alice, slots[0], common.Hash{0xbb})
+		Expect(sp.GetState(alice, slots[0])).To(Equal(common.Hash{0xbb}))
+		Expect(sp.GetState(alice, slots[1])).To(Equal(common.Hash{}))
+		Expect(storage(alice)).To(Equal(map[common.Hash]common.Hash{slots[0]: {0xbb}}))
+
+		var exported int
+		sp.Finalize()
+		sp.IterateState(func(addr common.Address, _, _ common.Hash) bool {
+			if addr == alice {
+				exported++
+			}
+			return false
+		})
+		Expect(exported).To(Equal(1))
+	})
+
+	It("should prune the stale storage in bounded steps", func() {
+		sp.CreateAccount(alice)
+		sp.SetState(alice, slots[0], common.Hash{0xbb})
+		sp.Finalize()
+
+		// The live slot is scanned along with the first stale one, and the scan resumes from the
+		// last stale one.
+		Expect(state.PruneStorage(store, 2, 10)).To(Equal(1))
+		Expect(store.Has(state.StorageTombstoneKeyFor(alice))).To(BeTrue())
+		Expect(store.Get(types.PruneCursorKey)).To(Equal(state.SlotKeyFor(alice, slots[2])))
+
+		Expect(state.PruneStorage(store, 10, 10)).To(Equal(1))
+		Expect(store.Has(state.StorageTombstoneKeyFor(alice))).To(BeFalse())
+		Expect(store.Has(types.PruneCursorKey)).To(BeFalse())
+		Expect(state.PruneStorage(store, 10, 10)).To(BeZero())
+
+		for _, slot := range slots[1:] {
+			Expect(store.Has(state.SlotKeyFor(alice, slot))).To(BeFalse())
+		}
+		Expect(sp.GetState(alice, slots[0])).To(Equal(common.Hash{0xbb}))
+		Expect(sp.GetState(bob, slots[0])).To(Equal(value))
+	})
+
+	It("should bound the number of accounts pruned", func() {
+		sp.DeleteAccounts([]common.Address{bob})
+		sp.Finalize()
+
+		pruned := state.PruneStorage(store, 10, 1)
+		Expect(store.Has(state.StorageTombstoneKeyFor(alice)) !=
+			store.Has(state.StorageTombstoneKeyFor(bob))).To(BeTrue())
+		Expect(pruned + state.PruneStorage(store, 10, 1)).To(Equal(len(slots) + 1))
+		Expect(store.Has(state.StorageTombstoneKeyFor(alice))).To(BeFalse())
+		Expect(store.Has(state.StorageTombstoneKeyFor(bob))).To(BeFalse())
+	})
+
+	It("should rescan an account destroyed again while it is pruned", func() {
+		sp.CreateAccount(alice)
+		sp.SetState(alice, slots[0], common.Hash{0xbb})
+		sp.Finalize()
+		Expect(state.PruneStorage(store, 2, 10)).To(Equal(1))
+
+		sp.DeleteAccounts([]common.Address{alice})
+		sp.Finalize()
+		Expect(store.Has(types.PruneCursorKey)).To(BeFalse())
+		Expect(state.PruneStorage(store, 10, 10)).To(Equal(2))
+		for _, slot := range slots {
+			Expect(store.Has(state.SlotKeyFor(alice, slot))).To(BeFalse())
+		}
+	})
+
+	It("should read values written before storage generations", func() {
+		store.Set(state.SlotKeyFor(bob, slots[1]), value[:])
+		Expect(sp.GetState(bob, slots[1])).To(Equal(value))
+	})
+})
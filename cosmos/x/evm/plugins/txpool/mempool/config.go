@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mempool
+
+import "time"
+
+const (
+	// defaultGlobalSlots is the default maximum number of transactions in the pool.
+	defaultGlobalSlots = 10000
+
+	// defaultAccountSlots is the default maximum number of Ethereum transactions of an account,
+	// the pending and queued ones of go-ethereum combined.
+	defaultAccountSlots = 64
+
+	// defaultLifetime is the default time the transactions of an inactive account stay in the
+	// pool, as in go-ethereum.
+	defaultLifetime = 3 * time.Hour
+
+	// defaultPriceBump is the default minimum price bump percentage to replace a transaction, as
+	// in go-ethereum.
+	defaultPriceBump = 10
+
+	// evictionInterval is the minimum time between two evictions of the stale transactions.
+	evictionInterval = time.Minute
+)
+
+// Config is the configuration of the limits of an `EthTxPool`, which prevent the unbounded
+// growth of the mempool of public nodes.
+type Config struct {
+	// GlobalSlots is the maximum number of transactions in the pool. When the pool is full, an
+	// Ethereum transaction evicts the last one of the account paying the lowest tip, if it pays
+	// more.
+	GlobalSlots uint64
+
+	// AccountSlots is the maximum number of Ethereum transactions of an account in the pool.
+	AccountSlots uint64
+
+	// Lifetime is the maximum time the Ethereum transactions of an account stay in the pool
+	// without it sending a new one, or zero to keep them forever.
+	Lifetime time.Duration
+
+	// PriceBump is the minimum price bump percentage to replace a transaction of the same nonce.
+	PriceBump uint64
+}
+
+// DefaultConfig returns the default configuration of an `EthTxPool`.
+func DefaultConfig() Config {
+	return Config{
+		GlobalSlots:  defaultGlobalSlots,
+		AccountSlots: defaultAccountSlots,
+		Lifetime:     defaultLifetime,
+		PriceBump:    defaultPriceBump,
+	}
+}
@@ -23,5 +23,8 @@ package mempool
 import "errors"
 
 var (
-	ErrIncorrectTxType = errors.New("tx is not of type WrappedEthereumTransaction")
+	ErrIncorrectTxType  = errors.New("tx is not of type WrappedEthereumTransaction")
+	ErrTxPoolOverflow   = errors.New("txpool is full")
+	ErrUnderpriced      = errors.New("transaction underpriced")
+	ErrAccountSlotsFull = errors.New("account slots full")
 )
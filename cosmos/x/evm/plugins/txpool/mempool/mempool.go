@@ -23,6 +23,7 @@ package mempool
 import (
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
@@ -52,19 +53,30 @@ type EthTxPool struct {
 	// by nonce.
 	nonceToHash map[common.Address]map[uint64]common.Hash
 
+	// cfg holds the limits of the pool.
+	cfg Config
+
+	// lastSeen is the last time each account inserted a transaction, after which its
+	// transactions are evicted once their lifetime is over.
+	lastSeen map[common.Address]time.Time
+	// lastEviction is the last time the stale transactions were evicted.
+	lastEviction time.Time
+	// now returns the current time.
+	now func() time.Time
+
 	// We have a mutex to protect the ethTxCache and nonces maps since they are accessed
 	// concurrently by multiple goroutines.
 	mu sync.RWMutex
 }
 
-// NewPolarisEthereumTxPool creates a new Ethereum transaction pool.
-func NewPolarisEthereumTxPool() *EthTxPool {
+// NewPolarisEthereumTxPool creates a new Ethereum transaction pool with the given limits.
+func NewPolarisEthereumTxPool(cfg Config) *EthTxPool {
 	tpp := EthereumTxPriorityPolicy{
 		baseFee: big.NewInt(0),
 	}
 	config := mempool.PriorityNonceMempoolConfig[*big.Int]{
 		TxReplacement: EthereumTxReplacePolicy[*big.Int]{
-			PriceBump: 10, //nolint:gomnd // 10% to match geth.
+			PriceBump: cfg.PriceBump,
 		}.Func,
 		TxPriority: mempool.TxPriority[*big.Int]{
 			GetTxPriority: tpp.GetTxPriority,
@@ -73,7 +85,8 @@ func NewPolarisEthereumTxPool() *EthTxPool {
 			},
 			MinValue: big.NewInt(-1),
 		},
-		MaxTx: 10000, //nolint:gomnd // todo: parametize this.
+		// The global slots are enforced by the pool, which can evict transactions when full.
+		MaxTx: 0,
 	}
 
 	return &EthTxPool{
@@ -81,6 +94,9 @@ func NewPolarisEthereumTxPool() *EthTxPool {
 		nonceToHash:          make(map[common.Address]map[uint64]common.Hash),
 		ethTxCache:           make(map[common.Hash]*coretypes.Transaction),
 		priorityPolicy:       &tpp,
+		cfg:                  cfg,
+		lastSeen:             make(map[common.Address]time.Time),
+		now:                  time.Now,
 	}
 }
 
@@ -89,6 +105,15 @@ func (etp *EthTxPool) SetNonceRetriever(nr NonceRetriever) {
 	etp.nr = nr
 }
 
+// SetConfig updates the limits of the pool, except the price bump, which is fixed when the pool is
+// created. Lowered limits do not evict the transactions already in the pool.
+func (etp *EthTxPool) SetConfig(cfg Config) {
+	etp.mu.Lock()
+	defer etp.mu.Unlock()
+	cfg.PriceBump = etp.cfg.PriceBump
+	etp.cfg = cfg
+}
+
 // SetBaseFee updates the base fee in the priority policy.
 func (etp *EthTxPool) SetBaseFee(baseFee *big.Int) {
 	etp.priorityPolicy.baseFee = baseFee
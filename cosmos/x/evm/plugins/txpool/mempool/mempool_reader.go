@@ -32,8 +32,12 @@ import (
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 )
 
-// Get is called when a transaction is retrieved from the mempool.
+// Get returns the transaction in the mempool with the given hash, or nil if there is none. It is
+// served from the cache of transactions by hash, so that transactions that are not yet included
+// in a block can be looked up by the rpc as pending.
 func (etp *EthTxPool) Get(hash common.Hash) *coretypes.Transaction {
+	etp.mu.RLock()
+	defer etp.mu.RUnlock()
 	return etp.ethTxCache[hash]
 }
 
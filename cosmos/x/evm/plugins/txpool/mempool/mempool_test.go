@@ -27,6 +27,7 @@ import (
 	"math/big"
 	"sync"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
@@ -74,7 +75,7 @@ var _ = Describe("EthTxPool", func() {
 		sp.SetNonce(addr2, 2)
 		sp.Finalize()
 		sp.Reset(ctx)
-		etp = NewPolarisEthereumTxPool()
+		etp = NewPolarisEthereumTxPool(DefaultConfig())
 		etp.SetNonceRetriever(sp)
 	})
 
@@ -175,16 +176,21 @@ var _ = Describe("EthTxPool", func() {
 			Expect(etp.Insert(ctx, tx3)).To(HaveOccurred()) // should skip the math for replacement
 		})
 		It("should handle spam txs and prevent DOS attacks", func() {
-			for i := 1; i < 1000; i++ {
-				_, tx := buildTx(key1, &coretypes.LegacyTx{Nonce: uint64(i)})
+			for i := uint64(1); i <= defaultAccountSlots; i++ {
+				_, tx := buildTx(key1, &coretypes.LegacyTx{Nonce: i})
 				Expect(etp.Insert(ctx, tx)).ToNot(HaveOccurred())
 			}
-			// probably more stuff down here...
+			_, tx := buildTx(key1, &coretypes.LegacyTx{Nonce: defaultAccountSlots + 1})
+			Expect(etp.Insert(ctx, tx)).To(MatchError(ErrAccountSlotsFull))
+
+			// Replacing a transaction does not take a new slot.
+			_, tx = buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(100)})
+			Expect(etp.Insert(ctx, tx)).ToNot(HaveOccurred())
 		})
 		It("should be able to fetch transactions from the cache", func() {
 
 			var txHashes []common.Hash
-			for i := 1; i < 100; i++ {
+			for i := 1; i <= defaultAccountSlots; i++ {
 				ethTx, tx := buildTx(key1, &coretypes.LegacyTx{Nonce: uint64(i)})
 				Expect(etp.Insert(ctx, tx)).ToNot(HaveOccurred())
 				txHashes = append(txHashes, ethTx.Hash())
@@ -393,6 +399,90 @@ var _ = Describe("EthTxPool", func() {
 		})
 
 	})
+
+	Describe("Limits", func() {
+		var (
+			key3, _ = crypto.GenerateEthKey()
+			key4, _ = crypto.GenerateEthKey()
+			now     time.Time
+		)
+
+		BeforeEach(func() {
+			etp = NewPolarisEthereumTxPool(Config{
+				GlobalSlots:  3,
+				AccountSlots: 2,
+				Lifetime:     time.Hour,
+				PriceBump:    10,
+			})
+			etp.SetNonceRetriever(sp)
+			now = time.Unix(0, 0)
+			etp.now = func() time.Time { return now }
+		})
+
+		It("should evict the last tx of the account paying the lowest tip when full", func() {
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(5)})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(1)})
+			ethTx3, tx3 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(2)})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+
+			ethTx4, tx4 := buildTx(key3, &coretypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(3)})
+			Expect(etp.Insert(ctx, tx4)).ToNot(HaveOccurred())
+			Expect(etp.CountTx()).To(Equal(3))
+			Expect(etp.Get(ethTx1.Hash())).ToNot(BeNil())
+			Expect(etp.Get(ethTx2.Hash())).To(BeNil())
+			Expect(etp.Get(ethTx3.Hash())).ToNot(BeNil())
+			Expect(etp.Get(ethTx4.Hash())).ToNot(BeNil())
+		})
+
+		It("should reject underpriced txs when full", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2)})
+			_, tx2 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(2)})
+			_, tx3 := buildTx(key3, &coretypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(2)})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+
+			_, tx4 := buildTx(key4, &coretypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(2)})
+			Expect(etp.Insert(ctx, tx4)).To(MatchError(ErrUnderpriced))
+			Expect(etp.Insert(ctx, buildSdkTx(key4, 0))).To(MatchError(ErrTxPoolOverflow))
+			Expect(etp.CountTx()).To(Equal(3))
+		})
+
+		It("should evict the txs of inactive accounts after their lifetime", func() {
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+
+			now = now.Add(30 * time.Minute)
+			ethTx2, tx2 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2})
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+
+			now = now.Add(45 * time.Minute)
+			_, tx3 := buildTx(key3, &coretypes.LegacyTx{Nonce: 0})
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+			Expect(etp.Get(ethTx1.Hash())).To(BeNil())
+			Expect(etp.Get(ethTx2.Hash())).ToNot(BeNil())
+			Expect(etp.CountTx()).To(Equal(2))
+		})
+
+		It("should apply updated limits to the txs inserted afterwards", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			_, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+
+			_, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+			Expect(etp.Insert(ctx, tx3)).To(MatchError(ErrAccountSlotsFull))
+			etp.SetConfig(Config{GlobalSlots: 3, AccountSlots: 3, Lifetime: time.Hour})
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+
+			etp.SetConfig(Config{GlobalSlots: 1, AccountSlots: 1, Lifetime: time.Hour})
+			Expect(etp.CountTx()).To(Equal(3))
+			_, tx4 := buildTx(key2, &coretypes.LegacyTx{Nonce: 2})
+			Expect(etp.Insert(ctx, tx4)).To(MatchError(ErrUnderpriced))
+		})
+	})
 })
 
 // MOCKS BELOW.
@@ -23,6 +23,8 @@ package mempool
 import (
 	"context"
 	"errors"
+	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -36,13 +38,28 @@ func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
 	etp.mu.Lock()
 	defer etp.mu.Unlock()
 
+	now := etp.now()
+	if etp.cfg.Lifetime > 0 && now.Sub(etp.lastEviction) >= evictionInterval {
+		etp.evictStale(now)
+	}
+
+	// Enforce the limits of the pool before inserting the transaction.
+	ethTx := evmtypes.GetAsEthTx(tx)
+	if ethTx != nil {
+		if err := etp.makeRoom(ctx, tx, ethTx); err != nil {
+			return err
+		}
+	} else if uint64(etp.CountTx()) >= etp.cfg.GlobalSlots {
+		return ErrTxPoolOverflow
+	}
+
 	// Call the base mempool's Insert method
 	if err := etp.PriorityNonceMempool.Insert(ctx, tx); err != nil {
 		return err
 	}
 
 	// We want to cache the transaction for lookup.
-	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
+	if ethTx != nil {
 		sender := coretypes.GetSender(ethTx)
 		nonce := ethTx.Nonce()
 
@@ -62,6 +79,7 @@ func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
 		}
 		etp.nonceToHash[sender][nonce] = newHash
 		etp.ethTxCache[newHash] = ethTx
+		etp.lastSeen[sender] = now
 	}
 
 	return nil
@@ -71,7 +89,11 @@ func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
 func (etp *EthTxPool) Remove(tx sdk.Tx) error {
 	etp.mu.Lock()
 	defer etp.mu.Unlock()
+	return etp.remove(tx)
+}
 
+// remove removes the given transaction from the mempool, the lock must be held.
+func (etp *EthTxPool) remove(tx sdk.Tx) error {
 	// Call the base mempool's Remove method
 	if err := etp.PriorityNonceMempool.Remove(tx); err != nil {
 		return err
@@ -79,9 +101,90 @@ func (etp *EthTxPool) Remove(tx sdk.Tx) error {
 
 	// We want to remove any references to the tx from the cache.
 	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
+		sender := coretypes.GetSender(ethTx)
 		delete(etp.ethTxCache, ethTx.Hash())
-		delete(etp.nonceToHash[coretypes.GetSender(ethTx)], ethTx.Nonce())
+		delete(etp.nonceToHash[sender], ethTx.Nonce())
+		if len(etp.nonceToHash[sender]) == 0 {
+			delete(etp.nonceToHash, sender)
+			delete(etp.lastSeen, sender)
+		}
 	}
 
 	return nil
 }
+
+// makeRoom enforces the account and global slots for the given Ethereum transaction, which does
+// not take a new slot if it replaces one. When the pool is full, the last transaction of the
+// account paying the lowest tip is evicted, so that no nonce gap is created, if the given one
+// pays more.
+func (etp *EthTxPool) makeRoom(
+	ctx context.Context, tx sdk.Tx, ethTx *coretypes.Transaction,
+) error {
+	sender := coretypes.GetSender(ethTx)
+	if _, replacement := etp.nonceToHash[sender][ethTx.Nonce()]; replacement {
+		return nil
+	}
+	if uint64(len(etp.nonceToHash[sender])) >= etp.cfg.AccountSlots {
+		return ErrAccountSlotsFull
+	}
+	if uint64(etp.CountTx()) < etp.cfg.GlobalSlots {
+		return nil
+	}
+
+	victim, victimPriority := etp.cheapestTail(ctx, sender)
+	if victim == nil || etp.priorityPolicy.GetTxPriority(ctx, tx).Cmp(victimPriority) <= 0 {
+		return ErrUnderpriced
+	}
+	return etp.remove(victim)
+}
+
+// cheapestTail returns the last Ethereum transaction of the account paying the lowest tip among
+// the accounts other than the given one, with its priority, or nil if there are none.
+func (etp *EthTxPool) cheapestTail(
+	ctx context.Context, exclude common.Address,
+) (sdk.Tx, *big.Int) {
+	tails := make(map[common.Address]sdk.Tx)
+	tailNonces := make(map[common.Address]uint64)
+	for iter := etp.PriorityNonceMempool.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		ethTx := evmtypes.GetAsEthTx(iter.Tx())
+		if ethTx == nil {
+			continue
+		}
+		sender := coretypes.GetSender(ethTx)
+		if nonce, ok := tailNonces[sender]; sender != exclude && (!ok || ethTx.Nonce() > nonce) {
+			tails[sender] = iter.Tx()
+			tailNonces[sender] = ethTx.Nonce()
+		}
+	}
+
+	var (
+		cheapest         sdk.Tx
+		cheapestPriority *big.Int
+	)
+	for _, tail := range tails {
+		if priority := etp.priorityPolicy.GetTxPriority(ctx, tail); cheapest == nil ||
+			priority.Cmp(cheapestPriority) < 0 {
+			cheapest, cheapestPriority = tail, priority
+		}
+	}
+	return cheapest, cheapestPriority
+}
+
+// evictStale removes the Ethereum transactions of the accounts that have not inserted one for
+// longer than the lifetime, the lock must be held.
+func (etp *EthTxPool) evictStale(now time.Time) {
+	etp.lastEviction = now
+
+	var stale []sdk.Tx
+	iter := etp.PriorityNonceMempool.Select(context.Background(), nil)
+	for ; iter != nil; iter = iter.Next() {
+		ethTx := evmtypes.GetAsEthTx(iter.Tx())
+		if ethTx != nil && now.Sub(etp.lastSeen[coretypes.GetSender(ethTx)]) > etp.cfg.Lifetime {
+			stale = append(stale, iter.Tx())
+		}
+	}
+	for _, tx := range stale {
+		// The transactions are in the pool, as the lock is held.
+		_ = etp.remove(tx)
+	}
+}
@@ -21,7 +21,10 @@
 package txpool
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 
@@ -35,10 +38,20 @@ import (
 func SerializeToSdkTx(
 	clientCtx client.Context, signedTx *coretypes.Transaction,
 ) (sdk.Tx, error) {
-	// TODO: do we really need to use extensions for anything? Since we
-	// are using the standard ante handler stuff I don't think we actually need to.
 	tx := clientCtx.TxConfig.NewTxBuilder()
 
+	// First, we mark the Cosmos Tx as an Ethereum transaction by attaching the Ethereum
+	// extension option, which allows Cosmos tooling to identify it.
+	extTx, ok := tx.(client.ExtendedTxBuilder)
+	if !ok {
+		return nil, errors.New("tx builder does not support extension options")
+	}
+	option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEthereumTx{})
+	if err != nil {
+		return nil, err
+	}
+	extTx.SetExtensionOptions(option)
+
 	// We can also retrieve the gaslimit for the transaction from the ethereum transaction.
 	tx.SetGasLimit(signedTx.Gas())
 
@@ -46,9 +59,7 @@ func SerializeToSdkTx(
 	// from the V,R,S values of the transaction. This allows us for a little trick to allow
 	// ethereum transactions to work in the standard cosmos app-side mempool with no modifications.
 	// Some gigabrain shit tbh.
-	pkBz, err := coretypes.PubkeyFromTx(
-		signedTx, coretypes.LatestSignerForChainID(signedTx.ChainId()),
-	)
+	pkBz, err := coretypes.PubkeyFromTx(signedTx, coretypes.SignerForTx(signedTx))
 	if err != nil {
 		return nil, err
 	}
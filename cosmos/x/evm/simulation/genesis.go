@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/crypto"
+)
+
+// maxGenesisBalance is the maximum balance, in ether, allocated to a simulation account in the
+// EVM genesis.
+const maxGenesisBalance = 1000
+
+// RandomizedGenState generates a random EVM genesis, which allocates a random balance to the
+// Ethereum address of every simulation account.
+func RandomizedGenState(simState *module.SimulationState) {
+	ethGen := *core.DefaultGenesis
+	ethGen.Alloc = make(core.GenesisAlloc, len(core.DefaultGenesis.Alloc)+len(simState.Accounts))
+	for addr, account := range core.DefaultGenesis.Alloc {
+		ethGen.Alloc[addr] = account
+	}
+
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil) //nolint:gomnd // 1 ether.
+	for _, acc := range simState.Accounts {
+		key, err := crypto.ToECDSA(acc.PrivKey.Bytes())
+		if err != nil {
+			panic(err)
+		}
+		balance := big.NewInt(simState.Rand.Int63n(maxGenesisBalance) + 1)
+		ethGen.Alloc[crypto.PubkeyToAddress(key.PublicKey)] = core.GenesisAccount{
+			Balance: balance.Mul(balance, ether),
+		}
+	}
+
+	bz, err := ethGen.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	simState.GenState[types.ModuleName] = bz
+}
@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/plugins/txpool"
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+// The keys of the weights of the simulation operations in the simulation parameters.
+const (
+	OpWeightEthTransfer    = "op_weight_eth_transfer"    //nolint:gosec // not a credential.
+	OpWeightDeployContract = "op_weight_deploy_contract" //nolint:gosec // not a credential.
+	OpWeightCallContract   = "op_weight_call_contract"   //nolint:gosec // not a credential.
+	OpWeightCallPrecompile = "op_weight_call_precompile" //nolint:gosec // not a credential.
+)
+
+// The default weights of the simulation operations.
+const (
+	DefaultWeightEthTransfer    = 100
+	DefaultWeightDeployContract = 30
+	DefaultWeightCallContract   = 50
+	DefaultWeightCallPrecompile = 20
+)
+
+// The operation types reported by the simulation operations.
+const (
+	TypeEthTransfer    = "eth_transfer"
+	TypeDeployContract = "deploy_contract"
+	TypeCallContract   = "call_contract"
+	TypeCallPrecompile = "call_precompile"
+)
+
+const (
+	// transferGas is the gas limit of the simulated transfers.
+	transferGas = 21_000
+	// maxTip is the maximum tip, in wei, of the simulated transactions.
+	maxTip = 1_000_000_000
+	// contractGas is the gas limit of the simulated contract deployments and calls.
+	contractGas = 1_000_000
+	// maxCodeSize is the maximum size of the random code and call data of the operations.
+	maxCodeSize = 256
+)
+
+// AccountKeeper defines the account keeper used by the simulation operations.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// EVMKeeper defines the evm keeper used by the simulation operations.
+type EVMKeeper interface {
+	GetChainConfig(ctx sdk.Context) *params.ChainConfig
+	GetBaseFee(ctx sdk.Context) (*big.Int, error)
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress) *big.Int
+}
+
+// WeightedOperations returns the weighted simulation operations of the evm module, which send
+// Ethereum transactions signed by the Ethereum keys of the simulation accounts.
+func WeightedOperations(
+	appParams simtypes.AppParams, txConfig client.TxConfig, ak AccountKeeper, ek EVMKeeper,
+) simtypes.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simtypes.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightEthTransfer, DefaultWeightEthTransfer),
+			SimulateEthTransfer(txConfig, ak, ek),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightDeployContract, DefaultWeightDeployContract),
+			SimulateDeployContract(txConfig, ak, ek),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightCallContract, DefaultWeightCallContract),
+			SimulateCallContract(txConfig, ak, ek),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightCallPrecompile, DefaultWeightCallPrecompile),
+			SimulateCallPrecompile(txConfig, ak, ek),
+		),
+	}
+}
+
+// SimulateEthTransfer sends a random amount of ether between two random accounts.
+func SimulateEthTransfer(
+	txConfig client.TxConfig, ak AccountKeeper, ek EVMKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		toAddr := ethAddress(mustEthKey(to))
+		op := func(_ common.Address, _ uint64, balance *big.Int) *coretypes.DynamicFeeTx {
+			return &coretypes.DynamicFeeTx{
+				To:    &toAddr,
+				Gas:   transferGas,
+				Value: randomAmount(r, balance),
+			}
+		}
+		return deliverEthTx(r, app, ctx, txConfig, ak, ek, from, TypeEthTransfer, op)
+	}
+}
+
+// SimulateDeployContract deploys a contract with random runtime code.
+func SimulateDeployContract(
+	txConfig client.TxConfig, ak AccountKeeper, ek EVMKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		op := func(_ common.Address, _ uint64, _ *big.Int) *coretypes.DynamicFeeTx {
+			return &coretypes.DynamicFeeTx{
+				Gas:  contractGas,
+				Data: initCode(randomRuntimeCode(r)),
+			}
+		}
+		return deliverEthTx(r, app, ctx, txConfig, ak, ek, from, TypeDeployContract, op)
+	}
+}
+
+// SimulateCallContract calls a contract previously deployed by a random account with random call
+// data. The address may hold no contract, in which case the call is a plain transfer.
+func SimulateCallContract(
+	txConfig client.TxConfig, ak AccountKeeper, ek EVMKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		op := func(sender common.Address, nonce uint64, balance *big.Int) *coretypes.DynamicFeeTx {
+			if nonce == 0 {
+				return nil
+			}
+			contract := crypto.CreateAddress(sender, uint64(r.Int63n(int64(nonce))))
+			return &coretypes.DynamicFeeTx{
+				To:    &contract,
+				Gas:   contractGas,
+				Value: randomAmount(r, balance),
+				Data:  randomBytes(r),
+			}
+		}
+		return deliverEthTx(r, app, ctx, txConfig, ak, ek, from, TypeCallContract, op)
+	}
+}
+
+// SimulateCallPrecompile calls a random Ethereum precompiled contract with random input.
+func SimulateCallPrecompile(
+	txConfig client.TxConfig, ak AccountKeeper, ek EVMKeeper,
+) simtypes.Operation {
+	// The precompiles are sorted, as iterating over a map is not deterministic.
+	precompiles := make([]common.Address, 0, len(vm.PrecompiledContractsBerlin))
+	for addr := range vm.PrecompiledContractsBerlin {
+		precompiles = append(precompiles, addr)
+	}
+	sort.Slice(precompiles, func(i, j int) bool {
+		return bytes.Compare(precompiles[i][:], precompiles[j][:]) < 0
+	})
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		precompile := precompiles[r.Intn(len(precompiles))]
+		op := func(_ common.Address, _ uint64, _ *big.Int) *coretypes.DynamicFeeTx {
+			return &coretypes.DynamicFeeTx{
+				To:   &precompile,
+				Gas:  contractGas,
+				Data: randomBytes(r),
+			}
+		}
+		return deliverEthTx(r, app, ctx, txConfig, ak, ek, from, TypeCallPrecompile, op)
+	}
+}
+
+// deliverEthTx signs the transaction built by op with the Ethereum key of the given account and
+// delivers it. op is given the sender, its nonce and its balance left after paying for the gas,
+// and returns nil if there is nothing to send.
+func deliverEthTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txConfig client.TxConfig,
+	ak AccountKeeper, ek EVMKeeper, simAccount simtypes.Account, opType string,
+	op func(sender common.Address, nonce uint64, balance *big.Int) *coretypes.DynamicFeeTx,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	chainConfig := ek.GetChainConfig(ctx)
+	if chainConfig == nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "chain config not found"), nil, nil
+	}
+	baseFee, err := ek.GetBaseFee(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "base fee not found"), nil, nil
+	}
+
+	key := mustEthKey(simAccount)
+	sender := ethAddress(key)
+	var nonce uint64
+	if acc := ak.GetAccount(ctx, sender.Bytes()); acc != nil {
+		nonce = acc.GetSequence()
+	}
+
+	// The fee cap leaves room for the base fee to rise until the transaction is executed.
+	tip := big.NewInt(r.Int63n(maxTip) + 1)
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip) //nolint:gomnd // 2x.
+	balance := new(big.Int).Sub(
+		ek.GetBalance(ctx, sender.Bytes()), new(big.Int).Mul(feeCap, big.NewInt(contractGas)),
+	)
+	if balance.Sign() < 0 {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "insufficient balance"), nil, nil
+	}
+
+	txData := op(sender, nonce, balance)
+	if txData == nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "nothing to send"), nil, nil
+	}
+	txData.ChainID = chainConfig.ChainID
+	txData.Nonce = nonce
+	txData.GasTipCap = tip
+	txData.GasFeeCap = feeCap
+
+	signer := coretypes.LatestSignerForChainID(chainConfig.ChainID)
+	signedTx, err := coretypes.SignNewTx(key, signer, txData)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "unable to sign tx"), nil, err
+	}
+	tx, err := txpool.SerializeToSdkTx(client.Context{}.WithTxConfig(txConfig), signedTx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "unable to build tx"), nil, err
+	}
+
+	// A transaction whose execution fails is still included, so only the failures to deliver it
+	// are reported as errors.
+	if _, _, err = app.SimDeliver(txConfig.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, opType, "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsgBasic(types.ModuleName, opType, "", true, nil), nil, nil
+}
+
+// initCode returns the init code of a contract that deploys the given runtime code.
+func initCode(runtime []byte) []byte {
+	size := byte(len(runtime))
+	return append([]byte{
+		byte(vm.PUSH1), size, byte(vm.PUSH1), 0x0c, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY),
+		byte(vm.PUSH1), size, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+	}, runtime...)
+}
+
+// randomRuntimeCode returns random runtime code, which does not start with the 0xEF byte rejected
+// by EIP-3541.
+func randomRuntimeCode(r *rand.Rand) []byte {
+	code := randomBytes(r)
+	if len(code) > 0 && code[0] == 0xef {
+		code[0] = byte(vm.STOP)
+	}
+	return code
+}
+
+// randomBytes returns up to maxCodeSize - 1 random bytes.
+func randomBytes(r *rand.Rand) []byte {
+	bz := make([]byte, r.Intn(maxCodeSize))
+	_, _ = r.Read(bz)
+	return bz
+}
+
+// randomAmount returns a random amount of at most a tenth of the given balance.
+func randomAmount(r *rand.Rand, balance *big.Int) *big.Int {
+	limit := new(big.Int).Div(balance, big.NewInt(10)) //nolint:gomnd // a tenth.
+	if limit.Sign() <= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Rand(r, limit)
+}
+
+// mustEthKey returns the Ethereum key of the simulation account, which shares its private key.
+func mustEthKey(acc simtypes.Account) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(acc.PrivKey.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// ethAddress returns the Ethereum address of the given key.
+func ethAddress(key *ecdsa.PrivateKey) common.Address {
+	return crypto.PubkeyToAddress(key.PublicKey)
+}
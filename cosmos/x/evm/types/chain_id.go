@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+var (
+	// ErrUnknownChainID is returned when a Cosmos chain-id is not in the chain ID registry.
+	ErrUnknownChainID = errors.New("cosmos chain-id is not in the chain ID registry")
+	// ErrChainIDMismatch is returned when the EVM chain ID of the chain config is not the one
+	// that the chain ID registry maps the Cosmos chain-id to.
+	ErrChainIDMismatch = errors.New("evm chain ID does not match the chain ID registry")
+)
+
+// ReservedChainIDs are the EVM chain IDs of well-known public networks. A Polaris chain must not
+// use them, as transactions signed for those networks could then be replayed on the chain, and
+// vice versa.
+var ReservedChainIDs = map[uint64]string{
+	1:        "Ethereum Mainnet",
+	5:        "Goerli",
+	10:       "OP Mainnet",
+	56:       "BNB Smart Chain",
+	137:      "Polygon",
+	8453:     "Base",
+	17000:    "Holesky",
+	42161:    "Arbitrum One",
+	43114:    "Avalanche C-Chain",
+	11155111: "Sepolia",
+}
+
+// ChainIDRegistry maps the Cosmos chain-id of each environment of a chain (e.g. its mainnet and
+// testnets) to the EVM chain ID of that environment.
+type ChainIDRegistry map[string]uint64
+
+// Validate ensures that every EVM chain ID of the registry is set, is not reserved, and is used by
+// a single Cosmos chain-id, so that a transaction signed for one environment can never be replayed
+// on another.
+func (r ChainIDRegistry) Validate() error {
+	seen := make(map[uint64]string, len(r))
+	for cosmosChainID, evmChainID := range r {
+		if cosmosChainID == "" {
+			return errors.New("cosmos chain-id cannot be empty")
+		}
+		if evmChainID == 0 {
+			return fmt.Errorf("evm chain ID of %s cannot be zero", cosmosChainID)
+		}
+		if network, ok := ReservedChainIDs[evmChainID]; ok {
+			return fmt.Errorf("evm chain ID %d of %s is reserved by %s", evmChainID, cosmosChainID, network)
+		}
+		if other, ok := seen[evmChainID]; ok {
+			return fmt.Errorf("evm chain ID %d is used by both %s and %s", evmChainID, other, cosmosChainID)
+		}
+		seen[evmChainID] = cosmosChainID
+	}
+	return nil
+}
+
+// EVMChainID returns the EVM chain ID that the given Cosmos chain-id maps to.
+func (r ChainIDRegistry) EVMChainID(cosmosChainID string) (*big.Int, error) {
+	evmChainID, ok := r[cosmosChainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownChainID, cosmosChainID)
+	}
+	return new(big.Int).SetUint64(evmChainID), nil
+}
+
+// ValidateChainConfig ensures that the chain ID of the given chain config is the EVM chain ID that
+// the given Cosmos chain-id maps to.
+func (r ChainIDRegistry) ValidateChainConfig(cosmosChainID string, cc *params.ChainConfig) error {
+	evmChainID, err := r.EVMChainID(cosmosChainID)
+	if err != nil {
+		return err
+	}
+	if cc == nil || cc.ChainID == nil || cc.ChainID.Cmp(evmChainID) != 0 {
+		var have *big.Int
+		if cc != nil {
+			have = cc.ChainID
+		}
+		return fmt.Errorf("%w: %s maps to %s, have %v", ErrChainIDMismatch, cosmosChainID, evmChainID, have)
+	}
+	return nil
+}
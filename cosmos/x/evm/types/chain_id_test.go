@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChainIDRegistry", func() {
+	registry := types.ChainIDRegistry{
+		"polaris-2061":      2061,
+		"polaris-testnet-1": 2062,
+	}
+
+	It("should validate a registry with distinct chain IDs", func() {
+		Expect(registry.Validate()).To(Succeed())
+	})
+
+	It("should reject a chain ID used by two environments", func() {
+		Expect(types.ChainIDRegistry{"a-1": 2061, "b-1": 2061}.Validate()).ToNot(Succeed())
+	})
+
+	It("should reject a reserved or zero chain ID", func() {
+		Expect(types.ChainIDRegistry{"a-1": 1}.Validate()).ToNot(Succeed())
+		Expect(types.ChainIDRegistry{"a-1": 11155111}.Validate()).ToNot(Succeed())
+		Expect(types.ChainIDRegistry{"a-1": 0}.Validate()).ToNot(Succeed())
+		Expect(types.ChainIDRegistry{"": 2061}.Validate()).ToNot(Succeed())
+	})
+
+	It("should map a cosmos chain-id to its evm chain ID", func() {
+		chainID, err := registry.EVMChainID("polaris-testnet-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(chainID).To(Equal(big.NewInt(2062)))
+
+		_, err = registry.EVMChainID("polaris-3")
+		Expect(err).To(MatchError(types.ErrUnknownChainID))
+	})
+
+	It("should validate the chain ID of a chain config", func() {
+		Expect(registry.ValidateChainConfig("polaris-2061", params.DefaultChainConfig)).To(Succeed())
+		Expect(registry.ValidateChainConfig("polaris-testnet-1", params.DefaultChainConfig)).
+			To(MatchError(types.ErrChainIDMismatch))
+		Expect(registry.ValidateChainConfig("polaris-3", params.DefaultChainConfig)).
+			To(MatchError(types.ErrUnknownChainID))
+	})
+})
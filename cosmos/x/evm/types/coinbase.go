@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// MsgRegisterCoinbase defines a Cosmos SDK message for registering the coinbase of a validator.
+var _ sdk.Msg = (*MsgRegisterCoinbase)(nil)
+
+// NewMsgRegisterCoinbase returns a message registering the given coinbase for the validator
+// operated by the given account.
+func NewMsgRegisterCoinbase(operator sdk.AccAddress, coinbase common.Address) *MsgRegisterCoinbase {
+	return &MsgRegisterCoinbase{
+		Operator: operator.String(),
+		Coinbase: coinbase.Hex(),
+	}
+}
+
+// GetSigners returns the address(es) that must sign over the message.
+func (m *MsgRegisterCoinbase) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{operator}
+}
+
+// ValidateBasic ensures that the operator and coinbase addresses are valid.
+func (m *MsgRegisterCoinbase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return err
+	}
+	if !common.IsHexAddress(m.Coinbase) {
+		return errors.New("coinbase is not a valid hex address")
+	}
+	return nil
+}
+
+// ValAddress returns the address of the validator operated by the operator of the message.
+func (m *MsgRegisterCoinbase) ValAddress() sdk.ValAddress {
+	operator, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		return nil
+	}
+	return sdk.ValAddress(operator)
+}
+
+// CoinbaseAddress returns the coinbase registered by the message.
+func (m *MsgRegisterCoinbase) CoinbaseAddress() common.Address {
+	return common.HexToAddress(m.Coinbase)
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// verifiedEthTxKey is the context key under which the ante handler stores the Ethereum
+// transaction it verified.
+type verifiedEthTxKey struct{}
+
+// VerifiedEthTx is an Ethereum transaction that has been decoded and verified by the ante
+// handler. The decoded transaction caches its recovered sender, so that execution does not have
+// to recover it again.
+type VerifiedEthTx struct {
+	// Tx is the decoded Ethereum transaction.
+	Tx *coretypes.Transaction
+	// Sender is the address recovered from the transaction signature.
+	Sender common.Address
+}
+
+// WithVerifiedEthTx returns a copy of the context carrying the given verified transaction.
+func WithVerifiedEthTx(ctx sdk.Context, vtx *VerifiedEthTx) sdk.Context {
+	return ctx.WithValue(verifiedEthTxKey{}, vtx)
+}
+
+// VerifiedEthTxFromContext returns the verified Ethereum transaction stored in the context by
+// the ante handler, if any.
+func VerifiedEthTxFromContext(ctx context.Context) (*VerifiedEthTx, bool) {
+	vtx, ok := ctx.Value(verifiedEthTxKey{}).(*VerifiedEthTx)
+	return vtx, ok && vtx != nil
+}
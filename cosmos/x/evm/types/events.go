@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+const (
+	// EventTypeEthereumTx is the type of the event emitted for every processed Ethereum
+	// transaction, it allows Cosmos tooling to search for Ethereum transactions via CometBFT RPC.
+	EventTypeEthereumTx = "ethereum_tx"
+	// EventTypeTxLog is the type of the event emitted for every log of a processed Ethereum
+	// transaction, it allows Cosmos tooling to react to EVM activity without JSON-RPC.
+	EventTypeTxLog = "tx_log"
+
+	// AttributeKeyEthereumTxHash is the attribute key for the hash of the Ethereum transaction.
+	AttributeKeyEthereumTxHash = "ethereum_tx_hash"
+	// AttributeKeyTxGasUsed is the attribute key for the gas used by the Ethereum transaction.
+	AttributeKeyTxGasUsed = "tx_gas_used"
+	// AttributeKeyEthereumTxFailed is the attribute key for the vm error of a failed Ethereum
+	// transaction.
+	AttributeKeyEthereumTxFailed = "ethereum_tx_failed"
+	// AttributeKeyEthereumTxSender is the attribute key for the sender of the Ethereum transaction.
+	AttributeKeyEthereumTxSender = "ethereum_tx_sender"
+	// AttributeKeyEthereumTxRecipient is the attribute key for the recipient of the Ethereum
+	// transaction, which is the address of the created contract for contract creations.
+	AttributeKeyEthereumTxRecipient = "ethereum_tx_recipient"
+
+	// AttributeKeyLogIndex is the attribute key for the index of the log in the block.
+	AttributeKeyLogIndex = "log_index"
+	// AttributeKeyLogAddress is the attribute key for the address of the contract that emitted
+	// the log.
+	AttributeKeyLogAddress = "log_address"
+	// AttributeKeyLogTopics is the attribute key for the comma-separated topics of the log.
+	AttributeKeyLogTopics = "log_topics"
+	// AttributeKeyLogData is the attribute key for the hex encoded data of the log.
+	AttributeKeyLogData = "log_data"
+)
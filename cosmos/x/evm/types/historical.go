@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+)
+
+const (
+	// ReceiptsVersionLegacy is the version of the receipts stored as a bare RLP list of
+	// `ReceiptForStorage`, before receipts were versioned. Their encoding starts with an RLP list
+	// prefix (0xc0 and above), which never collides with a version byte.
+	ReceiptsVersionLegacy byte = 0x00
+
+	// ReceiptsVersionProto is the version of the receipts stored as a version byte followed by the
+	// protobuf encoding of `StoredReceipts`.
+	ReceiptsVersionProto byte = 0x01
+
+	// CurrentReceiptsVersion is the version used by `MarshalReceipts`.
+	CurrentReceiptsVersion = ReceiptsVersionProto
+
+	// rlpListPrefix is the smallest first byte of an RLP encoded list.
+	rlpListPrefix byte = 0xc0
+)
+
+// ErrUnknownReceiptsVersion is returned when decoding receipts of an unknown version.
+var ErrUnknownReceiptsVersion = errors.New("unknown receipts version")
+
+// receiptsDecoders are the decoders of the payloads of the versioned receipts encodings, keyed by
+// version. A new version must register its decoder here, so that nodes keep reading the receipts
+// already stored under older versions.
+var receiptsDecoders = map[byte]func([]byte) (coretypes.Receipts, error){
+	ReceiptsVersionProto: decodeReceiptsProto,
+}
+
+// MarshalReceipts marshals the receipts of a block to bytes using the current versioned encoding.
+// Only the fields that are not derived from the block and its transactions are encoded.
+func MarshalReceipts(receipts coretypes.Receipts) ([]byte, error) {
+	stored := &StoredReceipts{Receipts: make([]*StoredReceipt, len(receipts))}
+	for i, receipt := range receipts {
+		sr := &StoredReceipt{
+			CumulativeGasUsed: receipt.CumulativeGasUsed,
+			Logs:              make([]*Log, len(receipt.Logs)),
+			Bloom:             receipt.Bloom.Bytes(),
+		}
+		if len(receipt.PostState) > 0 {
+			sr.PostState = receipt.PostState
+		} else {
+			sr.Status = receipt.Status
+		}
+		for j, log := range receipt.Logs {
+			topics := make([][]byte, len(log.Topics))
+			for k, topic := range log.Topics {
+				topics[k] = topic.Bytes()
+			}
+			sr.Logs[j] = &Log{Address: log.Address.Bytes(), Topics: topics, Data: log.Data}
+		}
+		stored.Receipts[i] = sr
+	}
+
+	bz, err := stored.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{CurrentReceiptsVersion}, bz...), nil
+}
+
+// UnmarshalReceipts unmarshals the receipts of a block, accepting every known version of the
+// encoding, including the legacy unversioned RLP encoding. The derived fields of the receipts
+// must be set with `DeriveFields`.
+func UnmarshalReceipts(bz []byte) (coretypes.Receipts, error) {
+	version, err := ReceiptsVersion(bz)
+	if err != nil {
+		return nil, err
+	}
+	if version == ReceiptsVersionLegacy {
+		return coretypes.UnmarshalReceipts(bz)
+	}
+	return receiptsDecoders[version](bz[1:])
+}
+
+// ReceiptsVersion returns the version of the encoded receipts.
+func ReceiptsVersion(bz []byte) (byte, error) {
+	if len(bz) == 0 {
+		return 0, errors.New("empty receipts encoding")
+	}
+	if bz[0] >= rlpListPrefix {
+		return ReceiptsVersionLegacy, nil
+	}
+	if _, ok := receiptsDecoders[bz[0]]; !ok {
+		return 0, fmt.Errorf("%w: %d", ErrUnknownReceiptsVersion, bz[0])
+	}
+	return bz[0], nil
+}
+
+// MigrateReceipts re-encodes receipts stored under an older version with the current version. It
+// returns whether the receipts were re-encoded.
+func MigrateReceipts(bz []byte) ([]byte, bool, error) {
+	version, err := ReceiptsVersion(bz)
+	if err != nil {
+		return nil, false, err
+	}
+	if version == CurrentReceiptsVersion {
+		return bz, false, nil
+	}
+	receipts, err := UnmarshalReceipts(bz)
+	if err != nil {
+		return nil, false, err
+	}
+	if bz, err = MarshalReceipts(receipts); err != nil {
+		return nil, false, err
+	}
+	return bz, true, nil
+}
+
+// decodeReceiptsProto decodes the protobuf encoding of the receipts of a block.
+func decodeReceiptsProto(bz []byte) (coretypes.Receipts, error) {
+	stored := &StoredReceipts{}
+	if err := stored.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	receipts := make(coretypes.Receipts, len(stored.Receipts))
+	for i, sr := range stored.Receipts {
+		receipt := &coretypes.Receipt{
+			PostState:         sr.PostState,
+			Status:            sr.Status,
+			CumulativeGasUsed: sr.CumulativeGasUsed,
+			Logs:              make([]*coretypes.Log, len(sr.Logs)),
+		}
+		for j, log := range sr.Logs {
+			topics := make([]common.Hash, len(log.Topics))
+			for k, topic := range log.Topics {
+				topics[k] = common.BytesToHash(topic)
+			}
+			receipt.Logs[j] = &coretypes.Log{
+				Address: common.BytesToAddress(log.Address),
+				Topics:  topics,
+				Data:    log.Data,
+			}
+		}
+		if len(sr.Bloom) > 0 {
+			receipt.Bloom = coretypes.BytesToBloom(sr.Bloom)
+		} else {
+			receipt.Bloom = coretypes.CreateBloom(coretypes.Receipts{receipt})
+		}
+		receipts[i] = receipt
+	}
+	return receipts, nil
+}
+
+// NewBlockMetadata returns the metadata of the given block.
+func NewBlockMetadata(block *coretypes.Block) *BlockMetadata {
+	md := &BlockMetadata{
+		Hash:     block.Hash().Bytes(),
+		Number:   block.NumberU64(),
+		Time:     block.Time(),
+		GasLimit: block.GasLimit(),
+		GasUsed:  block.GasUsed(),
+		Bloom:    block.Bloom().Bytes(),
+	}
+	if baseFee := block.BaseFee(); baseFee != nil {
+		// a zero base fee is kept as a zero byte, so that it is not read back as a missing one.
+		md.BaseFee = baseFee.Bytes()
+		if len(md.BaseFee) == 0 {
+			md.BaseFee = []byte{0}
+		}
+	}
+	return md
+}
+
+// BaseFeeInt returns the base fee of the block, or nil if the block is prior to London.
+func (m *BlockMetadata) BaseFeeInt() *big.Int {
+	if len(m.BaseFee) == 0 {
+		return nil
+	}
+	return new(big.Int).SetBytes(m.BaseFee)
+}
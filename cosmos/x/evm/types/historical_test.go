@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Historical", func() {
+	var receipts coretypes.Receipts
+
+	BeforeEach(func() {
+		receipts = coretypes.Receipts{
+			{
+				Status:            coretypes.ReceiptStatusSuccessful,
+				CumulativeGasUsed: 21000,
+				Logs: []*coretypes.Log{{
+					Address: common.Address{0x1},
+					Topics:  []common.Hash{{0x2}, {0x3}},
+					Data:    []byte{0x4},
+				}},
+			},
+			{
+				PostState:         common.Hash{0x5}.Bytes(),
+				CumulativeGasUsed: 42000,
+				Logs:              []*coretypes.Log{},
+			},
+		}
+		for _, receipt := range receipts {
+			receipt.Bloom = coretypes.CreateBloom(coretypes.Receipts{receipt})
+		}
+	})
+
+	It("should marshal and unmarshal receipts", func() {
+		bz, err := types.MarshalReceipts(receipts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bz[0]).To(Equal(types.CurrentReceiptsVersion))
+
+		decoded, err := types.UnmarshalReceipts(bz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(receipts))
+	})
+
+	It("should read and migrate legacy receipts", func() {
+		legacy, err := coretypes.MarshalReceipts(receipts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(types.ReceiptsVersion(legacy)).To(Equal(types.ReceiptsVersionLegacy))
+
+		decoded, err := types.UnmarshalReceipts(legacy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(receipts))
+
+		migrated, ok, err := types.MigrateReceipts(legacy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(migrated[0]).To(Equal(types.CurrentReceiptsVersion))
+		decoded, err = types.UnmarshalReceipts(migrated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(receipts))
+
+		_, ok, err = types.MigrateReceipts(migrated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should reject receipts of an unknown version", func() {
+		_, err := types.UnmarshalReceipts([]byte{0x7f, 0x00})
+		Expect(err).To(MatchError(types.ErrUnknownReceiptsVersion))
+		_, err = types.UnmarshalReceipts(nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep the base fee of a block", func() {
+		header := &coretypes.Header{Number: big.NewInt(7), GasLimit: 1000, Time: 3}
+		md := types.NewBlockMetadata(coretypes.NewBlock(header, nil, nil, nil, nil))
+		Expect(md.Number).To(Equal(uint64(7)))
+		Expect(md.BaseFeeInt()).To(BeNil())
+
+		header.BaseFee = new(big.Int)
+		block := coretypes.NewBlock(header, nil, nil, nil, nil)
+		bz, err := types.NewBlockMetadata(block).Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		md = &types.BlockMetadata{}
+		Expect(md.Unmarshal(bz)).To(Succeed())
+		Expect(md.Hash).To(Equal(block.Hash().Bytes()))
+		Expect(md.BaseFeeInt()).To(Equal(new(big.Int)))
+	})
+})
@@ -20,18 +20,168 @@
 
 package types
 
+// The keys of the x/evm store are split into namespaces, identified by the first byte of the key.
+// The byte of a namespace is fixed: it must never be renumbered nor reused, as the data already
+// stored under it would silently be read as another type. A new data type is given an unused
+// byte, registered in `Namespaces`.
+//
+// Singleton values are stored under the singleton namespace, followed by their own fixed id, so
+// that they can never collide with an entry of a keyed namespace.
+//
+// The keys of a keyed namespace are the namespace byte followed by the components of the key.
+// Components of variable length, such as Cosmos addresses, are prefixed by their length in a
+// single byte (see `address.MustLengthPrefix`), as are EVM addresses, so that no key is the
+// prefix of a key of another entry.
+// Hashes and big-endian numbers have a fixed length and are not prefixed.
+const (
+	// SingletonKeyPrefix is the namespace of the singleton values.
+	SingletonKeyPrefix byte = 0x20
+
+	// CodeKeyPrefix is the namespace of the contract code, keyed by code hash.
+	CodeKeyPrefix byte = 0x21
+	// CodeHashKeyPrefix is the namespace of the code hashes, keyed by address.
+	CodeHashKeyPrefix byte = 0x22
+	// BalanceKeyPrefix is the namespace of the balances, keyed by address.
+	BalanceKeyPrefix byte = 0x23
+	// StorageKeyPrefix is the namespace of the storage slots, keyed by address and slot.
+	StorageKeyPrefix byte = 0x24
+	// StorageGenerationKeyPrefix is the namespace of the storage generations, keyed by address.
+	StorageGenerationKeyPrefix byte = 0x25
+	// StorageTombstoneKeyPrefix is the namespace of the storage tombstones, keyed by address.
+	StorageTombstoneKeyPrefix byte = 0x26
+
+	// HeaderHashKeyPrefix is the namespace of the hashes of the previous headers, keyed by
+	// number.
+	HeaderHashKeyPrefix byte = 0x30
+	// CoinbaseKeyPrefix is the namespace of the coinbases, keyed by validator address.
+	CoinbaseKeyPrefix byte = 0x31
+	// CanonicalHashKeyPrefix is the namespace of the hashes of the canonical headers, keyed by
+	// number.
+	CanonicalHashKeyPrefix byte = 0x32
+
+	// BlockHashKeyToNumPrefix is the namespace of the block numbers, keyed by block hash.
+	BlockHashKeyToNumPrefix byte = 0x40
+	// BlockNumKeyToBlockPrefix is the namespace of the blocks, keyed by number.
+	BlockNumKeyToBlockPrefix byte = 0x41
+	// BlockNumKeyToMetadataPrefix is the namespace of the block metadata, keyed by number.
+	BlockNumKeyToMetadataPrefix byte = 0x42
+	// BlockHashKeyToReceiptsPrefix is the namespace of the receipts, keyed by block hash.
+	BlockHashKeyToReceiptsPrefix byte = 0x43
+	// TxHashKeyToTxPrefix is the namespace of the transaction lookup entries, keyed by hash.
+	TxHashKeyToTxPrefix byte = 0x44
+	// ContractCreationKeyPrefix is the namespace of the hashes of the transactions that deployed
+	// contracts, keyed by contract address.
+	ContractCreationKeyPrefix byte = 0x45
+	// CallTracesKeyPrefix is the namespace of the call traces of the transactions, keyed by block
+	// number and transaction index. It is only written to the off-chain database.
+	CallTracesKeyPrefix byte = 0x46
+	// CosmosTxHashKeyPrefix is the namespace of the hashes of the Cosmos transactions that
+	// wrapped the Ethereum transactions, keyed by Ethereum transaction hash.
+	CosmosTxHashKeyPrefix byte = 0x47
+	// EthTxHashKeyPrefix is the namespace of the hashes of the Ethereum transactions, keyed by the
+	// hash of the Cosmos transaction that wrapped them.
+	EthTxHashKeyPrefix byte = 0x48
+	// BloomBitsKeyPrefix is the namespace of the compressed bit vectors of the bloom bits index,
+	// keyed by bit and section. It is only written to the off-chain database.
+	BloomBitsKeyPrefix byte = 0x49
+)
+
+// The ids of the singleton values, stored under `SingletonKeyPrefix`.
+const (
+	paramsID           byte = 0x01
+	chainConfigID      byte = 0x02
+	headerID           byte = 0x03
+	genesisHeaderID    byte = 0x04
+	versionID          byte = 0x05
+	balanceRemainderID byte = 0x06
+	indexedHeightID    byte = 0x07
+	bloomSectionsID    byte = 0x08
+	logsBloomHeightID  byte = 0x09
+	pruneCursorID      byte = 0x0a
+)
+
+var (
+	// ParamsKey is the key of the params of the module.
+	ParamsKey = []byte{SingletonKeyPrefix, paramsID}
+	// ChainConfigKey is the key of the Ethereum chain configuration.
+	ChainConfigKey = []byte{SingletonKeyPrefix, chainConfigID}
+	// HeaderKey is the key of the header of the latest finalized block.
+	HeaderKey = []byte{SingletonKeyPrefix, headerID}
+	// GenesisHeaderKey is the key of the header of the genesis block.
+	GenesisHeaderKey = []byte{SingletonKeyPrefix, genesisHeaderID}
+	// VersionKey is the key of the number of the latest block of the historical data.
+	VersionKey = []byte{SingletonKeyPrefix, versionID}
+	// BalanceRemainderKey is the key of the remainder of the reserve backing the balances.
+	BalanceRemainderKey = []byte{SingletonKeyPrefix, balanceRemainderID}
+	// IndexedHeightKey is the key of the number of the latest block written to the off-chain
+	// database, in which it is stored.
+	IndexedHeightKey = []byte{SingletonKeyPrefix, indexedHeightID}
+	// BloomSectionsKey is the key of the number of sections of the bloom bits index, in the
+	// off-chain database in which it is stored.
+	BloomSectionsKey = []byte{SingletonKeyPrefix, bloomSectionsID}
+	// LogsBloomHeightKey is the key of the number of the first block whose header commits to the
+	// logs bloom of its receipts. The headers of the blocks before it have an empty logs bloom.
+	LogsBloomHeightKey = []byte{SingletonKeyPrefix, logsBloomHeightID}
+	// PruneCursorKey is the key of the storage slot from which the pruning of the stale storage
+	// of a destroyed account resumes in the next block.
+	PruneCursorKey = []byte{SingletonKeyPrefix, pruneCursorID}
+)
+
+// Namespaces is the registry of the namespaces of the x/evm store, keyed by their byte. As it is
+// a map literal, registering two namespaces under the same byte does not compile.
+var Namespaces = map[byte]string{
+	SingletonKeyPrefix:           "singleton",
+	CodeKeyPrefix:                "code",
+	CodeHashKeyPrefix:            "code hash",
+	BalanceKeyPrefix:             "balance",
+	StorageKeyPrefix:             "storage",
+	StorageGenerationKeyPrefix:   "storage generation",
+	StorageTombstoneKeyPrefix:    "storage tombstone",
+	HeaderHashKeyPrefix:          "header hash",
+	CoinbaseKeyPrefix:            "coinbase",
+	CanonicalHashKeyPrefix:       "canonical hash",
+	BlockHashKeyToNumPrefix:      "block number",
+	BlockNumKeyToBlockPrefix:     "block",
+	BlockNumKeyToMetadataPrefix:  "block metadata",
+	BlockHashKeyToReceiptsPrefix: "receipts",
+	TxHashKeyToTxPrefix:          "transaction",
+	ContractCreationKeyPrefix:    "contract creation",
+	CallTracesKeyPrefix:          "call traces",
+	CosmosTxHashKeyPrefix:        "cosmos tx hash",
+	EthTxHashKeyPrefix:           "ethereum tx hash",
+	BloomBitsKeyPrefix:           "bloom bits",
+}
+
+// Singletons is the registry of the singleton values, keyed by their id.
+var Singletons = map[byte]string{
+	paramsID:           "params",
+	chainConfigID:      "chain config",
+	headerID:           "header",
+	genesisHeaderID:    "genesis header",
+	versionID:          "version",
+	balanceRemainderID: "balance remainder",
+	indexedHeightID:    "indexed height",
+	bloomSectionsID:    "bloom sections",
+	logsBloomHeightID:  "logs bloom height",
+	pruneCursorID:      "prune cursor",
+}
+
+// The namespaces of the layout of the store at consensus version 1, the layout of the released
+// versions, in which every namespace was a single byte enumerated in declaration order. They are
+// only used to migrate the store and to read the state and the headers at the heights prior to
+// the migration.
 const (
-	CodeKeyPrefix byte = iota
-	BalanceKeyPrefix
-	StorageKeyPrefix
-	CodeHashKeyPrefix
-	BlockHashKeyToNumPrefix
-	BlockNumKeyToBlockPrefix
-	BlockHashKeyToReceiptsPrefix
-	TxHashKeyToTxPrefix
-	VersionKey
-	HeaderKey
-	GenesisHeaderKey
-	ParamsKey
-	ChainConfigPrefix
+	LegacyCodeKeyPrefix byte = iota
+	LegacyBalanceKeyPrefix
+	LegacyStorageKeyPrefix
+	LegacyCodeHashKeyPrefix
+	LegacyBlockHashKeyToNumPrefix
+	LegacyBlockNumKeyToBlockPrefix
+	LegacyBlockHashKeyToReceiptsPrefix
+	LegacyTxHashKeyToTxPrefix
+	LegacyVersionKey
+	LegacyHeaderKey
+	LegacyGenesisHeaderKey
+	_ // the params were never written under the legacy layout.
+	LegacyChainConfigPrefix
 )
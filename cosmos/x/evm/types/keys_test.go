@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types_test
+
+import (
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keys", func() {
+	It("should not reuse a namespace of the legacy layout", func() {
+		for ns, name := range types.Namespaces {
+			Expect(ns).To(BeNumerically(">", types.LegacyChainConfigPrefix), name)
+		}
+	})
+
+	It("should keep every singleton under its own key", func() {
+		keys := [][]byte{
+			types.ParamsKey, types.ChainConfigKey, types.HeaderKey,
+			types.GenesisHeaderKey, types.VersionKey, types.BalanceRemainderKey,
+			types.IndexedHeightKey, types.BloomSectionsKey, types.LogsBloomHeightKey,
+			types.PruneCursorKey,
+		}
+		Expect(keys).To(HaveLen(len(types.Singletons)))
+		seen := make(map[string]struct{})
+		for _, key := range keys {
+			Expect(key).To(HaveLen(2))
+			Expect(key[0]).To(Equal(types.SingletonKeyPrefix))
+			Expect(types.Singletons).To(HaveKey(key[1]))
+			seen[string(key)] = struct{}{}
+		}
+		Expect(seen).To(HaveLen(len(keys)))
+	})
+})
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+var (
+	// ErrPrecompilePaused is returned when a paused precompiled contract is called.
+	ErrPrecompilePaused = errors.New("precompile is paused")
+	// ErrPrecompileCallerNotAllowed is returned when the policy of a precompiled contract does not
+	// allow its caller.
+	ErrPrecompileCallerNotAllowed = errors.New("caller is not allowed to call the precompile")
+)
+
+// DefaultParams returns the default parameters of the x/evm module, which burn the base fee.
+func DefaultParams() *Params {
+	return &Params{
+		BaseFeeDisposition: BaseFeeDisposition_BASE_FEE_DISPOSITION_BURN,
+	}
+}
+
+// Validate ensures that the parameters are valid.
+func (p *Params) Validate() error {
+	// The EVM interpreter enforces the Ethereum init code limit itself, so it can only be lowered.
+	if p.MaxInitCodeSize > params.MaxInitCodeSize {
+		return fmt.Errorf(
+			"max init code size %d exceeds the EVM limit of %d", p.MaxInitCodeSize, params.MaxInitCodeSize,
+		)
+	}
+
+	if err := validatePrecompilePolicies(p.PrecompilePolicies); err != nil {
+		return err
+	}
+
+	switch p.BaseFeeDisposition {
+	case BaseFeeDisposition_BASE_FEE_DISPOSITION_BURN,
+		BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL:
+		return nil
+	case BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY:
+		if !common.IsHexAddress(p.Treasury) {
+			return errors.New("treasury is not a valid hex address")
+		}
+		return nil
+	default:
+		return errors.New("unknown base fee disposition")
+	}
+}
+
+// BaseFeeRecipient returns the treasury that receives the base fee, or nil if it is not sent to
+// the treasury.
+func (p *Params) BaseFeeRecipient() *common.Address {
+	if p.BaseFeeDisposition != BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY {
+		return nil
+	}
+	treasury := common.HexToAddress(p.Treasury)
+	return &treasury
+}
+
+// InitCodeSizeLimit returns the maximum size of the init code of a contract creation transaction.
+func (p *Params) InitCodeSizeLimit() int {
+	if p.MaxInitCodeSize == 0 {
+		return params.MaxInitCodeSize
+	}
+	return int(p.MaxInitCodeSize)
+}
+
+// PrecompilePolicy returns the policy of the precompiled contract at the given address, or nil if
+// its callers are not restricted.
+func (p *Params) PrecompilePolicy(addr common.Address) *PrecompilePolicy {
+	for _, policy := range p.PrecompilePolicies {
+		if common.HexToAddress(policy.Address) == addr {
+			return policy
+		}
+	}
+	return nil
+}
+
+// Authorize returns an error if the policy does not allow the caller to call the precompiled
+// contract. isOrigin is whether the caller is the account that sent the transaction.
+func (pp *PrecompilePolicy) Authorize(caller common.Address, isOrigin bool) error {
+	if pp.Paused {
+		return ErrPrecompilePaused
+	}
+	// Calls made directly by the sender of the transaction are only restricted by the pause.
+	if isOrigin {
+		return nil
+	}
+	if pp.EoaOnly {
+		return ErrPrecompileCallerNotAllowed
+	}
+	if len(pp.AllowedCallers) == 0 {
+		return nil
+	}
+	for _, allowed := range pp.AllowedCallers {
+		if common.HexToAddress(allowed) == caller {
+			return nil
+		}
+	}
+	return ErrPrecompileCallerNotAllowed
+}
+
+// validatePrecompilePolicies ensures that every policy names a distinct precompile by a valid
+// address and that its restrictions are consistent.
+func validatePrecompilePolicies(policies []*PrecompilePolicy) error {
+	seen := make(map[common.Address]struct{}, len(policies))
+	for _, policy := range policies {
+		if policy == nil {
+			return errors.New("precompile policy cannot be nil")
+		}
+		if !common.IsHexAddress(policy.Address) {
+			return fmt.Errorf("precompile policy address %q is not a valid hex address", policy.Address)
+		}
+		addr := common.HexToAddress(policy.Address)
+		if _, found := seen[addr]; found {
+			return fmt.Errorf("duplicate precompile policy for %s", addr.Hex())
+		}
+		seen[addr] = struct{}{}
+
+		if policy.EoaOnly && len(policy.AllowedCallers) > 0 {
+			return fmt.Errorf(
+				"precompile policy for %s cannot both be EOA only and allow callers", addr.Hex(),
+			)
+		}
+		for _, caller := range policy.AllowedCallers {
+			if !common.IsHexAddress(caller) {
+				return fmt.Errorf("allowed caller %q is not a valid hex address", caller)
+			}
+		}
+	}
+	return nil
+}
+
+// MsgUpdateParams defines a Cosmos SDK message for updating the x/evm parameters.
+var _ sdk.Msg = (*MsgUpdateParams)(nil)
+
+// GetSigners returns the address(es) that must sign over the message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic ensures that the authority address and the parameters are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+	if m.Params == nil {
+		return errors.New("params must be supplied")
+	}
+	return m.Params.Validate()
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"pkg.berachain.dev/polaris/cosmos/x/evm/types"
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Params", func() {
+	treasury := common.BytesToAddress([]byte{0x01})
+
+	It("should burn the base fee by default", func() {
+		params := types.DefaultParams()
+		Expect(params.Validate()).To(Succeed())
+		Expect(params.BaseFeeRecipient()).To(BeNil())
+	})
+
+	It("should send the base fee to the treasury", func() {
+		params := &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY,
+			Treasury:           treasury.Hex(),
+		}
+		Expect(params.Validate()).To(Succeed())
+		Expect(params.BaseFeeRecipient()).To(Equal(&treasury))
+	})
+
+	It("should require a valid treasury", func() {
+		params := &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_TREASURY,
+			Treasury:           "not an address",
+		}
+		Expect(params.Validate()).ToNot(Succeed())
+	})
+
+	It("should send the base fee to the community pool", func() {
+		params := &types.Params{
+			BaseFeeDisposition: types.BaseFeeDisposition_BASE_FEE_DISPOSITION_COMMUNITY_POOL,
+		}
+		Expect(params.Validate()).To(Succeed())
+		Expect(params.BaseFeeRecipient()).To(BeNil())
+	})
+
+	It("should reject an unknown disposition", func() {
+		params := &types.Params{BaseFeeDisposition: types.BaseFeeDisposition(42)}
+		Expect(params.Validate()).ToNot(Succeed())
+	})
+
+	It("should default to the Ethereum init code limit", func() {
+		Expect(types.DefaultParams().InitCodeSizeLimit()).To(Equal(49152))
+	})
+
+	It("should lower the init code limit", func() {
+		params := &types.Params{MaxInitCodeSize: 1024}
+		Expect(params.Validate()).To(Succeed())
+		Expect(params.InitCodeSizeLimit()).To(Equal(1024))
+	})
+
+	It("should not raise the init code limit above the EVM limit", func() {
+		params := &types.Params{MaxInitCodeSize: 49153}
+		Expect(params.Validate()).ToNot(Succeed())
+	})
+
+	When("restricting precompile callers", func() {
+		precompile := common.BytesToAddress([]byte{0x09})
+		contract := common.BytesToAddress([]byte{0x0a})
+
+		It("should find the policy of a precompile", func() {
+			params := &types.Params{PrecompilePolicies: []*types.PrecompilePolicy{
+				{Address: precompile.Hex(), Paused: true},
+			}}
+			Expect(params.Validate()).To(Succeed())
+			Expect(params.PrecompilePolicy(precompile)).ToNot(BeNil())
+			Expect(params.PrecompilePolicy(contract)).To(BeNil())
+		})
+
+		It("should reject invalid policies", func() {
+			for _, policies := range [][]*types.PrecompilePolicy{
+				{{Address: "not an address"}},
+				{{Address: precompile.Hex()}, {Address: precompile.Hex()}},
+				{{Address: precompile.Hex(), EoaOnly: true, AllowedCallers: []string{contract.Hex()}}},
+				{{Address: precompile.Hex(), AllowedCallers: []string{"not an address"}}},
+			} {
+				params := &types.Params{PrecompilePolicies: policies}
+				Expect(params.Validate()).ToNot(Succeed())
+			}
+		})
+
+		It("should reject all callers of a paused precompile", func() {
+			policy := &types.PrecompilePolicy{Address: precompile.Hex(), Paused: true}
+			Expect(policy.Authorize(treasury, true)).To(MatchError(types.ErrPrecompilePaused))
+			Expect(policy.Authorize(contract, false)).To(MatchError(types.ErrPrecompilePaused))
+		})
+
+		It("should only allow the transaction sender of an EOA only precompile", func() {
+			policy := &types.PrecompilePolicy{Address: precompile.Hex(), EoaOnly: true}
+			Expect(policy.Authorize(treasury, true)).To(Succeed())
+			Expect(policy.Authorize(contract, false)).To(
+				MatchError(types.ErrPrecompileCallerNotAllowed),
+			)
+		})
+
+		It("should only allow the listed contracts", func() {
+			policy := &types.PrecompilePolicy{
+				Address:        precompile.Hex(),
+				AllowedCallers: []string{contract.Hex()},
+			}
+			Expect(policy.Authorize(treasury, true)).To(Succeed())
+			Expect(policy.Authorize(contract, false)).To(Succeed())
+			Expect(policy.Authorize(treasury, false)).To(
+				MatchError(types.ErrPrecompileCallerNotAllowed),
+			)
+		})
+	})
+})
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrGenesisMismatch is returned by the startup self-check when the genesis of the node does
+	// not match the one the chain was started from.
+	ErrGenesisMismatch = errors.New("genesis does not match the chain")
+	// ErrHeightMismatch is returned by the startup self-check when the latest block of the evm
+	// store is not at the height of the app.
+	ErrHeightMismatch = errors.New("evm store is not at the app height")
+	// ErrIndexBehind is returned by the startup self-check when blocks of the off-chain database
+	// are missing, which would not be served.
+	ErrIndexBehind = errors.New("off-chain database is behind the app")
+)
+
+// Severity is the severity of a finding of the startup self-check.
+type Severity uint8
+
+const (
+	// SeverityWarning is the severity of a finding that is logged, the node still starts.
+	SeverityWarning Severity = iota
+	// SeverityFatal is the severity of a finding that would make the node serve wrong data, the
+	// node refuses to start unless the self-check only warns.
+	SeverityFatal
+)
+
+// Diagnostic is a finding of the startup self-check, or of the integrity check of the stored
+// blocks.
+type Diagnostic struct {
+	// Check is the name of the check that found it.
+	Check    string
+	Severity Severity
+	Err      error
+}
+
+// Warning returns a diagnostic of the given check that is only logged.
+func Warning(check string, err error) Diagnostic {
+	return Diagnostic{Check: check, Severity: SeverityWarning, Err: err}
+}
+
+// Fatal returns a diagnostic of the given check that prevents the node from starting.
+func Fatal(check string, err error) Diagnostic {
+	return Diagnostic{Check: check, Severity: SeverityFatal, Err: err}
+}
+
+// Diagnostics are the findings of the startup self-check, or of the integrity check of the stored
+// blocks.
+type Diagnostics []Diagnostic
+
+// Err returns the fatal findings joined in a single error, or nil if there are none.
+func (ds Diagnostics) Err() error {
+	var errs []error
+	for _, d := range ds {
+		if d.Severity == SeverityFatal {
+			errs = append(errs, fmt.Errorf("%s: %w", d.Check, d.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -38,7 +38,7 @@ var _ sdk.Msg = (*WrappedEthereumTransaction)(nil)
 
 // NewFromTransaction sets the transaction data from an `coretypes.Transaction`.
 func NewFromTransaction(tx *coretypes.Transaction) *WrappedEthereumTransaction {
-	bz, err := tx.MarshalBinary()
+	bz, err := coretypes.MarshalTx(tx)
 	if err != nil {
 		panic(err)
 	}
@@ -59,8 +59,8 @@ func (etr *WrappedEthereumTransaction) GetSigners() []sdk.AccAddress {
 
 // AsTransaction extracts the transaction as an `coretypes.Transaction`.
 func (etr *WrappedEthereumTransaction) AsTransaction() *coretypes.Transaction {
-	tx := new(coretypes.Transaction)
-	if err := tx.UnmarshalBinary(etr.Data); err != nil {
+	tx, err := coretypes.UnmarshalTx(etr.Data)
+	if err != nil {
 		return nil
 	}
 	return tx
@@ -69,29 +69,24 @@ func (etr *WrappedEthereumTransaction) AsTransaction() *coretypes.Transaction {
 // GetSignBytes returns the bytes to sign over for the transaction.
 func (etr *WrappedEthereumTransaction) GetSignBytes() ([]byte, error) {
 	tx := etr.AsTransaction()
-	return coretypes.LatestSignerForChainID(tx.ChainId()).
-		Hash(tx).Bytes(), nil
+	return coretypes.SignerForTx(tx).Hash(tx).Bytes(), nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetSender() (common.Address, error) {
-	tx := etr.AsTransaction()
-	signer := coretypes.LatestSignerForChainID(tx.ChainId())
-	return signer.Sender(tx)
+	return coretypes.SenderOf(etr.AsTransaction())
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetPubKey() ([]byte, error) {
 	tx := etr.AsTransaction()
-	signer := coretypes.LatestSignerForChainID(tx.ChainId())
-	return signer.PubKey(tx)
+	return coretypes.SignerForTx(tx).PubKey(tx)
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetSignature() ([]byte, error) {
 	tx := etr.AsTransaction()
-	signer := coretypes.LatestSignerForChainID(tx.ChainId())
-	return signer.Signature(tx)
+	return coretypes.SignerForTx(tx).Signature(tx)
 }
 
 // GetGas returns the gas limit of the transaction.
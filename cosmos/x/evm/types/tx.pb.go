@@ -147,38 +147,279 @@ func (m *WrappedEthereumTransactionResult) GetReturnData() []byte {
 	return nil
 }
 
+// ExtensionOptionsEthereumTx is the extension option attached to every Cosmos transaction that
+// wraps an Ethereum transaction. It allows Cosmos tooling (block explorers, indexers, etc.) to
+// identify Ethereum transactions and is used by the ante handler to route them.
+type ExtensionOptionsEthereumTx struct {
+}
+
+func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
+func (m *ExtensionOptionsEthereumTx) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionsEthereumTx) ProtoMessage()    {}
+func (*ExtensionOptionsEthereumTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{2}
+}
+func (m *ExtensionOptionsEthereumTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionsEthereumTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionsEthereumTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionsEthereumTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionsEthereumTx.Merge(m, src)
+}
+func (m *ExtensionOptionsEthereumTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionsEthereumTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionsEthereumTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionsEthereumTx proto.InternalMessageInfo
+
+// MsgRegisterCoinbase registers the EVM address used as the coinbase of the blocks proposed by a
+// validator. The coinbase receives the priority fees of the transactions in those blocks.
+type MsgRegisterCoinbase struct {
+	// operator is the account address of the validator operator.
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// coinbase is the hex encoded EVM address to use as the coinbase.
+	Coinbase string `protobuf:"bytes,2,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+}
+
+func (m *MsgRegisterCoinbase) Reset()         { *m = MsgRegisterCoinbase{} }
+func (m *MsgRegisterCoinbase) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCoinbase) ProtoMessage()    {}
+func (*MsgRegisterCoinbase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{3}
+}
+func (m *MsgRegisterCoinbase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCoinbase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCoinbase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCoinbase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCoinbase.Merge(m, src)
+}
+func (m *MsgRegisterCoinbase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCoinbase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCoinbase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCoinbase proto.InternalMessageInfo
+
+func (m *MsgRegisterCoinbase) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgRegisterCoinbase) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+// MsgRegisterCoinbaseResponse defines the Msg/RegisterCoinbase response type.
+type MsgRegisterCoinbaseResponse struct {
+}
+
+func (m *MsgRegisterCoinbaseResponse) Reset()         { *m = MsgRegisterCoinbaseResponse{} }
+func (m *MsgRegisterCoinbaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCoinbaseResponse) ProtoMessage()    {}
+func (*MsgRegisterCoinbaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{4}
+}
+func (m *MsgRegisterCoinbaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCoinbaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCoinbaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCoinbaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCoinbaseResponse.Merge(m, src)
+}
+func (m *MsgRegisterCoinbaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCoinbaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCoinbaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCoinbaseResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the parameters of the x/evm module.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/evm parameters to update. All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{5}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8b33d2a2c64400f, []int{6}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "polaris.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "polaris.evm.v1alpha1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*MsgRegisterCoinbase)(nil), "polaris.evm.v1alpha1.MsgRegisterCoinbase")
+	proto.RegisterType((*MsgRegisterCoinbaseResponse)(nil), "polaris.evm.v1alpha1.MsgRegisterCoinbaseResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "polaris.evm.v1alpha1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "polaris.evm.v1alpha1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("polaris/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
 
 var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbd, 0x4e, 0xe3, 0x40,
-	0x14, 0x85, 0x33, 0xbb, 0xd9, 0xcd, 0xee, 0xec, 0x6a, 0x0b, 0xef, 0x6a, 0x09, 0x46, 0x98, 0x28,
-	0x55, 0x14, 0x21, 0x9b, 0x10, 0x89, 0x82, 0x92, 0x90, 0x74, 0x34, 0x06, 0x84, 0x44, 0x63, 0xdd,
-	0xd8, 0x17, 0xdb, 0x4a, 0xfc, 0xa3, 0x99, 0xf1, 0xc8, 0xa1, 0x42, 0x79, 0x01, 0x78, 0x94, 0x3c,
-	0x06, 0x65, 0x4a, 0x4a, 0x94, 0x14, 0x79, 0x0d, 0xe4, 0x71, 0x22, 0x51, 0x90, 0x82, 0x6a, 0x7e,
-	0xbe, 0xb9, 0xa3, 0x73, 0xee, 0x3d, 0x74, 0x3f, 0x4d, 0xc6, 0xc0, 0x42, 0x6e, 0xa1, 0x8c, 0x2c,
-	0xd9, 0x81, 0x71, 0x1a, 0x40, 0xc7, 0x12, 0xb9, 0x99, 0xb2, 0x44, 0x24, 0xda, 0xbf, 0x35, 0x36,
-	0x51, 0x46, 0xe6, 0x06, 0xeb, 0x3b, 0x6e, 0xc2, 0xa3, 0x84, 0x5b, 0x11, 0xf7, 0x2d, 0xd9, 0x29,
-	0x96, 0xf2, 0x79, 0x73, 0x4a, 0xa8, 0x7e, 0xc3, 0x20, 0x4d, 0xd1, 0xeb, 0x8b, 0x00, 0x19, 0x66,
-	0xd1, 0x15, 0x83, 0x98, 0x83, 0x2b, 0xc2, 0x24, 0xd6, 0x34, 0x5a, 0xf5, 0x40, 0x40, 0x9d, 0x34,
-	0x48, 0xeb, 0xb7, 0xad, 0xf6, 0x5a, 0x97, 0xfe, 0x0f, 0xc0, 0x1d, 0x4d, 0x9c, 0xbb, 0x30, 0x77,
-	0x5c, 0xc8, 0x38, 0x3a, 0xe5, 0xef, 0xf5, 0x2f, 0x0d, 0xd2, 0xfa, 0x69, 0xff, 0x55, 0x74, 0x10,
-	0xe6, 0xbd, 0x82, 0xf5, 0x14, 0x3a, 0xdd, 0x9b, 0xae, 0x66, 0xed, 0x2d, 0x75, 0xcd, 0x09, 0x6d,
-	0x6c, 0xd7, 0x60, 0x23, 0xcf, 0xc6, 0x42, 0xdb, 0xa5, 0x3f, 0x7c, 0xe0, 0x4e, 0xc6, 0xd1, 0x53,
-	0x6a, 0xaa, 0x76, 0xcd, 0x07, 0x7e, 0xcd, 0xd1, 0x2b, 0x90, 0x8c, 0x1c, 0x64, 0x2c, 0x61, 0x6b,
-	0x09, 0x35, 0x19, 0xf5, 0x8b, 0xa3, 0x76, 0x40, 0x7f, 0x31, 0x14, 0x19, 0x8b, 0x1d, 0x65, 0xe3,
-	0xab, 0xb2, 0x41, 0xcb, 0xab, 0x73, 0x10, 0x70, 0xfc, 0x48, 0x28, 0xbd, 0xe0, 0xfe, 0x25, 0x32,
-	0x19, 0xba, 0xa8, 0xdd, 0xd3, 0x3f, 0x7d, 0x11, 0xbc, 0xef, 0xc0, 0x91, 0xf9, 0x51, 0x43, 0xcd,
-	0xed, 0x7a, 0xf5, 0x93, 0xcf, 0x56, 0x94, 0x0e, 0xf5, 0x6f, 0x0f, 0xab, 0x59, 0x9b, 0x9c, 0x0d,
-	0x9e, 0x17, 0x06, 0x99, 0x2f, 0x0c, 0xf2, 0xba, 0x30, 0xc8, 0xd3, 0xd2, 0xa8, 0xcc, 0x97, 0x46,
-	0xe5, 0x65, 0x69, 0x54, 0x6e, 0x0f, 0xd3, 0x91, 0x6f, 0x0e, 0x91, 0x81, 0x1b, 0x40, 0x18, 0x9b,
-	0x1e, 0x4a, 0x6b, 0x93, 0x85, 0xf5, 0x78, 0x73, 0x15, 0x0a, 0x31, 0x49, 0x91, 0x0f, 0xbf, 0xab,
-	0x01, 0x77, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x4d, 0x7b, 0x17, 0x30, 0x02, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0xfb, 0xf5, 0x6b, 0x9b, 0x69, 0x29, 0xc8, 0x45, 0xb4, 0x75, 0x53, 0x13, 0x2c, 0x21,
+	0x95, 0x0a, 0x6c, 0xd2, 0x22, 0x16, 0x59, 0x12, 0xd2, 0x5d, 0x04, 0x32, 0x54, 0x48, 0x08, 0xc9,
+	0x9a, 0xd8, 0x17, 0xdb, 0x6a, 0xec, 0x19, 0xcd, 0x1d, 0x5b, 0x0e, 0x2b, 0xd4, 0x27, 0xe0, 0x51,
+	0xfa, 0x18, 0x2c, 0xbb, 0x64, 0x89, 0x92, 0x45, 0x5e, 0x03, 0xf9, 0x27, 0x69, 0x01, 0x47, 0x2a,
+	0xab, 0x64, 0xe6, 0x9c, 0x39, 0xe7, 0xcc, 0xdc, 0x7b, 0x4d, 0x0e, 0x38, 0x1b, 0x52, 0x11, 0xa2,
+	0x05, 0x69, 0x64, 0xa5, 0x6d, 0x3a, 0xe4, 0x01, 0x6d, 0x5b, 0x32, 0x33, 0xb9, 0x60, 0x92, 0xa9,
+	0xf7, 0x2b, 0xd8, 0x84, 0x34, 0x32, 0x67, 0xb0, 0xb6, 0xe3, 0x32, 0x8c, 0x18, 0x5a, 0x11, 0xfa,
+	0x56, 0xda, 0xce, 0x7f, 0x4a, 0xba, 0xf6, 0xa8, 0x56, 0x8d, 0x53, 0x41, 0x23, 0x2c, 0x29, 0xc6,
+	0x85, 0x42, 0xb4, 0x0f, 0x82, 0x72, 0x0e, 0x5e, 0x4f, 0x06, 0x20, 0x20, 0x89, 0xde, 0x0b, 0x1a,
+	0x23, 0x75, 0x65, 0xc8, 0x62, 0x55, 0x25, 0x2b, 0x1e, 0x95, 0x74, 0x57, 0x69, 0x29, 0x87, 0x9b,
+	0x76, 0xf1, 0x5f, 0x3d, 0x21, 0x0f, 0x02, 0xea, 0x9e, 0x8f, 0x9c, 0xcf, 0x61, 0xe6, 0xb8, 0x34,
+	0x41, 0x70, 0xca, 0x00, 0xbb, 0xcb, 0x2d, 0xe5, 0xb0, 0x61, 0x6f, 0x17, 0xe8, 0x69, 0x98, 0x75,
+	0x73, 0xac, 0x5b, 0x40, 0x9d, 0xfd, 0x8b, 0xe9, 0xe5, 0xd1, 0x82, 0x73, 0xc6, 0x88, 0xb4, 0x16,
+	0x67, 0xb0, 0x01, 0x93, 0xa1, 0x54, 0xf7, 0xc8, 0xba, 0x4f, 0xd1, 0x49, 0x10, 0xbc, 0x22, 0xcd,
+	0x8a, 0xbd, 0xe6, 0x53, 0x3c, 0x43, 0xf0, 0x72, 0x28, 0x8d, 0x1c, 0x10, 0x82, 0x89, 0x2a, 0xc2,
+	0x5a, 0x1a, 0xf5, 0xf2, 0xa5, 0xfa, 0x90, 0x6c, 0x08, 0x90, 0x89, 0x88, 0x9d, 0xe2, 0x1a, 0xff,
+	0x15, 0xd7, 0x20, 0xe5, 0xd6, 0x6b, 0x2a, 0xa9, 0xd1, 0x24, 0x5a, 0x2f, 0x93, 0x10, 0x63, 0xc8,
+	0xe2, 0x37, 0x3c, 0xf7, 0xc3, 0x79, 0x86, 0xcc, 0xf8, 0x44, 0xb6, 0xfb, 0xe8, 0xdb, 0xe0, 0x87,
+	0x28, 0x41, 0x74, 0x59, 0x18, 0x0f, 0x28, 0x82, 0xaa, 0x91, 0x75, 0xc6, 0x41, 0x50, 0xc9, 0x44,
+	0x91, 0xa5, 0x61, 0xcf, 0xd7, 0x39, 0xe6, 0x56, 0xbc, 0x2a, 0xcc, 0x7c, 0xdd, 0xb9, 0x93, 0x3f,
+	0xc2, 0x9c, 0x6a, 0x1c, 0x90, 0xfd, 0x1a, 0x75, 0x1b, 0x90, 0xb3, 0x18, 0xc1, 0x48, 0xc8, 0xdd,
+	0x3e, 0xfa, 0x67, 0xdc, 0xa3, 0x12, 0xde, 0x16, 0x35, 0x53, 0x9b, 0xa4, 0x41, 0x13, 0x19, 0x30,
+	0x11, 0xca, 0x51, 0xe5, 0x7c, 0xbd, 0xa1, 0xbe, 0x20, 0xab, 0x65, 0x6d, 0x0b, 0xe3, 0x8d, 0xe3,
+	0xa6, 0x59, 0xd7, 0x2e, 0x66, 0xa9, 0x65, 0x57, 0xdc, 0xce, 0x56, 0x1e, 0xea, 0x5a, 0xc5, 0xd8,
+	0x23, 0x3b, 0x7f, 0xd8, 0xce, 0x12, 0x1d, 0x4f, 0x97, 0x09, 0xe9, 0xa3, 0xff, 0x0e, 0x44, 0x1a,
+	0xba, 0xa0, 0x7e, 0x21, 0x5b, 0x3d, 0x19, 0xdc, 0x6c, 0x97, 0xe7, 0xf5, 0x8e, 0x8b, 0x8b, 0xab,
+	0xbd, 0xfc, 0xd7, 0x13, 0x55, 0x3b, 0x70, 0x72, 0xef, 0xaf, 0xb2, 0x3c, 0xa9, 0xd7, 0xaa, 0x79,
+	0x63, 0xad, 0x7d, 0x6b, 0xea, 0xec, 0xf2, 0xaa, 0x47, 0x36, 0x7f, 0xab, 0xc5, 0xe3, 0x85, 0x12,
+	0x37, 0x69, 0xda, 0xb3, 0x5b, 0xd1, 0x66, 0x2e, 0xda, 0xff, 0x5f, 0xa7, 0x97, 0x47, 0xca, 0xab,
+	0xd3, 0xef, 0x63, 0x5d, 0xb9, 0x1a, 0xeb, 0xca, 0xcf, 0xb1, 0xae, 0x7c, 0x9b, 0xe8, 0x4b, 0x57,
+	0x13, 0x7d, 0xe9, 0xc7, 0x44, 0x5f, 0xfa, 0xf8, 0x94, 0x9f, 0xfb, 0xe6, 0x00, 0x04, 0x75, 0x03,
+	0x1a, 0xc6, 0xa6, 0x07, 0xa9, 0x35, 0x9b, 0xf2, 0xea, 0x33, 0x90, 0x15, 0xe3, 0x2e, 0x47, 0x1c,
+	0x70, 0xb0, 0x5a, 0x4c, 0xf9, 0xc9, 0x2f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x2e, 0xb7,
+	0x28, 0xd7, 0x58, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +436,11 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// RegisterCoinbase defines a method for a validator operator to register the EVM address used
+	// as the coinbase of the blocks proposed by its validator.
+	RegisterCoinbase(ctx context.Context, in *MsgRegisterCoinbase, opts ...grpc.CallOption) (*MsgRegisterCoinbaseResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgServiceClient struct {
@@ -214,10 +460,33 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
+func (c *msgServiceClient) RegisterCoinbase(ctx context.Context, in *MsgRegisterCoinbase, opts ...grpc.CallOption) (*MsgRegisterCoinbaseResponse, error) {
+	out := new(MsgRegisterCoinbaseResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/RegisterCoinbase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/polaris.evm.v1alpha1.MsgService/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// RegisterCoinbase defines a method for a validator operator to register the EVM address used
+	// as the coinbase of the blocks proposed by its validator.
+	RegisterCoinbase(context.Context, *MsgRegisterCoinbase) (*MsgRegisterCoinbaseResponse, error)
+	// UpdateParams defines a governance operation for updating the x/evm module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -227,6 +496,12 @@ type UnimplementedMsgServiceServer struct {
 func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
 }
+func (*UnimplementedMsgServiceServer) RegisterCoinbase(ctx context.Context, req *MsgRegisterCoinbase) (*MsgRegisterCoinbaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCoinbase not implemented")
+}
+func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -250,6 +525,42 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_RegisterCoinbase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCoinbase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).RegisterCoinbase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/RegisterCoinbase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).RegisterCoinbase(ctx, req.(*MsgRegisterCoinbase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polaris.evm.v1alpha1.MsgService/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "polaris.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -258,6 +569,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "RegisterCoinbase",
+			Handler:    _MsgService_RegisterCoinbase_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _MsgService_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "polaris/evm/v1alpha1/tx.proto",
@@ -339,64 +658,574 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 		i--
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionsEthereumTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCoinbase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCoinbase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCoinbase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coinbase) > 0 {
+		i -= len(m.Coinbase)
+		copy(dAtA[i:], m.Coinbase)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Coinbase)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCoinbaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCoinbaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCoinbaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WrappedEthereumTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HackyFixCauseCosmos)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedEthereumTransactionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCoinbase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Coinbase)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCoinbaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HackyFixCauseCosmos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HackyFixCauseCosmos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *WrappedEthereumTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HackyFixCauseCosmos)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *WrappedEthereumTransactionResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReturnData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCoinbase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -419,17 +1248,17 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCoinbase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCoinbase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -439,29 +1268,27 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HackyFixCauseCosmos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coinbase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -489,7 +1316,7 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HackyFixCauseCosmos = string(dAtA[iNdEx:postIndex])
+			m.Coinbase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -512,7 +1339,7 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCoinbaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,34 +1362,65 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCoinbaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCoinbaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 2:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -590,13 +1448,13 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -606,24 +1464,26 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ReturnData == nil {
-				m.ReturnData = []byte{}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -647,6 +1507,56 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
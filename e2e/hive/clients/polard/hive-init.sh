@@ -106,5 +106,8 @@ if [[ $1 == "pending" ]]; then
     echo "pending mode is on, please wait for the first block committed."
 fi
 
+# Forward the IPC socket of the node to TCP, as the simulators run in another container
+socat TCP-LISTEN:8548,fork,reuseaddr UNIX-CONNECT:"$HOMEDIR"/data/polaris/polaris.ipc &
+
 # Start the node (remove the --pruning=nothing flag if historical queries are not needed)m
 polard start --pruning=nothing "$TRACE" --log_level $LOGLEVEL --api.enabled-unsafe-cors --api.enable --api.swagger --minimum-gas-prices=0.0001abera --home "$HOMEDIR"
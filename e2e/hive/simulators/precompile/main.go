@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/ethereum/hive/hivesim"
+)
+
+// check is a contract from contracts/src/cosmos/precompile/testing/PrecompileChecks.sol that
+// exercises a single precompile. Its run function is either called or, if it changes state,
+// sent as a transaction with the given value.
+type check struct {
+	Name     string
+	Contract string
+	Send     bool
+	Value    string
+}
+
+var (
+	// This is the account funded in init/genesis.json that deploys and calls the checks.
+	senderKey = "0x63b508a03c3b5937ceb903af8b1b0c191012ef6eb7e9c3fb7afa94e5d214d376"
+
+	checksPath = "src/cosmos/precompile/testing/PrecompileChecks.sol"
+
+	files = map[string]string{
+		"/genesis.json": "./init/genesis.json",
+	}
+
+	clientEnv = hivesim.Params{
+		"HIVE_NETWORK_ID": "7",
+		"HIVE_CHAIN_ID":   "7",
+	}
+)
+
+var checks = []check{
+	{Name: "auth", Contract: "AuthCheck"},
+	{Name: "bank", Contract: "BankCheck", Send: true, Value: "1gwei"},
+	{Name: "distribution", Contract: "DistributionCheck", Send: true},
+	{Name: "erc20", Contract: "ERC20Check"},
+	{Name: "governance", Contract: "GovernanceCheck"},
+	{Name: "mint", Contract: "MintCheck"},
+	{Name: "slashing", Contract: "SlashingCheck"},
+	{Name: "staking", Contract: "StakingCheck"},
+}
+
+func main() {
+	suite := hivesim.Suite{
+		Name: "precompile",
+		Description: `The precompile test suite deploys the Solidity checks in the contracts
+directory with Foundry and runs each of them against the stateful precompiles of a running
+node.`[1:],
+	}
+
+	suite.Add(&hivesim.ClientTestSpec{
+		Role:        "eth1",
+		Name:        "client launch",
+		Description: `This test launches the client and runs every precompile check against it.`,
+		Parameters:  clientEnv,
+		Files:       files,
+		Run:         runAllChecks,
+		AlwaysRun:   true,
+	})
+
+	sim := hivesim.New()
+	hivesim.MustRunSuite(sim, suite)
+}
+
+// runAllChecks runs the checks one after another, since they are all sent from the same
+// account and would otherwise race on its nonce.
+func runAllChecks(t *hivesim.T, c *hivesim.Client) {
+	rpcURL := fmt.Sprintf("http://%v:8545/", c.IP)
+
+	// wait for blocks...
+	time.Sleep(3 * time.Second) //nolint:gomnd // it's okay.
+	for _, chk := range checks {
+		chk := chk
+		t.Run(hivesim.TestSpec{
+			Name:        fmt.Sprintf("%s (%s)", chk.Name, c.Type),
+			Description: fmt.Sprintf("Deploys %s and runs it against the node.", chk.Contract),
+			Run:         func(t *hivesim.T) { runCheck(t, rpcURL, chk) },
+		})
+	}
+}
+
+// runCheck deploys the check contract and executes its run function.
+func runCheck(t *hivesim.T, rpcURL string, chk check) {
+	out, err := foundry(t, "forge", "create", checksPath+":"+chk.Contract,
+		"--rpc-url", rpcURL, "--private-key", senderKey, "--json")
+	if err != nil {
+		t.Fatalf("could not deploy %s: %v", chk.Contract, err)
+	}
+	var deployed struct {
+		DeployedTo string `json:"deployedTo"`
+	}
+	if err = json.Unmarshal(lastLine(out), &deployed); err != nil {
+		t.Fatalf("could not decode deployment of %s: %v", chk.Contract, err)
+	}
+	t.Logf("%s deployed to %s", chk.Contract, deployed.DeployedTo)
+
+	if !chk.Send {
+		if _, err = foundry(t, "cast", "call", deployed.DeployedTo, "run()",
+			"--rpc-url", rpcURL, "--from", senderAddress(t)); err != nil {
+			t.Fatalf("%s failed: %v", chk.Contract, err)
+		}
+		return
+	}
+
+	args := []string{"send", deployed.DeployedTo, "run()",
+		"--rpc-url", rpcURL, "--private-key", senderKey, "--json"}
+	if chk.Value != "" {
+		args = append(args, "--value", chk.Value)
+	}
+	if out, err = foundry(t, "cast", args...); err != nil {
+		t.Fatalf("%s failed: %v", chk.Contract, err)
+	}
+	var receipt struct {
+		Status string `json:"status"`
+	}
+	if err = json.Unmarshal(lastLine(out), &receipt); err != nil {
+		t.Fatalf("could not decode receipt of %s: %v", chk.Contract, err)
+	}
+	if receipt.Status != "0x1" {
+		t.Fatalf("%s reverted, receipt status %s", chk.Contract, receipt.Status)
+	}
+}
+
+// senderAddress returns the address of senderKey.
+func senderAddress(t *hivesim.T) string {
+	out, err := foundry(t, "cast", "wallet", "address", "--private-key", senderKey)
+	if err != nil {
+		t.Fatalf("could not derive sender address: %v", err)
+	}
+	return string(lastLine(out))
+}
+
+// foundry runs a Foundry command from the contracts directory and returns its output.
+func foundry(t *hivesim.T, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...) //#nosec:G204 // the commands are fixed by the simulator.
+	cmd.Dir = "./contracts"
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Logf("%s %s:\n%s", name, strings.Join(args, " "), out)
+	}
+	return out, err
+}
+
+// lastLine returns the last non-empty line of a command's output, which is where Foundry
+// prints its result after any compilation progress.
+func lastLine(out []byte) []byte {
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return []byte(strings.TrimSpace(lines[len(lines)-1]))
+}
@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ethereum/hive/hivesim"
+)
+
+// The capture mode of the HTTP tests is configured through the environment of the simulator:
+//
+//   - HIVE_RPC_CAPTURE selects the format of the captured requests. It is either "har" for an
+//     HTTP Archive 1.2 file, which most HTTP tools can import and replay, or "json" for a plain
+//     list of request and response pairs. Capturing is disabled if it is unset.
+//   - HIVE_RPC_CAPTURE_DIR is the directory the artifacts of failed tests are written to. If it
+//     is unset, the artifact is written to the test output instead.
+const (
+	captureModeEnv = "HIVE_RPC_CAPTURE"
+	captureDirEnv  = "HIVE_RPC_CAPTURE_DIR"
+
+	captureHAR  = "har"
+	captureJSON = "json"
+)
+
+// capture records the request and response pairs sent by a loggingRoundTrip. A nil capture
+// records nothing.
+type capture struct {
+	mode string
+	dir  string
+
+	mu      sync.Mutex
+	entries []captureEntry
+}
+
+// captureEntry is a single captured request and its response.
+type captureEntry struct {
+	Started        time.Time     `json:"started"`
+	Duration       time.Duration `json:"duration"`
+	Method         string        `json:"method"`
+	URL            string        `json:"url"`
+	Proto          string        `json:"proto"`
+	RequestHeader  http.Header   `json:"requestHeader"`
+	Request        []byte        `json:"request"`
+	Status         int           `json:"status"`
+	ResponseHeader http.Header   `json:"responseHeader"`
+	Response       []byte        `json:"response"`
+}
+
+// newCapture returns a capture in the mode configured in the environment, or nil if capturing
+// is disabled.
+func newCapture() *capture {
+	mode := os.Getenv(captureModeEnv)
+	switch mode {
+	case captureHAR, captureJSON:
+		return &capture{mode: mode, dir: os.Getenv(captureDirEnv)}
+	case "":
+		return nil
+	default:
+		panic(fmt.Sprintf("unknown %s %q, expected %q or %q",
+			captureModeEnv, mode, captureHAR, captureJSON))
+	}
+}
+
+// record adds an entry to the capture.
+func (c *capture) record(entry captureEntry) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, entry)
+}
+
+// save writes the captured entries of a failed test as an artifact, so that the requests can
+// be replayed against a local node. Captures of passing tests are discarded.
+func (c *capture) save(t *hivesim.T) {
+	if c == nil || !t.Failed() {
+		return
+	}
+	data, err := c.export()
+	if err != nil {
+		t.Logf("could not export captured requests: %v", err)
+		return
+	}
+
+	if c.dir == "" {
+		t.Logf("captured requests (%s):\n%s", c.mode, data)
+		return
+	}
+	path := filepath.Join(c.dir, fmt.Sprintf("suite%d-test%d.%s", t.SuiteID, t.TestID, c.mode))
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		t.Logf("could not write captured requests to %s: %v", path, err)
+		return
+	}
+	t.Logf("captured %d requests to %s", len(c.entries), path)
+}
+
+// export encodes the captured entries in the format of the capture mode.
+func (c *capture) export() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sort.SliceStable(c.entries, func(i, j int) bool {
+		return c.entries[i].Started.Before(c.entries[j].Started)
+	})
+
+	if c.mode == captureJSON {
+		return json.MarshalIndent(c.entries, "", "  ")
+	}
+	archive := har{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "polaris-hive-rpc", Version: "1.0"},
+		Entries: make([]harEntry, len(c.entries)),
+	}}
+	for i, entry := range c.entries {
+		archive.Log.Entries[i] = entry.har()
+	}
+	return json.MarshalIndent(archive, "", "  ")
+}
+
+// har converts the entry to an HTTP Archive entry. Bodies that are not valid UTF-8, such as
+// compressed ones, are base64 encoded.
+func (e *captureEntry) har() harEntry {
+	ms := float64(e.Duration) / float64(time.Millisecond)
+	reqText, reqEncoding := harText(e.Request)
+	respText, respEncoding := harText(e.Response)
+	return harEntry{
+		StartedDateTime: e.Started.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      e.Method,
+			URL:         e.URL,
+			HTTPVersion: e.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(e.RequestHeader),
+			QueryString: []harNameValue{},
+			PostData: &harPostData{
+				MimeType: e.RequestHeader.Get("Content-Type"),
+				Text:     reqText,
+				Comment:  reqEncoding,
+			},
+			HeadersSize: -1,
+			BodySize:    len(e.Request),
+		},
+		Response: harResponse{
+			Status:      e.Status,
+			StatusText:  http.StatusText(e.Status),
+			HTTPVersion: e.Proto,
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(e.ResponseHeader),
+			Content: harContent{
+				Size:     len(e.Response),
+				MimeType: e.ResponseHeader.Get("Content-Type"),
+				Text:     respText,
+				Encoding: respEncoding,
+			},
+			HeadersSize: -1,
+			BodySize:    len(e.Response),
+		},
+		Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+	}
+}
+
+// harText returns the body as HAR text along with its encoding, if any.
+func harText(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+// harHeaders returns the headers as sorted HAR name and value pairs.
+func harHeaders(header http.Header) []harNameValue {
+	headers := make([]harNameValue, 0, len(header))
+	for name, values := range header {
+		for _, value := range values {
+			headers = append(headers, harNameValue{Name: name, Value: value})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
+
+// The types below are the subset of the HTTP Archive 1.2 format written by the capture.
+type (
+	har struct {
+		Log harLog `json:"log"`
+	}
+
+	harLog struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+	}
+
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+	}
+
+	harRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		QueryString []harNameValue `json:"queryString"`
+		PostData    *harPostData   `json:"postData,omitempty"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	harResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		Content     harContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	harPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+		Comment  string `json:"comment,omitempty"`
+	}
+
+	harContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+		Encoding string `json:"encoding,omitempty"`
+	}
+
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
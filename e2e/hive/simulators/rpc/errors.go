@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// JSON-RPC 2.0 error codes, as defined by the specification.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// codeTxRejected is the error code of the transactions rejected by the node, as defined by
+// EIP-1474.
+const codeTxRejected = -32003
+
+// maxRequestContentLength is the largest request body accepted by the HTTP server.
+const maxRequestContentLength = 5 * 1024 * 1024
+
+// jsonrpcResponse is a single JSON-RPC response as returned by the server.
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonrpcError   `json:"error"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// errorCase is a raw request that the server must answer with the given error.
+type errorCase struct {
+	name    string
+	body    string
+	code    int
+	message string
+}
+
+var errorCases = []errorCase{
+	{
+		name:    "malformed JSON",
+		body:    `{"jsonrpc":"2.0","id":1,"method":`,
+		code:    codeParseError,
+		message: "parse error",
+	},
+	{
+		name:    "missing method",
+		body:    `{"jsonrpc":"2.0","id":1}`,
+		code:    codeInvalidRequest,
+		message: "invalid request",
+	},
+	{
+		name:    "empty batch",
+		body:    `[]`,
+		code:    codeInvalidRequest,
+		message: "empty batch",
+	},
+	{
+		name:    "unknown method",
+		body:    `{"jsonrpc":"2.0","id":1,"method":"eth_doesNotExist","params":[]}`,
+		code:    codeMethodNotFound,
+		message: "the method eth_doesNotExist does not exist/is not available",
+	},
+	{
+		name:    "unknown namespace",
+		body:    `{"jsonrpc":"2.0","id":1,"method":"foo_bar","params":[]}`,
+		code:    codeMethodNotFound,
+		message: "the method foo_bar does not exist/is not available",
+	},
+	{
+		name:    "non-array params",
+		body:    `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":"0x01"}`,
+		code:    codeInvalidParams,
+		message: "non-array args",
+	},
+	{
+		name:    "too many params",
+		body:    `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[1]}`,
+		code:    codeInvalidParams,
+		message: "too many arguments, want at most 0",
+	},
+	{
+		name: "missing required param",
+		body: `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance",` +
+			`"params":["0x0000000000000000000000000000000000000001"]}`,
+		code:    codeInvalidParams,
+		message: "missing value for required argument 1",
+	},
+	{
+		name:    "wrong param type",
+		body:    `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest","yes"]}`,
+		code:    codeInvalidParams,
+		message: "invalid argument 1: json: cannot unmarshal string into Go value of type bool",
+	},
+	{
+		name: "malformed address",
+		body: `{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x01","latest"]}`,
+		code: codeInvalidParams,
+		message: "invalid argument 0: json: cannot unmarshal hex string has length 2, " +
+			"want 40 for common.Address into Go value of type common.Address",
+	},
+}
+
+// errorCodesTest sends requests that violate the JSON-RPC specification or the method
+// signatures and checks the exact error code and message of every response.
+func errorCodesTest(t *TestEnv) {
+	for _, tc := range errorCases {
+		status, body := t.PostRaw([]byte(tc.body))
+		if status != http.StatusOK {
+			t.Fatalf("%s: expected status %d, got %d", tc.name, http.StatusOK, status)
+		}
+
+		var resp jsonrpcResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			t.Fatalf("%s: could not decode response %s: %v", tc.name, body, err)
+		}
+		checkError(t, tc.name, &resp, tc.code, tc.message)
+	}
+}
+
+// batchErrorsTest checks that an error in one call of a batch is returned for that call only,
+// while the other calls of the batch still succeed.
+func batchErrorsTest(t *TestEnv) {
+	status, body := t.PostRaw([]byte(`[` +
+		`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]},` +
+		`{"jsonrpc":"2.0","id":2,"method":"eth_doesNotExist","params":[]},` +
+		`{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[1]}` +
+		`]`))
+	if status != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	var resps []jsonrpcResponse
+	if err := json.Unmarshal(body, &resps); err != nil {
+		t.Fatalf("could not decode batch response %s: %v", body, err)
+	}
+	if len(resps) != 3 { //nolint:gomnd // one response per call.
+		t.Fatalf("expected 3 responses, got %d", len(resps))
+	}
+
+	byID := make(map[string]*jsonrpcResponse, len(resps))
+	for i := range resps {
+		byID[string(resps[i].ID)] = &resps[i]
+	}
+	if resp := byID["1"]; resp == nil || resp.Error != nil {
+		t.Fatalf("expected eth_chainId to succeed, got %+v", resp)
+	} else if chainIDHex := fmt.Sprintf(`"0x%x"`, chainID); string(resp.Result) != chainIDHex {
+		t.Fatalf("expected chain ID %s, got %s", chainIDHex, resp.Result)
+	}
+	checkError(t, "batched unknown method", byID["2"], codeMethodNotFound,
+		"the method eth_doesNotExist does not exist/is not available")
+	checkError(t, "batched too many params", byID["3"], codeInvalidParams,
+		"too many arguments, want at most 0")
+}
+
+// oversizedBatchTest checks that a batch larger than the maximum request size is rejected at
+// the HTTP layer before any of its calls are executed.
+func oversizedBatchTest(t *TestEnv) {
+	call := `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+	calls := make([]string, maxRequestContentLength/len(call)+1)
+	for i := range calls {
+		calls[i] = call
+	}
+	body := "[" + strings.Join(calls, ",") + "]"
+
+	resp, msg := t.DoRaw(t.NewRawRequest(strings.NewReader(body)))
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+	if !bytes.HasPrefix(msg, []byte("content length too large")) {
+		t.Fatalf("expected content length error, got %q", msg)
+	}
+}
+
+// unprotectedTxTest checks that a transaction that is not replay-protected is rejected with its
+// own error code, as the client does not allow unprotected transactions by default.
+func unprotectedTxTest(t *TestEnv) {
+	tx := types.MustSignNewTx(receiptSenderKey, types.HomesteadSigner{}, &types.LegacyTx{
+		GasPrice: big.NewInt(1),
+		Gas:      21000, //nolint:gomnd // intrinsic gas of a transfer.
+		To:       &common.Address{0x1},
+	})
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("could not encode transaction: %v", err)
+	}
+
+	status, body := t.PostRaw([]byte(fmt.Sprintf(
+		`{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["%s"]}`,
+		hexutil.Encode(raw),
+	)))
+	if status != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, status)
+	}
+
+	var resp jsonrpcResponse
+	if err = json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("could not decode response %s: %v", body, err)
+	}
+	checkError(t, "unprotected transaction", &resp, codeTxRejected,
+		"only replay-protected (EIP-155) transactions allowed over RPC")
+}
+
+// checkError fails the test if resp is not a JSON-RPC 2.0 error with the given code and message.
+func checkError(t *TestEnv, name string, resp *jsonrpcResponse, code int, message string) {
+	switch {
+	case resp == nil:
+		t.Fatalf("%s: missing response", name)
+	case resp.Version != "2.0":
+		t.Fatalf("%s: expected jsonrpc version 2.0, got %q", name, resp.Version)
+	case resp.Error == nil:
+		t.Fatalf("%s: expected error, got result %s", name, resp.Result)
+	case resp.Error.Code != code:
+		t.Fatalf("%s: expected error code %d, got %d (%s)",
+			name, code, resp.Error.Code, resp.Error.Message)
+	case resp.Error.Message != message:
+		t.Fatalf("%s: expected error message %q, got %q", name, message, resp.Error.Message)
+	}
+}
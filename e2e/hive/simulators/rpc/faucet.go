@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// codeLimitExceeded is the error code of the requests rejected by a rate limit, as defined by
+// EIP-1474.
+const codeLimitExceeded = -32005
+
+// faucetAmount is the amount of wei transferred by the faucet of the client per request.
+var faucetAmount = big.NewInt(1e18) //nolint:gomnd // 1 ether.
+
+// faucetTest checks that the faucet of the client funds an address once, and then rate limits
+// the requests of the address and of the client IP, both over JSON-RPC and over HTTP.
+func faucetTest(t *TestEnv) {
+	funded := common.Address{0xfa, 0x0}
+	var hash common.Hash
+	if err := t.CallContext(t.Ctx(), &hash, "faucet_fund", funded); err != nil {
+		t.Fatalf("could not request funds: %v", err)
+	}
+	waitForReceipt(t, hash)
+
+	balance, err := t.Eth.BalanceAt(t.Ctx(), funded, nil)
+	if err != nil {
+		t.Fatalf("could not get balance: %v", err)
+	}
+	if balance.Cmp(faucetAmount) != 0 {
+		t.Fatalf("expected the funded balance to be %v, got %v", faucetAmount, balance)
+	}
+
+	// The address was just funded.
+	var rpcErr rpc.Error
+	err = t.CallContext(t.Ctx(), &hash, "faucet_fund", funded)
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != codeLimitExceeded {
+		t.Fatalf("expected a second request of the address to be rate limited, got %v", err)
+	}
+
+	// The IP of the simulator was just funded.
+	req := t.NewRawRequest(bytes.NewReader([]byte(fmt.Sprintf(
+		`{"address":"%s"}`, common.Address{0xfa, 0x1}.Hex(),
+	))))
+	req.URL.Path = "/faucet"
+	resp, body := t.DoRaw(req)
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status %d for a second request of the IP, got %d: %s",
+			http.StatusTooManyRequests, resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// bridgeNetwork is the default network that connects the simulator to its clients.
+	bridgeNetwork = "bridge"
+	// faultSeed seeds the dropped responses, so that the faults of a test are reproducible.
+	faultSeed = 7
+	// faultTimeout bounds how long the fault tests wait for the client to fail or recover.
+	faultTimeout = 30 * time.Second
+)
+
+// errDroppedResponse is returned for responses dropped by the fault injection. It wraps
+// io.ErrUnexpectedEOF, since to the client a dropped response is a connection lost mid-reply.
+var errDroppedResponse = fmt.Errorf(
+	"fault injection dropped the response: %w", io.ErrUnexpectedEOF,
+)
+
+var faultTests = []testSpec{
+	{Name: "http/PausedClientTest", Run: pausedClientTest, Retry: &noRetryPolicy},
+	{Name: "ws/PausedSubscriptionTest", Run: pausedSubscriptionTest},
+	{Name: "http/PartitionTest", Run: partitionTest, Retry: &noRetryPolicy},
+	{Name: "http/DroppedResponsesTest", Run: droppedResponsesTest, Retry: &RetryPolicy{
+		Attempts:       8,                      //nolint:gomnd // enough to outlast the drops.
+		InitialBackoff: 50 * time.Millisecond,  //nolint:gomnd // it's okay.
+		MaxBackoff:     500 * time.Millisecond, //nolint:gomnd // it's okay.
+		Multiplier:     2,                      //nolint:gomnd // it's okay.
+	}},
+}
+
+// faultRoundTrip drops a percentage of the responses to the requests it sends. The requests
+// still reach the client, only their responses are discarded.
+type faultRoundTrip struct {
+	inner http.RoundTripper
+
+	mu          sync.Mutex
+	rng         *rand.Rand
+	dropPercent int
+}
+
+func newFaultRoundTrip(inner http.RoundTripper) *faultRoundTrip {
+	return &faultRoundTrip{
+		inner: inner,
+		rng:   rand.New(rand.NewSource(faultSeed)), //#nosec:G404 // reproducible on purpose.
+	}
+}
+
+func (rt *faultRoundTrip) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.inner.RoundTrip(req)
+	if err != nil || !rt.drop() {
+		return resp, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return nil, errDroppedResponse
+}
+
+// drop reports whether the next response is dropped.
+func (rt *faultRoundTrip) drop() bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.dropPercent > 0 && rt.rng.Intn(100) < rt.dropPercent //nolint:gomnd // percent.
+}
+
+// DropResponses drops the given percentage of the responses to the HTTP requests of the test,
+// until it is called again with zero.
+func (t *TestEnv) DropResponses(percent int) {
+	if t.faults == nil {
+		t.Fatal("responses can only be dropped over HTTP")
+	}
+	t.faults.mu.Lock()
+	defer t.faults.mu.Unlock()
+	t.faults.dropPercent = percent
+}
+
+// PauseClient freezes the client container. Tests must resume it with ResumeClient before
+// they end, since the following tests share the client.
+func (t *TestEnv) PauseClient() {
+	if err := t.Client.Pause(); err != nil {
+		t.Fatalf("could not pause client: %v", err)
+	}
+}
+
+// ResumeClient resumes a client container paused by PauseClient.
+func (t *TestEnv) ResumeClient() {
+	if err := t.Client.Unpause(); err != nil {
+		t.Fatalf("could not resume client: %v", err)
+	}
+}
+
+// PartitionClient disconnects the client container from the network of the simulator. Tests
+// must heal the partition with HealPartition before they end.
+func (t *TestEnv) PartitionClient() {
+	if err := t.Sim.DisconnectContainer(t.SuiteID, bridgeNetwork, t.Client.Container); err != nil {
+		t.Fatalf("could not partition client: %v", err)
+	}
+}
+
+// HealPartition reconnects a client container disconnected by PartitionClient. As the client
+// may be given a new address, the HTTP clients of the test are pointed to it.
+func (t *TestEnv) HealPartition() {
+	if err := t.Sim.ConnectContainer(t.SuiteID, bridgeNetwork, t.Client.Container); err != nil {
+		t.Fatalf("could not heal partition of client: %v", err)
+	}
+	ip, err := t.Sim.ContainerNetworkIP(t.SuiteID, bridgeNetwork, t.Client.Container)
+	if err != nil {
+		t.Fatalf("could not get address of client: %v", err)
+	}
+	if ip == t.Client.IP.String() || t.HTTP == nil {
+		return
+	}
+
+	t.Logf("client moved from %v to %s", t.Client.IP, ip)
+	t.URL = fmt.Sprintf("http://%s:8545/", ip)
+	//nolint: staticcheck // rpc.DialOptions requires ctx
+	rpcClient, _ := rpc.DialHTTPWithClient(t.URL, t.HTTP)
+	t.RPC.Close()
+	t.RPC = rpcClient
+	t.Eth = ethclient.NewClient(rpcClient)
+}
+
+// waitForBlockAfter polls the block number until it exceeds the given one.
+func waitForBlockAfter(t *TestEnv, number uint64) {
+	ctx, cancel := context.WithTimeout(context.Background(), faultTimeout)
+	defer cancel()
+	for {
+		current, err := t.Eth.BlockNumber(ctx)
+		if err == nil && current > number {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("client did not recover past block %d: %v", number, err)
+		case <-time.After(delay * time.Millisecond):
+		}
+	}
+}
+
+// pausedClientTest checks that calls to a paused client fail with the deadline of the caller
+// instead of hanging, and that the client keeps producing blocks once it is resumed.
+func pausedClientTest(t *TestEnv) {
+	number, err := t.Eth.BlockNumber(t.Ctx())
+	if err != nil {
+		t.Fatalf("could not get block number: %v", err)
+	}
+
+	t.PauseClient()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout*time.Second)
+	_, err = t.Eth.BlockNumber(ctx)
+	cancel()
+	t.ResumeClient()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected call to paused client to time out, got %v", err)
+	}
+
+	waitForBlockAfter(t, number)
+}
+
+// pausedSubscriptionTest checks that a head subscription survives a paused client and
+// delivers the heads produced after it is resumed.
+func pausedSubscriptionTest(t *TestEnv) {
+	heads := make(chan *types.Header)
+	sub, err := t.Eth.SubscribeNewHead(context.Background(), heads)
+	if err != nil {
+		t.Fatalf("could not subscribe to new heads: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	nextHead := func() *big.Int {
+		select {
+		case head := <-heads:
+			return head.Number
+		case err = <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(faultTimeout):
+			t.Fatal("timed out waiting for a new head")
+		}
+		return nil
+	}
+
+	before := nextHead()
+	t.PauseClient()
+	time.Sleep(timeout * time.Second)
+	t.ResumeClient()
+
+	// Heads produced before the pause may still be in flight, so wait for a newer one.
+	deadline := time.Now().Add(faultTimeout)
+	for after := nextHead(); after.Cmp(before) <= 0; after = nextHead() {
+		if time.Now().After(deadline) {
+			t.Fatalf("subscription did not recover past block %v", before)
+		}
+	}
+}
+
+// partitionTest checks that calls fail while the client is partitioned from the simulator,
+// and succeed again once the partition is healed.
+func partitionTest(t *TestEnv) {
+	number, err := t.Eth.BlockNumber(t.Ctx())
+	if err != nil {
+		t.Fatalf("could not get block number: %v", err)
+	}
+
+	t.PartitionClient()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout*time.Second)
+	_, err = t.Eth.BlockNumber(ctx)
+	cancel()
+	t.HealPartition()
+	if err == nil {
+		t.Fatal("expected call to partitioned client to fail")
+	}
+	t.Logf("call to partitioned client failed as expected: %v", err)
+
+	waitForBlockAfter(t, number)
+}
+
+// droppedResponsesTest checks that dropped responses surface as errors to the caller, and
+// that the retry policy of the test recovers from a lossy connection.
+func droppedResponsesTest(t *TestEnv) {
+	t.DropResponses(100) //nolint:gomnd // drop everything.
+	if _, err := t.Eth.ChainID(t.Ctx()); !errors.Is(err, errDroppedResponse) {
+		t.Fatalf("expected dropped response error, got %v", err)
+	}
+
+	t.DropResponses(30) //nolint:gomnd // a lossy connection.
+	defer t.DropResponses(0)
+	for i := 0; i < 20; i++ {
+		cID, err := t.Eth.ChainID(t.Ctx())
+		if err != nil {
+			t.Fatalf("call %d failed despite retries: %v", i, err)
+		}
+		if cID.Cmp(chainID) != 0 {
+			t.Fatalf("expected chain ID %d, got %d", chainID, cID)
+		}
+	}
+}
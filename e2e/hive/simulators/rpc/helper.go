@@ -30,6 +30,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"time"
 
@@ -49,6 +50,16 @@ type TestEnv struct {
 	Eth   *ethclient.Client
 	Vault *vault
 
+	// HTTP and URL are the logging HTTP client and endpoint used by runHTTP. They are only set
+	// for HTTP tests and allow sending raw request bodies that the RPC client cannot produce.
+	HTTP *http.Client
+	URL  string
+
+	// Client is the client container under test, used to inject faults.
+	Client *hivesim.Client
+	// faults injects faults into the HTTP requests of the test. It is only set by runHTTP.
+	faults *faultRoundTrip
+
 	// This holds most recent context created by the Ctx method.
 	// Every time Ctx is called, it creates a new context with the default
 	// timeout and cancels the previous one.
@@ -62,23 +73,37 @@ const (
 )
 
 // runHTTP runs the given test function using the HTTP RPC client.
-func runHTTP(t *hivesim.T, c *hivesim.Client, v *vault, fn func(*TestEnv)) {
+// Requests that fail for transient reasons are retried according to the given policy.
+func runHTTP(
+	t *hivesim.T, c *hivesim.Client, v *vault, policy RetryPolicy, fn func(*TestEnv),
+) {
 	// This sets up debug logging of the requests and responses.
-	client := &http.Client{
-		Transport: &loggingRoundTrip{
-			t:     t,
-			inner: http.DefaultTransport,
+	faults := newFaultRoundTrip(http.DefaultTransport)
+	transport := &loggingRoundTrip{
+		t: t,
+		inner: &retryRoundTrip{
+			t:      t,
+			inner:  faults,
+			policy: policy,
 		},
+		capture: newCapture(),
 	}
+	defer transport.capture.save(t)
+	client := &http.Client{Transport: transport}
 
+	url := fmt.Sprintf("http://%v:8545/", c.IP)
 	//nolint: staticcheck // rpc.DialOptions requires ctx
-	rpcClient, _ := rpc.DialHTTPWithClient(fmt.Sprintf("http://%v:8545/", c.IP), client)
+	rpcClient, _ := rpc.DialHTTPWithClient(url, client)
 	defer rpcClient.Close()
 	env := &TestEnv{
-		T:     t,
-		RPC:   rpcClient,
-		Eth:   ethclient.NewClient(rpcClient),
-		Vault: v,
+		T:      t,
+		RPC:    rpcClient,
+		Eth:    ethclient.NewClient(rpcClient),
+		Vault:  v,
+		HTTP:   client,
+		URL:    url,
+		Client: c,
+		faults: faults,
 	}
 	fn(env)
 	if env.lastCtx != nil {
@@ -97,10 +122,41 @@ func runWS(t *hivesim.T, c *hivesim.Client, v *vault, fn func(*TestEnv)) {
 	defer rpcClient.Close()
 
 	env := &TestEnv{
-		T:     t,
-		RPC:   rpcClient,
-		Eth:   ethclient.NewClient(rpcClient),
-		Vault: v,
+		T:      t,
+		RPC:    rpcClient,
+		Eth:    ethclient.NewClient(rpcClient),
+		Vault:  v,
+		Client: c,
+	}
+	fn(env)
+	if env.lastCtx != nil {
+		env.lastCancel()
+	}
+}
+
+// runIPC runs the given test function using the IPC RPC client. As the client runs in another
+// container, its IPC socket is forwarded to the 8548 TCP port, over which the IPC codec is used.
+func runIPC(t *hivesim.T, c *hivesim.Client, v *vault, fn func(*TestEnv)) {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("%v:8548", c.IP), timeout*time.Second)
+	if err != nil {
+		t.Fatal("IPC connection failed:", err)
+	}
+	defer conn.Close()
+
+	ctx, done := context.WithTimeout(context.Background(), timeout*time.Second)
+	rpcClient, err := rpc.DialIO(ctx, conn, conn)
+	done()
+	if err != nil {
+		t.Fatal("IPC connection failed:", err)
+	}
+	defer rpcClient.Close()
+
+	env := &TestEnv{
+		T:      t,
+		RPC:    rpcClient,
+		Eth:    ethclient.NewClient(rpcClient),
+		Vault:  v,
+		Client: c,
 	}
 	fn(env)
 	if env.lastCtx != nil {
@@ -115,6 +171,43 @@ func (t *TestEnv) CallContext(ctx context.Context, result interface{}, method st
 	return t.RPC.CallContext(ctx, result, method, args...)
 }
 
+// NewRawRequest creates a JSON POST request to the HTTP endpoint of the client. Tests can
+// change its headers before sending it with DoRaw.
+func (t *TestEnv) NewRawRequest(body io.Reader) *http.Request {
+	if t.HTTP == nil {
+		t.Fatal("raw requests are only supported over HTTP")
+	}
+	req, err := http.NewRequestWithContext(t.Ctx(), http.MethodPost, t.URL, body)
+	if err != nil {
+		t.Fatalf("could not create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// DoRaw sends the request with the logging HTTP client and returns the response along with
+// its body. It fails the test if the request could not be sent.
+func (t *TestEnv) DoRaw(req *http.Request) (*http.Response, []byte) {
+	resp, err := t.HTTP.Do(req)
+	if err != nil {
+		t.Fatalf("could not send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("could not read response: %v", err)
+	}
+	return resp, respBody
+}
+
+// PostRaw sends the given body to the HTTP endpoint of the client as is and returns the
+// response status code and body.
+func (t *TestEnv) PostRaw(body []byte) (int, []byte) {
+	resp, respBody := t.DoRaw(t.NewRawRequest(bytes.NewReader(body)))
+	return resp.StatusCode, respBody
+}
+
 // Ctx returns a context with the default timeout.
 // For subsequent calls to Ctx, it also cancels the previous context.
 func (t *TestEnv) Ctx() context.Context {
@@ -216,27 +309,37 @@ func (t *TestEnv) Ctx() context.Context {
 // 	return nil, ethereum.NotFound
 // }
 
-// loggingRoundTrip writes requests and responses to the test log.
+// loggingRoundTrip writes requests and responses to the test log. If capture is set, it also
+// records them for export when the test fails.
 type loggingRoundTrip struct {
-	t     *hivesim.T
-	inner http.RoundTripper
+	t       *hivesim.T
+	inner   http.RoundTripper
+	capture *capture
 }
 
+// maxLoggedBody is the size above which loggingRoundTrip only logs the length of a body.
+const maxLoggedBody = 4096
+
 func (rt *loggingRoundTrip) RoundTrip(req *http.Request) (*http.Response, error) {
-	// Read and log the request body.
-	reqBytes, err := io.ReadAll(req.Body)
-	if err != nil {
-		return nil, err
-	}
-	err = req.Body.Close()
-	if err != nil {
-		return nil, err
-	}
-	rt.t.Logf(">>  %s", bytes.TrimSpace(reqBytes))
+	var reqBytes []byte
 	reqCopy := *req
-	reqCopy.Body = io.NopCloser(bytes.NewReader(reqBytes))
+	if req.Body != nil {
+		// Read and log the request body.
+		var err error
+		reqBytes, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		err = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		rt.logBody(">>", req.Header, reqBytes)
+		reqCopy.Body = io.NopCloser(bytes.NewReader(reqBytes))
+	}
 
 	// Do the round trip.
+	started := time.Now()
 	resp, err := rt.inner.RoundTrip(&reqCopy)
 	if err != nil {
 		return nil, err
@@ -250,10 +353,35 @@ func (rt *loggingRoundTrip) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 	respCopy := *resp
 	respCopy.Body = io.NopCloser(bytes.NewReader(respBytes))
-	rt.t.Logf("<<  %s", bytes.TrimSpace(respBytes))
+	rt.logBody("<<", resp.Header, respBytes)
+	rt.capture.record(captureEntry{
+		Started:        started,
+		Duration:       time.Since(started),
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		Proto:          resp.Proto,
+		RequestHeader:  req.Header.Clone(),
+		Request:        reqBytes,
+		Status:         resp.StatusCode,
+		ResponseHeader: resp.Header.Clone(),
+		Response:       respBytes,
+	})
 	return &respCopy, nil
 }
 
+// logBody writes a request or response body to the test log. Compressed and oversized bodies
+// are summarized, since they are unreadable or would flood the log.
+func (rt *loggingRoundTrip) logBody(prefix string, header http.Header, body []byte) {
+	switch {
+	case header.Get("Content-Encoding") != "":
+		rt.t.Logf("%s  <%d bytes, %s encoded>", prefix, len(body), header.Get("Content-Encoding"))
+	case len(body) > maxLoggedBody:
+		rt.t.Logf("%s  %s... <%d bytes>", prefix, body[:maxLoggedBody], len(body))
+	default:
+		rt.t.Logf("%s  %s", prefix, bytes.TrimSpace(body))
+	}
+}
+
 // func loadGenesis() *types.Block {
 // 	contents, err := os.ReadFile("init/genesis.json")
 // 	if err != nil {
@@ -37,6 +37,16 @@ type testSpec struct {
 	Name  string
 	About string
 	Run   func(*TestEnv)
+	// Retry overrides the default retry policy of the HTTP requests of the test.
+	Retry *RetryPolicy
+}
+
+// retryPolicy returns the retry policy of the test.
+func (s *testSpec) retryPolicy() RetryPolicy {
+	if s.Retry != nil {
+		return *s.Retry
+	}
+	return defaultRetryPolicy
 }
 
 var (
@@ -57,6 +67,21 @@ var (
 
 var tests = []testSpec{
 	{Name: "http/ConsistentChainIDTest", Run: consistentChainIDTest},
+	{Name: "http/TransactionReceiptTest", Run: transactionReceiptTest},
+	{Name: "http/DeterministicDeploymentTest", Run: deterministicDeploymentTest},
+	{Name: "http/ErrorCodesTest", Run: errorCodesTest},
+	{Name: "http/BatchErrorsTest", Run: batchErrorsTest},
+	{Name: "http/UnprotectedTxTest", Run: unprotectedTxTest},
+	{Name: "http/FaucetTest", Run: faucetTest},
+	{Name: "http/OversizedBatchTest", Run: oversizedBatchTest},
+	{Name: "http/GzipTest", Run: gzipTest},
+	{Name: "http/HTTP10Test", Run: http10Test},
+	{Name: "http/KeepAliveTest", Run: keepAliveTest, Retry: &noRetryPolicy},
+	{Name: "http/ChunkedRequestTest", Run: chunkedRequestTest},
+	{Name: "http/ContentTypeTest", Run: contentTypeTest},
+	{Name: "http/HugeRequestTest", Run: hugeRequestTest},
+	{Name: "ipc/ConsistentChainIDTest", Run: consistentChainIDTest},
+	{Name: "ws/ContiguousHeadsTest", Run: contiguousHeadsTest},
 }
 
 func main() {
@@ -77,6 +102,18 @@ interacting with one.`[1:],
 		AlwaysRun:   true,
 	})
 
+	// The fault tests pause and disconnect their client, so they run one after another against
+	// a client of their own.
+	suite.Add(&hivesim.ClientTestSpec{
+		Role: "eth1",
+		Name: "fault injection",
+		Description: `This test launches a client and checks the behavior of the RPC layer while
+the client is paused, partitioned from the network or loses responses.`[1:],
+		Parameters: clientEnv,
+		Files:      files,
+		Run:        func(t *hivesim.T, c *hivesim.Client) { runFaultTests(t, c, c.Type) },
+	})
+
 	sim := hivesim.New()
 	hivesim.MustRunSuite(sim, suite)
 }
@@ -93,25 +130,40 @@ func runAllTests(t *hivesim.T, c *hivesim.Client, clientName string) {
 		s.get()
 		go func() {
 			defer s.put()
-			t.Run(hivesim.TestSpec{
-				Name:        fmt.Sprintf("%s (%s)", test.Name, clientName),
-				Description: test.About,
-				Run: func(t *hivesim.T) {
-					switch test.Name[:strings.IndexByte(test.Name, '/')] {
-					case "http":
-						runHTTP(t, c, vault, test.Run)
-					case "ws":
-						runWS(t, c, vault, test.Run)
-					default:
-						panic("bad test prefix in name " + test.Name)
-					}
-				},
-			})
+			runTest(t, c, vault, clientName, test)
 		}()
 	}
 	s.drain()
 }
 
+// runFaultTests runs the fault tests against a client instance, one at a time.
+func runFaultTests(t *hivesim.T, c *hivesim.Client, clientName string) {
+	vault := newVault()
+	for _, test := range faultTests {
+		runTest(t, c, vault, clientName, test)
+	}
+}
+
+// runTest runs a single test over the transport given by the prefix of its name.
+func runTest(t *hivesim.T, c *hivesim.Client, vault *vault, clientName string, test testSpec) {
+	t.Run(hivesim.TestSpec{
+		Name:        fmt.Sprintf("%s (%s)", test.Name, clientName),
+		Description: test.About,
+		Run: func(t *hivesim.T) {
+			switch test.Name[:strings.IndexByte(test.Name, '/')] {
+			case "http":
+				runHTTP(t, c, vault, test.retryPolicy(), test.Run)
+			case "ws":
+				runWS(t, c, vault, test.Run)
+			case "ipc":
+				runIPC(t, c, vault, test.Run)
+			default:
+				panic("bad test prefix in name " + test.Name)
+			}
+		},
+	})
+}
+
 type semaphore chan struct{}
 
 func newSemaphore(n int) semaphore {
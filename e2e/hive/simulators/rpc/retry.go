@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/hive/hivesim"
+)
+
+// RetryPolicy configures how HTTP requests of a test are retried when they fail for transient
+// reasons, such as a reset connection or a rate limited (429) response. Parallel hive runs on
+// shared runners hit these regularly, without the node being at fault.
+type RetryPolicy struct {
+	// Attempts is the maximum number of times a request is sent. Values below 2 disable retries.
+	Attempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries, including delays requested by the server.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the delay grows by after each retry.
+	Multiplier float64
+}
+
+var (
+	// defaultRetryPolicy is used by tests that do not set their own policy.
+	defaultRetryPolicy = RetryPolicy{
+		Attempts:       4,                      //nolint:gomnd // it's okay.
+		InitialBackoff: 250 * time.Millisecond, //nolint:gomnd // it's okay.
+		MaxBackoff:     2 * time.Second,        //nolint:gomnd // it's okay.
+		Multiplier:     2,                      //nolint:gomnd // it's okay.
+	}
+
+	// noRetryPolicy disables retries, for tests that assert on transport failures themselves.
+	noRetryPolicy = RetryPolicy{Attempts: 1}
+)
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		delay *= p.Multiplier
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// retryRoundTrip resends requests that failed for transient reasons according to its policy.
+type retryRoundTrip struct {
+	t      *hivesim.T
+	inner  http.RoundTripper
+	policy RetryPolicy
+}
+
+func (rt *retryRoundTrip) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.policy.Attempts < 2 { //nolint:gomnd // a single attempt does not retry.
+		return rt.inner.RoundTrip(req)
+	}
+
+	// The body is buffered so that it can be sent again on every attempt.
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		if err = req.Body.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		reqCopy := *req
+		if req.Body != nil {
+			reqCopy.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		resp, err := rt.inner.RoundTrip(&reqCopy)
+		if attempt == rt.policy.Attempts {
+			return resp, err
+		}
+
+		delay := rt.policy.backoff(attempt)
+		switch {
+		case err != nil && isTransientError(err):
+			rt.t.Logf("retrying request in %v after attempt %d failed: %v", delay, attempt, err)
+		case err == nil && resp.StatusCode == http.StatusTooManyRequests:
+			if after := retryAfter(resp); after > 0 {
+				delay = after
+				if rt.policy.MaxBackoff > 0 && delay > rt.policy.MaxBackoff {
+					delay = rt.policy.MaxBackoff
+				}
+			}
+			resp.Body.Close()
+			rt.t.Logf("retrying request in %v after attempt %d was rate limited", delay, attempt)
+		default:
+			return resp, err
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isTransientError reports whether the request failed because of the connection rather than
+// because of the request, so that sending it again may succeed.
+func isTransientError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the response, if it is
+// given in seconds.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -25,7 +25,31 @@
 
 package main
 
-import "math/big"
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// receiptSenderKey is the key of an account funded in the simulator genesis.
+	receiptSenderKey, _ = crypto.HexToECDSA("63b508a03c3b5937ceb903af8b1b0c191012ef6eb7e9c3fb7afa94e5d214d376")
+	// emptyContractCode is init code that emits a log with the topic `deployedTopic` and deploys
+	// a contract with empty runtime code.
+	emptyContractCode = common.FromHex("0x602a60006000a160006000f3")
+	// deployedTopic is the topic of the log emitted by `emptyContractCode`.
+	deployedTopic = common.BigToHash(big.NewInt(0x2a)) //nolint:gomnd // pushed by the init code.
+	// deterministicDeploymentProxy is the canonical address of the deterministic deployment
+	// proxy, which is predeployed.
+	deterministicDeploymentProxy = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+)
 
 func consistentChainIDTest(t *TestEnv) {
 	var (
@@ -41,3 +65,280 @@ func consistentChainIDTest(t *TestEnv) {
 		t.Fatalf("expected chain ID %d, got %d", expectedChainID, cID)
 	}
 }
+
+func transactionReceiptTest(t *TestEnv) {
+	var (
+		sender    = crypto.PubkeyToAddress(receiptSenderKey.PublicKey)
+		recipient = common.Address{0x1}
+		signer    = types.NewEIP155Signer(chainID)
+	)
+
+	nonce, err := t.Eth.PendingNonceAt(t.Ctx(), sender)
+	if err != nil {
+		t.Fatalf("could not get nonce: %v", err)
+	}
+	gasPrice, err := t.Eth.SuggestGasPrice(t.Ctx())
+	if err != nil {
+		t.Fatalf("could not get gas price: %v", err)
+	}
+
+	deployTx := types.MustSignNewTx(receiptSenderKey, signer, &types.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      100000, //nolint:gomnd // enough for an empty deployment.
+		Data:     emptyContractCode,
+	})
+	transferTx := types.MustSignNewTx(receiptSenderKey, signer, &types.LegacyTx{
+		Nonce:    nonce + 1,
+		GasPrice: gasPrice,
+		Gas:      21000, //nolint:gomnd // intrinsic gas of a transfer.
+		To:       &recipient,
+		Value:    big.NewInt(1),
+	})
+	// The transfer is sent first, so that it is held by the pool until the deployment fills its
+	// nonce gap, and must be served as pending meanwhile.
+	for _, tx := range []*types.Transaction{transferTx, deployTx} {
+		if err = t.Eth.SendTransaction(t.Ctx(), tx); err != nil {
+			t.Fatalf("could not send transaction: %v", err)
+		}
+		if tx == transferTx {
+			checkTransactionByHash(t, tx, true)
+		}
+	}
+
+	for _, tx := range []*types.Transaction{deployTx, transferTx} {
+		receipt := waitForReceipt(t, tx.Hash())
+		checkTransactionByHash(t, tx, false)
+
+		if receipt.Type != tx.Type() {
+			t.Fatalf("expected receipt type %d, got %d", tx.Type(), receipt.Type)
+		}
+		if receipt.EffectiveGasPrice == nil || receipt.EffectiveGasPrice.Cmp(gasPrice) != 0 {
+			t.Fatalf("expected effective gas price %v, got %v", gasPrice, receipt.EffectiveGasPrice)
+		}
+
+		expectedContract := common.Address{}
+		if tx.To() == nil {
+			expectedContract = crypto.CreateAddress(sender, tx.Nonce())
+		}
+		if receipt.ContractAddress != expectedContract {
+			t.Fatalf("expected contract address %v, got %v", expectedContract, receipt.ContractAddress)
+		}
+
+		checkCumulativeGasUsed(t, receipt.BlockHash)
+		checkLogsBloom(t, receipt.BlockHash)
+		checkTransactionsByIndex(t, receipt.BlockHash)
+	}
+
+	deployReceipt := waitForReceipt(t, deployTx.Hash())
+	if len(deployReceipt.Logs) != 1 || deployReceipt.Logs[0].Topics[0] != deployedTopic {
+		t.Fatalf("expected the deployment to emit a log with topic %v, got %v",
+			deployedTopic, deployReceipt.Logs)
+	}
+	if !types.BloomLookup(deployReceipt.Bloom, deployReceipt.ContractAddress) ||
+		!types.BloomLookup(deployReceipt.Bloom, deployedTopic) {
+		t.Fatalf("expected the bloom of the deployment to contain its log")
+	}
+}
+
+// deterministicDeploymentTest checks that the deterministic deployment proxy is predeployed at
+// its canonical address, and that it deploys contracts at their CREATE2 address.
+func deterministicDeploymentTest(t *TestEnv) {
+	code, err := t.Eth.CodeAt(t.Ctx(), deterministicDeploymentProxy, nil)
+	if err != nil {
+		t.Fatalf("could not get code of the deployment proxy: %v", err)
+	}
+	if len(code) == 0 {
+		t.Fatalf("expected the deployment proxy to be predeployed at %v", deterministicDeploymentProxy)
+	}
+
+	salt := common.Hash{0x1}
+	result, err := t.Eth.CallContract(t.Ctx(), ethereum.CallMsg{
+		To:   &deterministicDeploymentProxy,
+		Gas:  100000, //nolint:gomnd // enough for an empty deployment.
+		Data: append(salt.Bytes(), emptyContractCode...),
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not call the deployment proxy: %v", err)
+	}
+	expected := crypto.CreateAddress2(
+		deterministicDeploymentProxy, salt, crypto.Keccak256(emptyContractCode),
+	)
+	if common.BytesToAddress(result) != expected {
+		t.Fatalf("expected the deployment proxy to deploy at %v, got %x", expected, result)
+	}
+}
+
+// contiguousHeadsTest checks that a head subscription delivers consecutive blocks, without
+// skipping any of them.
+func contiguousHeadsTest(t *TestEnv) {
+	const numHeads = 3
+
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	heads := make(chan *types.Header)
+	sub, err := t.Eth.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		t.Fatalf("could not subscribe to new heads: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	var last *types.Header
+	for i := 0; i < numHeads; i++ {
+		select {
+		case head := <-heads:
+			if last != nil && head.Number.Uint64() != last.Number.Uint64()+1 {
+				t.Fatalf("expected head %v after head %v, got %v", last.Number.Uint64()+1,
+					last.Number, head.Number)
+			}
+			if last != nil && head.ParentHash != last.Hash() {
+				t.Fatalf("expected head %v to follow %v", head.Number, last.Hash())
+			}
+			last = head
+		case err = <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for head %d of %d", i+1, numHeads)
+		}
+	}
+}
+
+// waitForReceipt polls the receipt of the given transaction until it is included in a block.
+func waitForReceipt(t *TestEnv, txHash common.Hash) *types.Receipt {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	for {
+		receipt, err := t.Eth.TransactionReceipt(ctx, txHash)
+		switch {
+		case err == nil:
+			return receipt
+		case !errors.Is(err, ethereum.NotFound):
+			t.Fatalf("could not get receipt of %v: %v", txHash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for receipt of %v", txHash)
+		case <-time.After(delay * time.Millisecond):
+		}
+	}
+}
+
+// checkTransactionByHash ensures that the given transaction is served by its hash, as pending if
+// it is not yet included in a block.
+func checkTransactionByHash(t *TestEnv, tx *types.Transaction, pending bool) {
+	found, isPending, err := t.Eth.TransactionByHash(t.Ctx(), tx.Hash())
+	if err != nil {
+		t.Fatalf("could not get transaction %v: %v", tx.Hash(), err)
+	}
+	if found.Hash() != tx.Hash() {
+		t.Fatalf("expected transaction %v, got %v", tx.Hash(), found.Hash())
+	}
+	if isPending != pending {
+		t.Fatalf("expected transaction %v to be pending: %v, got %v", tx.Hash(), pending, isPending)
+	}
+}
+
+// indexedTransaction holds the fields of a transaction served by its position in a block.
+type indexedTransaction struct {
+	Hash             common.Hash     `json:"hash"`
+	BlockHash        *common.Hash    `json:"blockHash"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+}
+
+// checkTransactionsByIndex ensures that every transaction of the given block is served at its
+// index by both block hash and block number, and that no transaction is served past them.
+func checkTransactionsByIndex(t *TestEnv, blockHash common.Hash) {
+	block, err := t.Eth.BlockByHash(t.Ctx(), blockHash)
+	if err != nil {
+		t.Fatalf("could not get block %v: %v", blockHash, err)
+	}
+
+	txs := block.Transactions()
+	for idx := 0; idx <= len(txs); idx++ {
+		var byHash, byNumber *indexedTransaction
+		if err = t.CallContext(t.Ctx(), &byHash, "eth_getTransactionByBlockHashAndIndex",
+			blockHash, hexutil.Uint(idx)); err != nil {
+			t.Fatalf("could not get transaction %d of block %v: %v", idx, blockHash, err)
+		}
+		if err = t.CallContext(t.Ctx(), &byNumber, "eth_getTransactionByBlockNumberAndIndex",
+			hexutil.EncodeBig(block.Number()), hexutil.Uint(idx)); err != nil {
+			t.Fatalf("could not get transaction %d of block %v: %v", idx, block.Number(), err)
+		}
+
+		if idx == len(txs) {
+			if byHash != nil || byNumber != nil {
+				t.Fatalf("expected no transaction at index %d of block %v", idx, blockHash)
+			}
+			continue
+		}
+		for _, tx := range []*indexedTransaction{byHash, byNumber} {
+			switch {
+			case tx == nil:
+				t.Fatalf("expected transaction %v at index %d, got none", txs[idx].Hash(), idx)
+			case tx.Hash != txs[idx].Hash():
+				t.Fatalf("expected transaction %v at index %d, got %v", txs[idx].Hash(), idx, tx.Hash)
+			case tx.BlockHash == nil || *tx.BlockHash != blockHash:
+				t.Fatalf("expected transaction %v in block %v, got %v", tx.Hash, blockHash, tx.BlockHash)
+			case tx.TransactionIndex == nil || uint64(*tx.TransactionIndex) != uint64(idx):
+				t.Fatalf("expected transaction %v at index %d, got %v", tx.Hash, idx, tx.TransactionIndex)
+			}
+		}
+	}
+}
+
+// checkCumulativeGasUsed ensures that the cumulative gas used of every receipt in the given
+// block is the sum of the gas used by the receipts up to and including it.
+func checkCumulativeGasUsed(t *TestEnv, blockHash common.Hash) {
+	block, err := t.Eth.BlockByHash(t.Ctx(), blockHash)
+	if err != nil {
+		t.Fatalf("could not get block %v: %v", blockHash, err)
+	}
+
+	var cumulativeGasUsed uint64
+	for idx, tx := range block.Transactions() {
+		var receipt *types.Receipt
+		if receipt, err = t.Eth.TransactionReceipt(t.Ctx(), tx.Hash()); err != nil {
+			t.Fatalf("could not get receipt of %v: %v", tx.Hash(), err)
+		}
+		cumulativeGasUsed += receipt.GasUsed
+		if receipt.CumulativeGasUsed != cumulativeGasUsed {
+			t.Fatalf("expected cumulative gas used %d at index %d, got %d",
+				cumulativeGasUsed, idx, receipt.CumulativeGasUsed)
+		}
+		if receipt.TxHash != tx.Hash() {
+			t.Fatalf("expected receipt of %v at index %d, got %v", tx.Hash(), idx, receipt.TxHash)
+		}
+	}
+	if block.GasUsed() != cumulativeGasUsed {
+		t.Fatalf("expected block gas used %d, got %d", cumulativeGasUsed, block.GasUsed())
+	}
+}
+
+// checkLogsBloom ensures that the bloom of every receipt in the given block is the bloom of its
+// logs, and that the logs bloom of the block header is the union of the blooms of the receipts.
+func checkLogsBloom(t *TestEnv, blockHash common.Hash) {
+	block, err := t.Eth.BlockByHash(t.Ctx(), blockHash)
+	if err != nil {
+		t.Fatalf("could not get block %v: %v", blockHash, err)
+	}
+
+	var bloom types.Bloom
+	for _, tx := range block.Transactions() {
+		var receipt *types.Receipt
+		if receipt, err = t.Eth.TransactionReceipt(t.Ctx(), tx.Hash()); err != nil {
+			t.Fatalf("could not get receipt of %v: %v", tx.Hash(), err)
+		}
+		if expected := types.CreateBloom(types.Receipts{receipt}); receipt.Bloom != expected {
+			t.Fatalf("expected bloom of receipt of %v to match its %d logs", tx.Hash(), len(receipt.Logs))
+		}
+		for i := range bloom {
+			bloom[i] |= receipt.Bloom[i]
+		}
+	}
+	if block.Bloom() != bloom {
+		t.Fatalf("expected logs bloom of block %v to be the union of the blooms of its receipts",
+			blockHash)
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// chainIDRequest is a minimal valid request used by the transport tests.
+const chainIDRequest = `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+
+// checkChainIDResponse fails the test if body is not a successful eth_chainId response.
+func checkChainIDResponse(t *TestEnv, name string, body []byte) {
+	var resp jsonrpcResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatalf("%s: could not decode response %q: %v", name, body, err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("%s: unexpected error %d (%s)", name, resp.Error.Code, resp.Error.Message)
+	}
+	if expected := fmt.Sprintf(`"0x%x"`, chainID); string(resp.Result) != expected {
+		t.Fatalf("%s: expected chain ID %s, got %s", name, expected, resp.Result)
+	}
+}
+
+// gzipTest checks that responses are compressed for clients accepting gzip, and that a
+// compressed request body, which the server does not decode, is answered with a parse error
+// rather than a transport failure.
+func gzipTest(t *TestEnv) {
+	req := t.NewRawRequest(strings.NewReader(chainIDRequest))
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, body := t.DoRaw(req)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if encoding := resp.Header.Get("Content-Encoding"); encoding != "gzip" {
+		t.Fatalf("expected gzip encoded response, got encoding %q", encoding)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("could not read gzip response: %v", err)
+	}
+	if body, err = io.ReadAll(zr); err != nil {
+		t.Fatalf("could not decompress response: %v", err)
+	}
+	checkChainIDResponse(t, "gzip response", body)
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err = zw.Write([]byte(chainIDRequest)); err != nil {
+		t.Fatalf("could not compress request: %v", err)
+	}
+	if err = zw.Close(); err != nil {
+		t.Fatalf("could not compress request: %v", err)
+	}
+	req = t.NewRawRequest(&compressed)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, body = t.DoRaw(req)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var rpcResp jsonrpcResponse
+	if err = json.Unmarshal(body, &rpcResp); err != nil {
+		t.Fatalf("could not decode response %q: %v", body, err)
+	}
+	checkError(t, "gzip request", &rpcResp, codeParseError, "parse error")
+}
+
+// http10Test sends a request as an HTTP/1.0 client over a plain TCP connection and checks
+// that it is answered in kind and that the connection is closed afterwards.
+func http10Test(t *TestEnv) {
+	endpoint, err := url.Parse(t.URL)
+	if err != nil {
+		t.Fatalf("could not parse endpoint %q: %v", t.URL, err)
+	}
+	conn, err := net.DialTimeout("tcp", endpoint.Host, timeout*time.Second)
+	if err != nil {
+		t.Fatalf("could not connect to %s: %v", endpoint.Host, err)
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(rpcTimeout)); err != nil {
+		t.Fatalf("could not set deadline: %v", err)
+	}
+
+	if _, err = fmt.Fprintf(conn, "POST / HTTP/1.0\r\nHost: %s\r\n"+
+		"Content-Type: application/json\r\nContent-Length: %d\r\n\r\n%s",
+		endpoint.Host, len(chainIDRequest), chainIDRequest); err != nil {
+		t.Fatalf("could not send request: %v", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatalf("could not read response: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.ProtoAtLeast(1, 1) {
+		t.Fatalf("expected HTTP/1.0 response, got %s", resp.Proto)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("could not read response body: %v", err)
+	}
+	checkChainIDResponse(t, "HTTP/1.0", body)
+
+	if _, err = reader.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected the server to close the connection, got %v", err)
+	}
+}
+
+// keepAliveTest checks that consecutive requests reuse the same connection.
+func keepAliveTest(t *TestEnv) {
+	for i := 0; i < 2; i++ {
+		var reused bool
+		req := t.NewRawRequest(strings.NewReader(chainIDRequest))
+		req = req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+			GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+		}))
+		_, body := t.DoRaw(req)
+		checkChainIDResponse(t, "keep-alive", body)
+		if i > 0 && !reused {
+			t.Fatalf("expected request %d to reuse the connection", i)
+		}
+	}
+}
+
+// chunkedRequestTest checks that a request body sent with chunked transfer encoding, and so
+// without a content length, is read in full.
+func chunkedRequestTest(t *TestEnv) {
+	req := t.NewRawRequest(strings.NewReader(chainIDRequest))
+	req.ContentLength = -1
+	req.TransferEncoding = []string{"chunked"}
+	resp, body := t.DoRaw(req)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	checkChainIDResponse(t, "chunked request", body)
+}
+
+// contentTypeTest checks which content types the server accepts for requests.
+func contentTypeTest(t *TestEnv) {
+	const rejected = "invalid content type, only application/json is supported"
+	for _, tc := range []struct {
+		contentType string
+		status      int
+	}{
+		{"", http.StatusUnsupportedMediaType},
+		{"text/plain", http.StatusUnsupportedMediaType},
+		{"application/json", http.StatusOK},
+		{"application/json; charset=utf-8", http.StatusOK},
+		{"application/json-rpc", http.StatusOK},
+		{"application/jsonrequest", http.StatusOK},
+	} {
+		req := t.NewRawRequest(strings.NewReader(chainIDRequest))
+		if tc.contentType == "" {
+			req.Header.Del("Content-Type")
+		} else {
+			req.Header.Set("Content-Type", tc.contentType)
+		}
+
+		resp, body := t.DoRaw(req)
+		if resp.StatusCode != tc.status {
+			t.Fatalf("content type %q: expected status %d, got %d",
+				tc.contentType, tc.status, resp.StatusCode)
+		}
+		if tc.status != http.StatusOK {
+			if msg := string(bytes.TrimSpace(body)); msg != rejected {
+				t.Fatalf("content type %q: expected %q, got %q", tc.contentType, rejected, msg)
+			}
+			continue
+		}
+		checkChainIDResponse(t, fmt.Sprintf("content type %q", tc.contentType), body)
+	}
+}
+
+// hugeRequestTest checks that a single call just below the maximum request size is executed,
+// and that one just above it is rejected.
+func hugeRequestTest(t *TestEnv) {
+	const overhead = 1024
+	data := bytes.Repeat([]byte{0xab}, (maxRequestContentLength-overhead)/2) //nolint:gomnd // hex.
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"web3_sha3","params":["%s"]}`,
+		hexutil.Encode(data))
+
+	resp, respBody := t.DoRaw(t.NewRawRequest(strings.NewReader(body)))
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var rpcResp jsonrpcResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		t.Fatalf("could not decode response %q: %v", respBody, err)
+	}
+	if rpcResp.Error != nil {
+		t.Fatalf("unexpected error %d (%s)", rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+	expected := fmt.Sprintf("%q", crypto.Keccak256Hash(data).Hex())
+	if string(rpcResp.Result) != expected {
+		t.Fatalf("expected hash %s, got %s", expected, rpcResp.Result)
+	}
+
+	data = append(data, bytes.Repeat([]byte{0xab}, overhead)...)
+	body = fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"web3_sha3","params":["%s"]}`,
+		hexutil.Encode(data))
+	resp, respBody = t.DoRaw(t.NewRawRequest(strings.NewReader(body)))
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d", http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+	if !bytes.HasPrefix(respBody, []byte("content length too large")) {
+		t.Fatalf("expected content length error, got %q", respBody)
+	}
+}
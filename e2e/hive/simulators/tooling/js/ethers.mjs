@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Smoke test of ethers v6: deploy, call, estimate and decode events.
+
+import { ContractFactory, JsonRpcProvider, Wallet } from "ethers";
+import { assert, chainId, compileCounter, privateKey, rpcURL, run } from "./lib/common.mjs";
+
+run("ethers", async () => {
+  const provider = new JsonRpcProvider(rpcURL);
+  const network = await provider.getNetwork();
+  assert.equal(network.chainId, BigInt(chainId));
+
+  const wallet = new Wallet(privateKey, provider);
+  const { abi, bytecode } = compileCounter();
+  const counter = await new ContractFactory(abi, bytecode, wallet).deploy();
+  await counter.waitForDeployment();
+  assert.equal(await counter.count(), 0n);
+
+  const gas = await counter.increment.estimateGas();
+  assert.ok(gas > 21000n, `unexpected gas estimate ${gas}`);
+
+  const tx = await counter.increment({ gasLimit: gas });
+  const receipt = await tx.wait();
+  assert.equal(receipt.status, 1);
+  assert.equal(receipt.logs.length, 1);
+  const event = counter.interface.parseLog(receipt.logs[0]);
+  assert.equal(event.name, "Incremented");
+  assert.equal(event.args.sender, wallet.address);
+  assert.equal(event.args.count, 1n);
+
+  const events = await counter.queryFilter(counter.filters.Incremented(), receipt.blockNumber);
+  assert.equal(events.length, 1);
+  assert.equal(await counter.count(), 1n);
+
+  const block = await provider.getBlock(receipt.blockNumber, true);
+  assert.equal(block.hash, receipt.blockHash);
+  assert.ok(block.prefetchedTransactions.some((t) => t.hash === tx.hash));
+});
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Hardhat config of the tooling smoke tests. The simulator passes the node to test and a funded
+// account through the environment.
+
+require("@nomicfoundation/hardhat-ethers");
+
+module.exports = {
+  solidity: {
+    version: "0.8.20",
+    settings: { evmVersion: "shanghai" },
+  },
+  defaultNetwork: "polaris",
+  networks: {
+    polaris: {
+      url: process.env.POLARIS_RPC_URL ?? "http://127.0.0.1:8545",
+      chainId: Number(process.env.POLARIS_CHAIN_ID ?? 0) || undefined,
+      accounts: process.env.POLARIS_PRIVATE_KEY ? [process.env.POLARIS_PRIVATE_KEY] : [],
+    },
+  },
+};
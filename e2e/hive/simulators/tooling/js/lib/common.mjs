@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Shared setup of the tooling smoke tests. The simulator passes the node to test and a funded
+// account through the environment.
+
+import { readFileSync } from "node:fs";
+import { createRequire } from "node:module";
+import assert from "node:assert/strict";
+
+const require = createRequire(import.meta.url);
+const solc = require("solc");
+
+export const rpcURL = process.env.POLARIS_RPC_URL;
+export const privateKey = process.env.POLARIS_PRIVATE_KEY;
+export const chainId = Number(process.env.POLARIS_CHAIN_ID);
+
+assert.ok(rpcURL, "POLARIS_RPC_URL is not set");
+assert.ok(privateKey, "POLARIS_PRIVATE_KEY is not set");
+assert.ok(chainId > 0, "POLARIS_CHAIN_ID is not set");
+
+// compileCounter compiles contracts/Counter.sol with solc-js, so that the tools that do not
+// compile Solidity themselves deploy the same contract as Hardhat.
+export function compileCounter() {
+  const source = readFileSync(new URL("../contracts/Counter.sol", import.meta.url), "utf8");
+  const output = JSON.parse(
+    solc.compile(
+      JSON.stringify({
+        language: "Solidity",
+        sources: { "Counter.sol": { content: source } },
+        settings: {
+          evmVersion: "shanghai",
+          outputSelection: { "*": { "*": ["abi", "evm.bytecode.object"] } },
+        },
+      }),
+    ),
+  );
+  const errors = (output.errors ?? []).filter((e) => e.severity === "error");
+  assert.equal(errors.length, 0, errors.map((e) => e.formattedMessage).join("\n"));
+
+  const counter = output.contracts["Counter.sol"].Counter;
+  return { abi: counter.abi, bytecode: "0x" + counter.evm.bytecode.object };
+}
+
+// run runs the smoke test and exits with a non-zero code if it fails.
+export function run(name, fn) {
+  fn().then(
+    () => console.log(`${name}: ok`),
+    (err) => {
+      console.error(`${name}: failed`);
+      console.error(err);
+      process.exit(1);
+    },
+  );
+}
+
+export { assert };
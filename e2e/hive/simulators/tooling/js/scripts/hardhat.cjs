@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Smoke test of Hardhat with its ethers plugin: compile, deploy, call, estimate and decode
+// events. Run with `npx hardhat run scripts/hardhat.cjs`.
+
+const assert = require("node:assert/strict");
+const hre = require("hardhat");
+
+async function main() {
+  const [signer] = await hre.ethers.getSigners();
+  const counter = await hre.ethers.deployContract("Counter");
+  await counter.waitForDeployment();
+  assert.equal(await counter.count(), 0n);
+
+  const gas = await counter.increment.estimateGas();
+  assert.ok(gas > 21000n, `unexpected gas estimate ${gas}`);
+
+  const receipt = await (await counter.increment({ gasLimit: gas })).wait();
+  assert.equal(receipt.status, 1);
+  const event = counter.interface.parseLog(receipt.logs[0]);
+  assert.equal(event.name, "Incremented");
+  assert.equal(event.args.sender, signer.address);
+  assert.equal(event.args.count, 1n);
+  assert.equal(await counter.count(), 1n);
+}
+
+main().then(
+  () => console.log("hardhat: ok"),
+  (err) => {
+    console.error("hardhat: failed");
+    console.error(err);
+    process.exit(1);
+  },
+);
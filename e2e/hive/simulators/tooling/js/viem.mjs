@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Smoke test of viem: deploy, call, estimate and decode events.
+
+import { createPublicClient, createWalletClient, defineChain, http, parseEventLogs } from "viem";
+import { privateKeyToAccount } from "viem/accounts";
+import { assert, chainId, compileCounter, privateKey, rpcURL, run } from "./lib/common.mjs";
+
+run("viem", async () => {
+  const chain = defineChain({
+    id: chainId,
+    name: "polaris",
+    network: "polaris",
+    nativeCurrency: { name: "Bera", symbol: "BERA", decimals: 18 },
+    rpcUrls: { default: { http: [rpcURL] }, public: { http: [rpcURL] } },
+  });
+  const account = privateKeyToAccount(privateKey);
+  const publicClient = createPublicClient({ chain, transport: http() });
+  const walletClient = createWalletClient({ account, chain, transport: http() });
+  assert.equal(await publicClient.getChainId(), chainId);
+
+  const { abi, bytecode } = compileCounter();
+  const deployHash = await walletClient.deployContract({ abi, bytecode });
+  const deployment = await publicClient.waitForTransactionReceipt({ hash: deployHash });
+  assert.equal(deployment.status, "success");
+  const address = deployment.contractAddress;
+  const count = () => publicClient.readContract({ address, abi, functionName: "count" });
+  assert.equal(await count(), 0n);
+
+  const gas = await publicClient.estimateContractGas({
+    address,
+    abi,
+    functionName: "increment",
+    account,
+  });
+  assert.ok(gas > 21000n, `unexpected gas estimate ${gas}`);
+
+  const { request } = await publicClient.simulateContract({
+    address,
+    abi,
+    functionName: "increment",
+    account,
+  });
+  const hash = await walletClient.writeContract(request);
+  const receipt = await publicClient.waitForTransactionReceipt({ hash });
+  assert.equal(receipt.status, "success");
+  const [event] = parseEventLogs({ abi, logs: receipt.logs });
+  assert.equal(event.eventName, "Incremented");
+  assert.equal(event.args.sender, account.address);
+  assert.equal(event.args.count, 1n);
+
+  const logs = await publicClient.getContractEvents({
+    address,
+    abi,
+    eventName: "Incremented",
+    fromBlock: receipt.blockNumber,
+  });
+  assert.equal(logs.length, 1);
+  assert.equal(await count(), 1n);
+
+  const block = await publicClient.getBlock({
+    blockNumber: receipt.blockNumber,
+    includeTransactions: true,
+  });
+  assert.equal(block.hash, receipt.blockHash);
+  assert.ok(block.transactions.some((t) => t.hash === hash));
+});
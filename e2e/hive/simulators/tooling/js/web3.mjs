@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Smoke test of web3.js v4: deploy, call, estimate and decode events.
+
+import { Web3 } from "web3";
+import { assert, chainId, compileCounter, privateKey, rpcURL, run } from "./lib/common.mjs";
+
+run("web3.js", async () => {
+  const web3 = new Web3(rpcURL);
+  assert.equal(await web3.eth.getChainId(), BigInt(chainId));
+
+  const account = web3.eth.accounts.privateKeyToAccount(privateKey);
+  web3.eth.accounts.wallet.add(account);
+
+  const { abi, bytecode } = compileCounter();
+  const counter = await new web3.eth.Contract(abi)
+    .deploy({ data: bytecode })
+    .send({ from: account.address });
+  assert.ok(counter.options.address, "missing contract address");
+  assert.equal(await counter.methods.count().call(), 0n);
+
+  const gas = await counter.methods.increment().estimateGas({ from: account.address });
+  assert.ok(gas > 21000n, `unexpected gas estimate ${gas}`);
+
+  const receipt = await counter.methods.increment().send({ from: account.address, gas });
+  assert.equal(receipt.status, 1n);
+  const event = receipt.events.Incremented;
+  assert.ok(event, "missing Incremented event");
+  assert.equal(event.returnValues.sender, account.address);
+  assert.equal(event.returnValues.count, 1n);
+
+  const events = await counter.getPastEvents("Incremented", { fromBlock: receipt.blockNumber });
+  assert.equal(events.length, 1);
+  assert.equal(await counter.methods.count().call(), 1n);
+
+  const block = await web3.eth.getBlock(receipt.blockNumber, true);
+  assert.equal(block.hash, receipt.blockHash);
+  assert.ok(block.transactions.some((t) => t.hash === receipt.transactionHash));
+});
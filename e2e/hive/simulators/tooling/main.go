@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/ethereum/hive/hivesim"
+)
+
+// tool is a smoke test of a JavaScript Ethereum library, run from the js directory.
+type tool struct {
+	Name    string
+	Command []string
+}
+
+var (
+	// This is the account funded in init/genesis.json that the smoke tests send from.
+	senderKey = "0x63b508a03c3b5937ceb903af8b1b0c191012ef6eb7e9c3fb7afa94e5d214d376"
+	chainID   = "7"
+
+	files = map[string]string{
+		"/genesis.json": "./init/genesis.json",
+	}
+
+	clientEnv = hivesim.Params{
+		"HIVE_NETWORK_ID": chainID,
+		"HIVE_CHAIN_ID":   chainID,
+	}
+)
+
+var tools = []tool{
+	{Name: "ethers", Command: []string{"node", "ethers.mjs"}},
+	{Name: "viem", Command: []string{"node", "viem.mjs"}},
+	{Name: "web3.js", Command: []string{"node", "web3.mjs"}},
+	{Name: "hardhat", Command: []string{"npx", "hardhat", "run", "scripts/hardhat.cjs"}},
+}
+
+func main() {
+	suite := hivesim.Suite{
+		Name: "tooling",
+		Description: `The tooling test suite runs smoke tests of the popular JavaScript Ethereum
+libraries against a running node. Each test deploys a contract, calls it, estimates and sends a
+transaction and decodes its events, which catches the JSON-RPC incompatibilities these tools are
+sensitive to.`[1:],
+	}
+
+	suite.Add(&hivesim.ClientTestSpec{
+		Role:        "eth1",
+		Name:        "client launch",
+		Description: `This test launches the client and runs every tooling smoke test against it.`,
+		Parameters:  clientEnv,
+		Files:       files,
+		Run:         runAllTools,
+		AlwaysRun:   true,
+	})
+
+	sim := hivesim.New()
+	hivesim.MustRunSuite(sim, suite)
+}
+
+// runAllTools runs the smoke tests one after another, since they all send from the same
+// account and would otherwise race on its nonce.
+func runAllTools(t *hivesim.T, c *hivesim.Client) {
+	rpcURL := fmt.Sprintf("http://%v:8545/", c.IP)
+
+	// wait for blocks...
+	time.Sleep(3 * time.Second) //nolint:gomnd // it's okay.
+	for _, tl := range tools {
+		tl := tl
+		t.Run(hivesim.TestSpec{
+			Name:        fmt.Sprintf("%s (%s)", tl.Name, c.Type),
+			Description: fmt.Sprintf("Runs the %s smoke test against the node.", tl.Name),
+			Run:         func(t *hivesim.T) { runTool(t, rpcURL, tl) },
+		})
+	}
+}
+
+// runTool runs the smoke test of the tool and fails the test if it exits with an error.
+func runTool(t *hivesim.T, rpcURL string, tl tool) {
+	cmd := exec.Command(tl.Command[0], tl.Command[1:]...) //#nosec:G204 // the commands are fixed.
+	cmd.Dir = "./js"
+	cmd.Env = append(os.Environ(),
+		"POLARIS_RPC_URL="+rpcURL,
+		"POLARIS_PRIVATE_KEY="+senderKey,
+		"POLARIS_CHAIN_ID="+chainID,
+	)
+	out, err := cmd.CombinedOutput()
+	t.Logf("%s", out)
+	if err != nil {
+		t.Fatalf("%s smoke test failed: %v", tl.Name, err)
+	}
+}
@@ -25,8 +25,14 @@ import (
 )
 
 type (
-	Bytes = hexutil.Bytes
-	Uint  = hexutil.Uint
+	Big    = hexutil.Big
+	Bytes  = hexutil.Bytes
+	Uint   = hexutil.Uint
+	Uint64 = hexutil.Uint64
 )
 
-var MustDecode = hexutil.MustDecode
+var (
+	Decode     = hexutil.Decode
+	Encode     = hexutil.Encode
+	MustDecode = hexutil.MustDecode
+)
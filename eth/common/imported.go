@@ -40,5 +40,6 @@ var (
 	HexToAddress   = common.HexToAddress
 	Hex2Bytes      = common.Hex2Bytes
 	HexToHash      = common.HexToHash
+	IsHexAddress   = common.IsHexAddress
 	LeftPadBytes   = common.LeftPadBytes
 )
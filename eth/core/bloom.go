@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import (
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/lib/errors"
+)
+
+// MergeBlooms returns the logs bloom of a block with the given receipts, which is the union of
+// the blooms of the receipts.
+func MergeBlooms(receipts types.Receipts) types.Bloom {
+	var bloom types.Bloom
+	for _, receipt := range receipts {
+		for i := range bloom {
+			bloom[i] |= receipt.Bloom[i]
+		}
+	}
+	return bloom
+}
+
+// VerifyLogsBloom checks that the bloom of each of the given receipts is the bloom of its logs,
+// and that the given logs bloom of their block is the union of the blooms of the receipts.
+func VerifyLogsBloom(bloom types.Bloom, receipts types.Receipts) error {
+	for i, receipt := range receipts {
+		if receipt.Bloom != types.CreateBloom(types.Receipts{receipt}) {
+			return errors.Wrapf(
+				ErrInvalidLogsBloom, "bloom of receipt %d of tx [%s]", i, receipt.TxHash.Hex(),
+			)
+		}
+	}
+	if bloom != MergeBlooms(receipts) {
+		return errors.Wrap(ErrInvalidLogsBloom, "logs bloom of the header")
+	}
+	return nil
+}
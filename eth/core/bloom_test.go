@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core_test
+
+import (
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logs Bloom", func() {
+	var receipts types.Receipts
+
+	BeforeEach(func() {
+		receipts = types.Receipts{
+			{Logs: []*types.Log{{Address: common.Address{0x1}, Topics: []common.Hash{{0x2}}}}},
+			{},
+			{Logs: []*types.Log{{Address: common.Address{0x3}}}},
+		}
+		for _, receipt := range receipts {
+			receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+		}
+	})
+
+	It("should merge the blooms of the receipts", func() {
+		bloom := core.MergeBlooms(receipts)
+		Expect(bloom).To(Equal(types.CreateBloom(receipts)))
+		Expect(bloom.Test(common.Address{0x1}.Bytes())).To(BeTrue())
+		Expect(bloom.Test(common.Hash{0x2}.Bytes())).To(BeTrue())
+		Expect(bloom.Test(common.Address{0x3}.Bytes())).To(BeTrue())
+		Expect(core.MergeBlooms(nil)).To(Equal(types.Bloom{}))
+	})
+
+	It("should verify the logs bloom of a block", func() {
+		Expect(core.VerifyLogsBloom(types.CreateBloom(receipts), receipts)).To(Succeed())
+		Expect(core.VerifyLogsBloom(types.Bloom{}, nil)).To(Succeed())
+
+		Expect(core.VerifyLogsBloom(types.Bloom{}, receipts)).To(MatchError(core.ErrInvalidLogsBloom))
+
+		bloom := types.CreateBloom(receipts)
+		receipts[1].Bloom = receipts[0].Bloom
+		Expect(core.VerifyLogsBloom(bloom, receipts)).To(MatchError(core.ErrInvalidLogsBloom))
+	})
+})
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"sync"
 	"sync/atomic"
 
 	lru "github.com/ethereum/go-ethereum/common/lru"
@@ -66,10 +67,8 @@ type blockchain struct {
 	// vmConfig is the configuration used to create the EVM.
 	vmConfig *vm.Config
 
-	// currentBlock is the current/pending block.
+	// currentBlock is the latest block, which is also the safe and finalized block.
 	currentBlock atomic.Pointer[types.Block]
-	// finalizedBlock is the finalized/latest block.
-	finalizedBlock atomic.Pointer[types.Block]
 	// currentReceipts is the current/pending receipts.
 	currentReceipts atomic.Value
 	// currentLogs is the current/pending logs.
@@ -94,9 +93,18 @@ type blockchain struct {
 	chainHeadFeed   event.Feed
 	logsFeed        event.Feed
 	pendingLogsFeed event.Feed
-	rmLogsFeed      event.Feed // currently never used
+	rmLogsFeed      event.Feed
 	chainSideFeed   event.Feed // currently never used
 	logger          log.Logger
+
+	// notifications is the queue of the events of the finalized blocks, which are sent to the
+	// feeds in the background.
+	notifications chan *chainNotification
+	// deferred are the blocks finalized while the queue was full, whose events are read back from
+	// the chain. deferredReady is signaled when a block is deferred.
+	deferredMu    sync.Mutex
+	deferred      []*chainNotification
+	deferredReady chan struct{}
 }
 
 // =========================================================================
@@ -119,6 +127,8 @@ func NewChain(host PolarisHostChain) *blockchain { //nolint:revive // only used
 		txLookupCache:  lru.NewCache[common.Hash, *types.TxLookupEntry](defaultCacheSizeBytes),
 		chainHeadFeed:  event.Feed{},
 		scope:          event.SubscriptionScope{},
+		notifications:  make(chan *chainNotification, chainEventsQueueSize),
+		deferredReady:  make(chan struct{}, 1),
 		logger:         log.Root(),
 	}
 	bc.statedb = state.NewStateDB(bc.sp)
@@ -126,7 +136,7 @@ func NewChain(host PolarisHostChain) *blockchain { //nolint:revive // only used
 		bc.cp, bc.gp, host.GetPrecompilePlugin(), bc.statedb, bc.vmConfig,
 	)
 	bc.currentBlock.Store(nil)
-	bc.finalizedBlock.Store(nil)
+	go bc.notifyLoop()
 
 	return bc
 }
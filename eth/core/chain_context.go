@@ -25,6 +25,7 @@ import (
 
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
 )
 
 // GetHeader returns the header for the given hash or number. This is used by the `GetHashFn`.
@@ -36,6 +37,18 @@ func (bc *blockchain) GetHeader(hash common.Hash, number uint64) *types.Header {
 	return header
 }
 
+// getHashFn returns a GetHashFunc which returns the hashes of the headers preceding the given
+// header, as stored by the block plugin. It backs the BLOCKHASH opcode, which only queries the
+// 256 most recent blocks.
+func (bc *blockchain) getHashFn(ref *types.Header) vm.GetHashFunc {
+	return func(n uint64) common.Hash {
+		if n+1 == ref.Number.Uint64() {
+			return ref.ParentHash
+		}
+		return bc.bp.GetHeaderHash(n)
+	}
+}
+
 // Engine returns the consensus engine. For our use case, this never gets called.
 func (bc *blockchain) Engine() consensus.Engine {
 	return nil
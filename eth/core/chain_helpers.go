@@ -25,6 +25,51 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/types"
 )
 
+// isCanonical returns whether the block with the given hash is the canonical block at the given
+// height. A block that was rolled back by the host chain is no longer canonical, even if it is
+// still cached or stored by the historical plugin.
+func (bc *blockchain) isCanonical(hash common.Hash, number uint64) bool {
+	return bc.bp.GetCanonicalHash(number) == hash
+}
+
+// rollbackTo drops the blocks from the given height up to the current block, which were rolled
+// back by the host chain, from the caches. It returns the logs of the dropped blocks, marked as
+// removed, for the removed logs subscribers.
+func (bc *blockchain) rollbackTo(number uint64) []*types.Log {
+	current := bc.currentBlock.Load()
+	if current == nil || current.NumberU64() < number {
+		return nil
+	}
+
+	var removed []*types.Log
+	for n := current.NumberU64() + 1; n > number; n-- {
+		block, ok := bc.blockNumCache.Peek(n - 1)
+		if !ok {
+			continue
+		}
+		bc.logger.Info("rolling back evm block", "block_hash", block.Hash().Hex(), "number", n-1)
+		bc.blockNumCache.Remove(n - 1)
+		bc.blockHashCache.Remove(block.Hash())
+		for _, tx := range block.Transactions() {
+			bc.txLookupCache.Remove(tx.Hash())
+		}
+
+		receipts, ok := bc.receiptsCache.Peek(block.Hash())
+		if !ok {
+			continue
+		}
+		bc.receiptsCache.Remove(block.Hash())
+		for _, receipt := range receipts {
+			for _, log := range receipt.Logs {
+				removedLog := *log
+				removedLog.Removed = true
+				removed = append(removed, &removedLog)
+			}
+		}
+	}
+	return removed
+}
+
 // deriveReceipts derives the receipts from the block.
 func (bc *blockchain) deriveReceipts(receipts types.Receipts, blockHash common.Hash) (types.Receipts, error) {
 	// get the block to derive the receipts
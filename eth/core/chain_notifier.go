@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// chainEventsQueueSize is the number of finalized blocks whose events can wait to be sent to the
+// subscribers. The events of the blocks finalized while the queue is full are not dropped, they
+// are deferred and read back from the chain once the subscribers catch up.
+const chainEventsQueueSize = 128
+
+// chainNotification holds the events of a finalized block, along with the logs of the blocks
+// that it rolled back. The notification of a deferred block only holds its number and the logs
+// that it removed, its events are read back from the chain.
+type chainNotification struct {
+	block   *types.Block
+	number  uint64
+	logs    []*types.Log
+	removed []*types.Log
+}
+
+// notify queues the events of the given finalized block for the subscribers. It never blocks the
+// finalization of the block on slow subscribers: once the queue is full, the block is deferred,
+// along with every block finalized until the subscribers catch up, so that they are sent in
+// order. The logs removed by a deferred block are kept, as they cannot be read back.
+func (bc *blockchain) notify(n *chainNotification) {
+	bc.deferredMu.Lock()
+	defer bc.deferredMu.Unlock()
+	if len(bc.deferred) == 0 {
+		select {
+		case bc.notifications <- n:
+			return
+		default:
+		}
+	}
+
+	bc.logger.Warn(
+		"chain event subscribers are behind, deferring to backfill",
+		"number", n.block.NumberU64(), "removed_logs", len(n.removed),
+	)
+	bc.deferred = append(bc.deferred, &chainNotification{
+		number: n.block.NumberU64(), removed: n.removed,
+	})
+	select {
+	case bc.deferredReady <- struct{}{}:
+	default:
+	}
+}
+
+// notifyLoop sends the events of the queued blocks to the subscription feeds, in order. The
+// deferred blocks follow the queued ones, so they are sent once the queue is drained, without
+// waiting for another block to be finalized.
+func (bc *blockchain) notifyLoop() {
+	for {
+		select {
+		case n := <-bc.notifications:
+			bc.sendNotification(n)
+		case <-bc.deferredReady:
+			for drained := false; !drained; {
+				select {
+				case n := <-bc.notifications:
+					bc.sendNotification(n)
+				default:
+					drained = true
+				}
+			}
+
+			// the blocks finalized from now on are queued again, after the deferred ones.
+			bc.deferredMu.Lock()
+			deferred := bc.deferred
+			bc.deferred = nil
+			bc.deferredMu.Unlock()
+			for _, n := range deferred {
+				bc.sendNotification(n)
+			}
+		}
+	}
+}
+
+// sendNotification sends the logs removed by the block of the given notification, then its
+// events, which are read back from the chain if it was deferred.
+func (bc *blockchain) sendNotification(n *chainNotification) {
+	if len(n.removed) > 0 {
+		bc.rmLogsFeed.Send(RemovedLogsEvent{Logs: n.removed})
+	}
+	if n.block == nil {
+		bc.backfillEvents(n.number)
+		return
+	}
+	bc.sendEvents(n.block, n.logs)
+}
+
+// backfillEvents sends the events of the block at the given height, read back from the chain.
+func (bc *blockchain) backfillEvents(number uint64) {
+	block := bc.GetBlockByNumber(number)
+	if block == nil {
+		bc.logger.Error("failed to backfill chain events", "number", number)
+		return
+	}
+
+	logs := make([]*types.Log, 0)
+	for _, receipt := range bc.GetReceiptsByHash(block.Hash()) {
+		logs = append(logs, receipt.Logs...)
+	}
+	bc.logger.Info("backfilling chain events", "number", number, "num_logs", len(logs))
+	bc.sendEvents(block, logs)
+}
+
+// sendEvents sends the events of the given block and its logs to the subscription feeds.
+func (bc *blockchain) sendEvents(block *types.Block, logs []*types.Log) {
+	if logs != nil {
+		bc.pendingLogsFeed.Send(logs)
+		if len(logs) > 0 {
+			bc.logsFeed.Send(logs)
+		}
+	}
+	bc.chainFeed.Send(ChainEvent{Block: block, Hash: block.Hash(), Logs: logs})
+	bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
+}
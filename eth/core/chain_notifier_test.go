@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"math/big"
+	"time"
+
+	lru "github.com/ethereum/go-ethereum/common/lru"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain notifications", func() {
+	const numBlocks = chainEventsQueueSize + 10
+
+	var (
+		bc      *blockchain
+		blocks  []*types.Block
+		events  chan ChainEvent
+		removed chan RemovedLogsEvent
+	)
+
+	BeforeEach(func() {
+		bc = &blockchain{
+			blockNumCache:  lru.NewCache[uint64, *types.Block](defaultCacheSizeBytes),
+			blockHashCache: lru.NewCache[common.Hash, *types.Block](defaultCacheSizeBytes),
+			receiptsCache:  lru.NewCache[common.Hash, types.Receipts](defaultCacheSizeBytes),
+			notifications:  make(chan *chainNotification, chainEventsQueueSize),
+			deferredReady:  make(chan struct{}, 1),
+			logger:         log.Root(),
+		}
+		blocks = make([]*types.Block, numBlocks+2)
+		for i := range blocks {
+			blocks[i] = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(int64(i))})
+			bc.blockNumCache.Add(uint64(i), blocks[i])
+		}
+
+		events = make(chan ChainEvent, len(blocks))
+		removed = make(chan RemovedLogsEvent, len(blocks))
+		DeferCleanup(bc.chainFeed.Subscribe(events).Unsubscribe)
+		DeferCleanup(bc.rmLogsFeed.Subscribe(removed).Unsubscribe)
+	})
+
+	It("should send the events of the blocks finalized while the queue is full", func() {
+		rolledBack := []*types.Log{{Address: common.Address{0x1}, BlockNumber: numBlocks - 5}}
+		for i := 1; i <= numBlocks; i++ {
+			n := &chainNotification{block: blocks[i], logs: []*types.Log{}}
+			if i == numBlocks-5 {
+				n.removed = rolledBack
+			}
+			bc.notify(n)
+		}
+		Expect(bc.notifications).To(HaveLen(chainEventsQueueSize))
+		Expect(bc.deferred).To(HaveLen(numBlocks - chainEventsQueueSize))
+
+		// the subscribers catch up, no other block is finalized meanwhile.
+		go bc.notifyLoop()
+		for i := 1; i <= numBlocks; i++ {
+			var event ChainEvent
+			Eventually(events, time.Second).Should(Receive(&event))
+			Expect(event.Block.NumberU64()).To(Equal(uint64(i)))
+		}
+		Eventually(removed, time.Second).Should(Receive(Equal(RemovedLogsEvent{Logs: rolledBack})))
+
+		// the blocks are queued again once the deferred ones are sent.
+		bc.notify(&chainNotification{block: blocks[numBlocks+1], logs: []*types.Log{}})
+		var event ChainEvent
+		Eventually(events, time.Second).Should(Receive(&event))
+		Expect(event.Block.NumberU64()).To(Equal(uint64(numBlocks + 1)))
+		Consistently(events).ShouldNot(Receive())
+	})
+})
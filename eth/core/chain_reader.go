@@ -21,6 +21,7 @@
 package core
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"pkg.berachain.dev/polaris/eth/common"
@@ -48,6 +49,8 @@ type ChainBlockReader interface {
 	GetHeaderByHash(common.Hash) *types.Header
 	GetBlockByNumber(uint64) *types.Block
 	GetTransactionLookup(common.Hash) *types.TxLookupEntry
+	GetContractCreation(common.Address) *types.TxLookupEntry
+	GetCallTraces(*types.TxLookupEntry) json.RawMessage
 	GetTd(common.Hash, uint64) *big.Int
 
 	// THIS SHOULD BE MOVED TO A "MINER" TYPE THING
@@ -94,23 +97,16 @@ func (bc *blockchain) CurrentSnapBlock() *types.Header {
 	return nil
 }
 
-// GetHeadersFrom returns a contiguous segment of headers, in rlp-form, going
-// backwards from the given number.
+// CurrentFinalBlock returns the header of the current finalized block. CometBFT has single-slot
+// finality, so every committed block is final and the finalized block is the current block.
 func (bc *blockchain) CurrentFinalBlock() *types.Header {
-	fb, ok := utils.GetAs[*types.Block](bc.finalizedBlock.Load())
-	if fb == nil || !ok {
-		return nil
-	}
-	bc.blockNumCache.Add(fb.Number().Uint64(), fb)
-	bc.blockHashCache.Add(fb.Hash(), fb)
-	return fb.Header()
+	return bc.CurrentBlock()
 }
 
-// CurrentSafeBlock retrieves the current safe block of the canonical
-// chain. The block is retrieved from the blockchain's internal cache.
+// CurrentSafeBlock returns the header of the current safe block, which is the current block, as
+// committed blocks can never be reorganized.
 func (bc *blockchain) CurrentSafeBlock() *types.Header {
-	// TODO: determine the difference between safe and final in polaris.
-	return bc.CurrentFinalBlock()
+	return bc.CurrentBlock()
 }
 
 // GetHeaderByHash retrieves a block header from the database by hash, caching it if
@@ -193,6 +189,9 @@ func (bc *blockchain) GetBlock(hash common.Hash, number uint64) *types.Block {
 func (bc *blockchain) GetBlockByHash(hash common.Hash) *types.Block {
 	// check the block hash cache
 	if block, ok := bc.blockHashCache.Get(hash); ok {
+		if !bc.isCanonical(hash, block.NumberU64()) {
+			return nil
+		}
 		bc.blockNumCache.Add(block.Number().Uint64(), block)
 		return block
 	}
@@ -209,6 +208,9 @@ func (bc *blockchain) GetBlockByHash(hash common.Hash) *types.Block {
 		bc.logger.Debug("failed to get receipts from historical plugin", "block", block, "err", err)
 		return nil
 	}
+	if !bc.isCanonical(hash, block.NumberU64()) {
+		return nil
+	}
 
 	// Cache the found block for next time and return
 	bc.blockNumCache.Add(block.Number().Uint64(), block)
@@ -288,6 +290,9 @@ func (bc *blockchain) GetTransactionLookup(
 ) *types.TxLookupEntry {
 	// check the cache
 	if txLookupEntry, ok := bc.txLookupCache.Get(hash); ok {
+		if !bc.isCanonical(txLookupEntry.BlockHash, txLookupEntry.BlockNum) {
+			return nil
+		}
 		return txLookupEntry
 	}
 
@@ -299,7 +304,7 @@ func (bc *blockchain) GetTransactionLookup(
 
 	// check the historical plugin
 	txLookupEntry, err := bc.hp.GetTransactionByHash(hash)
-	if err != nil {
+	if err != nil || !bc.isCanonical(txLookupEntry.BlockHash, txLookupEntry.BlockNum) {
 		return nil
 	}
 
@@ -308,6 +313,40 @@ func (bc *blockchain) GetTransactionLookup(
 	return txLookupEntry
 }
 
+// GetContractCreation returns the lookup entry of the transaction that deployed the contract at
+// the given address. It returns nil if the historical plugin of the host chain does not index
+// contract creations, or if the contract was not deployed by a canonical transaction.
+func (bc *blockchain) GetContractCreation(address common.Address) *types.TxLookupEntry {
+	ccp, ok := bc.hp.(ContractCreationsPlugin)
+	if !ok {
+		bc.logger.Debug("contract creations not indexed by host chain")
+		return nil
+	}
+
+	txHash, err := ccp.GetContractCreationTx(address)
+	if err != nil {
+		return nil
+	}
+	return bc.GetTransactionLookup(txHash)
+}
+
+// GetCallTraces returns the flattened call traces recorded when the transaction of the given
+// lookup entry was executed. It returns nil if the historical plugin of the host chain does not
+// persist call traces, or if they were not recorded or already pruned.
+func (bc *blockchain) GetCallTraces(tle *types.TxLookupEntry) json.RawMessage {
+	ctp, ok := bc.hp.(CallTracesPlugin)
+	if !ok {
+		bc.logger.Debug("call traces not persisted by host chain")
+		return nil
+	}
+
+	traces, err := ctp.GetCallTraces(tle.BlockNum, tle.TxIndex)
+	if err != nil {
+		return nil
+	}
+	return traces
+}
+
 // GetHeaderByNumber retrieves a header from the blockchain.
 func (bc *blockchain) GetHeaderByNumber(number uint64) *types.Header {
 	header, err := bc.bp.GetHeaderByNumber(number)
@@ -24,6 +24,7 @@ import (
 	"context"
 	"math/big"
 
+	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/state"
 	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/core/vm"
@@ -67,9 +68,21 @@ func (bc *blockchain) NewEVMBlockContext(header *types.Header) *vm.BlockContext
 		header.Difficulty = new(big.Int)
 	}
 	blockContext := NewEVMBlockContext(header, bc, &header.Coinbase)
+	blockContext.GetHash = bc.getHashFn(header)
+	blockContext.Transfer = transfer
 	return &blockContext
 }
 
+// transfer moves amount from sender to recipient through the state db if it implements
+// `vm.TransferStateDB`, so that the host chain moves it itself, and as Go-Ethereum does otherwise.
+func transfer(db vm.GethStateDB, sender, recipient common.Address, amount *big.Int) {
+	if tdb, ok := db.(vm.TransferStateDB); ok {
+		tdb.Transfer(sender, recipient, amount)
+		return
+	}
+	Transfer(db, sender, recipient, amount)
+}
+
 // GetVMConfig returns the vm.Config for the current chain.
 func (bc *blockchain) GetVMConfig() *vm.Config {
 	return bc.vmConfig
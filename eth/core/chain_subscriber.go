@@ -27,7 +27,7 @@ import (
 )
 
 type ChainSubscriber interface {
-	SubscribeRemovedLogsEvent(chan<- RemovedLogsEvent) event.Subscription // currently not used
+	SubscribeRemovedLogsEvent(chan<- RemovedLogsEvent) event.Subscription
 	SubscribeChainEvent(chan<- ChainEvent) event.Subscription
 	SubscribeChainHeadEvent(chan<- ChainHeadEvent) event.Subscription
 	SubscribeChainSideEvent(ch chan<- ChainSideEvent) event.Subscription // currently not used
@@ -38,6 +38,9 @@ type ChainWriter interface {
 	// ProcessTransaction processes the given transaction and returns the receipt after applying
 	// the state transition. This method is called for each tx in the block.
 	ProcessTransaction(context.Context, *types.Transaction) (*ExecutionResult, error)
+	// LastReceipt returns the receipt of the last transaction processed in the current block, or
+	// nil if no transaction has been processed yet.
+	LastReceipt() *types.Receipt
 	// Finalize is called after the last tx in the block.
 	Finalize(context.Context) error
 	// SendTx sends the given transaction to the tx pool.
@@ -68,8 +71,8 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		parent = bc.GetHeaderByNumber(number - 1)
 	}
 
-	// Polaris does not set Ethereum state root (Root), mix hash (MixDigest), extra data (Extra),
-	// and block nonce (Nonce) on the new header.
+	// Polaris does not set Ethereum state root (Root), extra data (Extra), and block nonce (Nonce)
+	// on the new header.
 	header := &types.Header{
 		// Used in Polaris.
 		ParentHash: parent.Hash(),
@@ -78,6 +81,11 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		GasLimit:   bc.gp.BlockGasLimit(),
 		Time:       timestamp,
 		BaseFee:    misc.CalcBaseFee(bc.Config(), parent),
+		// The difficulty is set to zero, rather than left nil, so that the header reads the same
+		// before and after it is stored, which decodes it as zero.
+		Difficulty: new(big.Int),
+		// The mix digest is the value returned by PREVRANDAO, as the difficulty is always zero.
+		MixDigest: bc.bp.GetNewBlockRandom(number),
 	}
 
 	bc.logger.Info("preparing evm block", "seal_hash", header.Hash())
@@ -90,6 +98,10 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		bc.GetEVM(ctx, vm.TxContext{}, bc.statedb, header, bc.vmConfig),
 		header,
 	)
+
+	// Record the call traces of the transactions if the host chain persists them.
+	ctp, ok := bc.hp.(CallTracesPlugin)
+	bc.processor.traceCalls = ok && ctp.TraceCalls()
 }
 
 // ProcessTransaction processes the given transaction and returns the receipt.
@@ -103,6 +115,11 @@ func (bc *blockchain) ProcessTransaction(ctx context.Context, tx *types.Transact
 	return bc.processor.ProcessTransaction(ctx, tx)
 }
 
+// LastReceipt returns the receipt of the last transaction processed in the current block.
+func (bc *blockchain) LastReceipt() *types.Receipt {
+	return bc.processor.LastReceipt()
+}
+
 // Finalize finalizes the current block.
 func (bc *blockchain) Finalize(ctx context.Context) error {
 	block, receipts, logs, err := bc.processor.Finalize(ctx)
@@ -130,16 +147,25 @@ func (bc *blockchain) Finalize(ctx context.Context) error {
 			bc.logger.Error("failed to store receipts", "err", err)
 			return err
 		}
+		if ctp, ok := bc.hp.(CallTracesPlugin); ok && bc.processor.traces != nil {
+			if err = ctp.StoreCallTraces(blockHash, bc.processor.traces); err != nil {
+				bc.logger.Error("failed to store call traces", "err", err)
+				return err
+			}
+		}
 		if err = bc.hp.StoreTransactions(blockNum, blockHash, block.Transactions()); err != nil {
 			bc.logger.Error("failed to store transactions", "err", err)
 			return err
 		}
 	}
 
+	// the blocks at and above this height are no longer canonical if the host chain rolled them
+	// back, so they are dropped before the block replaces them.
+	removed := bc.rollbackTo(blockNum)
+
 	// mark the current block, receipts, and logs
 	if block != nil {
 		bc.currentBlock.Store(block)
-		bc.finalizedBlock.Store(block)
 
 		// Todo: nuke these caches.
 		bc.blockNumCache.Add(blockNum, block)
@@ -164,16 +190,11 @@ func (bc *blockchain) Finalize(ctx context.Context) error {
 		bc.receiptsCache.Add(blockHash, receipts)
 	}
 	if logs != nil {
-		bc.pendingLogsFeed.Send(logs)
 		bc.currentLogs.Store(logs)
-		if len(logs) > 0 {
-			bc.logsFeed.Send(logs)
-		}
 	}
 
 	// Send chain events.
-	bc.chainFeed.Send(ChainEvent{Block: block, Hash: blockHash, Logs: logs})
-	bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
+	bc.notify(&chainNotification{block: block, logs: logs, removed: removed})
 
 	return nil
 }
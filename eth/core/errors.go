@@ -25,6 +25,9 @@ import "errors"
 var (
 	ErrBlockOutOfGas    = errors.New("block is out of gas")
 	ErrBlockNotFound    = errors.New("block not found")
+	ErrInvalidLogIndex  = errors.New("log index is not contiguous in block")
+	ErrInvalidGasUsed   = errors.New("gas used does not match the receipts")
+	ErrInvalidLogsBloom = errors.New("logs bloom does not match the logs of the receipts")
 	ErrReceiptsNotFound = errors.New("receipts not found")
 	ErrTxNotFound       = errors.New("transaction not found")
 )
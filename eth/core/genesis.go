@@ -37,6 +37,20 @@ type (
 	GenesisAccount = core.GenesisAccount
 )
 
+var (
+	// DeterministicDeploymentProxy is the canonical address of the deterministic deployment proxy
+	// (https://github.com/Arachnid/deterministic-deployment-proxy), which deploys contracts with
+	// CREATE2 so that they have the same address on every chain. It is predeployed, as the
+	// keyless transaction that deploys it elsewhere is not replay-protected.
+	DeterministicDeploymentProxy = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+	// DeterministicDeploymentProxyCode is the runtime code of the deterministic deployment proxy.
+	DeterministicDeploymentProxyCode = hexutil.MustDecode(
+		"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035" +
+			"828234f58015156039578182fd5b8082525050506014600cf3",
+	)
+)
+
 // DefaultGenesis is the default genesis block used by Polaris.
 var DefaultGenesis = &core.Genesis{
 	// Genesis Config
@@ -58,6 +72,10 @@ var DefaultGenesis = &core.Genesis{
 		common.HexToAddress("0x20f33CE90A13a4b5E7697E3544c3083B8F8A51D4"): {
 			Balance: big.NewInt(5e18), //nolint:gomnd // its okay.
 		},
+		DeterministicDeploymentProxy: {
+			Balance: big.NewInt(0),
+			Code:    DeterministicDeploymentProxyCode,
+		},
 	},
 }
 
@@ -21,6 +21,8 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/event"
@@ -67,8 +69,18 @@ type (
 		// GetNewBlockMetadata returns a new block metadata (coinbase, timestamp) for the given
 		// block number.
 		GetNewBlockMetadata(uint64) (common.Address, uint64)
+		// GetNewBlockRandom returns the entropy of the block at the given block number, which is
+		// exposed to contracts by the PREVRANDAO opcode. It must be deterministic.
+		GetNewBlockRandom(uint64) common.Hash
 		// GetHeaderByNumber returns the block header at the given block number.
 		GetHeaderByNumber(uint64) (*types.Header, error)
+		// GetHeaderHash returns the hash of the block header at the given block number. It is
+		// used by the BLOCKHASH opcode, so it must support at least the last 256 blocks.
+		GetHeaderHash(uint64) common.Hash
+		// GetCanonicalHash returns the hash of the canonical block header at the given block
+		// number, or the empty hash if there is none. A block whose hash differs from it was
+		// rolled back.
+		GetCanonicalHash(uint64) common.Hash
 		// StoreHeader stores the block header at the given block number.
 		StoreHeader(*types.Header) error
 		// BaseFee returns the base fee of the current block.
@@ -102,8 +114,9 @@ type (
 		GasRemaining() uint64
 		// GasConsumed returns the amount of gas used by the current transaction.
 		GasConsumed() uint64
-		// BlockGasConsumed returns the amount of gas used during the current block. The value
-		// returned should NOT include any gas consumed during this transaction.
+		// BlockGasConsumed returns the amount of gas used during the current block, by both EVM
+		// and non-EVM transactions, which bounds the gas available to the next transaction. The
+		// value returned should NOT include any gas consumed during this transaction.
 		// It should not panic.
 		BlockGasConsumed() uint64
 		// BlockGasLimit returns the gas limit of the current block. It should not panic.
@@ -173,6 +186,40 @@ type (
 		StoreTransactions(uint64, common.Hash, types.Transactions) error
 	}
 
+	// ContractCreationsPlugin defines the methods that a HistoricalPlugin can implement in order
+	// to index the transactions that deployed contracts, which the RPC backend serves to contract
+	// verification services. Implementing this plugin is optional.
+	ContractCreationsPlugin interface {
+		// GetContractCreationTx returns the hash of the transaction that deployed the contract at
+		// the given address.
+		GetContractCreationTx(common.Address) (common.Hash, error)
+	}
+
+	// CallTracesPlugin defines the methods that a HistoricalPlugin can implement in order to
+	// persist the flattened call traces of the transactions, recorded as they are executed, so
+	// that the RPC backend serves them without re-executing the transactions. Implementing this
+	// plugin is optional.
+	CallTracesPlugin interface {
+		// TraceCalls returns whether the call traces of the transactions of the block being
+		// processed are to be recorded.
+		TraceCalls() bool
+		// StoreCallTraces stores the call traces of the transactions of the given block hash, in
+		// the order of the transactions. It is called before `StoreTransactions`.
+		StoreCallTraces(common.Hash, []json.RawMessage) error
+		// GetCallTraces returns the call traces of the given transaction of the given block
+		// number.
+		GetCallTraces(uint64, uint64) (json.RawMessage, error)
+	}
+
+	// BaseFeePlugin defines the methods that a ConfigurationPlugin can implement in order to
+	// dispose of the base fee of the transactions, which the state transition burns, e.g. by
+	// crediting it to a treasury. Implementing this plugin is optional.
+	BaseFeePlugin interface {
+		// DisposeBaseFee disposes of the given base fee paid by a transaction, once its state
+		// transition bought its gas. The transaction fails if it returns an error.
+		DisposeBaseFee(context.Context, *big.Int) error
+	}
+
 	// PrecompilePlugin defines the methods that the chain running Polaris EVM should implement
 	// in order to support running their own stateful precompiled contracts. Implementing this
 	// plugin is optional.
@@ -46,6 +46,8 @@ type (
 )
 
 var (
+	// ApplyMessage computes the new state by applying the given message against the old state.
+	ApplyMessage = core.ApplyMessage
 	// ApplyTransactionWithEVM applies a transaction to the current state of the blockchain.
 	ApplyTransactionWithEVMWithResult = core.ApplyTransactionWithEVMWithResult
 	// NewEVMTxContext creates a new context for use in the EVM.
@@ -20,7 +20,10 @@
 
 package mock
 
-import "github.com/ethereum/go-ethereum/core/types"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
 
 // const testBaseFee = 69
 
@@ -28,8 +31,17 @@ import "github.com/ethereum/go-ethereum/core/types"
 
 func NewBlockPluginMock() *BlockPluginMock {
 	return &BlockPluginMock{
+		GetCanonicalHashFunc: func(v uint64) common.Hash {
+			return common.Hash{}
+		},
 		GetHeaderByNumberFunc: func(v uint64) (*types.Header, error) {
 			return &types.Header{}, nil
 		},
+		GetHeaderHashFunc: func(v uint64) common.Hash {
+			return common.Hash{}
+		},
+		GetNewBlockRandomFunc: func(v uint64) common.Hash {
+			return common.Hash{}
+		},
 	}
 }
@@ -25,12 +25,21 @@ var _ core.BlockPlugin = &BlockPluginMock{}
 //			BaseFeeFunc: func() *big.Int {
 //				panic("mock out the BaseFee method")
 //			},
+//			GetCanonicalHashFunc: func(v uint64) common.Hash {
+//				panic("mock out the GetCanonicalHash method")
+//			},
 //			GetHeaderByNumberFunc: func(v uint64) (*types.Header, error) {
 //				panic("mock out the GetHeaderByNumber method")
 //			},
+//			GetHeaderHashFunc: func(v uint64) common.Hash {
+//				panic("mock out the GetHeaderHash method")
+//			},
 //			GetNewBlockMetadataFunc: func(v uint64) (common.Address, uint64) {
 //				panic("mock out the GetNewBlockMetadata method")
 //			},
+//			GetNewBlockRandomFunc: func(v uint64) common.Hash {
+//				panic("mock out the GetNewBlockRandom method")
+//			},
 //			PrepareFunc: func(contextMoqParam context.Context)  {
 //				panic("mock out the Prepare method")
 //			},
@@ -47,12 +56,21 @@ type BlockPluginMock struct {
 	// BaseFeeFunc mocks the BaseFee method.
 	BaseFeeFunc func() *big.Int
 
+	// GetCanonicalHashFunc mocks the GetCanonicalHash method.
+	GetCanonicalHashFunc func(v uint64) common.Hash
+
 	// GetHeaderByNumberFunc mocks the GetHeaderByNumber method.
 	GetHeaderByNumberFunc func(v uint64) (*types.Header, error)
 
+	// GetHeaderHashFunc mocks the GetHeaderHash method.
+	GetHeaderHashFunc func(v uint64) common.Hash
+
 	// GetNewBlockMetadataFunc mocks the GetNewBlockMetadata method.
 	GetNewBlockMetadataFunc func(v uint64) (common.Address, uint64)
 
+	// GetNewBlockRandomFunc mocks the GetNewBlockRandom method.
+	GetNewBlockRandomFunc func(v uint64) common.Hash
+
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
 
@@ -64,16 +82,31 @@ type BlockPluginMock struct {
 		// BaseFee holds details about calls to the BaseFee method.
 		BaseFee []struct {
 		}
+		// GetCanonicalHash holds details about calls to the GetCanonicalHash method.
+		GetCanonicalHash []struct {
+			// V is the v argument value.
+			V uint64
+		}
 		// GetHeaderByNumber holds details about calls to the GetHeaderByNumber method.
 		GetHeaderByNumber []struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// GetHeaderHash holds details about calls to the GetHeaderHash method.
+		GetHeaderHash []struct {
+			// V is the v argument value.
+			V uint64
+		}
 		// GetNewBlockMetadata holds details about calls to the GetNewBlockMetadata method.
 		GetNewBlockMetadata []struct {
 			// V is the v argument value.
 			V uint64
 		}
+		// GetNewBlockRandom holds details about calls to the GetNewBlockRandom method.
+		GetNewBlockRandom []struct {
+			// V is the v argument value.
+			V uint64
+		}
 		// Prepare holds details about calls to the Prepare method.
 		Prepare []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -86,8 +119,11 @@ type BlockPluginMock struct {
 		}
 	}
 	lockBaseFee             sync.RWMutex
+	lockGetCanonicalHash    sync.RWMutex
 	lockGetHeaderByNumber   sync.RWMutex
+	lockGetHeaderHash       sync.RWMutex
 	lockGetNewBlockMetadata sync.RWMutex
+	lockGetNewBlockRandom   sync.RWMutex
 	lockPrepare             sync.RWMutex
 	lockStoreHeader         sync.RWMutex
 }
@@ -119,6 +155,38 @@ func (mock *BlockPluginMock) BaseFeeCalls() []struct {
 	return calls
 }
 
+// GetCanonicalHash calls GetCanonicalHashFunc.
+func (mock *BlockPluginMock) GetCanonicalHash(v uint64) common.Hash {
+	if mock.GetCanonicalHashFunc == nil {
+		panic("BlockPluginMock.GetCanonicalHashFunc: method is nil but BlockPlugin.GetCanonicalHash was just called")
+	}
+	callInfo := struct {
+		V uint64
+	}{
+		V: v,
+	}
+	mock.lockGetCanonicalHash.Lock()
+	mock.calls.GetCanonicalHash = append(mock.calls.GetCanonicalHash, callInfo)
+	mock.lockGetCanonicalHash.Unlock()
+	return mock.GetCanonicalHashFunc(v)
+}
+
+// GetCanonicalHashCalls gets all the calls that were made to GetCanonicalHash.
+// Check the length with:
+//
+//	len(mockedBlockPlugin.GetCanonicalHashCalls())
+func (mock *BlockPluginMock) GetCanonicalHashCalls() []struct {
+	V uint64
+} {
+	var calls []struct {
+		V uint64
+	}
+	mock.lockGetCanonicalHash.RLock()
+	calls = mock.calls.GetCanonicalHash
+	mock.lockGetCanonicalHash.RUnlock()
+	return calls
+}
+
 // GetHeaderByNumber calls GetHeaderByNumberFunc.
 func (mock *BlockPluginMock) GetHeaderByNumber(v uint64) (*types.Header, error) {
 	if mock.GetHeaderByNumberFunc == nil {
@@ -151,6 +219,38 @@ func (mock *BlockPluginMock) GetHeaderByNumberCalls() []struct {
 	return calls
 }
 
+// GetHeaderHash calls GetHeaderHashFunc.
+func (mock *BlockPluginMock) GetHeaderHash(v uint64) common.Hash {
+	if mock.GetHeaderHashFunc == nil {
+		panic("BlockPluginMock.GetHeaderHashFunc: method is nil but BlockPlugin.GetHeaderHash was just called")
+	}
+	callInfo := struct {
+		V uint64
+	}{
+		V: v,
+	}
+	mock.lockGetHeaderHash.Lock()
+	mock.calls.GetHeaderHash = append(mock.calls.GetHeaderHash, callInfo)
+	mock.lockGetHeaderHash.Unlock()
+	return mock.GetHeaderHashFunc(v)
+}
+
+// GetHeaderHashCalls gets all the calls that were made to GetHeaderHash.
+// Check the length with:
+//
+//	len(mockedBlockPlugin.GetHeaderHashCalls())
+func (mock *BlockPluginMock) GetHeaderHashCalls() []struct {
+	V uint64
+} {
+	var calls []struct {
+		V uint64
+	}
+	mock.lockGetHeaderHash.RLock()
+	calls = mock.calls.GetHeaderHash
+	mock.lockGetHeaderHash.RUnlock()
+	return calls
+}
+
 // GetNewBlockMetadata calls GetNewBlockMetadataFunc.
 func (mock *BlockPluginMock) GetNewBlockMetadata(v uint64) (common.Address, uint64) {
 	if mock.GetNewBlockMetadataFunc == nil {
@@ -183,6 +283,38 @@ func (mock *BlockPluginMock) GetNewBlockMetadataCalls() []struct {
 	return calls
 }
 
+// GetNewBlockRandom calls GetNewBlockRandomFunc.
+func (mock *BlockPluginMock) GetNewBlockRandom(v uint64) common.Hash {
+	if mock.GetNewBlockRandomFunc == nil {
+		panic("BlockPluginMock.GetNewBlockRandomFunc: method is nil but BlockPlugin.GetNewBlockRandom was just called")
+	}
+	callInfo := struct {
+		V uint64
+	}{
+		V: v,
+	}
+	mock.lockGetNewBlockRandom.Lock()
+	mock.calls.GetNewBlockRandom = append(mock.calls.GetNewBlockRandom, callInfo)
+	mock.lockGetNewBlockRandom.Unlock()
+	return mock.GetNewBlockRandomFunc(v)
+}
+
+// GetNewBlockRandomCalls gets all the calls that were made to GetNewBlockRandom.
+// Check the length with:
+//
+//	len(mockedBlockPlugin.GetNewBlockRandomCalls())
+func (mock *BlockPluginMock) GetNewBlockRandomCalls() []struct {
+	V uint64
+} {
+	var calls []struct {
+		V uint64
+	}
+	mock.lockGetNewBlockRandom.RLock()
+	calls = mock.calls.GetNewBlockRandom
+	mock.lockGetNewBlockRandom.RUnlock()
+	return calls
+}
+
 // Prepare calls PrepareFunc.
 func (mock *BlockPluginMock) Prepare(contextMoqParam context.Context) {
 	if mock.PrepareFunc == nil {
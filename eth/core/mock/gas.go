@@ -66,6 +66,10 @@ func (w *GasPluginMock) BlockGasConsumed() uint64 {
 	return w.blockGasUsed
 }
 
+func (w *GasPluginMock) SetBlockGasConsumed(amount uint64) {
+	w.blockGasUsed = amount
+}
+
 func (w *GasPluginMock) GasRemaining() uint64 {
 	return w.txGasLimit - w.txGasUsed
 }
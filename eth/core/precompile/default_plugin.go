@@ -53,7 +53,7 @@ func (dp *defaultPlugin) GetPrecompiles(rules *params.Rules) []Registrable {
 // GetActive implements core.PrecompilePlugin.
 func (dp *defaultPlugin) GetActive(rules *params.Rules) []common.Address {
 	pc := dp.GetPrecompiles(rules)
-	active := make([]common.Address, 0, len(pc))
+	active := make([]common.Address, len(pc))
 	for i, p := range pc {
 		active[i] = p.RegistryKey()
 	}
@@ -86,16 +86,18 @@ func (dp *defaultPlugin) DisableReentrancy(EVM) {}
 
 // GetDefaultPrecompiles returns the default set of precompiles for the given rules.
 func GetDefaultPrecompiles(rules *params.Rules) []Registrable {
-	// Depending on the hard fork rules, we need to register a different set of precompiles.
+	// Depending on the hard fork rules, we need to register a different set of precompiles. The
+	// latest fork is matched first, so that the Berlin set, with the EIP-2565 modexp pricing, is
+	// used from Berlin on. STATE-MACHINE BREAKING: see UPGRADING.md.
 	var addrToPrecompiles map[common.Address]vm.PrecompileContainer
 	switch {
-	case rules.IsIstanbul:
-		addrToPrecompiles = vm.PrecompiledContractsIstanbul
 	case rules.IsBerlin:
 		addrToPrecompiles = vm.PrecompiledContractsBerlin
+	case rules.IsIstanbul:
+		addrToPrecompiles = vm.PrecompiledContractsIstanbul
 	case rules.IsByzantium:
 		addrToPrecompiles = vm.PrecompiledContractsByzantium
-	case rules.IsHomestead:
+	default:
 		addrToPrecompiles = vm.PrecompiledContractsHomestead
 	}
 
@@ -21,7 +21,10 @@
 package precompile_test
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/precompile"
@@ -55,4 +58,25 @@ var _ = Describe("Default Plugin", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	When("getting the active precompiles", func() {
+		It("should return the addresses of the precompiles of the fork", func() {
+			rules := params.MainnetChainConfig.Rules(params.MainnetChainConfig.HomesteadBlock, false, 0)
+			Expect(dp.GetActive(&rules)).To(ConsistOf(vm.PrecompiledAddressesHomestead))
+		})
+
+		It("should use the Homestead precompiles before Homestead", func() {
+			rules := params.MainnetChainConfig.Rules(big.NewInt(0), false, 0)
+			Expect(dp.GetActive(&rules)).To(ConsistOf(vm.PrecompiledAddressesHomestead))
+		})
+
+		It("should use the Berlin precompiles after Berlin", func() {
+			rules := params.MainnetChainConfig.Rules(params.MainnetChainConfig.BerlinBlock, false, 0)
+			modexp := common.BytesToAddress([]byte{5})
+			Expect(dp.GetPrecompiles(&rules)).To(
+				ContainElement(vm.PrecompiledContractsBerlin[modexp]),
+			)
+			Expect(dp.GetActive(&rules)).To(ConsistOf(vm.PrecompiledAddressesBerlin))
+		})
+	})
 })
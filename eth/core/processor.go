@@ -22,9 +22,13 @@ package core
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/trie"
 
 	"pkg.berachain.dev/polaris/eth/common"
@@ -33,11 +37,18 @@ import (
 	"pkg.berachain.dev/polaris/eth/core/vm"
 	"pkg.berachain.dev/polaris/lib/errors"
 	"pkg.berachain.dev/polaris/lib/utils"
+
+	// Register the native tracers, which record the call traces of the transactions.
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 )
 
 // initialTxsCapacity is the initial capacity of the transactions and receipts slice.
 const initialTxsCapacity = 256
 
+// callTracer is the native tracer that records the call traces of the transactions, in the
+// flattened format of the `trace` namespace.
+const callTracer = "flatCallTracer"
+
 // StateProcessor is responsible for processing blocks, transactions, and updating the state.
 type StateProcessor struct {
 	// mtx is used to make sure we don't try to prepare a new block before finalizing the
@@ -71,6 +82,11 @@ type StateProcessor struct {
 	sealhash common.Hash // hash of the block prior to being sealed (prior to Finalize called)
 	txs      types.Transactions
 	receipts types.Receipts
+	logIndex uint // index of the next log in the block
+
+	// traceCalls records the call traces of the transactions of the block in `traces` if set.
+	traceCalls bool
+	traces     []json.RawMessage
 }
 
 // NewStateProcessor creates a new state processor with the given host, statedb, vmConfig, and
@@ -115,6 +131,8 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 	sp.sealhash = header.Hash()
 	sp.txs = make(types.Transactions, 0, initialTxsCapacity)
 	sp.receipts = make(types.Receipts, 0, initialTxsCapacity)
+	sp.logIndex = 0
+	sp.traces = nil
 
 	// Ensure that the gas plugin and header are in sync.
 	if sp.header.GasLimit != sp.gp.BlockGasLimit() {
@@ -122,9 +140,11 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 	}
 
 	// We must re-create the signer since we are processing a new block and the block number has
-	// increased.
+	// increased. The signer reuses the senders already recovered while checking the transactions.
 	chainConfig := sp.cp.ChainConfig()
-	sp.signer = types.MakeSigner(chainConfig, sp.header.Number, sp.header.Time)
+	sp.signer = types.NewCachingSigner(
+		types.MakeSigner(chainConfig, sp.header.Number, sp.header.Time),
+	)
 
 	// Setup the EVM for this block.
 	rules := chainConfig.Rules(sp.header.Number, true, sp.header.Time)
@@ -138,7 +158,7 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 
 // ProcessTransaction applies a transaction to the current state of the blockchain.
 func (sp *StateProcessor) ProcessTransaction(
-	_ context.Context, tx *types.Transaction,
+	ctx context.Context, tx *types.Transaction,
 ) (*ExecutionResult, error) {
 	// We set the gasPool = gasLimit - gasUsed.
 	gasPool := new(GasPool).AddGas(sp.header.GasLimit - sp.gp.BlockGasConsumed())
@@ -147,11 +167,20 @@ func (sp *StateProcessor) ProcessTransaction(
 	// This clears the logs and sets the transaction info.
 	sp.statedb.SetTxContext(tx.Hash(), len(sp.txs))
 
+	// Record the call trace of the transaction, if enabled.
+	var tracer tracers.Tracer
+	if sp.traceCalls {
+		tracer = sp.newCallTracer(tx)
+	}
+
 	// Inshallah we will be able to apply the transaction.
 	receipt, result, err := ApplyTransactionWithEVMWithResult(
 		sp.evm, sp.cp.ChainConfig(), gasPool, sp.statedb, sp.header.BaseFee,
 		sp.header.Number, sp.sealhash, sp.header.Time, tx, &sp.header.GasUsed,
 	)
+	if tracer != nil {
+		sp.evm.Config.Tracer = sp.vmConfig.Tracer
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not apply transaction [%s]", tx.Hash().Hex())
 	}
@@ -160,17 +189,52 @@ func (sp *StateProcessor) ProcessTransaction(
 	// gas on the plugin cases, the line below will consume the remaining gas for the block and
 	// transaction respectively.
 	if err = sp.gp.ConsumeGas(receipt.GasUsed); err != nil {
+		// The transaction is not included in the block, so its gas must not count towards the
+		// cumulative gas used of the receipts that follow.
+		sp.header.GasUsed -= receipt.GasUsed
 		return nil, errors.Wrapf(err, "could not consume gas used %d [%s]", len(sp.txs), tx.Hash().Hex())
 	}
 
+	// The state transition burns the base fee, which the host chain may dispose of otherwise.
+	if err = sp.disposeBaseFee(ctx, receipt.GasUsed); err != nil {
+		sp.header.GasUsed -= receipt.GasUsed
+		return nil, errors.Wrapf(err, "could not dispose of the base fee [%s]", tx.Hash().Hex())
+	}
+
+	// Set the price paid per unit of gas, as it is only derived for stored receipts.
+	receipt.EffectiveGasPrice = EffectiveGasPrice(tx, sp.header.BaseFee)
+
+	// Logs are indexed by their position in the block, not in the transaction.
+	for _, log := range receipt.Logs {
+		log.Index = sp.logIndex
+		sp.logIndex++
+	}
+
 	// Update the block information.
 	sp.txs = append(sp.txs, tx)
 	sp.receipts = append(sp.receipts, receipt)
+	if sp.traceCalls {
+		// A trace that cannot be recorded is left empty, it must not fail the transaction.
+		var trace json.RawMessage
+		if tracer != nil {
+			trace, _ = tracer.GetResult()
+		}
+		sp.traces = append(sp.traces, trace)
+	}
 
 	// Return the execution result to the caller.
 	return result, err
 }
 
+// LastReceipt returns the receipt of the last transaction processed in the current block, or nil
+// if no transaction has been processed yet.
+func (sp *StateProcessor) LastReceipt() *types.Receipt {
+	if len(sp.receipts) == 0 {
+		return nil
+	}
+	return sp.receipts[len(sp.receipts)-1]
+}
+
 // Finalize finalizes the block in the state processor and returns the receipts and bloom filter to
 // be "sealed".
 func (sp *StateProcessor) Finalize(
@@ -179,10 +243,24 @@ func (sp *StateProcessor) Finalize(
 	// We unlock the state processor to ensure that the state is consistent.
 	defer sp.mtx.Unlock()
 
+	// The gas used by the block is the sum of the gas used by its receipts, which must account for
+	// all the gas used by the EVM. The gas of the non-EVM transactions of the host chain only
+	// bounds the gas available to the block, as no receipt accounts for it.
+	gasUsed, err := receiptsGasUsed(sp.receipts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if gasUsed != sp.header.GasUsed {
+		return nil, nil, nil, errors.Wrapf(
+			ErrInvalidGasUsed, "receipts used %d, evm used %d", gasUsed, sp.header.GasUsed,
+		)
+	}
+
+	// The logs bloom of the block is the union of the blooms of its receipts.
+	sp.header.Bloom = MergeBlooms(sp.receipts)
+
 	var (
-		// "FinalizeAndAssemble" the block with the txs and receipts (sets the TxHash, ReceiptHash,
-		// and Bloom).
-		block = types.NewBlock(sp.header, sp.txs, nil, sp.receipts, trie.NewStackTrie(nil))
+		block = sp.assembleBlock()
 		hash  = block.Hash()
 		logs  []*types.Log
 	)
@@ -197,6 +275,11 @@ func (sp *StateProcessor) Finalize(
 		logs = append(logs, receipt.Logs...)
 	}
 
+	// Ensure that the logs of the block are indexed contiguously across all of its receipts.
+	if err = VerifyLogIndices(logs); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// We return a new block with the updated header and the receipts to the `blockchain`.
 	return block, sp.receipts, logs, nil
 }
@@ -205,6 +288,79 @@ func (sp *StateProcessor) Finalize(
 // Utilities
 // ===========================================================================
 
+// assembleBlock "FinalizeAndAssemble"s the block with the txs and receipts. It sets the TxHash,
+// ReceiptHash, Bloom and UncleHash of the header, as well as the WithdrawalsHash once Shanghai is
+// active, so that the block hash is that of the equivalent Ethereum header.
+func (sp *StateProcessor) assembleBlock() *types.Block {
+	rules := sp.cp.ChainConfig().Rules(sp.header.Number, true, sp.header.Time)
+	if !rules.IsShanghai {
+		return types.NewBlock(sp.header, sp.txs, nil, sp.receipts, trie.NewStackTrie(nil))
+	}
+	// Polaris does not process withdrawals, so the block commits to an empty withdrawals list.
+	return types.NewBlockWithWithdrawals(
+		sp.header, sp.txs, nil, sp.receipts, make([]*types.Withdrawal, 0), trie.NewStackTrie(nil),
+	)
+}
+
+// newCallTracer sets a tracer recording the call trace of the given transaction on the EVM of the
+// block, and returns it. It returns nil if the tracer cannot be created. The hash of the block is
+// not known before it is finalized, so the traces do not include it.
+func (sp *StateProcessor) newCallTracer(tx *types.Transaction) tracers.Tracer {
+	tracer, err := tracers.DefaultDirectory.New(callTracer, &tracers.Context{
+		BlockNumber: sp.header.Number,
+		TxIndex:     len(sp.txs),
+		TxHash:      tx.Hash(),
+	}, nil)
+	if err != nil {
+		return nil
+	}
+	sp.evm.Config.Tracer = tracer
+	return tracer
+}
+
+// disposeBaseFee disposes of the base fee paid for the given gas used, if the configuration
+// plugin implements the BaseFeePlugin.
+func (sp *StateProcessor) disposeBaseFee(ctx context.Context, gasUsed uint64) error {
+	bfp, ok := utils.GetAs[BaseFeePlugin](sp.cp)
+	if !ok || sp.header.BaseFee == nil || sp.header.BaseFee.Sign() == 0 || gasUsed == 0 {
+		return nil
+	}
+	fee := new(big.Int).Mul(sp.header.BaseFee, new(big.Int).SetUint64(gasUsed))
+	return bfp.DisposeBaseFee(ctx, fee)
+}
+
+// receiptsGasUsed returns the sum of the gas used by the given receipts, which is the gas used of
+// their block. The gas used by a receipt includes the gas that the precompiles it called charged
+// to the EVM for their Cosmos gas consumption. The gas of the non-EVM transactions of the host
+// chain is not part of any receipt, so that the gas used of each transaction can be derived from
+// the cumulative gas used of consecutive receipts, which must be the running sum of their gas used.
+func receiptsGasUsed(receipts types.Receipts) (uint64, error) {
+	var gasUsed uint64
+	for i, receipt := range receipts {
+		gasUsed += receipt.GasUsed
+		if receipt.CumulativeGasUsed != gasUsed {
+			return 0, errors.Wrapf(
+				ErrInvalidGasUsed, "receipt %d of tx [%s] has cumulative gas used %d, want %d",
+				i, receipt.TxHash.Hex(), receipt.CumulativeGasUsed, gasUsed,
+			)
+		}
+	}
+	return gasUsed, nil
+}
+
+// VerifyLogIndices checks that the given logs of a block are indexed contiguously across all of
+// its receipts, from zero.
+func VerifyLogIndices(logs []*types.Log) error {
+	for i, log := range logs {
+		if log.Index != uint(i) {
+			return errors.Wrapf(
+				ErrInvalidLogIndex, "log %d of tx [%s] has index %d", i, log.TxHash.Hex(), log.Index,
+			)
+		}
+	}
+	return nil
+}
+
 // BuildPrecompiles builds the given precompiles and registers them with the precompile plugins.
 func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.Registrable) {
 	for _, pc := range precompiles {
@@ -240,3 +396,12 @@ func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.R
 		}
 	}
 }
+
+// EffectiveGasPrice returns the price per unit of gas paid by the given transaction, i.e.
+// min(gasTipCap + baseFee, gasFeeCap), or the gas price if there is no base fee.
+func EffectiveGasPrice(tx *types.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return tx.GasPrice()
+	}
+	return math.BigMin(new(big.Int).Add(tx.GasTipCap(), baseFee), tx.GasFeeCap())
+}
@@ -22,8 +22,11 @@ package core_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/rlp"
+
 	bindings "pkg.berachain.dev/polaris/contracts/bindings/testing"
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core"
@@ -59,6 +62,21 @@ var (
 	}
 )
 
+// baseFeeConfigPlugin is a configuration plugin that records the base fees it disposes of.
+type baseFeeConfigPlugin struct {
+	*mock.ConfigurationPluginMock
+	fees []*big.Int
+	err  error
+}
+
+func (p *baseFeeConfigPlugin) DisposeBaseFee(_ context.Context, fee *big.Int) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.fees = append(p.fees, fee)
+	return nil
+}
+
 var _ = Describe("StateProcessor", func() {
 	var (
 		sdb *vmmock.PolarisStateDBMock
@@ -101,12 +119,27 @@ var _ = Describe("StateProcessor", func() {
 			Expect(receipts).To(BeEmpty())
 			Expect(logs).To(BeEmpty())
 		})
+
+		It("should seal the header like an Ethereum header", func() {
+			block, _, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			header := block.Header()
+			Expect(header.UncleHash).To(Equal(types.EmptyUncleHash))
+			Expect(header.TxHash).To(Equal(types.EmptyTxsHash))
+			Expect(header.ReceiptHash).To(Equal(types.EmptyReceiptsHash))
+			Expect(header.WithdrawalsHash).To(Equal(&types.EmptyWithdrawalsHash))
+			bz, err := rlp.EncodeToBytes(header)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Hash()).To(Equal(crypto.Keccak256Hash(bz)))
+		})
 	})
 
 	Context("Block with transactions", func() {
 		BeforeEach(func() {
 			_, _, _, err := sp.Finalize(context.Background())
 			Expect(err).ToNot(HaveOccurred())
+			// The header is shared by the tests, so its gas used is reset for each block.
+			dummyHeader.GasUsed = 0
 			sp.Prepare(evm, dummyHeader)
 		})
 
@@ -115,6 +148,7 @@ var _ = Describe("StateProcessor", func() {
 			receipt, err := sp.ProcessTransaction(context.Background(), types.NewTx(legacyTxData))
 			Expect(err).To(HaveOccurred())
 			Expect(receipt).To(BeNil())
+			Expect(sp.LastReceipt()).To(BeNil())
 			block, receipts, logs, err := sp.Finalize(context.Background())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(block).ToNot(BeNil())
@@ -134,6 +168,8 @@ var _ = Describe("StateProcessor", func() {
 			Expect(result).ToNot(BeNil())
 			Expect(result.Err).ToNot(HaveOccurred())
 			Expect(result.UsedGas).ToNot(BeZero())
+			Expect(sp.LastReceipt().TxHash).To(Equal(signedTx.Hash()))
+			Expect(sp.LastReceipt().GasUsed).To(Equal(result.UsedGas))
 			block, receipts, logs, err := sp.Finalize(context.Background())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(block).ToNot(BeNil())
@@ -141,6 +177,156 @@ var _ = Describe("StateProcessor", func() {
 			Expect(logs).To(BeEmpty())
 		})
 
+		It("should cap the gas refund to a fifth of the gas used", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			sdb.GetRefundFunc = func() uint64 { return 1000000 }
+			signedTx := types.MustSignNewTx(key, signer, legacyTxData)
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			result, err := sp.ProcessTransaction(context.Background(), signedTx)
+			Expect(err).ToNot(HaveOccurred())
+			// The transaction only pays the intrinsic gas of a call with 6 non-zero bytes of data,
+			// of which at most a fifth is refunded since London (EIP-3529).
+			gasUsed := uint64(21000 + 6*16)
+			Expect(result.UsedGas).To(Equal(gasUsed - gasUsed/5))
+			_, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts[0].GasUsed).To(Equal(result.UsedGas))
+			Expect(gp.GasConsumed()).To(Equal(result.UsedGas))
+		})
+
+		It("should keep the gas used of a mixed block the sum of its receipts", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			var gasUsed uint64
+			for i := 0; i < 2; i++ {
+				// A non-EVM transaction of the host chain consumes 1000 gas before each EVM one.
+				gp.SetBlockGasConsumed(gp.BlockGasConsumed() + 1000)
+				signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+					Nonce:    uint64(i),
+					To:       &dummyContract,
+					Gas:      1000000,
+					GasPrice: big.NewInt(1),
+				})
+				Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+				result, err := sp.ProcessTransaction(context.Background(), signedTx)
+				Expect(err).ToNot(HaveOccurred())
+				gasUsed += result.UsedGas
+			}
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(2))
+			Expect(receipts[1].CumulativeGasUsed).To(Equal(gasUsed))
+			Expect(block.GasUsed()).To(Equal(gasUsed))
+		})
+
+		It("should keep the gas used of an EVM only block the sum of its receipts", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			for i := 0; i < 3; i++ {
+				signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+					To:       &dummyContract,
+					Gas:      1000000,
+					GasPrice: big.NewInt(1),
+					Data:     make([]byte, i),
+				})
+				Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+				_, err := sp.ProcessTransaction(context.Background(), signedTx)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(3))
+			var gasUsed uint64
+			for _, receipt := range receipts {
+				gasUsed += receipt.GasUsed
+				Expect(receipt.CumulativeGasUsed).To(Equal(gasUsed))
+			}
+			Expect(block.GasUsed()).To(Equal(gasUsed))
+		})
+
+		It("should dispose of the base fee of a transaction", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			bfp := &baseFeeConfigPlugin{ConfigurationPluginMock: cp}
+			sp = core.NewStateProcessor(bfp, gp, pp, sdb, &vm.Config{})
+			sp.Prepare(evm, dummyHeader)
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			result, err := sp.ProcessTransaction(
+				context.Background(), types.MustSignNewTx(key, signer, legacyTxData),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			fee := new(big.Int).Mul(dummyHeader.BaseFee, new(big.Int).SetUint64(result.UsedGas))
+			Expect(bfp.fees).To(Equal([]*big.Int{fee}))
+		})
+
+		It("should not include a transaction whose base fee cannot be disposed of", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			bfp := &baseFeeConfigPlugin{
+				ConfigurationPluginMock: cp, err: errors.New("treasury unavailable"),
+			}
+			sp = core.NewStateProcessor(bfp, gp, pp, sdb, &vm.Config{})
+			sp.Prepare(evm, dummyHeader)
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			result, err := sp.ProcessTransaction(
+				context.Background(), types.MustSignNewTx(key, signer, legacyTxData),
+			)
+			Expect(err).To(MatchError(ContainSubstring("treasury unavailable")))
+			Expect(result).To(BeNil())
+			Expect(sp.LastReceipt()).To(BeNil())
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(BeEmpty())
+			Expect(block.Transactions()).To(BeEmpty())
+		})
+
+		It("should index logs across all the transactions in the block", func() {
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			sdb.GetLogsFunc = func(
+				hash common.Hash, blockNumber uint64, blockHash common.Hash,
+			) []*types.Log {
+				return []*types.Log{{TxHash: hash}, {TxHash: hash}}
+			}
+			for i := 0; i < 2; i++ {
+				signedTx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+					To:       &dummyContract,
+					Gas:      1000000,
+					GasPrice: big.NewInt(1),
+					Data:     []byte{byte(i)},
+				})
+				Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+				_, err := sp.ProcessTransaction(context.Background(), signedTx)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, receipts, logs, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(2))
+			Expect(logs).To(HaveLen(4))
+			for i, log := range logs {
+				Expect(log.Index).To(Equal(uint(i)))
+			}
+			Expect(core.VerifyLogIndices(logs)).To(Succeed())
+		})
+
+		It("should detect logs indexed per transaction", func() {
+			logs := []*types.Log{{Index: 0}, {Index: 1}, {Index: 0}}
+			Expect(core.VerifyLogIndices(logs)).To(MatchError(core.ErrInvalidLogIndex))
+		})
+
 		It("should handle", func() {
 			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
 				return big.NewInt(1000001)
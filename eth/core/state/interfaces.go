@@ -88,3 +88,11 @@ type Plugin interface {
 	// function.
 	ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error
 }
+
+// TransferPlugin is an OPTIONAL extension of the Plugin, implemented by the chains that move the
+// native token of the EVM between accounts themselves, e.g. to keep it in a bank module.
+type TransferPlugin interface {
+	Plugin
+	// Transfer moves amount from the balance of the first account to the balance of the second.
+	Transfer(common.Address, common.Address, *big.Int)
+}
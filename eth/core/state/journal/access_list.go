@@ -21,9 +21,13 @@
 package journal
 
 import (
+	"bytes"
+	"sort"
+
 	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/lib/ds"
-	"pkg.berachain.dev/polaris/lib/ds/stack"
+	dsjournal "pkg.berachain.dev/polaris/lib/ds/journal"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
 	"pkg.berachain.dev/polaris/lib/utils"
 )
@@ -41,20 +45,32 @@ type Accesslist interface {
 	SlotInAccessList(common.Address, common.Hash) (addressPresent bool, slotPresent bool)
 	// `AddressInAccessList` returns whether the given address is in the access list.
 	AddressInAccessList(common.Address) bool
+	// `AccessList` returns the addresses and slots accessed by the current transaction, sorted.
+	AccessList() coretypes.AccessList
+}
+
+// accessSet is the set of the addresses, and their storage slots, accessed by a transaction
+// (EIP-2929).
+type accessSet map[common.Address]map[common.Hash]struct{}
+
+// accessListChange is a journal entry recording an addition to the access list, so that it can be
+// undone on revert.
+type accessListChange struct {
+	addr    common.Address
+	slot    common.Hash
+	hasSlot bool // whether the slot, rather than the address, was added
 }
 
 type accessList struct {
-	*AccessList                       // current access list, always the head of journal stack.
-	journal     ds.Stack[*AccessList] // journal of access lists.
+	set     accessSet                    // accesses of the current transaction.
+	journal ds.Journal[accessListChange] // journal of additions to the access list.
 }
 
 // NewAccesslist returns a new `accessList` journal.
 func NewAccesslist() Accesslist {
-	journal := stack.New[*AccessList](initCapacity)
-	journal.Push(NewAccessList())
 	return &accessList{
-		AccessList: journal.Peek(),
-		journal:    journal,
+		set:     make(accessSet),
+		journal: dsjournal.New[accessListChange](initCapacity),
 	}
 }
 
@@ -65,35 +81,78 @@ func (al *accessList) RegistryKey() string {
 
 // AddAddressToAccessList implements `state.AccessListJournal`.
 func (al *accessList) AddAddressToAccessList(addr common.Address) {
-	al.AddAddress(addr)
+	if _, ok := al.set[addr]; ok {
+		return
+	}
+	al.set[addr] = nil
+	al.journal.Append(accessListChange{addr: addr})
 }
 
 // AddSlotToAccessList implements `state.AccessListJournal`.
 func (al *accessList) AddSlotToAccessList(addr common.Address, slot common.Hash) {
-	al.AddSlot(addr, slot)
+	slots, addrPresent := al.set[addr]
+	if !addrPresent {
+		al.journal.Append(accessListChange{addr: addr})
+	}
+	if _, slotPresent := slots[slot]; slotPresent {
+		return
+	}
+
+	if slots == nil {
+		slots = make(map[common.Hash]struct{})
+	}
+	slots[slot] = struct{}{}
+	al.set[addr] = slots
+	al.journal.Append(accessListChange{addr: addr, slot: slot, hasSlot: true})
 }
 
 // AddressInAccessList implements `state.AccessListJournal`.
 func (al *accessList) AddressInAccessList(addr common.Address) bool {
-	return al.ContainsAddress(addr)
+	_, ok := al.set[addr]
+	return ok
 }
 
 // SlotInAccessList implements `state.AccessListJournal`.
 func (al *accessList) SlotInAccessList(addr common.Address, slot common.Hash) (bool, bool) {
-	return al.Contains(addr, slot)
+	slots, addrPresent := al.set[addr]
+	_, slotPresent := slots[slot]
+	return addrPresent, slotPresent
+}
+
+// AccessList implements `Accesslist`.
+func (al *accessList) AccessList() coretypes.AccessList {
+	list := make(coretypes.AccessList, 0, len(al.set))
+	for addr, slots := range al.set {
+		keys := make([]common.Hash, 0, len(slots))
+		for slot := range slots {
+			keys = append(keys, slot)
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+		list = append(list, coretypes.AccessTuple{Address: addr, StorageKeys: keys})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Address[:], list[j].Address[:]) < 0
+	})
+	return list
 }
 
 // `Snapshot` implements `libtypes.Snapshottable`.
 func (al *accessList) Snapshot() int {
-	al.AccessList = al.AccessList.Copy()
-	al.journal.Push(al.AccessList)
-	return al.journal.Size() - 1
+	return al.journal.Size()
 }
 
 // RevertToSnapshot implements `libtypes.Snapshottable`.
 func (al *accessList) RevertToSnapshot(id int) {
-	al.journal.PopToSize(id)
-	al.AccessList = al.journal.Peek()
+	al.journal.RevertToSize(id, al.undo)
+}
+
+// undo removes the addition recorded by the given change from the access list.
+func (al *accessList) undo(change accessListChange) {
+	if change.hasSlot {
+		delete(al.set[change.addr], change.slot)
+		return
+	}
+	delete(al.set, change.addr)
 }
 
 // Finalize implements `libtypes.Controllable`.
@@ -103,16 +162,19 @@ func (al *accessList) Finalize() {
 
 // Clone implements `libtypes.Cloneable`.
 func (al *accessList) Clone() Accesslist {
-	size := al.journal.Size()
-	cpy := &accessList{
-		AccessList: al.AccessList.Copy(),
-		journal:    stack.New[*AccessList](size),
+	set := make(accessSet, len(al.set))
+	for addr, slots := range al.set {
+		var cpy map[common.Hash]struct{}
+		if slots != nil {
+			cpy = make(map[common.Hash]struct{}, len(slots))
+			for slot := range slots {
+				cpy[slot] = struct{}{}
+			}
+		}
+		set[addr] = cpy
 	}
-
-	cpy.journal.Push(cpy.AccessList)
-	for i := 1; i < size; i++ { // skip the root, already pushed above
-		cpy.journal.Push(al.journal.PeekAt(i).Copy())
+	return &accessList{
+		set:     set,
+		journal: al.journal.Clone(),
 	}
-
-	return cpy
 }
@@ -22,6 +22,7 @@ package journal
 
 import (
 	"pkg.berachain.dev/polaris/eth/common"
+	coretypes "pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/lib/utils"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -46,49 +47,85 @@ var _ = Describe("AccessList", func() {
 	})
 
 	It("should support controllable access list operations", func() {
-		Expect(al.AddAddress(a1)).To(BeTrue())
-		Expect(al.ContainsAddress(a1)).To(BeTrue())
-		Expect(al.ContainsAddress(a2)).To(BeFalse())
-		al.DeleteAddress(a1)
-		Expect(al.ContainsAddress(a1)).To(BeFalse())
-
-		ac, sc := al.AddSlot(a1, s1)
-		Expect(ac).To(BeTrue())
-		Expect(sc).To(BeTrue())
-		ac, sc = al.AddSlot(a1, s2)
-		Expect(ac).To(BeFalse())
-		Expect(sc).To(BeTrue())
+		al.AddAddressToAccessList(a1)
+		Expect(al.AddressInAccessList(a1)).To(BeTrue())
+		Expect(al.AddressInAccessList(a2)).To(BeFalse())
+
+		al.AddSlotToAccessList(a1, s1)
+		al.AddSlotToAccessList(a1, s2)
+		addrOk, slotOk := al.SlotInAccessList(a1, s2)
+		Expect(addrOk).To(BeTrue())
+		Expect(slotOk).To(BeTrue())
+		addrOk, slotOk = al.SlotInAccessList(a2, s2)
+		Expect(addrOk).To(BeFalse())
+		Expect(slotOk).To(BeFalse())
 
 		id := al.Snapshot()
 
-		ac, sc = al.AddSlot(a2, s1)
-		Expect(ac).To(BeTrue())
-		Expect(sc).To(BeTrue())
-		Expect(al.ContainsAddress(a2)).To(BeTrue())
+		al.AddSlotToAccessList(a2, s1)
+		Expect(al.AddressInAccessList(a2)).To(BeTrue())
 
 		al.RevertToSnapshot(id)
-		Expect(al.ContainsAddress(a2)).To(BeFalse())
+		Expect(al.AddressInAccessList(a2)).To(BeFalse())
 
 		Expect(func() { al.Finalize() }).ToNot(Panic())
+		Expect(al.journal.Size()).To(Equal(0))
+		Expect(al.AddressInAccessList(a1)).To(BeFalse())
+	})
+
+	It("should return the sorted access list", func() {
+		al.AddSlotToAccessList(a2, s2)
+		al.AddSlotToAccessList(a2, s1)
+		al.AddAddressToAccessList(a1)
+		Expect(al.AccessList()).To(Equal(coretypes.AccessList{
+			{Address: a1, StorageKeys: []common.Hash{}},
+			{Address: a2, StorageKeys: []common.Hash{s1, s2}},
+		}))
+
+		al.RevertToSnapshot(1)
+		Expect(al.AccessList()).To(Equal(coretypes.AccessList{
+			{Address: a2, StorageKeys: []common.Hash{s2}},
+		}))
+	})
+
+	It("should revert nested snapshots in order", func() {
+		al.AddAddressToAccessList(a1)
+		outer := al.Snapshot()
+		al.AddSlotToAccessList(a1, s1)
+		inner := al.Snapshot()
+		al.AddSlotToAccessList(a2, s2)
+		al.AddAddressToAccessList(a2) // already present, not journaled
+		Expect(al.journal.Size()).To(Equal(4))
+
+		al.RevertToSnapshot(inner)
+		Expect(al.AddressInAccessList(a2)).To(BeFalse())
+		addrOk, slotOk := al.SlotInAccessList(a1, s1)
+		Expect(addrOk).To(BeTrue())
+		Expect(slotOk).To(BeTrue())
+
+		al.RevertToSnapshot(outer)
+		addrOk, slotOk = al.SlotInAccessList(a1, s1)
+		Expect(addrOk).To(BeTrue())
+		Expect(slotOk).To(BeFalse())
 		Expect(al.journal.Size()).To(Equal(1))
 	})
 
 	It("should clone correctly", func() {
-		ac, sc := al.AddSlot(a1, s1)
-		Expect(ac).To(BeTrue())
-		Expect(sc).To(BeTrue())
-		ac, sc = al.AddSlot(a1, s2)
-		Expect(ac).To(BeFalse())
-		Expect(sc).To(BeTrue())
+		al.AddSlotToAccessList(a1, s1)
+		al.AddSlotToAccessList(a1, s2)
 
 		al2 := utils.MustGetAs[*accessList](al.Clone())
-		Expect(al2.ContainsAddress(a1)).To(BeTrue())
-		Expect(al2.ContainsAddress(a2)).To(BeFalse())
-
-		ac, sc = al2.AddSlot(a2, s1)
-		Expect(ac).To(BeTrue())
-		Expect(sc).To(BeTrue())
-		Expect(al2.ContainsAddress(a2)).To(BeTrue())
-		Expect(al.ContainsAddress(a2)).To(BeFalse())
+		Expect(al2.AddressInAccessList(a1)).To(BeTrue())
+		Expect(al2.AddressInAccessList(a2)).To(BeFalse())
+
+		al2.AddSlotToAccessList(a2, s1)
+		Expect(al2.AddressInAccessList(a2)).To(BeTrue())
+		Expect(al.AddressInAccessList(a2)).To(BeFalse())
+
+		al2.RevertToSnapshot(0)
+		Expect(al2.AddressInAccessList(a1)).To(BeFalse())
+		Expect(al.AddressInAccessList(a1)).To(BeTrue())
+		_, slotOk := al.SlotInAccessList(a1, s2)
+		Expect(slotOk).To(BeTrue())
 	})
 })
@@ -34,4 +34,6 @@ const (
 	suicidesRegistryKey = `suicides`
 	// `transientRegistryKey` is the registry key for the transient journal.
 	transientRegistryKey = `transient`
+	// `touchedRegistryKey` is the registry key for the touched accounts journal.
+	touchedRegistryKey = `touched`
 )
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"testing"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// callDepth is the depth of the call stack simulated by the benchmarks, which take a snapshot
+// when entering each call frame.
+const callDepth = 1024
+
+// Benchmarks simulate a recursive contract which warms an address and a slot and writes to
+// transient storage in each call frame, and whose innermost half of the call stack reverts. The
+// journals only record the changes made in each frame, so the cost of a snapshot does not grow
+// with the size of the state accumulated by the outer frames.
+
+func BenchmarkAccessListDeepCallStack(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		al := NewAccesslist()
+		snapshots := make([]int, 0, callDepth)
+		for d := 0; d < callDepth; d++ {
+			snapshots = append(snapshots, al.Snapshot())
+			addr := common.BytesToAddress([]byte{byte(d >> 8), byte(d)})
+			al.AddAddressToAccessList(addr)
+			al.AddSlotToAccessList(addr, common.BytesToHash([]byte{byte(d >> 8), byte(d)}))
+		}
+		al.RevertToSnapshot(snapshots[callDepth/2])
+	}
+}
+
+func BenchmarkTransientStorageDeepCallStack(b *testing.B) {
+	b.ReportAllocs()
+	addr := common.BytesToAddress([]byte{1})
+	for i := 0; i < b.N; i++ {
+		ts := NewTransientStorage()
+		snapshots := make([]int, 0, callDepth)
+		for d := 0; d < callDepth; d++ {
+			snapshots = append(snapshots, ts.Snapshot())
+			ts.SetTransientState(addr, common.BytesToHash([]byte{byte(d >> 8), byte(d)}), common.Hash{1})
+		}
+		ts.RevertToSnapshot(snapshots[callDepth/2])
+	}
+}
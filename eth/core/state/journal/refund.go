@@ -21,6 +21,8 @@
 package journal
 
 import (
+	"fmt"
+
 	"pkg.berachain.dev/polaris/lib/ds"
 	"pkg.berachain.dev/polaris/lib/ds/stack"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
@@ -68,9 +70,14 @@ func (r *refund) AddRefund(gas uint64) {
 	r.Push(r.Peek() + gas)
 }
 
-// SubRefund subtracts the given `gas` from the refund counter.
+// SubRefund subtracts the given `gas` from the refund counter. It panics if the refund counter
+// would go below zero, as in go-ethereum.
 func (r *refund) SubRefund(gas uint64) {
-	r.Push(r.Peek() - gas)
+	refund := r.Peek()
+	if gas > refund {
+		panic(fmt.Sprintf("Refund counter below zero (gas: %d > refund: %d)", gas, refund))
+	}
+	r.Push(refund - gas)
 }
 
 // Snapshot returns the current size of the refund counter, which is used to
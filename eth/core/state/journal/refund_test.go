@@ -55,6 +55,11 @@ var _ = Describe("Refund", func() {
 			It("should return the correct refund", func() {
 				Expect(r.GetRefund()).To(BeZero())
 			})
+
+			It("should panic when the refund counter would go below zero", func() {
+				Expect(func() { r.SubRefund(1) }).To(Panic())
+				Expect(r.GetRefund()).To(BeZero())
+			})
 		})
 	})
 
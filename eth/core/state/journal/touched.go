@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/lib/ds"
+	dsjournal "pkg.berachain.dev/polaris/lib/ds/journal"
+	libtypes "pkg.berachain.dev/polaris/lib/types"
+)
+
+// Touched is a journal that tracks the accounts touched by a transaction, as defined by EIP-161.
+type Touched interface {
+	// Touched implements `libtypes.Controllable`.
+	libtypes.Controllable[string]
+	// Touched implements `libtypes.Cloneable`.
+	libtypes.Cloneable[Touched]
+	// Touch marks the given account as touched.
+	Touch(common.Address)
+	// GetTouched returns the touched accounts, in the order they were first touched.
+	GetTouched() []common.Address
+}
+
+// touched is a journal of the accounts touched by the current transaction. Each account is only
+// journaled the first time it is touched.
+type touched struct {
+	journal ds.Journal[common.Address]
+	set     map[common.Address]struct{}
+}
+
+// NewTouched returns a new `touched` journal.
+func NewTouched() Touched {
+	return &touched{
+		journal: dsjournal.New[common.Address](initCapacity),
+		set:     make(map[common.Address]struct{}),
+	}
+}
+
+// RegistryKey implements `libtypes.Registrable`.
+func (t *touched) RegistryKey() string {
+	return touchedRegistryKey
+}
+
+// Touch implements `Touched`.
+func (t *touched) Touch(addr common.Address) {
+	if _, ok := t.set[addr]; ok {
+		return
+	}
+	t.set[addr] = struct{}{}
+	t.journal.Append(addr)
+}
+
+// GetTouched implements `Touched`.
+func (t *touched) GetTouched() []common.Address {
+	addrs := make([]common.Address, t.journal.Size())
+	for i := range addrs {
+		addrs[i] = t.journal.PeekAt(i)
+	}
+	return addrs
+}
+
+// Snapshot implements `libtypes.Snapshottable`.
+func (t *touched) Snapshot() int {
+	return t.journal.Size()
+}
+
+// RevertToSnapshot implements `libtypes.Snapshottable`.
+func (t *touched) RevertToSnapshot(id int) {
+	t.journal.RevertToSize(id, t.untouch)
+}
+
+// untouch removes the given account from the touched set.
+func (t *touched) untouch(addr common.Address) {
+	delete(t.set, addr)
+}
+
+// Finalize implements `libtypes.Controllable`.
+func (t *touched) Finalize() {
+	t.journal.Reset()
+	t.set = make(map[common.Address]struct{})
+}
+
+// Clone implements `libtypes.Cloneable`.
+func (t *touched) Clone() Touched {
+	set := make(map[common.Address]struct{}, len(t.set))
+	for addr := range t.set {
+		set[addr] = struct{}{}
+	}
+	return &touched{
+		journal: t.journal.Clone(),
+		set:     set,
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Touched", func() {
+	var (
+		t  *touched
+		a1 = common.BytesToAddress([]byte{1})
+		a2 = common.BytesToAddress([]byte{2})
+	)
+
+	BeforeEach(func() {
+		t = utils.MustGetAs[*touched](NewTouched())
+	})
+
+	It("should have the correct registry key", func() {
+		Expect(t.RegistryKey()).To(Equal("touched"))
+	})
+
+	It("should only journal the first touch of an account", func() {
+		t.Touch(a1)
+		t.Touch(a2)
+		t.Touch(a1)
+		Expect(t.GetTouched()).To(Equal([]common.Address{a1, a2}))
+		Expect(t.journal.Size()).To(Equal(2))
+	})
+
+	It("should untouch accounts on revert", func() {
+		t.Touch(a1)
+		id := t.Snapshot()
+		t.Touch(a2)
+		t.Touch(a1)
+		t.RevertToSnapshot(id)
+		Expect(t.GetTouched()).To(Equal([]common.Address{a1}))
+
+		t.Touch(a2)
+		Expect(t.GetTouched()).To(Equal([]common.Address{a1, a2}))
+	})
+
+	It("should clear the touched accounts on finalize", func() {
+		t.Touch(a1)
+		t.Finalize()
+		Expect(t.GetTouched()).To(BeEmpty())
+		t.Touch(a1)
+		Expect(t.GetTouched()).To(Equal([]common.Address{a1}))
+	})
+
+	It("should clone correctly", func() {
+		t.Touch(a1)
+		t2 := utils.MustGetAs[*touched](t.Clone())
+		t2.Touch(a2)
+		Expect(t.GetTouched()).To(Equal([]common.Address{a1}))
+		Expect(t2.GetTouched()).To(Equal([]common.Address{a1, a2}))
+	})
+})
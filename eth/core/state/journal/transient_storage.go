@@ -24,7 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"pkg.berachain.dev/polaris/lib/ds"
-	"pkg.berachain.dev/polaris/lib/ds/stack"
+	dsjournal "pkg.berachain.dev/polaris/lib/ds/journal"
 	libtypes "pkg.berachain.dev/polaris/lib/types"
 )
 
@@ -61,6 +61,13 @@ func (t transientState) Copy() transientState {
 	return storage
 }
 
+// transientChange is a journal entry recording the previous value of a transient storage slot, so
+// that it can be restored on revert.
+type transientChange struct {
+	addr      common.Address
+	key, prev common.Hash
+}
+
 type TransientStorage interface {
 	// TransientStorage implements `libtypes.Controllable`.
 	libtypes.Controllable[string]
@@ -74,13 +81,15 @@ type TransientStorage interface {
 
 // `transientStorage` is a journal that tracks the transient state.
 type transientStorage struct {
-	ds.Stack[transientState]
+	transientState                             // current transient state.
+	journal        ds.Journal[transientChange] // journal of changes to the transient state.
 }
 
 // `NewTransientStorage` returns a new `transient` journal.
 func NewTransientStorage() TransientStorage {
 	return &transientStorage{
-		stack.New[transientState](initCapacity),
+		transientState: make(transientState),
+		journal:        dsjournal.New[transientChange](initCapacity),
 	}
 }
 
@@ -91,46 +100,44 @@ func (t *transientStorage) RegistryKey() string {
 
 // `AddTransient` adds a transient change to the `transient` store.
 func (t *transientStorage) SetTransientState(addr common.Address, key, value common.Hash) {
-	currentState := t.Peek()
-	if currentState.Get(addr, key) == value {
+	prev := t.Get(addr, key)
+	if prev == value {
 		return
 	}
-	currentState = currentState.Copy()
-	currentState.Set(addr, key, value)
-	t.Push(currentState)
+	t.journal.Append(transientChange{addr: addr, key: key, prev: prev})
+	t.Set(addr, key, value)
 }
 
 // `GetTransient` returns previous transient storage state for a given account + key.
 func (t *transientStorage) GetTransientState(addr common.Address, key common.Hash) common.Hash {
-	return t.Peek().Get(addr, key)
+	return t.Get(addr, key)
 }
 
 // `Snapshot` implements `libtypes.Snapshottable`.
 func (t *transientStorage) Snapshot() int {
-	return t.Size()
+	return t.journal.Size()
 }
 
 // `RevertToSnapshot` implements `libtypes.Snapshottable`.
 func (t *transientStorage) RevertToSnapshot(id int) {
-	t.PopToSize(id)
+	t.journal.RevertToSize(id, t.undo)
+}
+
+// undo restores the previous value of the slot recorded by the given change.
+func (t *transientStorage) undo(change transientChange) {
+	t.Set(change.addr, change.key, change.prev)
 }
 
 // `Finalize` implements `libtypes.Controllable`.
 func (t *transientStorage) Finalize() {
-	t.Stack = stack.New[transientState](initCapacity)
+	t.transientState = make(transientState)
+	t.journal.Reset()
 }
 
 // Clone implements `libtypes.Cloneable`.
 func (t *transientStorage) Clone() TransientStorage {
-	size := t.Size()
-	clone := &transientStorage{
-		stack.New[transientState](size),
-	}
-
-	// copy every individual transient state
-	for i := 0; i < size; i++ {
-		clone.Push(t.PeekAt(i).Copy())
+	return &transientStorage{
+		transientState: t.transientState.Copy(),
+		journal:        t.journal.Clone(),
 	}
-
-	return clone
 }
@@ -46,41 +46,50 @@ var _ = Describe("TransientStorage", func() {
 	It("should add without impacting previous state", func() {
 		ts.SetTransientState(alice, key, value)
 		ts.SetTransientState(bob, key, value)
-		Expect(ts.PeekAt(0).Get(bob, key), common.Hash{})
+		Expect(ts.journal.PeekAt(0).prev).To(Equal(common.Hash{}))
+		Expect(ts.journal.Size()).To(Equal(2))
+	})
+
+	It("should not journal no-op changes", func() {
+		ts.SetTransientState(alice, key, common.Hash{})
+		Expect(ts.journal.Size()).To(Equal(0))
 	})
 
 	It("should have consistent gets and sets", func() {
 		ts.SetTransientState(alice, key, value) // {alice:value}
-		Expect(ts.GetTransientState(alice, key), value)
+		Expect(ts.GetTransientState(alice, key)).To(Equal(value))
 
 		before := ts.Snapshot()
 		ts.SetTransientState(alice, key, value2) // {alice:value2}
-		Expect(ts.GetTransientState(alice, key), value2)
+		Expect(ts.GetTransientState(alice, key)).To(Equal(value2))
 
 		ts.SetTransientState(bob, key, value) // {alice:value2, bob: value}
 		ts.RevertToSnapshot(before)           // {alice:value}
-		Expect(ts.GetTransientState(alice, key), value)
-		Expect(ts.GetTransientState(bob, key), common.Hash{})
+		Expect(ts.GetTransientState(alice, key)).To(Equal(value))
+		Expect(ts.GetTransientState(bob, key)).To(Equal(common.Hash{}))
 	})
 
 	It("should correctly finalize", func() {
 		ts.SetTransientState(alice, key, value)
 		ts.Finalize()
-		Expect(ts.Size(), 0)
+		Expect(ts.journal.Size()).To(Equal(0))
+		Expect(ts.GetTransientState(alice, key)).To(Equal(common.Hash{}))
 		Expect(func() { ts.Finalize() }).ToNot(Panic())
 	})
 
 	It("should correctly clone", func() {
 		ts.SetTransientState(bob, key, value)
-		Expect(ts.PeekAt(0).Get(bob, key), common.Hash{})
-		Expect(ts.GetTransientState(bob, key), value)
+		Expect(ts.GetTransientState(bob, key)).To(Equal(value))
 
 		ts2 := utils.MustGetAs[*transientStorage](ts.Clone())
-		Expect(ts2.PeekAt(0).Get(bob, key), common.Hash{})
-		Expect(ts2.GetTransientState(bob, key), value)
+		Expect(ts2.GetTransientState(bob, key)).To(Equal(value))
 
 		ts2.SetTransientState(alice, key, value2)
-		Expect(ts2.GetTransientState(alice, key), value2)
-		Expect(ts.GetTransientState(alice, key), common.Hash{})
+		Expect(ts2.GetTransientState(alice, key)).To(Equal(value2))
+		Expect(ts.GetTransientState(alice, key)).To(Equal(common.Hash{}))
+
+		ts2.RevertToSnapshot(0)
+		Expect(ts2.GetTransientState(bob, key)).To(Equal(common.Hash{}))
+		Expect(ts.GetTransientState(bob, key)).To(Equal(value))
 	})
 })
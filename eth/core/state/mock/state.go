@@ -67,10 +67,12 @@ func NewEmptyStatePlugin() *PluginMock {
 			}
 		},
 		ExistFunc: func(address common.Address) bool {
-			panic("mock out the Exist method")
+			_, ok := Accounts[address]
+			return ok
 		},
 		EmptyFunc: func(address common.Address) bool {
-			panic("mock out the Empty method")
+			acct, ok := Accounts[address]
+			return !ok || (acct.Balance.Sign() == 0 && len(acct.Code) == 0)
 		},
 		ErrorFunc: func() error {
 			return nil
@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/lib/ds"
+	dsjournal "pkg.berachain.dev/polaris/lib/ds/journal"
+)
+
+const (
+	// overlayRegistryKey is the registry key of the overlay plugin.
+	overlayRegistryKey = `overlay`
+	// overlayJournalCapacity is the initial capacity of the journal of the overlay.
+	overlayJournalCapacity = 32
+)
+
+var emptyCodeHash = crypto.Keccak256Hash(nil)
+
+// Overlay is a state plugin that keeps every change in memory, on top of a base plugin that it
+// only reads from. It follows the account semantics of Go-Ethereum rather than the ones of the
+// host chain, so that executing blocks against it gives a second opinion on the state changes
+// computed by the plugin of the host chain.
+type Overlay struct {
+	base Plugin
+
+	// accounts caches the accounts read from the base plugin and holds their changes.
+	accounts map[common.Address]*overlayAccount
+	// written records the accounts changed, even if the change was later reverted.
+	written map[common.Address]struct{}
+	// journal records the changes of the current transaction, to revert them.
+	journal ds.Journal[*overlayChange]
+}
+
+// overlayFields are the fields of an account, besides its storage.
+type overlayFields struct {
+	exists   bool
+	balance  *big.Int
+	nonce    uint64
+	code     []byte
+	codeHash common.Hash
+}
+
+// overlayAccount is an account of the overlay.
+type overlayAccount struct {
+	overlayFields
+	// storage holds the slots written, and committed their values at the end of the last
+	// finalized transaction.
+	storage   map[common.Hash]common.Hash
+	committed map[common.Hash]common.Hash
+	// cleared is set once the storage of the account in the base plugin no longer applies.
+	cleared bool
+}
+
+// overlayChange is a change of the overlay, recording what to restore when it is reverted.
+type overlayChange struct {
+	addr common.Address
+	// replaced is the account replaced by a creation or a deletion, nil if it was not loaded.
+	replaced *overlayAccount
+	replace  bool
+	// fields are the fields of the account before the change, if it changed them.
+	fields *overlayFields
+	// slot is the storage slot written and value its previous value, if the change wrote one.
+	slot    *common.Hash
+	value   common.Hash
+	hadSlot bool
+}
+
+// NewOverlay returns an overlay on top of the given base plugin, which is never written to.
+func NewOverlay(base Plugin) *Overlay {
+	return &Overlay{
+		base:     base,
+		accounts: make(map[common.Address]*overlayAccount),
+		written:  make(map[common.Address]struct{}),
+		journal:  dsjournal.New[*overlayChange](overlayJournalCapacity),
+	}
+}
+
+// RegistryKey implements `libtypes.Registrable`.
+func (o *Overlay) RegistryKey() string {
+	return overlayRegistryKey
+}
+
+// Prepare implements `libtypes.Preparable` by preparing the base plugin.
+func (o *Overlay) Prepare(ctx context.Context) {
+	o.base.Prepare(ctx)
+}
+
+// Reset implements `libtypes.Resettable` by resetting the base plugin.
+func (o *Overlay) Reset(ctx context.Context) {
+	o.base.Reset(ctx)
+}
+
+// GetContext implements `Plugin` by returning the context of the base plugin.
+func (o *Overlay) GetContext() context.Context {
+	return o.base.GetContext()
+}
+
+// Error implements `Plugin` by returning the error of the base plugin.
+func (o *Overlay) Error() error {
+	return o.base.Error()
+}
+
+// =============================================================================
+// Accounts
+// =============================================================================
+
+// account returns the given account, loading it from the base plugin the first time.
+func (o *Overlay) account(addr common.Address) *overlayAccount {
+	if acct, ok := o.accounts[addr]; ok {
+		return acct
+	}
+	acct := newOverlayAccount()
+	if acct.exists = o.base.Exist(addr); acct.exists {
+		acct.balance = new(big.Int).Set(o.base.GetBalance(addr))
+		acct.nonce = o.base.GetNonce(addr)
+		acct.codeHash = o.base.GetCodeHash(addr)
+		if acct.codeHash != emptyCodeHash && (acct.codeHash != common.Hash{}) {
+			acct.code = o.base.GetCode(addr)
+		}
+	}
+	o.accounts[addr] = acct
+	return acct
+}
+
+// newOverlayAccount returns an account that does not exist, without storage.
+func newOverlayAccount() *overlayAccount {
+	return &overlayAccount{
+		overlayFields: overlayFields{balance: new(big.Int)},
+		storage:       make(map[common.Hash]common.Hash),
+		committed:     make(map[common.Hash]common.Hash),
+	}
+}
+
+// change returns the given account, after journaling its fields, which the caller changes. As in
+// Go-Ethereum, changing an account that does not exist creates it.
+func (o *Overlay) change(addr common.Address) *overlayAccount {
+	acct := o.account(addr)
+	fields := acct.overlayFields
+	o.journal.Append(&overlayChange{addr: addr, fields: &fields})
+	o.written[addr] = struct{}{}
+	acct.exists = true
+	if (acct.codeHash == common.Hash{}) {
+		acct.codeHash = emptyCodeHash
+	}
+	return acct
+}
+
+// replace replaces the given account with the given one, after journaling it.
+func (o *Overlay) replace(addr common.Address, acct *overlayAccount) {
+	o.journal.Append(&overlayChange{addr: addr, replaced: o.accounts[addr], replace: true})
+	o.written[addr] = struct{}{}
+	o.accounts[addr] = acct
+}
+
+// CreateAccount implements `Plugin`. As in Go-Ethereum, the balance of the account is kept, but
+// its nonce, code and storage are not.
+func (o *Overlay) CreateAccount(addr common.Address) {
+	acct := newOverlayAccount()
+	acct.exists = true
+	acct.balance = new(big.Int).Set(o.account(addr).balance)
+	acct.codeHash = emptyCodeHash
+	acct.cleared = true
+	o.replace(addr, acct)
+}
+
+// Exist implements `Plugin`.
+func (o *Overlay) Exist(addr common.Address) bool {
+	return o.account(addr).exists
+}
+
+// Empty implements `Plugin`.
+func (o *Overlay) Empty(addr common.Address) bool {
+	acct := o.account(addr)
+	return acct.nonce == 0 && len(acct.code) == 0 && acct.balance.Sign() == 0
+}
+
+// DeleteAccounts implements `Plugin`.
+func (o *Overlay) DeleteAccounts(addrs []common.Address) {
+	for _, addr := range addrs {
+		if !o.account(addr).exists {
+			continue
+		}
+		acct := newOverlayAccount()
+		acct.cleared = true
+		o.replace(addr, acct)
+	}
+}
+
+// GetBalance implements `Plugin`.
+func (o *Overlay) GetBalance(addr common.Address) *big.Int {
+	return new(big.Int).Set(o.account(addr).balance)
+}
+
+// SetBalance implements `Plugin`.
+func (o *Overlay) SetBalance(addr common.Address, amount *big.Int) {
+	o.change(addr).balance = new(big.Int).Set(amount)
+}
+
+// SubBalance implements `Plugin`.
+func (o *Overlay) SubBalance(addr common.Address, amount *big.Int) {
+	acct := o.change(addr)
+	acct.balance = new(big.Int).Sub(acct.balance, amount)
+}
+
+// AddBalance implements `Plugin`.
+func (o *Overlay) AddBalance(addr common.Address, amount *big.Int) {
+	acct := o.change(addr)
+	acct.balance = new(big.Int).Add(acct.balance, amount)
+}
+
+// GetNonce implements `Plugin`.
+func (o *Overlay) GetNonce(addr common.Address) uint64 {
+	return o.account(addr).nonce
+}
+
+// SetNonce implements `Plugin`.
+func (o *Overlay) SetNonce(addr common.Address, nonce uint64) {
+	o.change(addr).nonce = nonce
+}
+
+// GetCodeHash implements `Plugin`. It returns the zero hash for the accounts that do not exist,
+// and the empty code hash for the existing accounts without code.
+func (o *Overlay) GetCodeHash(addr common.Address) common.Hash {
+	return o.account(addr).codeHash
+}
+
+// GetCode implements `Plugin`.
+func (o *Overlay) GetCode(addr common.Address) []byte {
+	return o.account(addr).code
+}
+
+// SetCode implements `Plugin`.
+func (o *Overlay) SetCode(addr common.Address, code []byte) {
+	acct := o.change(addr)
+	acct.code = bytes.Clone(code)
+	acct.codeHash = crypto.Keccak256Hash(code)
+}
+
+// =============================================================================
+// Storage
+// =============================================================================
+
+// GetCommittedState implements `Plugin` by returning the value of the slot at the end of the
+// last finalized transaction.
+func (o *Overlay) GetCommittedState(addr common.Address, slot common.Hash) common.Hash {
+	acct := o.account(addr)
+	if value, ok := acct.committed[slot]; ok {
+		return value
+	}
+	if acct.cleared {
+		return common.Hash{}
+	}
+	return o.base.GetState(addr, slot)
+}
+
+// GetState implements `Plugin`.
+func (o *Overlay) GetState(addr common.Address, slot common.Hash) common.Hash {
+	acct := o.account(addr)
+	if value, ok := acct.storage[slot]; ok {
+		return value
+	}
+	if acct.cleared {
+		return common.Hash{}
+	}
+	return o.base.GetState(addr, slot)
+}
+
+// SetState implements `Plugin`.
+func (o *Overlay) SetState(addr common.Address, slot, value common.Hash) {
+	acct := o.account(addr)
+	prev, ok := acct.storage[slot]
+	o.journal.Append(&overlayChange{addr: addr, slot: &slot, value: prev, hadSlot: ok})
+	o.written[addr] = struct{}{}
+	acct.storage[slot] = value
+}
+
+// SetStorage implements `Plugin`.
+func (o *Overlay) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	for slot, value := range storage {
+		o.SetState(addr, slot, value)
+	}
+}
+
+// ForEachStorage implements `Plugin` by iterating over the slots of the base plugin that were not
+// written, then over the slots written.
+func (o *Overlay) ForEachStorage(
+	addr common.Address, cb func(common.Hash, common.Hash) bool,
+) error {
+	acct := o.account(addr)
+	stopped := false
+	if !acct.cleared {
+		if err := o.base.ForEachStorage(addr, func(slot, value common.Hash) bool {
+			if _, ok := acct.storage[slot]; ok {
+				return true
+			}
+			stopped = !cb(slot, value)
+			return !stopped
+		}); err != nil {
+			return err
+		}
+	}
+	for slot, value := range acct.storage {
+		if stopped || (value == common.Hash{}) {
+			continue
+		}
+		stopped = !cb(slot, value)
+	}
+	return nil
+}
+
+// =============================================================================
+// Snapshots
+// =============================================================================
+
+// Snapshot implements `libtypes.Snapshottable`.
+func (o *Overlay) Snapshot() int {
+	return o.journal.Size()
+}
+
+// RevertToSnapshot implements `libtypes.Snapshottable`.
+func (o *Overlay) RevertToSnapshot(id int) {
+	o.journal.RevertToSize(id, o.revert)
+}
+
+// revert reverts the given change.
+func (o *Overlay) revert(c *overlayChange) {
+	switch {
+	case c.replace && c.replaced == nil:
+		delete(o.accounts, c.addr)
+	case c.replace:
+		o.accounts[c.addr] = c.replaced
+	case c.fields != nil:
+		o.accounts[c.addr].overlayFields = *c.fields
+	case c.hadSlot:
+		o.accounts[c.addr].storage[*c.slot] = c.value
+	default:
+		delete(o.accounts[c.addr].storage, *c.slot)
+	}
+}
+
+// Finalize implements `libtypes.Controllable` by committing the slots written by the transaction.
+func (o *Overlay) Finalize() {
+	for i := 0; i < o.journal.Size(); i++ {
+		c := o.journal.PeekAt(i)
+		if c.slot == nil {
+			continue
+		}
+		acct := o.accounts[c.addr]
+		if value, ok := acct.storage[*c.slot]; ok {
+			acct.committed[*c.slot] = value
+		}
+	}
+	o.journal.Reset()
+}
+
+// Clone implements `libtypes.Cloneable`. The base plugin is cloned as well.
+func (o *Overlay) Clone() Plugin {
+	clone := &Overlay{
+		base:     o.base.Clone(),
+		accounts: make(map[common.Address]*overlayAccount, len(o.accounts)),
+		written:  make(map[common.Address]struct{}, len(o.written)),
+		journal:  dsjournal.New[*overlayChange](overlayJournalCapacity),
+	}
+	for addr, acct := range o.accounts {
+		clone.accounts[addr] = acct.copy()
+	}
+	for addr := range o.written {
+		clone.written[addr] = struct{}{}
+	}
+	for i := 0; i < o.journal.Size(); i++ {
+		c := *o.journal.PeekAt(i)
+		if c.replaced != nil {
+			c.replaced = c.replaced.copy()
+		}
+		clone.journal.Append(&c)
+	}
+	return clone
+}
+
+// copy returns a deep copy of the account.
+func (acct *overlayAccount) copy() *overlayAccount {
+	cpy := *acct
+	cpy.balance = new(big.Int).Set(acct.balance)
+	cpy.storage = make(map[common.Hash]common.Hash, len(acct.storage))
+	for slot, value := range acct.storage {
+		cpy.storage[slot] = value
+	}
+	cpy.committed = make(map[common.Hash]common.Hash, len(acct.committed))
+	for slot, value := range acct.committed {
+		cpy.committed[slot] = value
+	}
+	return &cpy
+}
+
+// =============================================================================
+// Comparison
+// =============================================================================
+
+// Diff compares the accounts and the storage slots written to the overlay with the ones of the
+// given plugin, and returns a description of each difference, in the order of the addresses.
+func (o *Overlay) Diff(other Plugin) []string {
+	addrs := make([]common.Address, 0, len(o.written))
+	for addr := range o.written {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+
+	var diffs []string
+	for _, addr := range addrs {
+		acct := o.account(addr)
+		if exists := other.Exist(addr); exists != acct.exists {
+			diffs = append(diffs, fmt.Sprintf("%s: exists %t, want %t", addr, exists, acct.exists))
+			continue
+		}
+		if !acct.exists {
+			continue
+		}
+		if balance := other.GetBalance(addr); balance.Cmp(acct.balance) != 0 {
+			diffs = append(diffs, fmt.Sprintf("%s: balance %s, want %s", addr, balance, acct.balance))
+		}
+		if nonce := other.GetNonce(addr); nonce != acct.nonce {
+			diffs = append(diffs, fmt.Sprintf("%s: nonce %d, want %d", addr, nonce, acct.nonce))
+		}
+		if codeHash := other.GetCodeHash(addr); codeHash != acct.codeHash {
+			diffs = append(diffs, fmt.Sprintf("%s: code hash %s, want %s", addr, codeHash, acct.codeHash))
+		}
+		for slot := range acct.storage {
+			want := o.GetState(addr, slot)
+			if value := other.GetState(addr, slot); value != want {
+				diffs = append(diffs, fmt.Sprintf("%s: slot %s is %s, want %s", addr, slot, value, want))
+			}
+		}
+	}
+	return diffs
+}
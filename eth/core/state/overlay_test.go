@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/core/state/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Overlay", func() {
+	var (
+		base    *mock.PluginMock
+		overlay *state.Overlay
+	)
+
+	BeforeEach(func() {
+		base = &mock.PluginMock{
+			ExistFunc: func(addr common.Address) bool {
+				return addr == alice
+			},
+			GetBalanceFunc: func(common.Address) *big.Int {
+				return big.NewInt(100)
+			},
+			GetNonceFunc: func(common.Address) uint64 {
+				return 1
+			},
+			GetCodeHashFunc: func(common.Address) common.Hash {
+				return common.Hash{}
+			},
+			GetStateFunc: func(addr common.Address, key common.Hash) common.Hash {
+				if addr == alice && key == slot {
+					return common.Hash{7}
+				}
+				return common.Hash{}
+			},
+		}
+		overlay = state.NewOverlay(base)
+	})
+
+	It("should read through to the base without writing to it", func() {
+		Expect(overlay.Exist(alice)).To(BeTrue())
+		Expect(overlay.Exist(bob)).To(BeFalse())
+		Expect(overlay.GetBalance(alice)).To(Equal(big.NewInt(100)))
+
+		overlay.AddBalance(alice, big.NewInt(5))
+		overlay.SetNonce(bob, 3)
+		overlay.SetState(alice, slot, common.Hash{8})
+		Expect(overlay.GetBalance(alice)).To(Equal(big.NewInt(105)))
+		Expect(overlay.Exist(bob)).To(BeTrue())
+		Expect(overlay.GetState(alice, slot)).To(Equal(common.Hash{8}))
+		Expect(overlay.GetCommittedState(alice, slot)).To(Equal(common.Hash{7}))
+		Expect(base.SetStateCalls()).To(BeEmpty())
+		Expect(base.AddBalanceCalls()).To(BeEmpty())
+	})
+
+	It("should revert to a snapshot and commit on finalize", func() {
+		overlay.SetState(alice, slot, common.Hash{8})
+		id := overlay.Snapshot()
+		overlay.SetState(alice, slot, common.Hash{9})
+		overlay.CreateAccount(bob)
+		overlay.RevertToSnapshot(id)
+		Expect(overlay.GetState(alice, slot)).To(Equal(common.Hash{8}))
+		Expect(overlay.Exist(bob)).To(BeFalse())
+
+		overlay.Finalize()
+		Expect(overlay.GetCommittedState(alice, slot)).To(Equal(common.Hash{8}))
+	})
+
+	It("should clear the storage of the deleted accounts", func() {
+		overlay.DeleteAccounts([]common.Address{alice})
+		Expect(overlay.Exist(alice)).To(BeFalse())
+		Expect(overlay.GetBalance(alice).Sign()).To(BeZero())
+		Expect(overlay.GetState(alice, slot)).To(Equal(common.Hash{}))
+	})
+
+	It("should report the differences with another plugin", func() {
+		overlay.SubBalance(alice, big.NewInt(10))
+		overlay.SetState(alice, slot, common.Hash{8})
+		Expect(overlay.Diff(overlay)).To(BeEmpty())
+
+		diffs := overlay.Diff(state.NewOverlay(base))
+		Expect(diffs).To(HaveLen(2))
+		Expect(diffs[0]).To(ContainSubstring("balance 100, want 90"))
+		Expect(diffs[1]).To(ContainSubstring("is " + common.Hash{7}.String()))
+	})
+})
@@ -21,6 +21,8 @@
 package state
 
 import (
+	"math/big"
+
 	"pkg.berachain.dev/polaris/eth/common"
 	"pkg.berachain.dev/polaris/eth/core/state/journal"
 	coretypes "pkg.berachain.dev/polaris/eth/core/types"
@@ -41,23 +43,27 @@ type stateDB struct {
 	journal.Accesslist
 	journal.Suicides
 	journal.TransientStorage
+	journal.Touched
 
 	// ctrl is used to manage snapshots and reverts across plugins and journals.
 	ctrl libtypes.Controller[string, libtypes.Controllable[string]]
+
+	// origin is the sender of the transaction being executed, as given to Prepare.
+	origin common.Address
 }
 
 // NewStateDB returns a vm.PolarisStateDB with the given StatePlugin and new journals.
 func NewStateDB(sp Plugin) vm.PolarisStateDB {
 	return newStateDBWithJournals(
 		sp, journal.NewLogs(), journal.NewRefund(), journal.NewAccesslist(),
-		journal.NewSuicides(sp), journal.NewTransientStorage(),
+		journal.NewSuicides(sp), journal.NewTransientStorage(), journal.NewTouched(),
 	)
 }
 
 // newStateDBWithJournals returns a vm.PolarisStateDB with the given StatePlugin and journals.
 func newStateDBWithJournals(
 	sp Plugin, lj journal.Log, rj journal.Refund, aj journal.Accesslist,
-	sj journal.Suicides, tj journal.TransientStorage,
+	sj journal.Suicides, tj journal.TransientStorage, touched journal.Touched,
 ) vm.PolarisStateDB {
 	// Build the controller and register the plugins and journals
 	ctrl := snapshot.NewController[string, libtypes.Controllable[string]]()
@@ -67,6 +73,7 @@ func newStateDBWithJournals(
 	_ = ctrl.Register(aj)
 	_ = ctrl.Register(sj)
 	_ = ctrl.Register(tj)
+	_ = ctrl.Register(touched)
 
 	return &stateDB{
 		Plugin:           sp,
@@ -75,6 +82,7 @@ func newStateDBWithJournals(
 		Accesslist:       aj,
 		Suicides:         sj,
 		TransientStorage: tj,
+		Touched:          touched,
 		ctrl:             ctrl,
 	}
 }
@@ -97,18 +105,87 @@ func (sdb *stateDB) RevertToSnapshot(id int) {
 // Commit state
 // =============================================================================
 
-// Finalise deletes the suicided accounts and finalizes all plugins, preparing the statedb for the
-// next transaction.
-func (sdb *stateDB) Finalise(bool) {
+// Finalise deletes the suicided accounts and, if deleteEmptyObjects is set, the touched accounts
+// that are empty (EIP-161), then finalizes all plugins, preparing the statedb for the next
+// transaction.
+func (sdb *stateDB) Finalise(deleteEmptyObjects bool) {
 	sdb.DeleteAccounts(sdb.GetSuicides())
+	if deleteEmptyObjects {
+		sdb.DeleteAccounts(sdb.emptyTouchedAccounts())
+	}
 	sdb.ctrl.Finalize()
 }
 
+// emptyTouchedAccounts returns the existing accounts touched by the transaction that are empty.
+func (sdb *stateDB) emptyTouchedAccounts() []common.Address {
+	var empty []common.Address
+	for _, addr := range sdb.GetTouched() {
+		if sdb.Exist(addr) && sdb.Empty(addr) {
+			empty = append(empty, addr)
+		}
+	}
+	return empty
+}
+
 func (sdb *stateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 	sdb.Finalise(deleteEmptyObjects)
 	return common.Hash{}, nil
 }
 
+// =============================================================================
+// Touched accounts
+// =============================================================================
+
+// The following state changes touch the account they apply to (EIP-161), even if they do not
+// change it, such as transferring a zero value. Storage writes are not tracked, as only contracts,
+// which are never empty, write to storage.
+
+// CreateAccount implements vm.PolarisStateDB.
+func (sdb *stateDB) CreateAccount(addr common.Address) {
+	sdb.Touch(addr)
+	sdb.Plugin.CreateAccount(addr)
+}
+
+// AddBalance implements vm.PolarisStateDB.
+func (sdb *stateDB) AddBalance(addr common.Address, amount *big.Int) {
+	sdb.Touch(addr)
+	sdb.Plugin.AddBalance(addr, amount)
+}
+
+// SubBalance implements vm.PolarisStateDB.
+func (sdb *stateDB) SubBalance(addr common.Address, amount *big.Int) {
+	sdb.Touch(addr)
+	sdb.Plugin.SubBalance(addr, amount)
+}
+
+// Transfer moves amount from the balance of sender to the balance of recipient, through the
+// plugin if it implements `TransferPlugin`.
+//
+// Transfer implements vm.TransferStateDB.
+func (sdb *stateDB) Transfer(sender, recipient common.Address, amount *big.Int) {
+	tp, ok := sdb.Plugin.(TransferPlugin)
+	if !ok {
+		sdb.SubBalance(sender, amount)
+		sdb.AddBalance(recipient, amount)
+		return
+	}
+	sdb.Touch(sender)
+	sdb.Touch(recipient)
+	tp.Transfer(sender, recipient, amount)
+}
+
+// SetNonce implements vm.PolarisStateDB.
+func (sdb *stateDB) SetNonce(addr common.Address, nonce uint64) {
+	sdb.Touch(addr)
+	sdb.Plugin.SetNonce(addr, nonce)
+}
+
+// SetCode implements vm.PolarisStateDB.
+func (sdb *stateDB) SetCode(addr common.Address, code []byte) {
+	sdb.Touch(addr)
+	sdb.Plugin.SetCode(addr, code)
+}
+
 // =============================================================================
 // Prepare
 // =============================================================================
@@ -118,9 +195,12 @@ func (sdb *stateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 // Prepare implements vm.PolarisStateDB.
 func (sdb *stateDB) Prepare(rules params.Rules, sender, coinbase common.Address,
 	dest *common.Address, precompiles []common.Address, txAccesses coretypes.AccessList) {
+	sdb.origin = sender
+
 	if rules.IsBerlin {
-		// Clear out any leftover from previous executions
-		sdb.Accesslist = journal.NewAccesslist()
+		// Clear out any leftover from previous executions. The journal is reset in place, since
+		// it is registered on the snapshot controller.
+		sdb.Accesslist.Finalize()
 
 		sdb.AddAddressToAccessList(sender)
 		if dest != nil {
@@ -142,6 +222,13 @@ func (sdb *stateDB) Prepare(rules params.Rules, sender, coinbase common.Address,
 	}
 }
 
+// TxOrigin returns the sender of the transaction being executed.
+//
+// TxOrigin implements vm.OriginStateDB.
+func (sdb *stateDB) TxOrigin() common.Address {
+	return sdb.origin
+}
+
 // =============================================================================
 // PreImage
 // =============================================================================
@@ -175,6 +262,7 @@ func (sdb *stateDB) Copy() StateDBI {
 	return newStateDBWithJournals(
 		sdb.Plugin.Clone(), sdb.Log.Clone(), sdb.Refund.Clone(),
 		sdb.Accesslist.Clone(), sdb.Suicides.Clone(), sdb.TransientStorage.Clone(),
+		sdb.Touched.Clone(),
 	)
 }
 
@@ -202,7 +290,9 @@ func (sdb *stateDB) StartPrefetcher(_ string) {}
 
 func (sdb *stateDB) StopPrefetcher() {}
 
-func (sdb *stateDB) IntermediateRoot(_ bool) common.Hash {
+// IntermediateRoot finalises the state, as the Polaris StateDB does not compute state roots.
+func (sdb *stateDB) IntermediateRoot(deleteEmptyObjects bool) common.Hash {
+	sdb.Finalise(deleteEmptyObjects)
 	return common.Hash{}
 }
 
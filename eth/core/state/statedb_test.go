@@ -102,6 +102,13 @@ var _ = Describe("StateDB", func() {
 		Expect(sp).To(BeTrue())
 	})
 
+	It("should record the transaction origin", func() {
+		sdb.Prepare(params.Rules{}, alice, bob, nil, nil, nil)
+		odb, ok := sdb.(vm.OriginStateDB)
+		Expect(ok).To(BeTrue())
+		Expect(odb.TxOrigin()).To(Equal(alice))
+	})
+
 	It("should delete suicides on finalize", func() {
 		sdb.Snapshot()
 		sdb.SetTxContext(common.Hash{}, 0)
@@ -117,6 +124,34 @@ var _ = Describe("StateDB", func() {
 		Expect(sdb.HasSuicided(bob)).To(BeFalse())
 	})
 
+	It("should delete empty touched accounts on finalize (EIP-161)", func() {
+		sdb.CreateAccount(alice)
+		sdb.CreateAccount(bob)
+		sdb.AddBalance(bob, big.NewInt(10))
+		sdb.Finalise(true)
+		Expect(sdb.Exist(alice)).To(BeFalse())
+		Expect(sdb.Exist(bob)).To(BeTrue())
+
+		// touching with a zero value transfer deletes an empty account
+		sdb.CreateAccount(alice)
+		sdb.Finalise(false)
+		Expect(sdb.Exist(alice)).To(BeTrue())
+		sdb.AddBalance(alice, new(big.Int))
+		sdb.Finalise(true)
+		Expect(sdb.Exist(alice)).To(BeFalse())
+	})
+
+	It("should not delete accounts whose touch was reverted", func() {
+		sdb.CreateAccount(alice)
+		sdb.Finalise(false)
+
+		id := sdb.Snapshot()
+		sdb.AddBalance(alice, new(big.Int))
+		sdb.RevertToSnapshot(id)
+		sdb.Finalise(true)
+		Expect(sdb.Exist(alice)).To(BeTrue())
+	})
+
 	It("should handle saved errors", func() {
 		sp.ErrorFunc = func() error {
 			return errors.New("mocked saved error")
@@ -20,15 +20,101 @@
 
 package types
 
-import "github.com/ethereum/go-ethereum/rlp"
+import (
+	"errors"
+	"fmt"
 
-// MarshalHeader marshals a header, as type `Header`, to bytes using rlp encoding.
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// HeaderVersionLegacy is the version of the headers encoded as bare RLP lists, before headers
+	// were versioned. Their encoding starts with an RLP list prefix (0xc0 and above), which never
+	// collides with a version byte.
+	HeaderVersionLegacy byte = 0x00
+
+	// HeaderVersionRLP is the version of the headers encoded as a version byte followed by their
+	// RLP encoding. Fields added to the end of `Header` as optional RLP fields (e.g. the base fee,
+	// withdrawals root and blob gas) are handled by this version.
+	HeaderVersionRLP byte = 0x01
+
+	// CurrentHeaderVersion is the version used by `MarshalHeader`.
+	CurrentHeaderVersion = HeaderVersionRLP
+
+	// rlpListPrefix is the smallest first byte of an RLP encoded list.
+	rlpListPrefix byte = 0xc0
+)
+
+// ErrUnknownHeaderVersion is returned when decoding a header of an unknown version.
+var ErrUnknownHeaderVersion = errors.New("unknown header version")
+
+// headerDecoders are the decoders of the payloads of the versioned header encodings, keyed by
+// version. A version whose encoding is not compatible with the previous one must register its
+// decoder here, so that nodes keep reading the headers already stored under older versions.
+var headerDecoders = map[byte]func([]byte) (*Header, error){
+	HeaderVersionRLP: decodeHeaderRLP,
+}
+
+// MarshalHeader marshals a header, as type `Header`, to bytes using the current versioned
+// encoding.
 func MarshalHeader(header *Header) ([]byte, error) {
-	return rlp.EncodeToBytes(header)
+	bz, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{CurrentHeaderVersion}, bz...), nil
 }
 
-// UnmarshalHeader unmarshals a header from bytes to `Header` using rlp decoding.
+// UnmarshalHeader unmarshals a header from bytes to `Header`, accepting every known version of
+// the encoding, including the legacy unversioned RLP encoding.
 func UnmarshalHeader(data []byte) (*Header, error) {
+	version, err := HeaderVersion(data)
+	if err != nil {
+		return nil, err
+	}
+	if version == HeaderVersionLegacy {
+		return decodeHeaderRLP(data)
+	}
+	return headerDecoders[version](data[1:])
+}
+
+// HeaderVersion returns the version of the encoded header.
+func HeaderVersion(data []byte) (byte, error) {
+	if len(data) == 0 {
+		return 0, errors.New("empty header encoding")
+	}
+	if data[0] >= rlpListPrefix {
+		return HeaderVersionLegacy, nil
+	}
+	if _, ok := headerDecoders[data[0]]; !ok {
+		return 0, fmt.Errorf("%w: %d", ErrUnknownHeaderVersion, data[0])
+	}
+	return data[0], nil
+}
+
+// MigrateHeader re-encodes a header stored under an older version with the current version. It
+// returns whether the header was re-encoded.
+func MigrateHeader(data []byte) ([]byte, bool, error) {
+	version, err := HeaderVersion(data)
+	if err != nil {
+		return nil, false, err
+	}
+	if version == CurrentHeaderVersion {
+		return data, false, nil
+	}
+	header, err := UnmarshalHeader(data)
+	if err != nil {
+		return nil, false, err
+	}
+	bz, err := MarshalHeader(header)
+	if err != nil {
+		return nil, false, err
+	}
+	return bz, true, nil
+}
+
+// decodeHeaderRLP decodes the RLP encoding of a header.
+func decodeHeaderRLP(data []byte) (*Header, error) {
 	header := &Header{}
 	err := rlp.DecodeBytes(data, header)
 	return header, err
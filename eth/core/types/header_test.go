@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/polaris/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Header encoding", func() {
+	var header *Header
+
+	BeforeEach(func() {
+		header = &Header{
+			Number:          big.NewInt(10),
+			GasLimit:        30000000,
+			Difficulty:      big.NewInt(0),
+			BaseFee:         big.NewInt(7),
+			WithdrawalsHash: &EmptyWithdrawalsHash,
+			ParentHash:      common.Hash{0x1},
+		}
+	})
+
+	It("should round trip a header with the current version", func() {
+		bz, err := MarshalHeader(header)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(HeaderVersion(bz)).To(Equal(CurrentHeaderVersion))
+
+		decoded, err := UnmarshalHeader(bz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded.Hash()).To(Equal(header.Hash()))
+	})
+
+	It("should read and migrate legacy unversioned headers", func() {
+		legacy, err := rlp.EncodeToBytes(header)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(HeaderVersion(legacy)).To(Equal(HeaderVersionLegacy))
+
+		decoded, err := UnmarshalHeader(legacy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded.Hash()).To(Equal(header.Hash()))
+
+		migrated, ok, err := MigrateHeader(legacy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(HeaderVersion(migrated)).To(Equal(CurrentHeaderVersion))
+		Expect(UnmarshalHeader(migrated)).To(Equal(decoded))
+
+		_, ok, err = MigrateHeader(migrated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should read legacy headers without the optional fields", func() {
+		header.BaseFee = nil
+		header.WithdrawalsHash = nil
+		legacy, err := rlp.EncodeToBytes(header)
+		Expect(err).ToNot(HaveOccurred())
+
+		decoded, err := UnmarshalHeader(legacy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded.BaseFee).To(BeNil())
+		Expect(decoded.Hash()).To(Equal(header.Hash()))
+	})
+
+	It("should reject unknown versions and empty encodings", func() {
+		_, err := UnmarshalHeader([]byte{0x7f, 0xc0})
+		Expect(err).To(MatchError(ErrUnknownHeaderVersion))
+		_, err = UnmarshalHeader(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -43,6 +43,7 @@ type (
 	LegacyTx          = types.LegacyTx
 	TxData            = types.TxData
 	Signer            = types.Signer
+	Withdrawal        = types.Withdrawal
 )
 
 var (
@@ -64,6 +65,7 @@ var (
 	Sender                 = types.Sender
 	NewTx                  = types.NewTx
 	NewTransaction         = types.NewTransaction
+	NewContractCreation    = types.NewContractCreation
 	NewEIP2930Signer       = types.NewEIP2930Signer
 	LatestSignerForChainID = types.LatestSignerForChainID
 	SignNewTx              = types.SignNewTx
@@ -72,7 +74,17 @@ var (
 	ErrInvalidSig          = types.ErrInvalidSig
 )
 
+var (
+	NewBlockWithWithdrawals = types.NewBlockWithWithdrawals
+	EmptyWithdrawalsHash    = types.EmptyWithdrawalsHash
+)
+
 var (
 	ReceiptStatusFailed     = types.ReceiptStatusFailed
 	ReceiptStatusSuccessful = types.ReceiptStatusSuccessful
 )
+
+const (
+	BloomByteLength = types.BloomByteLength
+	BloomBitLength  = types.BloomBitLength
+)
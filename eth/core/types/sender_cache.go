@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	lru "github.com/ethereum/go-ethereum/common/lru"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// senderCacheSize is the number of recovered senders kept by the sender cache.
+const senderCacheSize = 8192
+
+// senderCache is the process-wide cache of recovered transaction senders, keyed by transaction
+// hash. It is shared between the mempool checks and block execution, which each decode their
+// own copy of a transaction and would otherwise recover its sender again.
+var senderCache = lru.NewCache[common.Hash, cachedSender](senderCacheSize)
+
+// cachedSender is a sender recovered by a signer.
+type cachedSender struct {
+	signer Signer
+	from   common.Address
+}
+
+// cachingSigner is a `Signer` that looks up and stores the senders it recovers in the sender
+// cache.
+type cachingSigner struct {
+	Signer
+}
+
+// NewCachingSigner returns a `Signer` that recovers senders using the given signer, caching them
+// by transaction hash. A cached sender is only reused by a signer equal to the one that
+// recovered it, so that the cache never accepts a transaction the given signer would reject.
+func NewCachingSigner(signer Signer) Signer {
+	if cs, ok := signer.(cachingSigner); ok {
+		return cs
+	}
+	return cachingSigner{Signer: signer}
+}
+
+// Sender implements `Signer`.
+func (s cachingSigner) Sender(tx *Transaction) (common.Address, error) {
+	hash := tx.Hash()
+	if cached, ok := senderCache.Get(hash); ok && s.Signer.Equal(cached.signer) {
+		return cached.from, nil
+	}
+
+	from, err := s.Signer.Sender(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	senderCache.Add(hash, cachedSender{signer: s.Signer, from: from})
+	return from, nil
+}
+
+// Equal implements `Signer`.
+func (s cachingSigner) Equal(s2 Signer) bool {
+	if cs, ok := s2.(cachingSigner); ok {
+		s2 = cs.Signer
+	}
+	return s.Signer.Equal(s2)
+}
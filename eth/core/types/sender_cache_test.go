@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/big"
+
+	ethcrypto "pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CachingSigner", func() {
+	var (
+		key, _ = ethcrypto.GenerateEthKey()
+		signer = NewLondonSigner(big.NewInt(420))
+		tx     *Transaction
+	)
+
+	BeforeEach(func() {
+		tx = MustSignNewTx(key, signer, &DynamicFeeTx{
+			Nonce: 0,
+			Gas:   10000000,
+			Data:  []byte("abcdef"),
+		})
+	})
+
+	It("should cache the sender across decoded copies of a transaction", func() {
+		from, err := NewCachingSigner(signer).Sender(tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(from).To(Equal(ethcrypto.PubkeyToAddress(key.PublicKey)))
+
+		cached, ok := senderCache.Get(tx.Hash())
+		Expect(ok).To(BeTrue())
+		Expect(cached.from).To(Equal(from))
+
+		bz, err := tx.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		copied := new(Transaction)
+		Expect(copied.UnmarshalBinary(bz)).To(Succeed())
+		Expect(NewCachingSigner(signer).Sender(copied)).To(Equal(from))
+	})
+
+	It("should not reuse a sender recovered by a different signer", func() {
+		_, err := NewCachingSigner(signer).Sender(tx)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = NewCachingSigner(NewLondonSigner(big.NewInt(69))).Sender(tx)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should be equal to the signer it wraps", func() {
+		Expect(NewCachingSigner(signer).Equal(signer)).To(BeTrue())
+		Expect(NewCachingSigner(signer).Equal(NewCachingSigner(signer))).To(BeTrue())
+	})
+})
@@ -69,8 +69,8 @@ func PubkeyFromTx(signedTx *Transaction, signer Signer) ([]byte, error) {
 	return crypto.CompressPubkey(pubKey), nil
 }
 
-// GetSender returns the sender of the transaction.
+// GetSender returns the sender of the transaction, or the zero address if it cannot be recovered.
 func GetSender(tx *Transaction) common.Address {
-	sender, _ := LatestSignerForChainID(tx.ChainId()).Sender(tx)
+	sender, _ := SignerForTx(tx).Sender(tx)
 	return sender
 }
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// ErrTxTypeNotSupported is returned when decoding a transaction of a type that is not supported.
+var ErrTxTypeNotSupported = types.ErrTxTypeNotSupported
+
+// IsSupportedTxType returns whether transactions of the given type can be encoded, decoded and
+// executed.
+func IsSupportedTxType(txType uint8) bool {
+	switch txType {
+	case types.LegacyTxType, types.AccessListTxType, types.DynamicFeeTxType:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalTx encodes the transaction into its wire format: the RLP list of a legacy transaction,
+// or the EIP-2718 envelope of a typed transaction.
+func MarshalTx(tx *Transaction) ([]byte, error) {
+	if !IsSupportedTxType(tx.Type()) {
+		return nil, ErrTxTypeNotSupported
+	}
+	return tx.MarshalBinary()
+}
+
+// UnmarshalTx decodes a transaction from its wire format.
+func UnmarshalTx(bz []byte) (*Transaction, error) {
+	tx := new(Transaction)
+	if err := tx.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	if !IsSupportedTxType(tx.Type()) {
+		return nil, ErrTxTypeNotSupported
+	}
+	return tx, nil
+}
+
+// MarshalTxJSON encodes the transaction into the JSON format used by the JSON-RPC API.
+func MarshalTxJSON(tx *Transaction) ([]byte, error) {
+	if !IsSupportedTxType(tx.Type()) {
+		return nil, ErrTxTypeNotSupported
+	}
+	return tx.MarshalJSON()
+}
+
+// UnmarshalTxJSON decodes a signed transaction from the JSON format used by the JSON-RPC API.
+func UnmarshalTxJSON(bz []byte) (*Transaction, error) {
+	tx := new(Transaction)
+	if err := tx.UnmarshalJSON(bz); err != nil {
+		return nil, err
+	}
+	if !IsSupportedTxType(tx.Type()) {
+		return nil, ErrTxTypeNotSupported
+	}
+	return tx, nil
+}
+
+// SignerForTx returns the signer that recovers the sender of the transaction from its own chain
+// id, caching the recovered sender.
+func SignerForTx(tx *Transaction) Signer {
+	return NewCachingSigner(LatestSignerForChainID(tx.ChainId()))
+}
+
+// SenderOf recovers the sender of the transaction.
+func SenderOf(tx *Transaction) (common.Address, error) {
+	from, err := SignerForTx(tx).Sender(tx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recovering sender of tx %s: %w", tx.Hash().Hex(), err)
+	}
+	return from, nil
+}
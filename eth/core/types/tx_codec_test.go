@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	ethcrypto "pkg.berachain.dev/polaris/eth/crypto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Transaction codec", func() {
+	var (
+		key, _  = ethcrypto.GenerateEthKey()
+		chainID = big.NewInt(420)
+		signer  = NewLondonSigner(chainID)
+		to      = common.HexToAddress("0x1234")
+	)
+
+	txs := func() []*Transaction {
+		return []*Transaction{
+			MustSignNewTx(key, signer, &LegacyTx{
+				Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1),
+			}),
+			MustSignNewTx(key, signer, &AccessListTx{
+				ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(10), Gas: 30000, To: &to,
+				AccessList: AccessList{{Address: to, StorageKeys: []common.Hash{{0x1}}}},
+			}),
+			MustSignNewTx(key, signer, &DynamicFeeTx{
+				ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10),
+				Gas: 50000, Data: []byte("abcdef"),
+			}),
+		}
+	}
+
+	It("should round trip every supported tx type through RLP", func() {
+		for _, tx := range txs() {
+			bz, err := MarshalTx(tx)
+			Expect(err).ToNot(HaveOccurred())
+			decoded, err := UnmarshalTx(bz)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.Type()).To(Equal(tx.Type()))
+			Expect(decoded.Hash()).To(Equal(tx.Hash()))
+		}
+	})
+
+	It("should round trip every supported tx type through JSON", func() {
+		for _, tx := range txs() {
+			bz, err := MarshalTxJSON(tx)
+			Expect(err).ToNot(HaveOccurred())
+			decoded, err := UnmarshalTxJSON(bz)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.Type()).To(Equal(tx.Type()))
+			Expect(decoded.Hash()).To(Equal(tx.Hash()))
+		}
+	})
+
+	It("should recover the sender of every supported tx type", func() {
+		for _, tx := range txs() {
+			Expect(SenderOf(tx)).To(Equal(ethcrypto.PubkeyToAddress(key.PublicKey)))
+			Expect(GetSender(tx)).To(Equal(ethcrypto.PubkeyToAddress(key.PublicKey)))
+		}
+	})
+
+	It("should reject unsupported tx types", func() {
+		Expect(IsSupportedTxType(0x03)).To(BeFalse())
+		_, err := UnmarshalTx([]byte{0x03, 0xc0})
+		Expect(err).To(HaveOccurred())
+		_, err = UnmarshalTxJSON([]byte(`{"type":"0x7f"}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to recover the sender of an unsigned tx", func() {
+		_, err := SenderOf(NewTx(&DynamicFeeTx{ChainID: chainID}))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+func FuzzUnmarshalTx(f *testing.F) {
+	key, _ := ethcrypto.GenerateEthKey()
+	signer := NewLondonSigner(big.NewInt(420))
+	for _, data := range []TxData{
+		&LegacyTx{Gas: 21000}, &AccessListTx{Gas: 21000}, &DynamicFeeTx{Gas: 21000},
+	} {
+		bz, err := MarshalTx(MustSignNewTx(key, signer, data))
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(bz)
+	}
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		tx, err := UnmarshalTx(bz)
+		if err != nil {
+			return
+		}
+		encoded, err := MarshalTx(tx)
+		if err != nil {
+			t.Fatalf("re-encoding decoded tx: %v", err)
+		}
+		decoded, err := UnmarshalTx(encoded)
+		if err != nil {
+			t.Fatalf("decoding re-encoded tx: %v", err)
+		}
+		if decoded.Hash() != tx.Hash() {
+			t.Fatalf("hash mismatch: %s != %s", decoded.Hash(), tx.Hash())
+		}
+	})
+}
+
+func FuzzTxJSONRoundTrip(f *testing.F) {
+	key, _ := ethcrypto.GenerateEthKey()
+	signer := NewLondonSigner(big.NewInt(420))
+	f.Add(uint8(2), uint64(0), uint64(21000), uint32(1), []byte{})
+	f.Add(uint8(0), uint64(7), uint64(50000), uint32(1e9), []byte("abcdef"))
+
+	f.Fuzz(func(t *testing.T, txType uint8, nonce, gas uint64, price uint32, data []byte) {
+		gasPrice := new(big.Int).SetUint64(uint64(price))
+		var txData TxData
+		switch txType % 3 {
+		case 0:
+			txData = &LegacyTx{Nonce: nonce, Gas: gas, GasPrice: gasPrice, Data: data}
+		case 1:
+			txData = &AccessListTx{Nonce: nonce, Gas: gas, GasPrice: gasPrice, Data: data}
+		default:
+			txData = &DynamicFeeTx{
+				Nonce: nonce, Gas: gas, GasTipCap: gasPrice, GasFeeCap: gasPrice, Data: data,
+			}
+		}
+		tx := MustSignNewTx(key, signer, txData)
+
+		bz, err := MarshalTxJSON(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := UnmarshalTxJSON(bz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Hash() != tx.Hash() {
+			t.Fatalf("hash mismatch: %s != %s", decoded.Hash(), tx.Hash())
+		}
+		from, err := SenderOf(decoded)
+		if err != nil || from != ethcrypto.PubkeyToAddress(key.PublicKey) {
+			t.Fatalf("unexpected sender %s: %v", from, err)
+		}
+	})
+}
@@ -22,6 +22,9 @@ package vm
 
 import (
 	"context"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
 )
 
 type (
@@ -32,4 +35,22 @@ type (
 		// GetContext returns the current context of the state plugin.
 		GetContext() context.Context
 	}
+
+	// TransferStateDB is implemented by the state databases that move the native token between
+	// accounts in a single operation, which the EVM uses to transfer value.
+	TransferStateDB interface {
+		GethStateDB
+		// Transfer moves amount from the balance of the first account to the balance of the
+		// second.
+		Transfer(common.Address, common.Address, *big.Int)
+	}
+
+	// OriginStateDB is implemented by the state databases that record the sender of the
+	// transaction being executed, so that precompiles can tell whether they were called directly by
+	// it.
+	OriginStateDB interface {
+		GethStateDB
+		// TxOrigin returns the sender of the transaction being executed.
+		TxOrigin() common.Address
+	}
 )
@@ -37,9 +37,11 @@ var (
 	EthSecp256k1Sign        = secp256k1.Sign
 	FromECDSA               = crypto.FromECDSA
 	GenerateEthKey          = crypto.GenerateKey
+	HexToECDSA              = crypto.HexToECDSA
 	ValidateSignatureValues = crypto.ValidateSignatureValues
 	Keccak256               = crypto.Keccak256
 	Keccak256Hash           = crypto.Keccak256Hash
+	LoadECDSA               = crypto.LoadECDSA
 	PubkeyToAddress         = crypto.PubkeyToAddress
 	SignatureLength         = crypto.SignatureLength
 	ToECDSA                 = crypto.ToECDSA
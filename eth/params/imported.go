@@ -39,3 +39,12 @@ var (
 	// InitialBaseFee is the initial base fee for the first block of the chain.
 	InitialBaseFee = params.InitialBaseFee
 )
+
+const (
+	// MaxInitCodeSize is the maximum size of the init code of a contract creation (EIP-3860).
+	MaxInitCodeSize = params.MaxInitCodeSize
+	// TxGas is the gas of a transaction that is not a contract creation and has no data.
+	TxGas = params.TxGas
+	// GWei is the number of wei in a gwei.
+	GWei = params.GWei
+)
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// invalidParamsErrorCode is the JSON-RPC error code of the requests with invalid parameters.
+const invalidParamsErrorCode = -32602
+
+// HostAddressConverter is implemented by the host chains whose accounts also have a native
+// address representation, like the Bech32 addresses of Cosmos chains.
+type HostAddressConverter interface {
+	// ConvertAddress parses the given address, either in the representation of the host chain or
+	// in hex, and returns both its host chain and its checksummed Ethereum representation.
+	ConvertAddress(address string) (string, common.Address, error)
+}
+
+// ConvertedAddress is an address in both its host chain and its Ethereum representation.
+type ConvertedAddress struct {
+	HostAddress string         `json:"hostAddress"`
+	EthAddress  common.Address `json:"ethAddress"`
+}
+
+// invalidAddressError is an address that could not be converted, served as invalid parameters.
+type invalidAddressError struct{ err error }
+
+// Error implements `error`.
+func (e *invalidAddressError) Error() string { return e.err.Error() }
+
+// ErrorCode implements `rpc.Error`.
+func (e *invalidAddressError) ErrorCode() int { return invalidParamsErrorCode }
+
+// addressAPI serves the `polaris` namespace method that converts addresses between their host
+// chain and their Ethereum representation, which explorers use to link the accounts of both
+// ecosystems.
+type addressAPI struct {
+	hac HostAddressConverter
+}
+
+// newAddressAPI creates a new `polaris` namespace service that converts the addresses with the
+// given converter.
+func newAddressAPI(hac HostAddressConverter) *addressAPI {
+	return &addressAPI{hac: hac}
+}
+
+// ConvertAddress returns both representations of the given address, which is either in the
+// representation of the host chain or in hex. A mixed-case hex address must match its EIP-55
+// checksum.
+func (api *addressAPI) ConvertAddress(
+	_ context.Context, address string,
+) (*ConvertedAddress, error) {
+	hostAddress, ethAddress, err := api.hac.ConvertAddress(address)
+	if err != nil {
+		return nil, &invalidAddressError{err: err}
+	}
+	return &ConvertedAddress{HostAddress: hostAddress, EthAddress: ethAddress}, nil
+}
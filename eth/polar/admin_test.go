@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Admin endpoint", func() {
+	var (
+		cfg    *NodeConfig
+		secret = bytes.Repeat([]byte{0x1}, jwtSecretLength)
+	)
+
+	BeforeEach(func() {
+		cfg = testNodeConfig()
+		cfg.JWTSecret = filepath.Join(GinkgoT().TempDir(), "jwt.hex")
+	})
+
+	It("should require a JWT secret", func() {
+		cfg.JWTSecret = ""
+		cfg.AdminHost = "127.0.0.1"
+		Expect(cfg.Validate()).ToNot(Succeed())
+	})
+
+	It("should never serve the admin modules publicly", func() {
+		cfg.HTTPModules = []string{"eth", "debug", "txpool", "polaris"}
+		cfg.WSModules = []string{"eth", "admin"}
+		Expect(cfg.httpServerConfig().modules).To(Equal([]string{"eth", "polaris"}))
+		Expect(cfg.httpServerConfig().wsModules).To(Equal([]string{"eth"}))
+	})
+
+	It("should read the JWT secret of the admin endpoint", func() {
+		_, err := cfg.adminServerConfig()
+		Expect(err).To(HaveOccurred())
+
+		Expect(os.WriteFile(cfg.JWTSecret, []byte("0x0102\n"), 0o600)).To(Succeed())
+		_, err = cfg.adminServerConfig()
+		Expect(err).To(HaveOccurred())
+
+		Expect(os.WriteFile(
+			cfg.JWTSecret, []byte(fmt.Sprintf("0x%x\n", secret)), 0o600,
+		)).To(Succeed())
+		cfg.AdminHost = "127.0.0.1"
+		adminCfg, err := cfg.adminServerConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(adminCfg.jwtSecret).To(Equal(secret))
+		Expect(adminCfg.endpoint).To(Equal(fmt.Sprintf("127.0.0.1:%d", defaultAdminPort)))
+	})
+
+	It("should only serve the requests authenticated with the JWT secret", func() {
+		s := startHTTPServer(httpServerConfig{
+			modules: []string{"test"}, vhosts: []string{"*"}, jwtSecret: secret,
+		}, nil)
+		statusWithAuth := func(auth rpc.HTTPAuth) int {
+			req, err := http.NewRequest(
+				http.MethodPost, "http://"+s.cfg.endpoint, strings.NewReader(echoCall),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Content-Type", "application/json")
+			if auth != nil {
+				Expect(auth(req.Header)).To(Succeed())
+			}
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			res.Body.Close()
+			return res.StatusCode
+		}
+
+		Expect(statusWithAuth(nil)).To(Equal(http.StatusUnauthorized))
+		Expect(statusWithAuth(node.NewJWTAuth([32]byte{0x2}))).To(Equal(http.StatusUnauthorized))
+		Expect(statusWithAuth(node.NewJWTAuth([32]byte(secret)))).To(Equal(http.StatusOK))
+	})
+
+	It("should serve all the APIs of the node on the admin endpoint", func() {
+		Expect(os.WriteFile(cfg.JWTSecret, []byte(fmt.Sprintf("%x", secret)), 0o600)).To(Succeed())
+		cfg.AdminHost = "127.0.0.1"
+		_, port, err := net.SplitHostPort(freeEndpoint())
+		Expect(err).ToNot(HaveOccurred())
+		cfg.AdminPort, err = strconv.Atoi(port)
+		Expect(err).ToNot(HaveOccurred())
+		n := startNode(cfg, rpc.API{Namespace: "debug", Service: echoAPI{}})
+
+		client, err := rpc.DialOptions(
+			context.Background(), "http://"+n.admin.cfg.endpoint,
+			rpc.WithHTTPAuth(node.NewJWTAuth([32]byte(secret))),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		defer client.Close()
+		var res string
+		Expect(client.Call(&res, "debug_echo", "hello")).To(Succeed())
+		Expect(res).To(Equal("hello"))
+	})
+})
@@ -25,11 +25,15 @@ import (
 )
 
 type (
-	EthBackend = ethapi.Backend
+	EthBackend      = ethapi.Backend
+	TransactionArgs = ethapi.TransactionArgs
+	BlockChainAPI   = ethapi.BlockChainAPI
 )
 
 var (
 	GethAPIs          = ethapi.GetAPIs
+	DoCall            = ethapi.DoCall
+	DoEstimateGas     = ethapi.DoEstimateGas
 	NewEthereumAPI    = ethapi.NewEthereumAPI
 	NewBlockChainAPI  = ethapi.NewBlockChainAPI
 	NewTransactionAPI = ethapi.NewTransactionAPI
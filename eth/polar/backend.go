@@ -84,7 +84,7 @@ func NewBackend(
 	if cfg.GPO.Default == nil {
 		panic("cfg.GPO.Default is nil")
 	}
-	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+	b.gpo = gasprice.NewOracle(b, cfg.GPO)
 	return b
 }
 
@@ -108,14 +108,10 @@ func (b *backend) CurrentBlock() *types.Header {
 	return b.polar.blockchain.CurrentBlock()
 }
 
-// SyncProgress returns the current progress of the sync algorithm.
+// SyncProgress returns the current progress of the sync algorithm, which is the catch-up of the
+// host chain with the network.
 func (b *backend) SyncProgress() ethereum.SyncProgress {
-	// Consider implementing this in the future.
-	b.logger.Warn("called eth.rpc.backend.SyncProgress", "sync_progress", "not implemented")
-	return ethereum.SyncProgress{
-		CurrentBlock: 0,
-		HighestBlock: 0,
-	}
+	return b.polar.syncProgress()
 }
 
 // SuggestGasTipCap returns the recommended gas tip cap for a new transaction.
@@ -149,24 +145,24 @@ func (b *backend) ExtRPCEnabled() bool {
 // RPCGasCap returns the global gas cap for eth_call over rpc: this is
 // if the user doesn't specify a cap.
 func (b *backend) RPCGasCap() uint64 {
-	return b.cfg.RPCGasCap
+	return b.polar.RPCLimits().GasCap
 }
 
 // RPCEVMTimeout returns the global timeout for eth_call over rpc.
 func (b *backend) RPCEVMTimeout() time.Duration {
-	return b.cfg.RPCEVMTimeout
+	return b.polar.RPCLimits().EVMTimeout
 }
 
 // RPCTxFeeCap returns the global gas price cap for transactions over rpc.
 func (b *backend) RPCTxFeeCap() float64 {
-	return b.cfg.RPCTxFeeCap
+	return b.polar.RPCLimits().TxFeeCap
 }
 
-// UnprotectedAllowed returns whether unprotected transactions are alloweds.
-// We will consider implementing these later, But our opinion is that
-// there is no reason in 2023 not to use these.
+// UnprotectedAllowed returns whether unprotected transactions are allowed. The unprotected
+// transactions are instead rejected by `SendTx` unless configured otherwise, so that they are
+// rejected with their own error code.
 func (b *backend) UnprotectedAllowed() bool {
-	return false
+	return true
 }
 
 // ==============================================================================
@@ -189,23 +185,14 @@ func (b *backend) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ty
 		header := b.polar.blockchain.CurrentHeader()
 		return header, nil
 	}
-	// Otherwise resolve and return the block
-	if number == rpc.LatestBlockNumber {
-		return b.polar.blockchain.CurrentBlock(), nil
-	}
-	if number == rpc.FinalizedBlockNumber {
-		block := b.polar.blockchain.CurrentFinalBlock()
-		if block != nil {
-			return block, nil
-		}
-		return nil, errors.New("finalized block not found")
-	}
-	if number == rpc.SafeBlockNumber {
-		block := b.polar.blockchain.CurrentSafeBlock()
-		if block != nil {
-			return block, nil
+	// Otherwise resolve and return the block. Blocks are final as soon as they are committed by
+	// CometBFT, so the safe and finalized blocks are the latest block.
+	if isLatest(number) {
+		header := b.polar.blockchain.CurrentBlock()
+		if header == nil {
+			return nil, errors.New("latest block not found")
 		}
-		return nil, errors.New("safe block not found")
+		return header, nil
 	}
 	return b.polar.blockchain.GetHeaderByNumber(uint64(number)), nil
 }
@@ -243,25 +230,30 @@ func (b *backend) BlockByNumber(_ context.Context, number rpc.BlockNumber) (*typ
 		// 	return block, nil
 		// todo: handling pending better.
 		header := b.polar.blockchain.CurrentBlock()
+		if header == nil {
+			return nil, errors.New("pending block not found")
+		}
 		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 	}
-	// Otherwise resolve and return the block
-	if number == rpc.LatestBlockNumber {
+	// Otherwise resolve and return the block, the safe and finalized blocks being the latest one.
+	if isLatest(number) {
 		header := b.polar.blockchain.CurrentBlock()
-		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
-	}
-	if number == rpc.FinalizedBlockNumber {
-		header := b.polar.blockchain.CurrentFinalBlock()
-		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
-	}
-	if number == rpc.SafeBlockNumber {
-		header := b.polar.blockchain.CurrentSafeBlock()
+		if header == nil {
+			return nil, errors.New("latest block not found")
+		}
 		return b.polar.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 	}
 	// safe to assume number >= 0
 	return b.polar.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
+// isLatest returns whether the given block number is a tag resolving to the latest block.
+func isLatest(number rpc.BlockNumber) bool {
+	return number == rpc.LatestBlockNumber ||
+		number == rpc.FinalizedBlockNumber ||
+		number == rpc.SafeBlockNumber
+}
+
 // BlockByHash returns the block with the given `hash`.
 func (b *backend) BlockByHash(_ context.Context, hash common.Hash) (*types.Block, error) {
 	block := b.polar.blockchain.GetBlockByHash(hash)
@@ -444,7 +436,23 @@ func (b *backend) SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.S
 // ==============================================================================
 
 func (b *backend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
-	return b.polar.blockchain.SendTx(ctx, signedTx)
+	if !b.cfg.RPCAllowUnprotectedTxs && !signedTx.Protected() {
+		return ErrUnprotectedTx
+	}
+	limits := b.polar.RPCLimits()
+	if size := signedTx.Size(); limits.MaxTxSize > 0 && size > limits.MaxTxSize {
+		return oversizedDataError("transaction", size, limits.MaxTxSize)
+	}
+	if size := uint64(len(signedTx.Data())); limits.MaxCalldata > 0 && size > limits.MaxCalldata {
+		return oversizedDataError("calldata", size, limits.MaxCalldata)
+	}
+	if err := b.polar.sendTx(ctx, signedTx); err != nil {
+		return err
+	}
+	if b.polar.localTxs != nil {
+		b.polar.localTxs.track(signedTx)
+	}
+	return nil
 }
 
 func (b *backend) GetPoolTransactions() (types.Transactions, error) {
@@ -525,13 +533,21 @@ func (b *backend) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscr
 	return b.polar.blockchain.SubscribePendingLogsEvent(ch)
 }
 
+// BloomStatus returns the size of the sections of the bloom bits index and the number of sections
+// indexed, which is zero if the index is not built.
 func (b *backend) BloomStatus() (uint64, uint64) {
-	// TODO: Implement your code here
-	return 0, 0
+	if b.polar.bloomIndexer == nil {
+		return 0, 0
+	}
+	return b.polar.bloomIndexer.status()
 }
 
-func (b *backend) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
-	// TODO: Implement your code here
+// ServiceFilter retrieves the bloom bits of the given matcher session of a log filter from the
+// bloom bits index. It is only called for the sections indexed.
+func (b *backend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
+	if b.polar.bloomIndexer != nil {
+		b.polar.bloomIndexer.serviceFilter(ctx, session)
+	}
 }
 
 // Version returns the current chain protocol version.
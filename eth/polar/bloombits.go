@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+const (
+	// bloomConfirms is the number of blocks after which the blooms of a section are indexed, so
+	// that the blocks rolled back by the host chain are not.
+	bloomConfirms = 256
+	// bloomThrottling is the time between two sections indexed during a backfill, the one of
+	// go-ethereum, so that the backfill does not starve the rpc calls of the historical headers.
+	bloomThrottling = 100 * time.Millisecond
+	// bloomIndexInterval is the time between two checks for new sections to index.
+	bloomIndexInterval = time.Minute
+
+	// bloomServiceThreads is the number of goroutines serving the bloom bits to the filters.
+	bloomServiceThreads = 16
+	// bloomFilterThreads is the number of goroutines of a filter retrieving bloom bits.
+	bloomFilterThreads = 3
+	// bloomRetrievalBatch is the maximum number of bloom bit retrievals served in a single batch.
+	bloomRetrievalBatch = 16
+	// bloomRetrievalWait is the maximum time to wait for enough bloom bit requests to fill a batch.
+	bloomRetrievalWait = time.Duration(0)
+)
+
+// BloomBitsStore is implemented by the host chains that can persist the bloom bits index, which
+// serves `eth_getLogs` over large block ranges without reading the header of every block.
+//
+// The index is split into sections of `params.BloomBitsBlocks` blocks, indexed in order. For
+// every section, it stores the bit vector of each of the `types.BloomBitLength` bits of the bloom
+// filters of its blocks, compressed.
+type BloomBitsStore interface {
+	// BloomBitsSections returns the number of sections indexed.
+	BloomBitsSections() (uint64, error)
+	// ReadBloomBits returns the compressed bit vector of the given bit of the given section.
+	ReadBloomBits(bit uint, section uint64) ([]byte, error)
+	// WriteBloomBits writes the compressed bit vectors of the given section, which is the next
+	// one, and marks it as indexed.
+	WriteBloomBits(section uint64, bits [][]byte) error
+}
+
+// bloomIndexer builds the bloom bits index in the background from the headers of the chain, and
+// serves it to the log filters.
+type bloomIndexer struct {
+	store BloomBitsStore
+	chain core.ChainBlockReader
+	// sections is the number of sections indexed.
+	sections atomic.Uint64
+	// requests are the bloom bits retrievals of the log filters.
+	requests chan chan *bloombits.Retrieval
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// newBloomIndexer creates a new indexer of the bloom bits of the given chain to the given store.
+func newBloomIndexer(store BloomBitsStore, chain core.ChainBlockReader) (*bloomIndexer, error) {
+	sections, err := store.BloomBitsSections()
+	if err != nil {
+		return nil, err
+	}
+	bi := &bloomIndexer{
+		store:    store,
+		chain:    chain,
+		requests: make(chan chan *bloombits.Retrieval),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	bi.sections.Store(sections)
+	return bi, nil
+}
+
+// start starts indexing the new sections and serving the bloom bits.
+func (bi *bloomIndexer) start() {
+	for i := 0; i < bloomServiceThreads; i++ {
+		go bi.serve()
+	}
+	go func() {
+		defer close(bi.done)
+		ticker := time.NewTicker(bloomIndexInterval)
+		defer ticker.Stop()
+		for {
+			bi.index()
+			select {
+			case <-ticker.C:
+			case <-bi.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops indexing and serving the bloom bits.
+func (bi *bloomIndexer) stop() {
+	close(bi.quit)
+	<-bi.done
+}
+
+// status returns the size of the sections and the number of sections indexed.
+func (bi *bloomIndexer) status() (uint64, uint64) {
+	return params.BloomBitsBlocks, bi.sections.Load()
+}
+
+// index indexes the sections whose blocks are all confirmed, until it is stopped.
+func (bi *bloomIndexer) index() {
+	for {
+		head := bi.chain.CurrentBlock()
+		if head == nil || head.Number.Uint64() < bloomConfirms {
+			return
+		}
+		section := bi.sections.Load()
+		if (section+1)*params.BloomBitsBlocks > head.Number.Uint64()-bloomConfirms+1 {
+			return
+		}
+
+		start := time.Now()
+		if err := bi.indexSection(section); err != nil {
+			// The section is retried on the next check, e.g. once its headers are available.
+			log.Root().Error("failed to index bloom bits", "section", section, "err", err)
+			return
+		}
+		bi.sections.Store(section + 1)
+		log.Root().Debug(
+			"indexed bloom bits", "section", section, "elapsed", time.Since(start),
+		)
+
+		select {
+		case <-time.After(bloomThrottling):
+		case <-bi.quit:
+			return
+		}
+	}
+}
+
+// indexSection writes the bloom bits of the given section from the blooms of its headers.
+func (bi *bloomIndexer) indexSection(section uint64) error {
+	gen, err := bloombits.NewGenerator(uint(params.BloomBitsBlocks))
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < params.BloomBitsBlocks; i++ {
+		number := section*params.BloomBitsBlocks + i
+		header := bi.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return fmt.Errorf("header %d not found", number)
+		}
+		if err = gen.AddBloom(uint(i), header.Bloom); err != nil {
+			return err
+		}
+	}
+
+	bits := make([][]byte, types.BloomBitLength)
+	for bit := range bits {
+		bitset, err := gen.Bitset(uint(bit))
+		if err != nil {
+			return err
+		}
+		bits[bit] = bitutil.CompressBytes(bitset)
+	}
+	return bi.store.WriteBloomBits(section, bits)
+}
+
+// serve serves the bloom bits retrievals of the log filters until the indexer is stopped.
+func (bi *bloomIndexer) serve() {
+	for {
+		select {
+		case <-bi.quit:
+			return
+		case request := <-bi.requests:
+			task := <-request
+			task.Bitsets = make([][]byte, len(task.Sections))
+			for i, section := range task.Sections {
+				compressed, err := bi.store.ReadBloomBits(task.Bit, section)
+				if err != nil {
+					task.Error = err
+					continue
+				}
+				blob, err := bitutil.DecompressBytes(
+					compressed, int(params.BloomBitsBlocks/8), //nolint:gomnd // bits per byte.
+				)
+				if err != nil {
+					task.Error = err
+					continue
+				}
+				task.Bitsets[i] = blob
+			}
+			request <- task
+		}
+	}
+}
+
+// serviceFilter retrieves the bloom bits of the given matcher session of a log filter.
+func (bi *bloomIndexer) serviceFilter(_ context.Context, session *bloombits.MatcherSession) {
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, bi.requests)
+	}
+}
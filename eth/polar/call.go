@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+	"pkg.berachain.dev/polaris/eth/rpc"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+var (
+	// ErrTxNotFound is returned when the transaction to trace is not part of the chain.
+	ErrTxNotFound = errors.New("transaction not found")
+	// ErrGenesisNotTraceable is returned when attempting to trace the genesis block.
+	ErrGenesisNotTraceable = errors.New("genesis is not traceable")
+	// ErrBlockNotFound is returned when the block to replay is not part of the chain.
+	ErrBlockNotFound = errors.New("block not found")
+	// ErrUnprotectedTx is returned when an unprotected transaction is sent over rpc while they
+	// are not allowed.
+	ErrUnprotectedTx = &txRejectedError{
+		"only replay-protected (EIP-155) transactions allowed over RPC",
+	}
+)
+
+// oversizedDataError returns the rejection of a transaction whose size, or the one of its
+// calldata, is above the given limit, as go-ethereum's.
+func oversizedDataError(what string, size, limit uint64) error {
+	return &txRejectedError{fmt.Sprintf("oversized data: %s size %d, limit %d", what, size, limit)}
+}
+
+// txRejectedErrorCode is the JSON-RPC error code of the transactions rejected by the node, as
+// defined by EIP-1474, so that clients can tell them apart from the invalid transactions.
+const txRejectedErrorCode = -32003
+
+// txRejectedError is a transaction rejection served with its own JSON-RPC error code.
+type txRejectedError struct{ msg string }
+
+// Error implements `error`.
+func (e *txRejectedError) Error() string { return e.msg }
+
+// ErrorCode implements `rpc.Error`.
+func (e *txRejectedError) ErrorCode() int { return txRejectedErrorCode }
+
+// Call executes the given message call on top of the state at `blockNrOrHash` and returns the
+// execution result. The call is capped by the lower of `gasCap` and the configured RPC gas cap,
+// a `gasCap` of 0 falling back to the configured one.
+func (pl *Polaris) Call(
+	ctx context.Context, args polarapi.TransactionArgs,
+	blockNrOrHash rpc.BlockNumberOrHash, gasCap uint64,
+) (*core.ExecutionResult, error) {
+	limits := pl.RPCLimits()
+	return polarapi.DoCall(
+		ctx, pl.backend, args, blockNrOrHash, nil, nil,
+		limits.EVMTimeout, capGas(gasCap, limits.GasCap),
+	)
+}
+
+// EstimateGas returns the lowest gas limit that allows the given message call to succeed on top
+// of the state at `blockNrOrHash`. The estimation is capped by the lower of `gasCap` and the
+// configured RPC gas cap, a `gasCap` of 0 falling back to the configured one.
+func (pl *Polaris) EstimateGas(
+	ctx context.Context, args polarapi.TransactionArgs,
+	blockNrOrHash rpc.BlockNumberOrHash, gasCap uint64,
+) (uint64, error) {
+	gas, err := polarapi.DoEstimateGas(
+		ctx, pl.backend, args, blockNrOrHash, capGas(gasCap, pl.RPCLimits().GasCap),
+	)
+	return uint64(gas), err
+}
+
+// capGas returns the gas cap of a call that requests the given one, which may only lower the
+// given node cap. A cap of 0 means no cap, as in go-ethereum.
+func capGas(requested, nodeCap uint64) uint64 {
+	if requested == 0 || (nodeCap != 0 && requested > nodeCap) {
+		return nodeCap
+	}
+	return requested
+}
+
+// TraceTransaction re-executes the transaction with the given hash on top of the state of its
+// parent block, replaying the transactions that precede it in the block, and returns the output
+// of the tracer requested by the given config. The struct logger is used if no tracer is named.
+func (pl *Polaris) TraceTransaction(
+	ctx context.Context, txHash common.Hash, cfg *tracers.TraceConfig,
+) (json.RawMessage, error) {
+	lookup := pl.blockchain.GetTransactionLookup(txHash)
+	if lookup == nil {
+		return nil, ErrTxNotFound
+	}
+	if lookup.BlockNum == 0 {
+		return nil, ErrGenesisNotTraceable
+	}
+	block := pl.blockchain.GetBlock(lookup.BlockHash, lookup.BlockNum)
+	if block == nil {
+		return nil, ErrTxNotFound
+	}
+
+	// Build the state of the parent block, all transactions prior to the traced one are
+	// re-applied on top of it.
+	gethState, err := pl.blockchain.StateAtBlockNumber(lookup.BlockNum - 1)
+	if err != nil {
+		return nil, err
+	}
+	statedb := utils.MustGetAs[vm.PolarisStateDB](gethState)
+
+	var (
+		header = block.Header()
+		signer = types.MakeSigner(pl.blockchain.Config(), block.Number(), block.Time())
+	)
+	for idx, tx := range block.Transactions() {
+		var msg *core.Message
+		if msg, err = core.TransactionToMessage(tx, signer, block.BaseFee()); err != nil {
+			return nil, err
+		}
+
+		vmConfig := *pl.blockchain.GetVMConfig()
+		var tracer tracers.Tracer
+		if uint64(idx) == lookup.TxIndex {
+			if tracer, err = newTracer(cfg, &tracers.Context{
+				BlockHash:   block.Hash(),
+				BlockNumber: block.Number(),
+				TxIndex:     idx,
+				TxHash:      txHash,
+			}); err != nil {
+				return nil, err
+			}
+			vmConfig.Tracer = tracer
+		}
+
+		statedb.SetTxContext(tx.Hash(), idx)
+		evm := pl.blockchain.GetEVM(ctx, core.NewEVMTxContext(msg), statedb, header, &vmConfig)
+		if tracer != nil {
+			return traceMessage(ctx, evm, msg, tracer, cfg, pl.RPCLimits().TraceTimeout)
+		}
+		if _, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.GasLimit)); err != nil {
+			return nil, err
+		}
+		statedb.Finalise(evm.ChainConfig().IsEIP158(block.Number()))
+	}
+
+	return nil, ErrTxNotFound
+}
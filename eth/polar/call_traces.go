@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package polar
+
+import (
+	"context"
+	"encoding/json"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// traceAPI serves the `trace` namespace methods that return the flattened call traces recorded
+// when the transactions were executed, if the host chain persists them. Unlike the tracers of the
+// `debug` namespace, they do not re-execute the transactions.
+type traceAPI struct {
+	chain core.ChainBlockReader
+}
+
+// newTraceAPI creates a new `trace` namespace service that reads the call traces of the given
+// chain.
+func newTraceAPI(chain core.ChainBlockReader) *traceAPI {
+	return &traceAPI{chain: chain}
+}
+
+// Transaction returns the call traces of the transaction with the given hash, or nil if they were
+// not recorded.
+func (api *traceAPI) Transaction(_ context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	tle := api.chain.GetTransactionLookup(hash)
+	if tle == nil {
+		return nil, nil //nolint:nilnil // null is returned for unknown transactions.
+	}
+	return api.callTraces(tle)
+}
+
+// Block returns the call traces of the transactions of the given block, or nil if they were not
+// recorded.
+func (api *traceAPI) Block(_ context.Context, number rpc.BlockNumber) ([]json.RawMessage, error) {
+	var block *types.Block
+	switch number {
+	case rpc.PendingBlockNumber, rpc.LatestBlockNumber,
+		rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		if header := api.chain.CurrentBlock(); header != nil {
+			block = api.chain.GetBlock(header.Hash(), header.Number.Uint64())
+		}
+	default:
+		block = api.chain.GetBlockByNumber(uint64(number.Int64()))
+	}
+	if block == nil {
+		return nil, nil //nolint:nilnil // null is returned for unknown blocks.
+	}
+
+	var traces []json.RawMessage
+	for txIndex, tx := range block.Transactions() {
+		txTraces, err := api.callTraces(&types.TxLookupEntry{
+			Tx:        tx,
+			TxIndex:   uint64(txIndex),
+			BlockNum:  block.NumberU64(),
+			BlockHash: block.Hash(),
+		})
+		if txTraces == nil || err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// callTraces returns the call traces of the transaction of the given lookup entry, with the hash
+// of its block, which is not known when they are recorded.
+func (api *traceAPI) callTraces(tle *types.TxLookupEntry) ([]json.RawMessage, error) {
+	raw := api.chain.GetCallTraces(tle)
+	if raw == nil {
+		return nil, nil //nolint:nilnil // null is returned if the traces were not recorded.
+	}
+	var frames []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &frames); err != nil {
+		return nil, err
+	}
+	blockHash, err := json.Marshal(tle.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]json.RawMessage, len(frames))
+	for i, frame := range frames {
+		frame["blockHash"] = blockHash
+		if traces[i], err = json.Marshal(frame); err != nil {
+			return nil, err
+		}
+	}
+	return traces, nil
+}
@@ -44,18 +44,30 @@ const (
 func DefaultConfig() *Config {
 	gpoConfig := ethconfig.FullNodeGPO
 	gpoConfig.Default = big.NewInt(gpoDefault)
+	// The prices are copied, as the defaults of go-ethereum are shared.
+	gpoConfig.MaxPrice = new(big.Int).Set(gpoConfig.MaxPrice)
+	gpoConfig.IgnorePrice = new(big.Int).Set(gpoConfig.IgnorePrice)
 	return &Config{
-		GPO:           &gpoConfig,
-		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
-		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
-		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		GPO:             gpoConfig,
+		RPCGasCap:       ethconfig.Defaults.RPCGasCap,
+		RPCTxFeeCap:     ethconfig.Defaults.RPCTxFeeCap,
+		RPCEVMTimeout:   ethconfig.Defaults.RPCEVMTimeout,
+		RPCTraceTimeout: defaultMaxTraceTimeout,
+		Faucet:          defaultFaucetConfig(),
+		Health:          defaultHealthConfig(),
+		Replica:         defaultReplicaConfig(),
+		Rebroadcast:     defaultRebroadcastConfig(),
+		Logs:            defaultLogsConfig(),
+		Shadow:          defaultShadowConfig(),
 	}
 }
 
 // Config represents the configurable parameters for Polaris.
 type Config struct {
-	// Gas Price Oracle config.
-	GPO *gasprice.Config
+	// GPO is the config of the gas price oracle, which suggests the tip of `eth_gasPrice` and
+	// `eth_maxPriorityFeePerGas` from the percentile of the effective tips of the transactions of
+	// the recent blocks, ignoring the ones below the ignore price.
+	GPO gasprice.Config
 
 	// RPCGasCap is the global gas cap for eth-call variants.
 	RPCGasCap uint64 `toml:""`
@@ -63,14 +75,77 @@ type Config struct {
 	// RPCEVMTimeout is the global timeout for eth-call.
 	RPCEVMTimeout time.Duration `toml:""`
 
+	// RPCTraceTimeout is the maximum time a tracer may run for, whatever the timeout requested in
+	// its config, or zero for no maximum.
+	RPCTraceTimeout time.Duration `toml:""`
+
 	// RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64 `toml:""`
+
+	// RPCNativeTxs includes the non-EVM transactions of the host chain in the blocks returned
+	// over rpc, as synthetic transactions of type `NativeTxType`.
+	RPCNativeTxs bool `toml:""`
+
+	// RPCAllowUnprotectedTxs accepts the transactions that are not replay-protected (pre-EIP-155)
+	// over rpc, such as the ones of deterministic deployments. They are rejected by default.
+	RPCAllowUnprotectedTxs bool `toml:""`
+
+	// RPCBloomIndex builds the bloom bits index of the blocks in the background, which serves
+	// `eth_getLogs` over large block ranges without reading the header of every block. It is
+	// meant for archive nodes, as it is built from the headers of all the blocks.
+	RPCBloomIndex bool `toml:""`
+
+	// Faucet is the config of the faucet of testnets, which transfers funds to the addresses that
+	// request them.
+	Faucet FaucetConfig
+
+	// Health is the config of the readiness of the node, served on the `/ready` HTTP path.
+	Health HealthConfig
+
+	// Replica is the config of the read replica mode, in which the transactions sent over rpc
+	// are forwarded to sentry nodes.
+	Replica ReplicaConfig
+
+	// Rebroadcast is the config of the rebroadcast of the transactions sent over rpc until they
+	// are included, whose status is served by `txpool_localStatus`.
+	Rebroadcast RebroadcastConfig
+
+	// Logs is the config of the limits of `eth_getLogs`, and of the pages of its paginated
+	// variant `polaris_getLogsPage`.
+	Logs LogsConfig
+
+	// Shadow is the config of the shadow execution, which re-executes the blocks against an
+	// in-memory state to alert on the divergences of the state plugin.
+	Shadow ShadowConfig
 }
 
-// LoadConfigFromFilePath reads in a Polaris config file from the fileystem.
+// RPCLimits are the caps of the rpc calls, which can be updated while the node runs.
+type RPCLimits struct {
+	// GasCap is the global gas cap for eth-call variants.
+	GasCap uint64
+	// EVMTimeout is the global timeout for eth-call.
+	EVMTimeout time.Duration
+	// TraceTimeout is the maximum time a tracer may run for, or zero for no maximum.
+	TraceTimeout time.Duration
+	// TxFeeCap is the global transaction fee (price * gaslimit) cap for send-transaction
+	// variants, in ether.
+	TxFeeCap float64
+	// MaxTxSize is the maximum size of an encoded transaction sent over rpc, or zero for no limit.
+	MaxTxSize uint64
+	// MaxCalldata is the maximum size of the calldata of a transaction sent over rpc, or zero for
+	// no limit.
+	MaxCalldata uint64
+}
+
+// LoadConfigFromFilePath reads in the `RPCConfig` section of a Polaris config file from the
+// filesystem, on top of the default config.
 func LoadConfigFromFilePath(filename string) (*Config, error) {
-	var config Config
+	file := struct {
+		RPCConfig Config
+	}{
+		RPCConfig: *DefaultConfig(),
+	}
 
 	// Read the TOML file
 	bytes, err := os.ReadFile(filename) //#nosec: G304 // required.
@@ -79,9 +154,9 @@ func LoadConfigFromFilePath(filename string) (*Config, error) {
 	}
 
 	// Unmarshal the TOML data into a struct
-	if err = toml.Unmarshal(bytes, &config); err != nil {
+	if _, err = toml.Decode(string(bytes), &file); err != nil {
 		return nil, fmt.Errorf("error parsing TOML data: %w", err)
 	}
 
-	return &config, nil
+	return &file.RPCConfig, nil
 }
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package polar
+
+import (
+	"context"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+)
+
+// ContractCreation is the deployment metadata of a contract, which contract verification services
+// such as Sourcify and Blockscout match the compiled contract against.
+type ContractCreation struct {
+	Address          common.Address `json:"address"`
+	Deployer         common.Address `json:"deployer"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	// CreationBytecode is the init code of the contract, including its constructor arguments.
+	CreationBytecode hexutil.Bytes `json:"creationBytecode"`
+}
+
+// contractsAPI serves the `polaris` namespace methods that return the deployment metadata of the
+// contracts, as indexed by the historical plugin of the host chain.
+type contractsAPI struct {
+	chain core.ChainBlockReader
+}
+
+// newContractsAPI creates a new `polaris` namespace service that reads the contract creations of
+// the given chain.
+func newContractsAPI(chain core.ChainBlockReader) *contractsAPI {
+	return &contractsAPI{chain: chain}
+}
+
+// GetContractCreation returns the deployment metadata of the contract at the given address. It
+// returns nil if the contract was not deployed by a transaction, such as the contracts created by
+// other contracts, or if the node does not index contract creations.
+func (api *contractsAPI) GetContractCreation(
+	_ context.Context, address common.Address,
+) (*ContractCreation, error) {
+	tle := api.chain.GetContractCreation(address)
+	if tle == nil {
+		return nil, nil //nolint:nilnil // null is returned for unknown contracts.
+	}
+	return &ContractCreation{
+		Address:          address,
+		Deployer:         types.GetSender(tle.Tx),
+		TransactionHash:  tle.Tx.Hash(),
+		TransactionIndex: hexutil.Uint64(tle.TxIndex),
+		BlockHash:        tle.BlockHash,
+		BlockNumber:      hexutil.Uint64(tle.BlockNum),
+		CreationBytecode: tle.Tx.Data(),
+	}, nil
+}
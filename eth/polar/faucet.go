@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+const (
+	// faucetPath is the HTTP path of the faucet.
+	faucetPath = "/faucet"
+	// maxFaucetRequestSize is the maximum size of the body of a request to the HTTP path of the
+	// faucet.
+	maxFaucetRequestSize = 1024
+
+	// limitExceededErrorCode is the JSON-RPC error code of the requests rejected by a rate limit
+	// or a query limit, as defined by EIP-1474.
+	limitExceededErrorCode = -32005
+)
+
+var (
+	// errFaucetRateLimited is returned for the requests of an address or an IP that was funded
+	// less than a cooldown ago.
+	errFaucetRateLimited = &limitExceededError{"faucet request rate limited, try again later"}
+	// errFaucetZeroAddress is returned for the requests without an address, which would otherwise
+	// fund the zero address.
+	errFaucetZeroAddress = &invalidAddressError{errors.New("faucet cannot fund the zero address")}
+)
+
+// limitExceededError is a rate limit or query limit rejection served with its own JSON-RPC error
+// code.
+type limitExceededError struct{ msg string }
+
+// Error implements `error`.
+func (e *limitExceededError) Error() string { return e.msg }
+
+// ErrorCode implements `rpc.Error`.
+func (e *limitExceededError) ErrorCode() int { return limitExceededErrorCode }
+
+// FaucetConfig is the config of the faucet, which transfers testnet funds from a funded account
+// to the addresses that request them.
+type FaucetConfig struct {
+	// Enabled serves the faucet in the `faucet` namespace and on the `/faucet` HTTP path. It must
+	// never be enabled on a chain whose funds have value.
+	Enabled bool `toml:""`
+
+	// KeyFile is the path of the file with the hex encoded private key of the funded account that
+	// the faucet transfers from.
+	KeyFile string `toml:""`
+
+	// Amount is the amount of wei transferred per request.
+	Amount *big.Int `toml:""`
+
+	// AddressCooldown is the time before an address can be funded again.
+	AddressCooldown time.Duration `toml:""`
+
+	// IPCooldown is the time before a client IP can request funds again.
+	IPCooldown time.Duration `toml:""`
+
+	// TrustedProxies are the IPs or CIDR ranges of the reverse proxies in front of the node. The
+	// client IP of the requests to the HTTP path of the faucet that they forward is read from the
+	// X-Forwarded-For header. The JSON-RPC method does not see the headers of the requests, so
+	// all the requests that a proxy forwards to it share the IP cooldown of the proxy.
+	TrustedProxies []string `toml:""`
+}
+
+// defaultFaucetConfig returns the default config of the faucet, which is disabled.
+func defaultFaucetConfig() FaucetConfig {
+	return FaucetConfig{
+		Amount:          big.NewInt(1e18), //nolint:gomnd // 1 ether.
+		AddressCooldown: 24 * time.Hour,   //nolint:gomnd // a day.
+		IPCooldown:      time.Hour,
+	}
+}
+
+// faucet transfers the configured amount from its account to the addresses that request it, at
+// most once per cooldown for every address and every client IP.
+type faucet struct {
+	cfg     *FaucetConfig
+	backend Backend
+	key     *ecdsa.PrivateKey
+	address common.Address
+	// proxies are the ranges of the trusted reverse proxies.
+	proxies []*net.IPNet
+
+	// mu serializes the transfers, so that they are sent with consecutive nonces, and guards the
+	// cooldowns.
+	mu sync.Mutex
+	// cooldowns is the time until which each address and IP cannot be funded again.
+	cooldowns map[string]time.Time
+}
+
+// newFaucet creates a new faucet that sends its transfers with the given backend.
+func newFaucet(cfg *FaucetConfig, backend Backend) (*faucet, error) {
+	if cfg.Amount == nil || cfg.Amount.Sign() <= 0 {
+		return nil, errors.New("faucet amount must be positive")
+	}
+	proxies, err := parseProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.LoadECDSA(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading faucet key %s: %w", cfg.KeyFile, err)
+	}
+	return &faucet{
+		cfg:       cfg,
+		backend:   backend,
+		key:       key,
+		address:   crypto.PubkeyToAddress(key.PublicKey),
+		proxies:   proxies,
+		cooldowns: make(map[string]time.Time),
+	}, nil
+}
+
+// parseProxies parses the given IPs or CIDR ranges of trusted proxies.
+func parseProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * len(ip)
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid faucet trusted proxy %q: %w", proxy, err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// fund transfers the configured amount to the given address on behalf of the client with the
+// given IP, which is empty for the local clients, and returns the hash of the transfer.
+func (f *faucet) fund(ctx context.Context, to common.Address, ip string) (common.Hash, error) {
+	if to == (common.Address{}) {
+		return common.Hash{}, errFaucetZeroAddress
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	for key, until := range f.cooldowns {
+		if !now.Before(until) {
+			delete(f.cooldowns, key)
+		}
+	}
+	addressKey, ipKey := "address/"+to.Hex(), "ip/"+ip
+	if _, ok := f.cooldowns[addressKey]; ok {
+		return common.Hash{}, errFaucetRateLimited
+	}
+	if _, ok := f.cooldowns[ipKey]; ok {
+		return common.Hash{}, errFaucetRateLimited
+	}
+
+	tx, err := f.transfer(ctx, to)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err = f.backend.SendTx(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	f.cooldowns[addressKey] = now.Add(f.cfg.AddressCooldown)
+	if ip != "" {
+		f.cooldowns[ipKey] = now.Add(f.cfg.IPCooldown)
+	}
+	return tx.Hash(), nil
+}
+
+// transfer returns the signed transfer of the configured amount to the given address, which pays
+// the suggested tip on top of twice the current base fee, so that it stays valid if the base fee
+// rises before its inclusion.
+func (f *faucet) transfer(ctx context.Context, to common.Address) (*types.Transaction, error) {
+	head := f.backend.CurrentHeader()
+	if head == nil || head.BaseFee == nil {
+		return nil, errors.New("faucet is not ready, no block has been produced yet")
+	}
+	nonce, err := f.backend.GetPoolNonce(ctx, f.address)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := f.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := f.backend.ChainConfig().ChainID
+	return types.SignNewTx(f.key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Lsh(head.BaseFee, 1)),
+		Gas:       params.TxGas,
+		To:        &to,
+		Value:     f.cfg.Amount,
+	})
+}
+
+// faucetAPI serves the `faucet` namespace methods, which fund the addresses of the callers.
+type faucetAPI struct {
+	faucet *faucet
+}
+
+// newFaucetAPI creates a new `faucet` namespace service that funds addresses with the given
+// faucet.
+func newFaucetAPI(faucet *faucet) *faucetAPI {
+	return &faucetAPI{faucet: faucet}
+}
+
+// Fund transfers testnet funds to the given address and returns the hash of the transfer. The
+// requests are rate limited per address and per client IP.
+func (api *faucetAPI) Fund(ctx context.Context, address common.Address) (common.Hash, error) {
+	var ip string
+	if info := rpc.PeerInfoFromContext(ctx); info.RemoteAddr != "" {
+		ip = remoteIP(info.RemoteAddr)
+	}
+	return api.faucet.fund(ctx, address, ip)
+}
+
+// faucetRequest is the body of a request to the HTTP path of the faucet.
+type faucetRequest struct {
+	Address common.Address `json:"address"`
+}
+
+// faucetResponse is the body of a successful response of the HTTP path of the faucet.
+type faucetResponse struct {
+	TransactionHash common.Hash `json:"transactionHash"`
+}
+
+// ServeHTTP serves the HTTP path of the faucet, which funds the address of the JSON body of POST
+// requests.
+//
+// ServeHTTP implements `http.Handler`.
+func (f *faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST requests are accepted", http.StatusMethodNotAllowed)
+		return
+	}
+	var req faucetRequest
+	body := http.MaxBytesReader(w, r.Body, maxFaucetRequestSize)
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	hash, err := f.fund(r.Context(), req.Address, f.clientIP(r))
+	switch {
+	case errors.Is(err, errFaucetZeroAddress):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, errFaucetRateLimited):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(faucetResponse{TransactionHash: hash})
+}
+
+// clientIP returns the IP of the client of the given request. For the requests forwarded by a
+// trusted proxy, it is the last IP of the X-Forwarded-For header that is not a trusted proxy, as
+// the IPs before it may be forged by the client.
+func (f *faucet) clientIP(r *http.Request) string {
+	ip := remoteIP(r.RemoteAddr)
+	if !f.trusted(ip) {
+		return ip
+	}
+	hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		if !f.trusted(hop) {
+			return hop
+		}
+		ip = hop
+	}
+	return ip
+}
+
+// trusted returns whether the given IP is one of a trusted proxy.
+func (f *faucet) trusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range f.proxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP returns the IP of the given remote address of a client.
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// faucetBackend is a backend that records the transactions sent by the faucet.
+type faucetBackend struct {
+	Backend
+	sent []*types.Transaction
+}
+
+func (b *faucetBackend) CurrentHeader() *types.Header {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1e9)}
+}
+
+func (b *faucetBackend) GetPoolNonce(context.Context, common.Address) (uint64, error) {
+	return uint64(len(b.sent)), nil
+}
+
+func (b *faucetBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (b *faucetBackend) ChainConfig() *params.ChainConfig { return params.DefaultChainConfig }
+
+func (b *faucetBackend) SendTx(_ context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+var _ = Describe("Faucet", func() {
+	var (
+		cfg     *FaucetConfig
+		backend *faucetBackend
+		f       *faucet
+		alice   = common.Address{0x1}
+		bob     = common.Address{0x2}
+	)
+
+	BeforeEach(func() {
+		key, err := crypto.GenerateEthKey()
+		Expect(err).ToNot(HaveOccurred())
+		keyFile := filepath.Join(GinkgoT().TempDir(), "faucet.key")
+		Expect(os.WriteFile(keyFile, []byte(hex.EncodeToString(crypto.FromECDSA(key))), 0o600)).
+			To(Succeed())
+
+		cfg = &FaucetConfig{
+			KeyFile:         keyFile,
+			Amount:          big.NewInt(1e18),
+			AddressCooldown: time.Hour,
+			IPCooldown:      time.Hour,
+			TrustedProxies:  []string{"10.0.0.0/8", "192.168.1.1"},
+		}
+		backend = &faucetBackend{}
+		f, err = newFaucet(cfg, backend)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	post := func(body, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, faucetPath, strings.NewReader(body))
+		r.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		w := httptest.NewRecorder()
+		f.ServeHTTP(w, r)
+		return w
+	}
+
+	It("should transfer the configured amount", func() {
+		hash, err := f.fund(context.Background(), alice, "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backend.sent).To(HaveLen(1))
+		Expect(backend.sent[0].Hash()).To(Equal(hash))
+		Expect(backend.sent[0].To()).To(Equal(&alice))
+		Expect(backend.sent[0].Value()).To(Equal(cfg.Amount))
+	})
+
+	It("should rate limit the addresses and the IPs", func() {
+		_, err := f.fund(context.Background(), alice, "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.fund(context.Background(), alice, "5.6.7.8")
+		Expect(err).To(MatchError(errFaucetRateLimited))
+		_, err = f.fund(context.Background(), bob, "1.2.3.4")
+		Expect(err).To(MatchError(errFaucetRateLimited))
+
+		// the local clients are only rate limited per address.
+		_, err = f.fund(context.Background(), bob, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backend.sent).To(HaveLen(2))
+	})
+
+	It("should fund an address again after its cooldown", func() {
+		cfg.AddressCooldown, cfg.IPCooldown = 0, 0
+		_, err := f.fund(context.Background(), alice, "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.fund(context.Background(), alice, "1.2.3.4")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject the zero address", func() {
+		_, err := f.fund(context.Background(), common.Address{}, "1.2.3.4")
+		Expect(err).To(MatchError(errFaucetZeroAddress))
+		Expect(post(`{}`, "1.2.3.4:1000", "").Code).To(Equal(http.StatusBadRequest))
+		Expect(backend.sent).To(BeEmpty())
+	})
+
+	It("should serve the HTTP path", func() {
+		Expect(post(`{"address":"`+alice.Hex()+`"}`, "1.2.3.4:1000", "").Code).
+			To(Equal(http.StatusOK))
+		Expect(post(`{"address":"`+bob.Hex()+`"}`, "1.2.3.4:1000", "").Code).
+			To(Equal(http.StatusTooManyRequests))
+		Expect(post(`not json`, "1.2.3.4:1000", "").Code).To(Equal(http.StatusBadRequest))
+
+		r := httptest.NewRequest(http.MethodGet, faucetPath, nil)
+		w := httptest.NewRecorder()
+		f.ServeHTTP(w, r)
+		Expect(w.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should read the client IP of the requests forwarded by a trusted proxy", func() {
+		clientIP := func(remoteAddr, forwardedFor string) string {
+			r := httptest.NewRequest(http.MethodPost, faucetPath, nil)
+			r.RemoteAddr = remoteAddr
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+			return f.clientIP(r)
+		}
+		Expect(clientIP("1.2.3.4:1000", "5.6.7.8")).To(Equal("1.2.3.4"))
+		Expect(clientIP("10.0.0.1:1000", "5.6.7.8")).To(Equal("5.6.7.8"))
+		Expect(clientIP("10.0.0.1:1000", "9.9.9.9, 5.6.7.8, 192.168.1.1")).To(Equal("5.6.7.8"))
+		Expect(clientIP("10.0.0.1:1000", "")).To(Equal("10.0.0.1"))
+		Expect(clientIP("10.0.0.1:1000", "10.0.0.2")).To(Equal("10.0.0.2"))
+
+		Expect(post(`{"address":"`+alice.Hex()+`"}`, "10.0.0.1:1000", "5.6.7.8").Code).
+			To(Equal(http.StatusOK))
+		Expect(post(`{"address":"`+bob.Hex()+`"}`, "10.0.0.1:1000", "9.9.9.9").Code).
+			To(Equal(http.StatusOK))
+	})
+
+	It("should reject an invalid trusted proxy", func() {
+		cfg.TrustedProxies = []string{"not an ip"}
+		_, err := newFaucet(cfg, backend)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+const (
+	// healthPath is the HTTP path that reports whether the node is up, with its sync status.
+	healthPath = "/health"
+	// readyPath is the HTTP path that reports whether the node is synced enough to serve traffic.
+	readyPath = "/ready"
+
+	// syncStatusTimeout is the time given to the host chain to report its sync status.
+	syncStatusTimeout = 5 * time.Second
+)
+
+// HostSyncStatus is the sync status of the host chain, as reported by its consensus engine.
+type HostSyncStatus struct {
+	// CatchingUp is true while the node catches up with the network, rather than following it.
+	CatchingUp bool
+	// EarliestHeight is the height of the earliest block of the node.
+	EarliestHeight uint64
+	// LatestHeight is the height of the latest block committed by the node.
+	LatestHeight uint64
+	// LatestBlockTime is the time of the latest block committed by the node.
+	LatestBlockTime time.Time
+	// IndexedHeight is the height of the latest block whose historical data, such as receipts and
+	// transaction lookups, was indexed.
+	IndexedHeight uint64
+	// PendingIndexBlocks is the number of finalized blocks whose historical data is not indexed
+	// yet, which are being written or backfilled.
+	PendingIndexBlocks uint64
+}
+
+// HostSyncStatusProvider is implemented by the host chains that can report their sync status.
+type HostSyncStatusProvider interface {
+	// SyncStatus returns the current sync status of the host chain.
+	SyncStatus(ctx context.Context) (*HostSyncStatus, error)
+}
+
+// HealthConfig is the config of the readiness of the node, which load balancers check on the
+// `/ready` HTTP path to only route traffic to the synced nodes.
+type HealthConfig struct {
+	// MaxBlockLag is the number of blocks the EVM head may be behind the latest block of the
+	// host chain for the node to be ready.
+	MaxBlockLag uint64 `toml:""`
+
+	// MaxIndexLag is the number of blocks the historical data may be behind the EVM head for the
+	// node to be ready.
+	MaxIndexLag uint64 `toml:""`
+
+	// MaxBlockAge is the age of the latest block above which the node is not ready, as it most
+	// likely stopped receiving blocks. It is not checked if 0, e.g. for chains that do not
+	// produce empty blocks.
+	MaxBlockAge time.Duration `toml:""`
+}
+
+// defaultHealthConfig returns the default config of the readiness of the node.
+func defaultHealthConfig() HealthConfig {
+	return HealthConfig{
+		MaxBlockLag: 1,
+		MaxIndexLag: 100, //nolint:gomnd // a few minutes of blocks.
+	}
+}
+
+// SyncReport is the sync status of the node served on the `/health` and `/ready` HTTP paths.
+type SyncReport struct {
+	// Ready is true if the node is synced enough to serve traffic, otherwise Reasons lists why.
+	Ready   bool     `json:"ready"`
+	Reasons []string `json:"reasons,omitempty"`
+
+	CatchingUp   bool   `json:"catchingUp"`
+	CurrentBlock uint64 `json:"currentBlock"`
+	LatestHeight uint64 `json:"latestHeight"`
+	// BlockLag is the number of blocks of the host chain that the EVM head is behind.
+	BlockLag uint64 `json:"blockLag"`
+	// LastBlockAge is the time since the latest block of the host chain.
+	LastBlockAge string `json:"lastBlockAge,omitempty"`
+
+	IndexedBlock uint64 `json:"indexedBlock"`
+	// IndexLag is the number of blocks that the historical data is behind the EVM head.
+	IndexLag           uint64 `json:"indexLag"`
+	PendingIndexBlocks uint64 `json:"pendingIndexBlocks"`
+}
+
+// syncReport returns the sync status of the node. Without a sync status from the host chain, the
+// node is ready as soon as it has an EVM head.
+func (pl *Polaris) syncReport(ctx context.Context) (*SyncReport, error) {
+	current := pl.blockchain.CurrentBlock()
+	if current == nil {
+		return &SyncReport{Reasons: []string{"no block finalized yet"}}, nil
+	}
+	report := &SyncReport{
+		Ready:        true,
+		CurrentBlock: current.Number.Uint64(),
+		LatestHeight: current.Number.Uint64(),
+		IndexedBlock: current.Number.Uint64(),
+	}
+	if pl.syncStatus == nil {
+		return report, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, syncStatusTimeout)
+	defer cancel()
+	status, err := pl.syncStatus.SyncStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.CatchingUp = status.CatchingUp
+	report.LatestHeight = status.LatestHeight
+	report.IndexedBlock = status.IndexedHeight
+	report.PendingIndexBlocks = status.PendingIndexBlocks
+	if status.LatestHeight > report.CurrentBlock {
+		report.BlockLag = status.LatestHeight - report.CurrentBlock
+	}
+	if report.CurrentBlock > status.IndexedHeight {
+		report.IndexLag = report.CurrentBlock - status.IndexedHeight
+	}
+
+	cfg := &pl.cfg.Health
+	if status.CatchingUp {
+		report.Reasons = append(report.Reasons, "catching up with the network")
+	}
+	if report.BlockLag > cfg.MaxBlockLag {
+		report.Reasons = append(report.Reasons, fmt.Sprintf(
+			"evm head %d blocks behind the latest block", report.BlockLag,
+		))
+	}
+	if report.IndexLag > cfg.MaxIndexLag {
+		report.Reasons = append(report.Reasons, fmt.Sprintf(
+			"historical data %d blocks behind the evm head", report.IndexLag,
+		))
+	}
+	if !status.LatestBlockTime.IsZero() {
+		age := time.Since(status.LatestBlockTime).Truncate(time.Second)
+		report.LastBlockAge = age.String()
+		if cfg.MaxBlockAge != 0 && age > cfg.MaxBlockAge {
+			report.Reasons = append(report.Reasons, "latest block is "+age.String()+" old")
+		}
+	}
+	report.Ready = len(report.Reasons) == 0
+	return report, nil
+}
+
+// syncProgress returns the progress of `eth_syncing`, which reports the node as syncing while the
+// host chain catches up with the network. As the host chain does not know the height of the
+// network until it caught up, the highest block is at least the one after the EVM head.
+func (pl *Polaris) syncProgress() ethereum.SyncProgress {
+	if pl.syncStatus == nil {
+		return ethereum.SyncProgress{}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), syncStatusTimeout)
+	defer cancel()
+	status, err := pl.syncStatus.SyncStatus(ctx)
+	if err != nil || !status.CatchingUp {
+		return ethereum.SyncProgress{}
+	}
+
+	var current uint64
+	if head := pl.blockchain.CurrentBlock(); head != nil {
+		current = head.Number.Uint64()
+	}
+	highest := status.LatestHeight
+	if highest <= current {
+		highest = current + 1
+	}
+	return ethereum.SyncProgress{
+		StartingBlock: status.EarliestHeight,
+		CurrentBlock:  current,
+		HighestBlock:  highest,
+	}
+}
+
+// healthHandler serves the sync status of the node on the `/health` and `/ready` HTTP paths. The
+// health check fails only if the sync status cannot be read, the readiness check also fails while
+// the node is not synced.
+type healthHandler struct {
+	pl *Polaris
+	// ready fails the check if the node is not ready.
+	ready bool
+}
+
+// ServeHTTP implements `http.Handler`.
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "only GET requests are accepted", http.StatusMethodNotAllowed)
+		return
+	}
+	report, err := h.pl.syncReport(r.Context())
+	if err != nil {
+		http.Error(w, "failed to read sync status: "+err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if h.ready && !report.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// headChain is a blockchain whose EVM head is the given header.
+type headChain struct {
+	core.Blockchain
+	head *types.Header
+}
+
+func (bc *headChain) CurrentBlock() *types.Header {
+	return bc.head
+}
+
+// staticSyncStatus is a host chain that reports the given sync status, or error.
+type staticSyncStatus struct {
+	status *HostSyncStatus
+	err    error
+}
+
+func (s *staticSyncStatus) SyncStatus(context.Context) (*HostSyncStatus, error) {
+	return s.status, s.err
+}
+
+var _ = Describe("Health", func() {
+	var (
+		chain  *headChain
+		status *staticSyncStatus
+		pl     *Polaris
+	)
+
+	BeforeEach(func() {
+		chain = &headChain{head: &types.Header{Number: big.NewInt(100)}}
+		status = &staticSyncStatus{status: &HostSyncStatus{
+			EarliestHeight: 10,
+			LatestHeight:   101,
+			IndexedHeight:  100,
+		}}
+		pl = &Polaris{cfg: DefaultConfig(), blockchain: chain, syncStatus: status}
+	})
+
+	// serve returns the status code and the report served by the health handler.
+	serve := func(ready bool, method string) (int, *SyncReport) {
+		rec := httptest.NewRecorder()
+		(&healthHandler{pl: pl, ready: ready}).ServeHTTP(
+			rec, httptest.NewRequest(method, healthPath, nil),
+		)
+		if rec.Code != http.StatusOK && rec.Code != http.StatusServiceUnavailable ||
+			rec.Header().Get("Content-Type") != "application/json" {
+			return rec.Code, nil
+		}
+		report := new(SyncReport)
+		Expect(json.Unmarshal(rec.Body.Bytes(), report)).To(Succeed())
+		return rec.Code, report
+	}
+
+	Context("syncReport", func() {
+		It("should not be ready before the first block", func() {
+			chain.head = nil
+			report, err := pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Ready).To(BeFalse())
+			Expect(report.Reasons).To(ConsistOf("no block finalized yet"))
+		})
+
+		It("should be ready with an EVM head if the host chain has no sync status", func() {
+			pl.syncStatus = nil
+			report, err := pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report).To(Equal(&SyncReport{
+				Ready:        true,
+				CurrentBlock: 100,
+				LatestHeight: 100,
+				IndexedBlock: 100,
+			}))
+		})
+
+		It("should be ready within the lags of the config", func() {
+			status.status.PendingIndexBlocks = 2
+			report, err := pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Ready).To(BeTrue())
+			Expect(report.Reasons).To(BeEmpty())
+			Expect(report.BlockLag).To(Equal(uint64(1)))
+			Expect(report.IndexLag).To(BeZero())
+			Expect(report.PendingIndexBlocks).To(Equal(uint64(2)))
+		})
+
+		It("should list every reason the node is not ready", func() {
+			status.status.CatchingUp = true
+			status.status.LatestHeight = 110
+			status.status.IndexedHeight = 0
+			status.status.LatestBlockTime = time.Now().Add(-time.Hour)
+			pl.cfg.Health.MaxBlockAge = time.Minute
+
+			report, err := pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Ready).To(BeFalse())
+			Expect(report.BlockLag).To(Equal(uint64(10)))
+			Expect(report.IndexLag).To(Equal(uint64(100)))
+			Expect(report.LastBlockAge).To(Equal("1h0m0s"))
+			Expect(report.Reasons).To(ConsistOf(
+				"catching up with the network",
+				"evm head 10 blocks behind the latest block",
+				"latest block is 1h0m0s old",
+			))
+
+			pl.cfg.Health.MaxIndexLag = 99
+			report, err = pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Reasons).To(ContainElement("historical data 100 blocks behind the evm head"))
+		})
+
+		It("should not check the age of the latest block if disabled", func() {
+			status.status.LatestBlockTime = time.Now().Add(-time.Hour)
+			report, err := pl.syncReport(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Ready).To(BeTrue())
+			Expect(report.LastBlockAge).To(Equal("1h0m0s"))
+		})
+
+		It("should return the errors of the host chain", func() {
+			status.err = errors.New("node is down")
+			_, err := pl.syncReport(context.Background())
+			Expect(err).To(MatchError("node is down"))
+		})
+	})
+
+	Context("syncProgress", func() {
+		It("should not be syncing if the host chain is not catching up", func() {
+			Expect(pl.syncProgress()).To(BeZero())
+			status.status.CatchingUp = true
+			status.err = errors.New("node is down")
+			Expect(pl.syncProgress()).To(BeZero())
+			pl.syncStatus = nil
+			Expect(pl.syncProgress()).To(BeZero())
+		})
+
+		It("should report the progress while the host chain catches up", func() {
+			status.status.CatchingUp = true
+			progress := pl.syncProgress()
+			Expect(progress.StartingBlock).To(Equal(uint64(10)))
+			Expect(progress.CurrentBlock).To(Equal(uint64(100)))
+			Expect(progress.HighestBlock).To(Equal(uint64(101)))
+		})
+
+		It("should report a highest block after the EVM head", func() {
+			status.status.CatchingUp = true
+			status.status.LatestHeight = 50
+			Expect(pl.syncProgress().HighestBlock).To(Equal(uint64(101)))
+
+			chain.head = nil
+			progress := pl.syncProgress()
+			Expect(progress.CurrentBlock).To(BeZero())
+			Expect(progress.HighestBlock).To(Equal(uint64(50)))
+		})
+	})
+
+	Context("healthHandler", func() {
+		It("should serve the sync report", func() {
+			code, report := serve(false, http.MethodGet)
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(report.Ready).To(BeTrue())
+			Expect(report.LatestHeight).To(Equal(uint64(101)))
+		})
+
+		It("should only fail the readiness check if the node is not synced", func() {
+			status.status.CatchingUp = true
+			code, report := serve(false, http.MethodGet)
+			Expect(code).To(Equal(http.StatusOK))
+			Expect(report.Ready).To(BeFalse())
+
+			code, report = serve(true, http.MethodGet)
+			Expect(code).To(Equal(http.StatusServiceUnavailable))
+			Expect(report.Reasons).To(ConsistOf("catching up with the network"))
+		})
+
+		It("should fail both checks if the sync status cannot be read", func() {
+			status.err = errors.New("node is down")
+			for _, ready := range []bool{false, true} {
+				code, report := serve(ready, http.MethodGet)
+				Expect(code).To(Equal(http.StatusServiceUnavailable))
+				Expect(report).To(BeNil())
+			}
+		})
+
+		It("should only accept GET and HEAD requests", func() {
+			code, _ := serve(true, http.MethodHead)
+			Expect(code).To(Equal(http.StatusOK))
+			code, _ = serve(true, http.MethodPost)
+			Expect(code).To(Equal(http.StatusMethodNotAllowed))
+		})
+	})
+})
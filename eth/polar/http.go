@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/node"
+	"golang.org/x/net/netutil"
+
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// shutdownTimeout is the time given to the in-flight HTTP requests to complete on stop, the one of
+// the servers of go-ethereum.
+const shutdownTimeout = 5 * time.Second
+
+// httpServerConfig is the configuration of an `httpServer`.
+type httpServerConfig struct {
+	// name identifies the server in the logs.
+	name     string
+	endpoint string
+	// modules are the API namespaces served.
+	modules []string
+	cors    []string
+	vhosts  []string
+	// jwtSecret is the secret that the requests must be authenticated with, if any.
+	jwtSecret []byte
+
+	// ws serves the APIs of the WebSocket modules to the WebSocket requests from the origins.
+	ws        bool
+	wsModules []string
+	wsOrigins []string
+	// wsOnly does not serve the HTTP requests.
+	wsOnly bool
+
+	timeouts       rpc.HTTPTimeouts
+	tlsCertFile    string
+	tlsKeyFile     string
+	maxConnections int
+}
+
+// httpServer serves JSON-RPC APIs over HTTP and WebSocket in place of the servers of the
+// go-ethereum node, which support neither TLS, connection limits, monitoring, nor authenticating
+// namespaces other than `eth` and `engine`. It is started and stopped with the node as one of its
+// lifecycles.
+type httpServer struct {
+	cfg httpServerConfig
+	// apis are the APIs to serve, which are filtered by the modules of the config on start.
+	apis []rpc.API
+	// rpcServer returns the RPC server to serve, which is built from the APIs by default.
+	rpcServer func() (*rpc.Server, error)
+	// monitor tracks the WebSocket connections and logs the slow calls, it is nil if disabled.
+	monitor *rpcMonitor
+	// mux routes the requests to the RPC server or to the handlers registered by path.
+	mux *http.ServeMux
+	srv *http.Server
+	// wsServer serves the WebSocket connections, which are not closed with the HTTP server.
+	wsServer *rpc.Server
+}
+
+// newHTTPServer creates a new HTTP server with the given config, monitored by the given monitor.
+func newHTTPServer(cfg httpServerConfig, monitor *rpcMonitor) *httpServer {
+	s := &httpServer{
+		cfg:     cfg,
+		mux:     http.NewServeMux(),
+		monitor: monitor,
+	}
+	s.rpcServer = func() (*rpc.Server, error) {
+		return s.apisServer(s.cfg.modules)
+	}
+	return s
+}
+
+// registerAPIs adds the given APIs to the APIs served.
+func (s *httpServer) registerAPIs(apis []rpc.API) {
+	s.apis = append(s.apis, apis...)
+}
+
+// registerHandler serves the given handler on the given path.
+func (s *httpServer) registerHandler(path string, handler http.Handler) {
+	s.mux.Handle(path, s.wrap(handler))
+}
+
+// Start starts serving the APIs, over TLS if a certificate is configured.
+//
+// Start implements `node.Lifecycle`.
+func (s *httpServer) Start() error {
+	handler, err := s.handler()
+	if err != nil {
+		return err
+	}
+	s.mux.Handle("/", handler)
+
+	listener, err := net.Listen("tcp", s.cfg.endpoint)
+	if err != nil {
+		return err
+	}
+	if s.cfg.maxConnections > 0 {
+		listener = netutil.LimitListener(listener, s.cfg.maxConnections)
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.mux,
+		ReadTimeout:       s.cfg.timeouts.ReadTimeout,
+		ReadHeaderTimeout: s.cfg.timeouts.ReadHeaderTimeout,
+		WriteTimeout:      s.cfg.timeouts.WriteTimeout,
+		IdleTimeout:       s.cfg.timeouts.IdleTimeout,
+	}
+	go func() {
+		var serveErr error
+		if s.cfg.tlsCertFile != "" {
+			serveErr = s.srv.ServeTLS(listener, s.cfg.tlsCertFile, s.cfg.tlsKeyFile)
+		} else {
+			serveErr = s.srv.Serve(listener)
+		}
+		if !errors.Is(serveErr, http.ErrServerClosed) {
+			log.Root().Error("polaris http server failed", "name", s.cfg.name, "err", serveErr)
+		}
+	}()
+	log.Root().Info(
+		"polaris http server started", "name", s.cfg.name, "endpoint", listener.Addr(),
+		"tls", s.cfg.tlsCertFile != "", "auth", s.cfg.jwtSecret != nil,
+		"max_connections", s.cfg.maxConnections, "ws", s.cfg.ws,
+	)
+	return nil
+}
+
+// handler returns the handler of the RPC servers, which routes the WebSocket requests to the
+// WebSocket one if served.
+func (s *httpServer) handler() (http.Handler, error) {
+	var (
+		httpHandler = http.NotFoundHandler()
+		err         error
+	)
+	if !s.cfg.wsOnly {
+		var server *rpc.Server
+		server, err = s.rpcServer()
+		if err != nil {
+			return nil, err
+		}
+		httpHandler = s.wrap(s.monitor.httpHandler(server))
+	}
+	if !s.cfg.ws {
+		return httpHandler, nil
+	}
+
+	if s.wsServer, err = s.apisServer(s.cfg.wsModules); err != nil {
+		return nil, err
+	}
+	wsHandler := node.NewWSHandlerStack(
+		s.monitor.wsHandler(s.wsServer, s.cfg.wsOrigins), s.cfg.jwtSecret,
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	}), nil
+}
+
+// apisServer returns an RPC server with the APIs of the given modules.
+func (s *httpServer) apisServer(modules []string) (*rpc.Server, error) {
+	server := rpc.NewServer()
+	if err := node.RegisterApis(s.apis, modules, server); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// Stop stops the server. It stops accepting connections and waits for the in-flight HTTP requests
+// up to the shutdown timeout, then sends a close message to the tracked WebSocket connections
+// before closing them, which ends their subscriptions.
+//
+// Stop implements `node.Lifecycle`.
+func (s *httpServer) Stop() error {
+	if s.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := s.srv.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Root().Warn("polaris http server requests timed out", "name", s.cfg.name)
+		err = s.srv.Close()
+	}
+
+	s.monitor.closeConnections()
+	if s.wsServer != nil {
+		s.wsServer.Stop()
+	}
+	return err
+}
+
+// wrap applies the CORS, virtual hosts and JWT checks of the config to the given handler.
+func (s *httpServer) wrap(handler http.Handler) http.Handler {
+	return node.NewHTTPHandlerStack(handler, s.cfg.cors, s.cfg.vhosts, s.cfg.jwtSecret)
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/eth/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// echoAPI is a namespace of the test RPC servers.
+type echoAPI struct{}
+
+func (echoAPI) Echo(s string) string {
+	return s
+}
+
+// Ticks opens a subscription which never sends notifications.
+func (echoAPI) Ticks(ctx context.Context) (*gethrpc.Subscription, error) {
+	notifier, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	return notifier.CreateSubscription(), nil
+}
+
+// echoCall is a call of `test_echo`.
+const echoCall = `{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`
+
+// freeEndpoint returns a local endpoint that is free to listen on.
+func freeEndpoint() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+// startHTTPServer starts an HTTP server with the given config, serving the `test` namespace, and
+// stops it at the end of the spec.
+func startHTTPServer(cfg httpServerConfig, monitor *rpcMonitor) *httpServer {
+	if cfg.endpoint == "" {
+		cfg.endpoint = freeEndpoint()
+	}
+	s := newHTTPServer(cfg, monitor)
+	s.registerAPIs([]rpc.API{
+		{Namespace: "test", Service: echoAPI{}},
+		{Namespace: "hidden", Service: echoAPI{}},
+	})
+	Expect(s.Start()).To(Succeed())
+	DeferCleanup(s.Stop)
+	return s
+}
+
+// postRPC posts the given JSON-RPC request to the given URL with the given host and origin.
+func postRPC(client *http.Client, url, host, body string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("Content-Type", "application/json")
+	if host != "" {
+		req.Host = host
+	}
+	return client.Do(req)
+}
+
+var _ = Describe("HTTP server", func() {
+	It("should only serve the APIs of its modules", func() {
+		s := startHTTPServer(httpServerConfig{modules: []string{"test"}, vhosts: []string{"*"}}, nil)
+
+		client, err := rpc.DialContext(context.Background(), "http://"+s.cfg.endpoint)
+		Expect(err).ToNot(HaveOccurred())
+		defer client.Close()
+		var res string
+		Expect(client.Call(&res, "test_echo", "hello")).To(Succeed())
+		Expect(res).To(Equal("hello"))
+		Expect(client.Call(&res, "hidden_echo", "hello")).ToNot(Succeed())
+	})
+
+	It("should check the virtual host and the origin of the requests", func() {
+		s := startHTTPServer(httpServerConfig{
+			modules: []string{"test"},
+			vhosts:  []string{"localhost"},
+			cors:    []string{"https://app.example"},
+		}, nil)
+		url := "http://" + s.cfg.endpoint
+
+		res, err := postRPC(http.DefaultClient, url, "evil.example", echoCall)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusForbidden))
+		res, err = postRPC(http.DefaultClient, url, "localhost", echoCall)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		req, err := http.NewRequest(http.MethodOptions, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Host = "localhost"
+		req.Header.Set("Origin", "https://app.example")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		res, err = http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.Header.Get("Access-Control-Allow-Origin")).To(Equal("https://app.example"))
+	})
+
+	It("should serve the registered handlers", func() {
+		s := newHTTPServer(httpServerConfig{endpoint: freeEndpoint(), vhosts: []string{"*"}}, nil)
+		s.registerHandler("/handler", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		}))
+		Expect(s.Start()).To(Succeed())
+		defer s.Stop()
+
+		res, err := http.Get("http://" + s.cfg.endpoint + "/handler")
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusTeapot))
+	})
+
+	It("should limit the number of connections", func() {
+		s := startHTTPServer(httpServerConfig{
+			modules: []string{"test"}, vhosts: []string{"*"}, maxConnections: 1,
+		}, nil)
+		url := "http://" + s.cfg.endpoint
+
+		// an idle connection holds the only slot.
+		conn, err := net.Dial("tcp", s.cfg.endpoint)
+		Expect(err).ToNot(HaveOccurred())
+		client := &http.Client{Timeout: 200 * time.Millisecond}
+		_, err = postRPC(client, url, "", echoCall)
+		Expect(err).To(HaveOccurred())
+
+		Expect(conn.Close()).To(Succeed())
+		Eventually(func() error {
+			res, err := postRPC(client, url, "", echoCall)
+			if err == nil {
+				res.Body.Close()
+			}
+			return err
+		}).Should(Succeed())
+	})
+
+	It("should serve the APIs over TLS", func() {
+		certFile, keyFile := writeSelfSignedCert(GinkgoT().TempDir())
+		s := startHTTPServer(httpServerConfig{
+			modules:     []string{"test"},
+			vhosts:      []string{"*"},
+			tlsCertFile: certFile,
+			tlsKeyFile:  keyFile,
+		}, nil)
+
+		res, err := postRPC(http.DefaultClient, "http://"+s.cfg.endpoint, "", echoCall)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //#nosec: G402 // test cert.
+		}}
+		res, err = postRPC(client, "https://"+s.cfg.endpoint, "", echoCall)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should stop without having started", func() {
+		Expect(newHTTPServer(httpServerConfig{}, nil).Stop()).To(Succeed())
+	})
+})
+
+// writeSelfSignedCert writes a self-signed certificate for localhost and its key to the given
+// directory, and returns their paths.
+func writeSelfSignedCert(dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	Expect(os.WriteFile(
+		certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600,
+	)).To(Succeed())
+	Expect(os.WriteFile(
+		keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600,
+	)).To(Succeed())
+	return certFile, keyFile
+}
@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+const (
+	// localTxPending is the status of a local transaction not included yet, which is rebroadcast.
+	localTxPending = "pending"
+	// localTxIncluded is the status of a local transaction included in a block.
+	localTxIncluded = "included"
+	// localTxReplaced is the status of a local transaction whose nonce was used by another one.
+	localTxReplaced = "replaced"
+	// localTxExpired is the status of a local transaction not included within the lifetime.
+	localTxExpired = "expired"
+)
+
+// RebroadcastConfig is the config of the rebroadcast of the transactions submitted over rpc, as
+// the mempool gossip of the host chain sometimes drops transactions under churn.
+type RebroadcastConfig struct {
+	// Interval is the time between two broadcasts of a pending local transaction. The local
+	// transactions are not tracked if it is 0.
+	Interval time.Duration `toml:""`
+
+	// Lifetime is the time after its submission during which a local transaction not included
+	// is rebroadcast. The status of a local transaction is served for twice the lifetime.
+	Lifetime time.Duration `toml:""`
+
+	// MaxTxs is the maximum number of tracked local transactions, above which the oldest ones are
+	// no longer tracked.
+	MaxTxs int `toml:""`
+}
+
+// defaultRebroadcastConfig returns the default config of the rebroadcast of local transactions.
+func defaultRebroadcastConfig() RebroadcastConfig {
+	return RebroadcastConfig{
+		Interval: time.Minute,
+		Lifetime: 3 * time.Hour, //nolint:gomnd // the lifetime of the txpool of go-ethereum.
+		MaxTxs:   4096,          //nolint:gomnd // the local slots of the txpool of go-ethereum.
+	}
+}
+
+// LocalTxStatus is the status of a transaction submitted to the node over rpc, served by
+// `txpool_localStatus`.
+type LocalTxStatus struct {
+	Hash  common.Hash    `json:"hash"`
+	From  common.Address `json:"from"`
+	Nonce hexutil.Uint64 `json:"nonce"`
+	// Status is `pending` until the transaction is included, or its nonce is used by another
+	// transaction (`replaced`), or it is not included within the lifetime (`expired`).
+	Status      string          `json:"status"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"`
+	SubmittedAt time.Time       `json:"submittedAt"`
+	// Broadcasts is the number of times the transaction was sent, the submission included.
+	Broadcasts      int       `json:"broadcasts"`
+	LastBroadcastAt time.Time `json:"lastBroadcastAt"`
+	LastError       string    `json:"lastError,omitempty"`
+}
+
+// localTxs tracks the transactions submitted to the node over rpc, and rebroadcasts them until
+// they are included or expire.
+type localTxs struct {
+	cfg *RebroadcastConfig
+	pl  *Polaris
+
+	mu  sync.Mutex
+	txs map[common.Hash]*LocalTxStatus
+	// byTx are the tracked transactions, to be rebroadcast.
+	byTx map[common.Hash]*types.Transaction
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// newLocalTxs creates a new tracker of the local transactions of the given Polaris instance.
+func newLocalTxs(cfg *RebroadcastConfig, pl *Polaris) *localTxs {
+	return &localTxs{
+		cfg:  cfg,
+		pl:   pl,
+		txs:  make(map[common.Hash]*LocalTxStatus),
+		byTx: make(map[common.Hash]*types.Transaction),
+		quit: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// track starts tracking the given transaction, which was just broadcast.
+func (lt *localTxs) track(tx *types.Transaction) {
+	signer := types.LatestSignerForChainID(tx.ChainId())
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	if _, ok := lt.txs[tx.Hash()]; ok {
+		return
+	}
+	if len(lt.txs) >= lt.cfg.MaxTxs {
+		lt.evictOldest()
+	}
+	lt.txs[tx.Hash()] = &LocalTxStatus{
+		Hash:            tx.Hash(),
+		From:            from,
+		Nonce:           hexutil.Uint64(tx.Nonce()),
+		Status:          localTxPending,
+		SubmittedAt:     now,
+		Broadcasts:      1,
+		LastBroadcastAt: now,
+	}
+	lt.byTx[tx.Hash()] = tx
+}
+
+// evictOldest stops tracking the oldest local transaction. It must be called with the lock held.
+func (lt *localTxs) evictOldest() {
+	var oldest *LocalTxStatus
+	for _, status := range lt.txs {
+		if oldest == nil || status.SubmittedAt.Before(oldest.SubmittedAt) {
+			oldest = status
+		}
+	}
+	if oldest != nil {
+		delete(lt.txs, oldest.Hash)
+		delete(lt.byTx, oldest.Hash)
+	}
+}
+
+// start starts rebroadcasting the pending local transactions every interval.
+func (lt *localTxs) start() {
+	go func() {
+		defer close(lt.done)
+		ticker := time.NewTicker(lt.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				lt.update()
+			case <-lt.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops rebroadcasting the local transactions.
+func (lt *localTxs) stop() {
+	close(lt.quit)
+	<-lt.done
+}
+
+// update updates the status of the tracked transactions, rebroadcasts the pending ones and stops
+// tracking the ones older than twice the lifetime.
+func (lt *localTxs) update() {
+	state, _, err := lt.pl.backend.StateAndHeaderByNumber(
+		context.Background(), rpc.LatestBlockNumber,
+	)
+	if err != nil {
+		log.Root().Debug("failed to read state of local transactions", "err", err)
+		return
+	}
+
+	lt.mu.Lock()
+	var (
+		now     = time.Now()
+		pending []*types.Transaction
+	)
+	for hash, status := range lt.txs {
+		switch {
+		case now.Sub(status.SubmittedAt) > 2*lt.cfg.Lifetime:
+			delete(lt.txs, hash)
+			delete(lt.byTx, hash)
+			continue
+		case status.Status != localTxPending:
+			continue
+		}
+
+		if lookup := lt.pl.blockchain.GetTransactionLookup(hash); lookup != nil {
+			blockNum := hexutil.Uint64(lookup.BlockNum)
+			status.Status, status.BlockNumber = localTxIncluded, &blockNum
+		} else if state.GetNonce(status.From) > uint64(status.Nonce) {
+			status.Status = localTxReplaced
+		} else if now.Sub(status.SubmittedAt) > lt.cfg.Lifetime {
+			status.Status = localTxExpired
+		} else if now.Sub(status.LastBroadcastAt) >= lt.cfg.Interval {
+			pending = append(pending, lt.byTx[hash])
+		}
+		if status.Status != localTxPending {
+			delete(lt.byTx, hash)
+		}
+	}
+	lt.mu.Unlock()
+
+	// The transactions are sent without the lock, as forwarding them to sentries takes time.
+	for _, tx := range pending {
+		err := lt.pl.sendTx(context.Background(), tx)
+		lt.mu.Lock()
+		if status, ok := lt.txs[tx.Hash()]; ok {
+			status.Broadcasts++
+			status.LastBroadcastAt = time.Now()
+			status.LastError = ""
+			if err != nil {
+				status.LastError = err.Error()
+			}
+		}
+		lt.mu.Unlock()
+	}
+	if len(pending) > 0 {
+		log.Root().Debug("rebroadcast local transactions", "count", len(pending))
+	}
+}
+
+// statuses returns the status of the tracked transactions, oldest first.
+func (lt *localTxs) statuses() []*LocalTxStatus {
+	lt.mu.Lock()
+	statuses := make([]*LocalTxStatus, 0, len(lt.txs))
+	for _, status := range lt.txs {
+		cpy := *status
+		statuses = append(statuses, &cpy)
+	}
+	lt.mu.Unlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].SubmittedAt.Before(statuses[j].SubmittedAt)
+	})
+	return statuses
+}
+
+// localTxsAPI serves the status of the local transactions in the `txpool` namespace.
+type localTxsAPI struct {
+	lt *localTxs
+}
+
+// LocalStatus returns the status of the transactions submitted to the node over rpc within the
+// rebroadcast lifetime, oldest first.
+func (api *localTxsAPI) LocalStatus() []*LocalTxStatus {
+	return api.lt.statuses()
+}
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/eth/crypto"
+	"pkg.berachain.dev/polaris/eth/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// localTxsChain is a blockchain that includes the given transactions and records the ones sent.
+type localTxsChain struct {
+	core.Blockchain
+	included map[common.Hash]uint64
+	sendErr  error
+
+	mu   sync.Mutex
+	sent []*types.Transaction
+}
+
+func (bc *localTxsChain) GetTransactionLookup(hash common.Hash) *types.TxLookupEntry {
+	if num, ok := bc.included[hash]; ok {
+		return &types.TxLookupEntry{BlockNum: num}
+	}
+	return nil
+}
+
+func (bc *localTxsChain) SendTx(_ context.Context, tx *types.Transaction) error {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.sent = append(bc.sent, tx)
+	return bc.sendErr
+}
+
+// nonceState is a state whose accounts have the given nonces.
+type nonceState struct {
+	vm.GethStateDB
+	nonces map[common.Address]uint64
+}
+
+func (s *nonceState) GetNonce(addr common.Address) uint64 {
+	return s.nonces[addr]
+}
+
+// stateBackend is a backend whose latest state is the given one, or fails with the given error.
+type stateBackend struct {
+	Backend
+	state vm.GethStateDB
+	err   error
+}
+
+func (b *stateBackend) StateAndHeaderByNumber(
+	context.Context, rpc.BlockNumber,
+) (vm.GethStateDB, *types.Header, error) {
+	return b.state, nil, b.err
+}
+
+var _ = Describe("Local transactions", func() {
+	var (
+		chain   *localTxsChain
+		state   *nonceState
+		backend *stateBackend
+		cfg     RebroadcastConfig
+		lt      *localTxs
+		from    common.Address
+		newTx   func(nonce uint64) *types.Transaction
+	)
+
+	BeforeEach(func() {
+		key, err := crypto.GenerateEthKey()
+		Expect(err).ToNot(HaveOccurred())
+		from = crypto.PubkeyToAddress(key.PublicKey)
+		signer := types.LatestSignerForChainID(big.NewInt(1))
+		newTx = func(nonce uint64) *types.Transaction {
+			return types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+				ChainID: big.NewInt(1), Nonce: nonce, Gas: 21000,
+			})
+		}
+
+		chain = &localTxsChain{included: make(map[common.Hash]uint64)}
+		state = &nonceState{nonces: make(map[common.Address]uint64)}
+		backend = &stateBackend{state: state}
+		cfg = RebroadcastConfig{Interval: time.Minute, Lifetime: time.Hour, MaxTxs: 2}
+		lt = newLocalTxs(&cfg, &Polaris{blockchain: chain, backend: backend})
+	})
+
+	// age moves the submission and the last broadcast of the given transaction back in time.
+	age := func(tx *types.Transaction, d time.Duration) {
+		lt.mu.Lock()
+		defer lt.mu.Unlock()
+		lt.txs[tx.Hash()].SubmittedAt = lt.txs[tx.Hash()].SubmittedAt.Add(-d)
+		lt.txs[tx.Hash()].LastBroadcastAt = lt.txs[tx.Hash()].LastBroadcastAt.Add(-d)
+	}
+
+	It("should track the signed transactions once", func() {
+		tx := newTx(0)
+		lt.track(tx)
+		lt.track(tx)
+		lt.track(types.NewTx(&types.LegacyTx{}))
+
+		statuses := lt.statuses()
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].Hash).To(Equal(tx.Hash()))
+		Expect(statuses[0].From).To(Equal(from))
+		Expect(statuses[0].Nonce).To(Equal(hexutil.Uint64(0)))
+		Expect(statuses[0].Status).To(Equal(localTxPending))
+		Expect(statuses[0].Broadcasts).To(Equal(1))
+	})
+
+	It("should stop tracking the oldest transactions above the limit", func() {
+		txs := []*types.Transaction{newTx(0), newTx(1), newTx(2)}
+		for i, tx := range txs {
+			lt.track(tx)
+			age(tx, time.Duration(len(txs)-i)*time.Second)
+		}
+
+		statuses := lt.statuses()
+		Expect(statuses).To(HaveLen(2))
+		Expect(statuses[0].Hash).To(Equal(txs[1].Hash()))
+		Expect(statuses[1].Hash).To(Equal(txs[2].Hash()))
+		Expect(lt.byTx).ToNot(HaveKey(txs[0].Hash()))
+	})
+
+	It("should return copies of the statuses", func() {
+		lt.track(newTx(0))
+		lt.statuses()[0].Status = localTxExpired
+		Expect(lt.statuses()[0].Status).To(Equal(localTxPending))
+	})
+
+	Context("update", func() {
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			tx = newTx(0)
+			lt.track(tx)
+		})
+
+		It("should not rebroadcast before the interval", func() {
+			lt.update()
+			Expect(chain.sent).To(BeEmpty())
+			Expect(lt.statuses()[0].Status).To(Equal(localTxPending))
+		})
+
+		It("should rebroadcast the pending transactions", func() {
+			age(tx, cfg.Interval)
+			lt.update()
+			Expect(chain.sent).To(ConsistOf(tx))
+			Expect(lt.statuses()[0].Broadcasts).To(Equal(2))
+			Expect(lt.statuses()[0].LastError).To(BeEmpty())
+
+			chain.sendErr = errors.New("mempool is full")
+			age(tx, cfg.Interval)
+			lt.update()
+			Expect(chain.sent).To(HaveLen(2))
+			Expect(lt.statuses()[0].Broadcasts).To(Equal(3))
+			Expect(lt.statuses()[0].LastError).To(Equal("mempool is full"))
+		})
+
+		It("should mark the included transactions", func() {
+			chain.included[tx.Hash()] = 7
+			age(tx, cfg.Interval)
+			lt.update()
+			Expect(chain.sent).To(BeEmpty())
+			Expect(lt.statuses()[0].Status).To(Equal(localTxIncluded))
+			Expect(*lt.statuses()[0].BlockNumber).To(Equal(hexutil.Uint64(7)))
+			Expect(lt.byTx).To(BeEmpty())
+		})
+
+		It("should mark the transactions whose nonce was used", func() {
+			state.nonces[from] = 1
+			age(tx, cfg.Interval)
+			lt.update()
+			Expect(chain.sent).To(BeEmpty())
+			Expect(lt.statuses()[0].Status).To(Equal(localTxReplaced))
+		})
+
+		It("should mark the transactions not included within the lifetime", func() {
+			age(tx, cfg.Lifetime+time.Second)
+			lt.update()
+			Expect(chain.sent).To(BeEmpty())
+			Expect(lt.statuses()[0].Status).To(Equal(localTxExpired))
+		})
+
+		It("should stop tracking the transactions after twice the lifetime", func() {
+			age(tx, 2*cfg.Lifetime+time.Second)
+			lt.update()
+			Expect(lt.statuses()).To(BeEmpty())
+			Expect(lt.byTx).To(BeEmpty())
+		})
+
+		It("should not update the statuses without the latest state", func() {
+			backend.err = errors.New("no state")
+			chain.included[tx.Hash()] = 7
+			lt.update()
+			Expect(lt.statuses()[0].Status).To(Equal(localTxPending))
+		})
+	})
+
+	It("should rebroadcast every interval until stopped", func() {
+		cfg.Interval = 10 * time.Millisecond
+		tx := newTx(0)
+		lt.track(tx)
+		lt.start()
+		Eventually(func() int {
+			return lt.statuses()[0].Broadcasts
+		}).Should(BeNumerically(">", 1))
+		lt.stop()
+		Expect(lt.done).To(BeClosed())
+	})
+
+	It("should serve the statuses in the txpool namespace", func() {
+		tx := newTx(0)
+		lt.track(tx)
+		statuses := (&localTxsAPI{lt: lt}).LocalStatus()
+		Expect(statuses).To(HaveLen(1))
+		Expect(statuses[0].Hash).To(Equal(tx.Hash()))
+	})
+})
@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/params"
+)
+
+const (
+	// logsChunkBlocks is the number of blocks whose logs are filtered at once, a bloom bits
+	// section, so that a query stops early once it has too many results.
+	logsChunkBlocks = params.BloomBitsBlocks
+	// logsCursorLength is the length of a cursor: the block number and the index in the block of
+	// the next log to return.
+	logsCursorLength = 16
+	// defaultLogsPageSize is the number of logs of a page when the results are not limited.
+	defaultLogsPageSize = 10000
+)
+
+var (
+	// errInvalidBlockRange is returned for the queries whose first block is after the last one,
+	// as by go-ethereum.
+	errInvalidBlockRange = errors.New("invalid block range params")
+	// errInvalidLogsCursor is returned for the cursors that were not returned for the query.
+	errInvalidLogsCursor = errors.New("invalid logs cursor")
+	// errLogsCursorWithBlockHash is returned for the paginated queries of a single block.
+	errLogsCursorWithBlockHash = errors.New("cursor cannot be used with blockHash")
+)
+
+// LogsConfig is the config of the limits of the log queries, which protect the node from the
+// unbounded queries of explorers and indexers. A limit of 0 disables it.
+type LogsConfig struct {
+	// MaxBlockRange is the maximum number of blocks of an `eth_getLogs` query, and the number of
+	// blocks scanned for a page of `polaris_getLogsPage`.
+	MaxBlockRange uint64 `toml:""`
+
+	// MaxResults is the maximum number of logs returned by `eth_getLogs`, above which the query is
+	// rejected, and the number of logs of a page of `polaris_getLogsPage`.
+	MaxResults int `toml:""`
+
+	// MaxTopics is the maximum number of topics of a query, summed over its positions.
+	MaxTopics int `toml:""`
+}
+
+// defaultLogsConfig returns the default limits of the log queries.
+func defaultLogsConfig() LogsConfig {
+	return LogsConfig{
+		MaxBlockRange: 10000, //nolint:gomnd // the range limit of the common rpc providers.
+		MaxResults:    10000, //nolint:gomnd // the result limit of the common rpc providers.
+		MaxTopics:     100,   //nolint:gomnd // far above the needs of the event filters.
+	}
+}
+
+// LogsPage is a page of the logs of a query, served by `polaris_getLogsPage`.
+type LogsPage struct {
+	Logs []*types.Log `json:"logs"`
+	// Cursor is passed with the same query to get the next page, or is null after the last page.
+	// A page is cut after the logs of MaxBlockRange blocks, so it may be empty and have a cursor.
+	Cursor *hexutil.Bytes `json:"cursor"`
+}
+
+// logPosition is the position of a log in the chain, the start of the next page of a query.
+type logPosition struct {
+	block uint64
+	index uint
+}
+
+// logsQuerier filters the logs of the chain within the limits of the config.
+type logsQuerier struct {
+	cfg    *LogsConfig
+	chain  core.ChainBlockReader
+	system *filters.FilterSystem
+}
+
+// blockLogs returns the logs of the block of the query, which must not exceed the results limit.
+func (lq *logsQuerier) blockLogs(
+	ctx context.Context, crit *filters.FilterCriteria,
+) ([]*types.Log, error) {
+	logs, err := lq.system.NewBlockFilter(*crit.BlockHash, crit.Addresses, crit.Topics).Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if lq.cfg.MaxResults > 0 && len(logs) > lq.cfg.MaxResults {
+		return nil, lq.tooManyResults()
+	}
+	if logs == nil {
+		return []*types.Log{}, nil
+	}
+	return logs, nil
+}
+
+// checkTopics rejects the queries with more topics than the limit.
+func (lq *logsQuerier) checkTopics(crit *filters.FilterCriteria) error {
+	if lq.cfg.MaxTopics <= 0 {
+		return nil
+	}
+	var topics int
+	for _, position := range crit.Topics {
+		topics += len(position)
+	}
+	if topics > lq.cfg.MaxTopics {
+		return &limitExceededError{
+			fmt.Sprintf("query has %d topics, exceeding the limit of %d", topics, lq.cfg.MaxTopics),
+		}
+	}
+	return nil
+}
+
+// blockRange returns the first and last blocks of the query, the latest block by default.
+func (lq *logsQuerier) blockRange(crit *filters.FilterCriteria) (uint64, uint64, error) {
+	head := lq.chain.CurrentBlock()
+	if head == nil {
+		return 0, 0, errors.New("no current block")
+	}
+	resolve := func(number *big.Int) uint64 {
+		// The pending, latest, safe and finalized blocks are the current block, as blocks are
+		// final once committed by the host chain.
+		if number == nil || number.Sign() < 0 {
+			return head.Number.Uint64()
+		}
+		return number.Uint64()
+	}
+	begin, end := resolve(crit.FromBlock), resolve(crit.ToBlock)
+	// The blocks after the current one have no logs yet.
+	if end > head.Number.Uint64() {
+		end = head.Number.Uint64()
+	}
+	if begin > end {
+		return 0, 0, errInvalidBlockRange
+	}
+	return begin, end, nil
+}
+
+// scan returns the logs of the query from the given position to the end block, up to the given
+// number of logs (unlimited if negative), and the position of the next log if there are more.
+func (lq *logsQuerier) scan(
+	ctx context.Context, crit *filters.FilterCriteria, from logPosition, end uint64, limit int,
+) ([]*types.Log, *logPosition, error) {
+	logs := []*types.Log{}
+	for start := from.block; ; start += logsChunkBlocks {
+		last := end
+		if end-start >= logsChunkBlocks {
+			last = start + logsChunkBlocks - 1
+		}
+		filter := lq.system.NewRangeFilter(int64(start), int64(last), crit.Addresses, crit.Topics)
+		chunk, err := filter.Logs(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, log := range chunk {
+			if log.BlockNumber == from.block && log.Index < from.index {
+				continue
+			}
+			if len(logs) == limit {
+				return logs, &logPosition{block: log.BlockNumber, index: log.Index}, nil
+			}
+			logs = append(logs, log)
+		}
+		if last == end {
+			return logs, nil, nil
+		}
+	}
+}
+
+// tooManyResults returns the rejection of the queries with more logs than the limit.
+func (lq *logsQuerier) tooManyResults() error {
+	return &limitExceededError{fmt.Sprintf(
+		"query returned more than %d results, narrow the block range or use polaris_getLogsPage",
+		lq.cfg.MaxResults,
+	)}
+}
+
+// logsAPI serves `eth_getLogs` within the limits of the config. It is registered after the filter
+// API of go-ethereum, whose method it overrides.
+type logsAPI struct {
+	lq *logsQuerier
+}
+
+// GetLogs returns the logs matching the given criteria, or a limit exceeded error if the query
+// spans too many blocks or matches too many logs.
+func (api *logsAPI) GetLogs(
+	ctx context.Context, crit filters.FilterCriteria,
+) ([]*types.Log, error) {
+	if err := api.lq.checkTopics(&crit); err != nil {
+		return nil, err
+	}
+	if crit.BlockHash != nil {
+		return api.lq.blockLogs(ctx, &crit)
+	}
+
+	begin, end, err := api.lq.blockRange(&crit)
+	if err != nil {
+		return nil, err
+	}
+	if maxRange := api.lq.cfg.MaxBlockRange; maxRange > 0 && end-begin >= maxRange {
+		return nil, &limitExceededError{fmt.Sprintf(
+			"query spans %d blocks, exceeding the limit of %d, use polaris_getLogsPage",
+			end-begin+1, maxRange,
+		)}
+	}
+
+	limit := api.lq.cfg.MaxResults
+	if limit <= 0 {
+		limit = -1
+	}
+	logs, next, err := api.lq.scan(ctx, &crit, logPosition{block: begin}, end, limit)
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		return nil, api.lq.tooManyResults()
+	}
+	return logs, nil
+}
+
+// logsPageAPI serves `polaris_getLogsPage`, the paginated variant of `eth_getLogs`, whose pages
+// are bounded by the limits of the config whatever the range of the query.
+type logsPageAPI struct {
+	lq *logsQuerier
+}
+
+// GetLogsPage returns a page of the logs matching the given criteria, starting at the given
+// cursor, or at the first block of the query without one. The next page is requested with the
+// same criteria and the cursor of the page, until it is null.
+func (api *logsPageAPI) GetLogsPage(
+	ctx context.Context, crit filters.FilterCriteria, cursor *hexutil.Bytes,
+) (*LogsPage, error) {
+	if err := api.lq.checkTopics(&crit); err != nil {
+		return nil, err
+	}
+	if crit.BlockHash != nil {
+		if cursor != nil {
+			return nil, errLogsCursorWithBlockHash
+		}
+		logs, err := api.lq.blockLogs(ctx, &crit)
+		if err != nil {
+			return nil, err
+		}
+		return &LogsPage{Logs: logs}, nil
+	}
+
+	begin, end, err := api.lq.blockRange(&crit)
+	if err != nil {
+		return nil, err
+	}
+	from := logPosition{block: begin}
+	if cursor != nil {
+		if from, err = decodeLogsCursor(*cursor); err != nil {
+			return nil, err
+		}
+		if from.block < begin || from.block > end {
+			return nil, errInvalidLogsCursor
+		}
+	}
+
+	// The page is cut after MaxBlockRange blocks, so that a page of a sparse query does not scan
+	// the whole chain.
+	last := end
+	if maxRange := api.lq.cfg.MaxBlockRange; maxRange > 0 && end-from.block >= maxRange {
+		last = from.block + maxRange - 1
+	}
+	size := api.lq.cfg.MaxResults
+	if size <= 0 {
+		size = defaultLogsPageSize
+	}
+	logs, next, err := api.lq.scan(ctx, &crit, from, last, size)
+	if err != nil {
+		return nil, err
+	}
+	if next == nil && last < end {
+		next = &logPosition{block: last + 1}
+	}
+
+	page := &LogsPage{Logs: logs}
+	if next != nil {
+		encoded := encodeLogsCursor(*next)
+		page.Cursor = &encoded
+	}
+	return page, nil
+}
+
+// encodeLogsCursor encodes the position of the next log of a query as a cursor.
+func encodeLogsCursor(pos logPosition) hexutil.Bytes {
+	cursor := make([]byte, logsCursorLength)
+	binary.BigEndian.PutUint64(cursor, pos.block)
+	binary.BigEndian.PutUint64(cursor[8:], uint64(pos.index))
+	return cursor
+}
+
+// decodeLogsCursor decodes the position of the next log of a query from a cursor.
+func decodeLogsCursor(cursor hexutil.Bytes) (logPosition, error) {
+	if len(cursor) != logsCursorLength {
+		return logPosition{}, errInvalidLogsCursor
+	}
+	return logPosition{
+		block: binary.BigEndian.Uint64(cursor),
+		index: uint(binary.BigEndian.Uint64(cursor[8:])),
+	}, nil
+}
@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// logsBackend is a filter backend of a chain of the given headers, whose blocks have the given
+// logs, and without a bloom bits index.
+type logsBackend struct {
+	filters.Backend
+	headers []*types.Header
+	logs    map[common.Hash][][]*types.Log
+}
+
+func (b *logsBackend) HeaderByNumber(
+	_ context.Context, number gethrpc.BlockNumber,
+) (*types.Header, error) {
+	if number < 0 {
+		return b.headers[len(b.headers)-1], nil
+	}
+	if int(number) >= len(b.headers) {
+		return nil, nil
+	}
+	return b.headers[number], nil
+}
+
+func (b *logsBackend) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	for _, header := range b.headers {
+		if header.Hash() == hash {
+			return header, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *logsBackend) GetLogs(
+	_ context.Context, hash common.Hash, _ uint64,
+) ([][]*types.Log, error) {
+	return b.logs[hash], nil
+}
+
+func (b *logsBackend) BloomStatus() (uint64, uint64) {
+	return 0, 0
+}
+
+var _ = Describe("Logs", func() {
+	var (
+		cfg     LogsConfig
+		headers []*types.Header
+		logs    []*types.Log
+		lq      *logsQuerier
+	)
+
+	BeforeEach(func() {
+		// a chain of 10 blocks, with 3 logs in block 2, 1 in block 5 and 2 in block 9.
+		backend := &logsBackend{logs: make(map[common.Hash][][]*types.Log)}
+		headers, logs = nil, nil
+		for i := 0; i < 10; i++ {
+			header := &types.Header{Number: big.NewInt(int64(i))}
+			headers = append(headers, header)
+
+			var count int
+			switch i {
+			case 2:
+				count = 3
+			case 5:
+				count = 1
+			case 9:
+				count = 2
+			}
+			var blockLogs []*types.Log
+			for j := 0; j < count; j++ {
+				blockLogs = append(blockLogs, &types.Log{
+					BlockNumber: uint64(i),
+					BlockHash:   header.Hash(),
+					TxHash:      common.BigToHash(big.NewInt(int64(i + 1))),
+					Index:       uint(j),
+				})
+			}
+			backend.logs[header.Hash()] = [][]*types.Log{blockLogs}
+			logs = append(logs, blockLogs...)
+		}
+		backend.headers = headers
+
+		cfg = LogsConfig{}
+		lq = &logsQuerier{
+			cfg:    &cfg,
+			chain:  &headChain{head: headers[len(headers)-1]},
+			system: filters.NewFilterSystem(backend, filters.Config{}),
+		}
+	})
+
+	// blocks returns the given criteria over the given blocks.
+	blocks := func(from, to int64) filters.FilterCriteria {
+		return filters.FilterCriteria{FromBlock: big.NewInt(from), ToBlock: big.NewInt(to)}
+	}
+
+	Context("blockRange", func() {
+		It("should default to the current block", func() {
+			begin, end, err := lq.blockRange(&filters.FilterCriteria{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect([]uint64{begin, end}).To(Equal([]uint64{9, 9}))
+
+			crit := blocks(gethrpc.SafeBlockNumber.Int64(), gethrpc.PendingBlockNumber.Int64())
+			begin, end, err = lq.blockRange(&crit)
+			Expect(err).ToNot(HaveOccurred())
+			Expect([]uint64{begin, end}).To(Equal([]uint64{9, 9}))
+		})
+
+		It("should stop at the current block", func() {
+			crit := blocks(3, 100)
+			begin, end, err := lq.blockRange(&crit)
+			Expect(err).ToNot(HaveOccurred())
+			Expect([]uint64{begin, end}).To(Equal([]uint64{3, 9}))
+		})
+
+		It("should reject the invalid ranges", func() {
+			crit := blocks(5, 4)
+			_, _, err := lq.blockRange(&crit)
+			Expect(err).To(MatchError(errInvalidBlockRange))
+
+			lq.chain = &headChain{}
+			_, _, err = lq.blockRange(&filters.FilterCriteria{})
+			Expect(err).To(MatchError("no current block"))
+		})
+	})
+
+	It("should reject the queries with too many topics", func() {
+		crit := filters.FilterCriteria{Topics: [][]common.Hash{{{}, {}}, {{}}}}
+		Expect(lq.checkTopics(&crit)).To(Succeed())
+
+		cfg.MaxTopics = 2
+		err := lq.checkTopics(&crit)
+		Expect(err).To(BeAssignableToTypeOf(&limitExceededError{}))
+		Expect(err).To(MatchError("query has 3 topics, exceeding the limit of 2"))
+	})
+
+	Context("eth_getLogs", func() {
+		var api *logsAPI
+
+		BeforeEach(func() {
+			api = &logsAPI{lq: lq}
+		})
+
+		It("should return the logs of the range", func() {
+			found, err := api.GetLogs(context.Background(), blocks(0, 9))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(Equal(logs))
+
+			found, err = api.GetLogs(context.Background(), blocks(3, 8))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(Equal(logs[3:4]))
+
+			found, err = api.GetLogs(context.Background(), blocks(6, 8))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeEmpty())
+		})
+
+		It("should return the logs of a block", func() {
+			hash := headers[2].Hash()
+			found, err := api.GetLogs(context.Background(), filters.FilterCriteria{BlockHash: &hash})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(Equal(logs[:3]))
+
+			hash = headers[3].Hash()
+			found, err = api.GetLogs(context.Background(), filters.FilterCriteria{BlockHash: &hash})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeEmpty())
+		})
+
+		It("should reject the queries over the block range limit", func() {
+			cfg.MaxBlockRange = 5
+			_, err := api.GetLogs(context.Background(), blocks(0, 4))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = api.GetLogs(context.Background(), blocks(0, 5))
+			Expect(err).To(BeAssignableToTypeOf(&limitExceededError{}))
+			Expect(err.Error()).To(ContainSubstring("query spans 6 blocks"))
+		})
+
+		It("should reject the queries over the results limit", func() {
+			cfg.MaxResults = 3
+			found, err := api.GetLogs(context.Background(), blocks(3, 9))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(Equal(logs[3:]))
+
+			_, err = api.GetLogs(context.Background(), blocks(0, 9))
+			Expect(err).To(BeAssignableToTypeOf(&limitExceededError{}))
+			Expect(err.Error()).To(ContainSubstring("more than 3 results"))
+
+			cfg.MaxResults = 2
+			hash := headers[2].Hash()
+			_, err = api.GetLogs(context.Background(), filters.FilterCriteria{BlockHash: &hash})
+			Expect(err).To(BeAssignableToTypeOf(&limitExceededError{}))
+		})
+	})
+
+	Context("polaris_getLogsPage", func() {
+		var api *logsPageAPI
+
+		BeforeEach(func() {
+			api = &logsPageAPI{lq: lq}
+		})
+
+		// pages returns the logs of every page of the given query, and the number of pages.
+		pages := func(crit filters.FilterCriteria) ([]*types.Log, int) {
+			var (
+				found  []*types.Log
+				cursor *hexutil.Bytes
+				count  int
+			)
+			for {
+				page, err := api.GetLogsPage(context.Background(), crit, cursor)
+				Expect(err).ToNot(HaveOccurred())
+				found, count = append(found, page.Logs...), count+1
+				if page.Cursor == nil {
+					return found, count
+				}
+				cursor = page.Cursor
+			}
+		}
+
+		It("should cut the pages after the results limit", func() {
+			cfg.MaxResults = 2
+			page, err := api.GetLogsPage(context.Background(), blocks(0, 9), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Logs).To(Equal(logs[:2]))
+			Expect(*page.Cursor).To(Equal(encodeLogsCursor(logPosition{block: 2, index: 2})))
+
+			found, count := pages(blocks(0, 9))
+			Expect(found).To(Equal(logs))
+			Expect(count).To(Equal(3))
+		})
+
+		It("should cut the pages after the block range limit", func() {
+			cfg.MaxBlockRange = 3
+			page, err := api.GetLogsPage(context.Background(), blocks(6, 9), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Logs).To(BeEmpty())
+			Expect(*page.Cursor).To(Equal(encodeLogsCursor(logPosition{block: 9})))
+
+			found, count := pages(blocks(0, 9))
+			Expect(found).To(Equal(logs))
+			Expect(count).To(Equal(4))
+		})
+
+		It("should return the logs of a block in a single page", func() {
+			cfg.MaxResults = 1
+			hash := headers[9].Hash()
+			page, err := api.GetLogsPage(
+				context.Background(), filters.FilterCriteria{BlockHash: &hash}, nil,
+			)
+			Expect(err).To(BeAssignableToTypeOf(&limitExceededError{}))
+			Expect(page).To(BeNil())
+
+			cfg.MaxResults = 0
+			page, err = api.GetLogsPage(
+				context.Background(), filters.FilterCriteria{BlockHash: &hash}, nil,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Logs).To(Equal(logs[4:]))
+			Expect(page.Cursor).To(BeNil())
+
+			cursor := encodeLogsCursor(logPosition{block: 9})
+			_, err = api.GetLogsPage(
+				context.Background(), filters.FilterCriteria{BlockHash: &hash}, &cursor,
+			)
+			Expect(err).To(MatchError(errLogsCursorWithBlockHash))
+		})
+
+		It("should reject the invalid cursors", func() {
+			for _, cursor := range []hexutil.Bytes{
+				{0x01},
+				encodeLogsCursor(logPosition{block: 1}),
+				encodeLogsCursor(logPosition{block: 10}),
+			} {
+				cursor := cursor
+				_, err := api.GetLogsPage(context.Background(), blocks(2, 9), &cursor)
+				Expect(err).To(MatchError(errInvalidLogsCursor))
+			}
+		})
+	})
+
+	It("should encode the position of the next log in the cursor", func() {
+		pos := logPosition{block: 1 << 40, index: 7}
+		cursor := encodeLogsCursor(pos)
+		Expect(cursor).To(HaveLen(logsCursorLength))
+		decoded, err := decodeLogsCursor(cursor)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decoded).To(Equal(pos))
+	})
+})
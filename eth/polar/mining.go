@@ -39,6 +39,11 @@ func (pl *Polaris) ProcessTransaction(ctx context.Context, tx *types.Transaction
 	return pl.blockchain.ProcessTransaction(ctx, tx)
 }
 
+// LastReceipt returns the receipt of the last transaction processed in the current block.
+func (pl *Polaris) LastReceipt() *types.Receipt {
+	return pl.blockchain.LastReceipt()
+}
+
 // Finalize finalizes the current block.
 func (pl *Polaris) Finalize(ctx context.Context) error {
 	return pl.blockchain.Finalize(ctx)
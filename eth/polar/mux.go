@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/node"
+
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// muxPathPrefix is the path prefix under which the instances of a `Mux` are served.
+const muxPathPrefix = "/evm/"
+
+// Mux serves the JSON-RPC APIs of several Polaris instances running in the same process from a
+// single networking stack, e.g. for app-chains that embed multiple EVMs. Each instance, which has
+// its own chain config, is served under the path `/evm/<name>` of the stack, over both HTTP and
+// WebSocket, with the same modules, checks and monitoring as a single instance.
+type Mux struct {
+	stack NetworkingStack
+	// config is the node config of the shared networking stack.
+	config *NodeConfig
+	// monitor monitors the RPC servers of the instances, it is nil if disabled.
+	monitor *rpcMonitor
+	// register mounts the handler of the RPC servers of an instance on the shared networking
+	// stack.
+	register func(name, path string, handler http.Handler)
+
+	// startOnce starts the shared networking stack for the first instance that starts it.
+	startOnce sync.Once
+	startErr  error
+
+	// mu guards open.
+	mu sync.Mutex
+	// open is the number of instances that were not closed yet. The shared networking stack is
+	// closed with the last of them.
+	open int
+}
+
+// NewMux creates a new `Mux` that serves Polaris instances from a networking stack built from the
+// given node config.
+func NewMux(config *NodeConfig) (*Mux, error) {
+	n, err := newNode(config)
+	if err != nil {
+		return nil, err
+	}
+	m := newMux(n, config, n.monitor)
+	m.register = n.registerRPCHandler
+	return m, nil
+}
+
+// newMux creates a new `Mux` that serves Polaris instances from the given networking stack.
+func newMux(stack NetworkingStack, config *NodeConfig, monitor *rpcMonitor) *Mux {
+	return &Mux{
+		stack:    stack,
+		config:   config,
+		monitor:  monitor,
+		register: stack.RegisterHandler,
+	}
+}
+
+// Stack returns the networking stack of the Polaris instance with the given name, which is to be
+// given to `NewWithNetworkingStack`. All the instances must start their services before the
+// shared networking stack is started, as handlers cannot be registered on a running stack.
+func (m *Mux) Stack(name string) NetworkingStack {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.open++
+	return &muxStack{
+		NetworkingStack: m.stack,
+		mux:             m,
+		path:            muxPathPrefix + name,
+		httpServer:      rpc.NewServer(),
+		wsServer:        rpc.NewServer(),
+	}
+}
+
+// muxStack is the networking stack of a Polaris instance of a `Mux`. It serves the public APIs of
+// the instance from its own RPC servers, mounted on the path of the instance. Its admin modules
+// are not served.
+type muxStack struct {
+	NetworkingStack
+	mux *Mux
+	// path is the path of the instance on the shared networking stack.
+	path string
+	// httpServer serves the APIs of the HTTP modules of the instance.
+	httpServer *rpc.Server
+	// wsServer serves the APIs of the WebSocket modules of the instance.
+	wsServer *rpc.Server
+	// mountOnce mounts the servers on the shared networking stack with the first APIs registered.
+	mountOnce sync.Once
+	// closeOnce closes the instance once.
+	closeOnce sync.Once
+}
+
+// RegisterAPIs registers the given APIs on the RPC servers of the instance, filtered by the public
+// HTTP and WebSocket modules of the node config.
+func (s *muxStack) RegisterAPIs(apis []rpc.API) {
+	cfg := s.mux.config
+	registerModules(s.httpServer, apis, cfg.publicModules(cfg.HTTPModules))
+	registerModules(s.wsServer, apis, cfg.publicModules(cfg.WSModules))
+	s.mountOnce.Do(func() {
+		s.mux.register("polaris "+s.path, s.path, s.handler())
+	})
+}
+
+// RegisterHandler registers the given handler under the path of the instance.
+func (s *muxStack) RegisterHandler(name, path string, handler http.Handler) {
+	s.NetworkingStack.RegisterHandler(name, s.path+path, handler)
+}
+
+// Start starts the shared networking stack, unless another instance already started it.
+func (s *muxStack) Start() error {
+	s.mux.startOnce.Do(func() {
+		s.mux.startErr = s.mux.stack.Start()
+	})
+	return s.mux.startErr
+}
+
+// Close stops the RPC servers of the instance. The shared networking stack keeps serving the other
+// instances, and is only closed with the last open instance. Closing an instance twice has no
+// effect.
+func (s *muxStack) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.httpServer.Stop()
+		s.wsServer.Stop()
+		s.mux.mu.Lock()
+		defer s.mux.mu.Unlock()
+		if s.mux.open--; s.mux.open == 0 {
+			err = s.mux.stack.Close()
+		}
+	})
+	return err
+}
+
+// handler returns the handler of the RPC servers of the instance, which applies the CORS, virtual
+// hosts and WebSocket origins checks of the node config and the monitoring of the shared
+// networking stack, as the single instance servers do.
+func (s *muxStack) handler() http.Handler {
+	cfg, monitor := s.mux.config, s.mux.monitor
+	httpHandler := node.NewHTTPHandlerStack(
+		monitor.httpHandler(s.httpServer), cfg.HTTPCors, cfg.HTTPVirtualHosts, nil,
+	)
+	wsHandler := node.NewWSHandlerStack(monitor.wsHandler(s.wsServer, cfg.WSOrigins), nil)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		httpHandler.ServeHTTP(w, r)
+	})
+}
+
+// registerModules registers the given APIs of the given modules on the given RPC server.
+func registerModules(server *rpc.Server, apis []rpc.API, modules []string) {
+	for _, api := range apis {
+		for _, module := range modules {
+			if api.Namespace != module {
+				continue
+			}
+			if err := server.RegisterName(api.Namespace, api.Service); err != nil {
+				panic(err)
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/node"
+
+	"pkg.berachain.dev/polaris/eth/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeStack is a networking stack that records the handlers registered on it.
+type fakeStack struct {
+	handlers map[string]http.Handler
+	started  int
+	closed   int
+}
+
+func newFakeStack() *fakeStack {
+	return &fakeStack{handlers: make(map[string]http.Handler)}
+}
+
+func (s *fakeStack) ExtRPCEnabled() bool { return true }
+
+func (s *fakeStack) RegisterHandler(_, path string, handler http.Handler) {
+	s.handlers[path] = handler
+}
+
+func (s *fakeStack) RegisterAPIs([]rpc.API) { Fail("the apis must be served by the instances") }
+
+func (s *fakeStack) Start() error {
+	s.started++
+	return nil
+}
+
+func (s *fakeStack) Close() error {
+	s.closed++
+	return nil
+}
+
+// nameAPI serves the name of the instance it is registered on.
+type nameAPI struct{ name string }
+
+func (api *nameAPI) Name() string { return api.name }
+
+var _ = Describe("Mux", func() {
+	var (
+		stack *fakeStack
+		a, b  NetworkingStack
+	)
+
+	// request calls the given method on the instance served under the given path, from the given
+	// virtual host.
+	request := func(path, host, method string) *httptest.ResponseRecorder {
+		Expect(stack.handlers).To(HaveKey(path))
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(
+			`{"jsonrpc":"2.0","id":1,"method":"`+method+`","params":[]}`,
+		))
+		req.Host = host
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		stack.handlers[path].ServeHTTP(rec, req)
+		return rec
+	}
+
+	// call calls the name api of the instance served under the given path.
+	call := func(path string) string {
+		return request(path, "localhost", "test_name").Body.String()
+	}
+
+	BeforeEach(func() {
+		stack = newFakeStack()
+		mux := newMux(stack, &NodeConfig{
+			Config: node.Config{
+				HTTPModules:      []string{"test", "admin"},
+				HTTPVirtualHosts: []string{"localhost"},
+			},
+			AdminModules: []string{"admin"},
+		}, nil)
+		a, b = mux.Stack("a"), mux.Stack("b")
+		a.RegisterAPIs([]rpc.API{
+			{Namespace: "test", Service: &nameAPI{name: "a"}},
+			{Namespace: "admin", Service: &nameAPI{name: "a"}},
+		})
+		b.RegisterAPIs([]rpc.API{{Namespace: "test", Service: &nameAPI{name: "b"}}})
+	})
+
+	It("should serve each instance under its own path", func() {
+		Expect(call("/evm/a")).To(ContainSubstring(`"result":"a"`))
+		Expect(call("/evm/b")).To(ContainSubstring(`"result":"b"`))
+
+		b.RegisterHandler("graphql", "/graphql", http.NotFoundHandler())
+		Expect(stack.handlers).To(HaveKey("/evm/b/graphql"))
+	})
+
+	It("should not serve the admin modules", func() {
+		Expect(request("/evm/a", "localhost", "admin_name").Body.String()).
+			To(ContainSubstring("does not exist"))
+	})
+
+	It("should check the virtual hosts of the node config", func() {
+		Expect(request("/evm/a", "example.com", "test_name").Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("should start the shared stack once", func() {
+		Expect(a.Start()).To(Succeed())
+		Expect(b.Start()).To(Succeed())
+		Expect(stack.started).To(Equal(1))
+	})
+
+	It("should close the shared stack with the last instance", func() {
+		Expect(a.Close()).To(Succeed())
+		Expect(a.Close()).To(Succeed())
+		Expect(stack.closed).To(BeZero())
+		Expect(call("/evm/b")).To(ContainSubstring(`"result":"b"`))
+
+		Expect(b.Close()).To(Succeed())
+		Expect(stack.closed).To(Equal(1))
+	})
+})
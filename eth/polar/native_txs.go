@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// NativeTxType is the transaction type reserved for the synthetic transactions that represent the
+// native transactions of the host chain in the blocks returned over rpc. It is the last type of
+// the EIP-2718 range, so that it does not collide with the types used by Ethereum.
+const NativeTxType = 0x7f
+
+// NativeTransaction is a transaction of the host chain that is not an EVM transaction.
+type NativeTransaction struct {
+	// Hash is the hash of the transaction on the host chain.
+	Hash common.Hash
+	// GasUsed is the amount of gas consumed by the transaction on the host chain.
+	GasUsed uint64
+}
+
+// NativeTxsProvider is implemented by the host chains that can list the native transactions of
+// their blocks.
+type NativeTxsProvider interface {
+	// GetNativeTransactions returns the native transactions of the block with the given number,
+	// in the order they were executed in.
+	GetNativeTransactions(ctx context.Context, number uint64) ([]*NativeTransaction, error)
+}
+
+// RPCNativeTransaction is the synthetic transaction that represents a native transaction of the
+// host chain in a block returned over rpc.
+type RPCNativeTransaction struct {
+	BlockHash        common.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Big   `json:"blockNumber"`
+	Gas              hexutil.Uint64 `json:"gas"`
+	Hash             common.Hash    `json:"hash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Type             hexutil.Uint64 `json:"type"`
+}
+
+// nativeTxsAPI overrides the block methods of the `eth` namespace, so that the blocks returned
+// list the native transactions of the host chain after their EVM transactions. Explorers then see
+// all the transactions that account for the gas used of a block.
+type nativeTxsAPI struct {
+	api *polarapi.BlockChainAPI
+	ntp NativeTxsProvider
+}
+
+// newNativeTxsAPI creates a new `eth` namespace service that returns the blocks of the given
+// backend with the native transactions of the given provider.
+func newNativeTxsAPI(b polarapi.EthBackend, ntp NativeTxsProvider) *nativeTxsAPI {
+	return &nativeTxsAPI{
+		api: polarapi.NewBlockChainAPI(b),
+		ntp: ntp,
+	}
+}
+
+// GetBlockByNumber returns the requested block, including its native transactions.
+func (api *nativeTxsAPI) GetBlockByNumber(
+	ctx context.Context, number rpc.BlockNumber, fullTx bool,
+) (map[string]interface{}, error) {
+	block, err := api.api.GetBlockByNumber(ctx, number, fullTx)
+	if block == nil || err != nil {
+		return block, err
+	}
+	return api.withNativeTxs(ctx, block, fullTx)
+}
+
+// GetBlockByHash returns the requested block, including its native transactions.
+func (api *nativeTxsAPI) GetBlockByHash(
+	ctx context.Context, hash common.Hash, fullTx bool,
+) (map[string]interface{}, error) {
+	block, err := api.api.GetBlockByHash(ctx, hash, fullTx)
+	if block == nil || err != nil {
+		return block, err
+	}
+	return api.withNativeTxs(ctx, block, fullTx)
+}
+
+// withNativeTxs appends the native transactions of the given block to its transactions, either as
+// hashes or as `RPCNativeTransaction`s, depending on `fullTx`. The pending block, which has no
+// hash, is returned as is.
+func (api *nativeTxsAPI) withNativeTxs(
+	ctx context.Context, block map[string]interface{}, fullTx bool,
+) (map[string]interface{}, error) {
+	hash, ok := block["hash"].(common.Hash)
+	if !ok {
+		return block, nil
+	}
+	number, ok := block["number"].(*hexutil.Big)
+	if !ok {
+		return block, nil
+	}
+	txs, _ := block["transactions"].([]interface{})
+
+	nativeTxs, err := api.ntp.GetNativeTransactions(ctx, number.ToInt().Uint64())
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range nativeTxs {
+		if !fullTx {
+			txs = append(txs, tx.Hash)
+			continue
+		}
+		txs = append(txs, &RPCNativeTransaction{
+			BlockHash:        hash,
+			BlockNumber:      number,
+			Gas:              hexutil.Uint64(tx.GasUsed),
+			Hash:             tx.Hash,
+			TransactionIndex: hexutil.Uint64(len(txs)),
+			Type:             NativeTxType,
+		})
+	}
+	block["transactions"] = txs
+	return block, nil
+}
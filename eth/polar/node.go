@@ -21,31 +21,305 @@
 package polar
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+
 	"github.com/ethereum/go-ethereum/node"
+
+	"pkg.berachain.dev/polaris/eth/rpc"
 )
 
+const (
+	// jwtSecretLength is the length in bytes of the JWT secret of the admin endpoint, as for the
+	// Engine API.
+	jwtSecretLength = 32
+
+	// defaultAdminPort is the default TCP port of the admin endpoint, next to the one of the
+	// Engine API.
+	defaultAdminPort = 8552
+
+	// maxIPCPathLength is the maximum length of the path of a unix socket, the smallest one among
+	// the supported platforms.
+	maxIPCPathLength = 104
+)
+
+// NodeConfig is the configuration of the networking stack of Polaris. On top of the go-ethereum
+// node config, it configures TLS and connection limits for the JSON-RPC HTTP server. The `IPCPath`
+// of the go-ethereum config enables the IPC endpoint, which serves all the APIs of the node on a
+// unix socket only accessible to its user, relative to the data directory unless absolute.
+type NodeConfig struct {
+	node.Config
+
+	// HTTPTLSCertFile is the path of the certificate to serve the JSON-RPC APIs over HTTPS with.
+	HTTPTLSCertFile string `toml:",omitempty"`
+
+	// HTTPTLSKeyFile is the path of the private key of the certificate.
+	HTTPTLSKeyFile string `toml:",omitempty"`
+
+	// HTTPMaxConnections is the maximum number of simultaneous HTTP connections, or zero for no
+	// limit.
+	HTTPMaxConnections int `toml:",omitempty"`
+
+	// AdminModules are the API namespaces that are dangerous to expose publicly. They are never
+	// served on the HTTP and WebSocket endpoints, even if listed in their modules, but only on the
+	// admin and IPC endpoints.
+	AdminModules []string `toml:",omitempty"`
+
+	// AdminHost is the host interface of the admin endpoint, which serves all the APIs of the node
+	// over HTTP to the requests authenticated with the JWT secret of the config, like the Engine
+	// API. The admin endpoint is disabled if empty.
+	AdminHost string `toml:",omitempty"`
+
+	// AdminPort is the TCP port of the admin endpoint.
+	AdminPort int `toml:",omitempty"`
+
+	// RPCSlowQueryThreshold is the duration above which the JSON-RPC calls are logged, with their
+	// params redacted, or zero to not log them.
+	RPCSlowQueryThreshold time.Duration `toml:",omitempty"`
+
+	// RPCConnectionMetrics tracks the subscriptions and the backlog of unanswered calls of each
+	// WebSocket connection, which are served by `admin_connectionStats` and as metrics.
+	RPCConnectionMetrics bool `toml:",omitempty"`
+}
+
+// Validate ensures that the TLS certificate and key are configured together, and that the IPC
+// endpoint, if any, fits in the path of a unix socket.
+func (c *NodeConfig) Validate() error {
+	if (c.HTTPTLSCertFile == "") != (c.HTTPTLSKeyFile == "") {
+		return errors.New("both the TLS certificate and key of the HTTP server must be set")
+	}
+	if c.HTTPMaxConnections < 0 {
+		return errors.New("the maximum number of HTTP connections cannot be negative")
+	}
+	if c.RPCSlowQueryThreshold < 0 {
+		return errors.New("the slow query threshold cannot be negative")
+	}
+	if c.AdminHost != "" && c.JWTSecret == "" {
+		return errors.New("the admin endpoint requires a JWT secret")
+	}
+	if endpoint := c.IPCEndpoint(); runtime.GOOS != "windows" && len(endpoint) > maxIPCPathLength {
+		return fmt.Errorf(
+			"the IPC endpoint %s is longer than the %d bytes limit of unix sockets",
+			endpoint, maxIPCPathLength,
+		)
+	}
+	return nil
+}
+
+// publicModules returns the given modules, without the admin modules.
+func (c *NodeConfig) publicModules(modules []string) []string {
+	public := make([]string, 0, len(modules))
+	for _, module := range modules {
+		isAdmin := false
+		for _, adminModule := range c.AdminModules {
+			isAdmin = isAdmin || module == adminModule
+		}
+		if !isAdmin {
+			public = append(public, module)
+		}
+	}
+	return public
+}
+
+// httpServerConfig returns the config of the Polaris HTTP server that serves the public APIs, and
+// the WebSocket ones if served by Polaris on the same endpoint.
+func (c *NodeConfig) httpServerConfig() httpServerConfig {
+	return httpServerConfig{
+		name:           "http",
+		endpoint:       c.HTTPEndpoint(),
+		modules:        c.publicModules(c.HTTPModules),
+		cors:           c.HTTPCors,
+		vhosts:         c.HTTPVirtualHosts,
+		timeouts:       c.HTTPTimeouts,
+		tlsCertFile:    c.HTTPTLSCertFile,
+		tlsKeyFile:     c.HTTPTLSKeyFile,
+		maxConnections: c.HTTPMaxConnections,
+		ws:             c.customWS() && c.WSEndpoint() == c.HTTPEndpoint(),
+		wsModules:      c.publicModules(c.WSModules),
+		wsOrigins:      c.WSOrigins,
+	}
+}
+
+// wsServerConfig returns the config of the Polaris server that serves the public APIs over
+// WebSocket only, on their own endpoint.
+func (c *NodeConfig) wsServerConfig() httpServerConfig {
+	return httpServerConfig{
+		name:      "ws",
+		endpoint:  c.WSEndpoint(),
+		ws:        true,
+		wsModules: c.publicModules(c.WSModules),
+		wsOrigins: c.WSOrigins,
+		wsOnly:    true,
+		timeouts:  c.HTTPTimeouts,
+	}
+}
+
+// adminServerConfig returns the config of the Polaris HTTP server of the admin endpoint, reading
+// the JWT secret from the file of the config.
+func (c *NodeConfig) adminServerConfig() (httpServerConfig, error) {
+	bz, err := os.ReadFile(c.JWTSecret)
+	if err != nil {
+		return httpServerConfig{}, fmt.Errorf("error reading JWT secret %s: %w", c.JWTSecret, err)
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+	if err != nil || len(secret) != jwtSecretLength {
+		return httpServerConfig{}, fmt.Errorf(
+			"invalid JWT secret %s, want %d hex encoded bytes", c.JWTSecret, jwtSecretLength,
+		)
+	}
+	return httpServerConfig{
+		name:        "admin",
+		endpoint:    net.JoinHostPort(c.AdminHost, strconv.Itoa(c.AdminPort)),
+		cors:        node.DefaultAuthCors,
+		vhosts:      c.AuthVirtualHosts,
+		jwtSecret:   secret,
+		timeouts:    c.HTTPTimeouts,
+		tlsCertFile: c.HTTPTLSCertFile,
+		tlsKeyFile:  c.HTTPTLSKeyFile,
+	}, nil
+}
+
+// monitored returns true if the JSON-RPC servers are monitored.
+func (c *NodeConfig) monitored() bool {
+	return c.RPCSlowQueryThreshold > 0 || c.RPCConnectionMetrics
+}
+
+// customHTTP returns true if the JSON-RPC APIs are served by the Polaris HTTP server rather than
+// by the one of the go-ethereum node, which is the case when TLS, connection limits or monitoring
+// are used.
+func (c *NodeConfig) customHTTP() bool {
+	return c.HTTPHost != "" &&
+		(c.HTTPTLSCertFile != "" || c.HTTPMaxConnections > 0 || c.monitored())
+}
+
+// customWS returns true if the JSON-RPC APIs are served over WebSocket by Polaris rather than by
+// the go-ethereum node, which is the case when monitoring is used.
+func (c *NodeConfig) customWS() bool {
+	return c.WSHost != "" && c.monitored()
+}
+
 // Node is a wrapper around the go-ethereum node.Node object, that allows us to conform to the
 // NetworkingStack interface, we have to do some hacky stuff to initialize the graphql service,
 // TODO: deprecate this and use a more elegant solution.
 type Node struct {
 	*node.Node
+
+	// http serves the JSON-RPC APIs over HTTP in place of the node, it is nil if not needed.
+	http *httpServer
+	// ws serves the JSON-RPC APIs over WebSocket in place of the node on their own endpoint, it
+	// is nil if not needed.
+	ws *httpServer
+	// admin serves the admin modules to authenticated requests, it is nil if disabled.
+	admin *httpServer
+	// monitor monitors the JSON-RPC servers, it is nil if disabled.
+	monitor *rpcMonitor
 }
 
 // NewGetNetworkingStack creates a new NetworkingStack instance for use on an underlying blockchain.
-func NewGethNetworkingStack(config *node.Config) (NetworkingStack, error) {
-	node, err := node.New(config)
+func NewGethNetworkingStack(config *NodeConfig) (NetworkingStack, error) {
+	n, err := newNode(config)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// newNode creates a new `Node` from the given config.
+func newNode(config *NodeConfig) (*Node, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The admin modules are never served publicly, and the HTTP server of the node is disabled if
+	// the Polaris one is used.
+	gethConfig := config.Config
+	gethConfig.HTTPModules = config.publicModules(config.HTTPModules)
+	gethConfig.WSModules = config.publicModules(config.WSModules)
+	if config.customHTTP() {
+		gethConfig.HTTPHost = ""
+	}
+	if config.customWS() {
+		gethConfig.WSHost = ""
+	}
+	node, err := node.New(&gethConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Node{
+	n := &Node{
 		Node: node,
-	}, nil
+	}
+	if config.monitored() {
+		n.monitor = newRPCMonitor(config.RPCSlowQueryThreshold)
+		node.RegisterAPIs([]rpc.API{{
+			Namespace: "admin",
+			Service:   &rpcMonitorAPI{monitor: n.monitor},
+		}})
+	}
+	if config.customHTTP() {
+		n.http = newHTTPServer(config.httpServerConfig(), n.monitor)
+		node.RegisterLifecycle(n.http)
+	}
+	if config.customWS() && (n.http == nil || !n.http.cfg.ws) {
+		n.ws = newHTTPServer(config.wsServerConfig(), n.monitor)
+		node.RegisterLifecycle(n.ws)
+	}
+	if config.AdminHost != "" {
+		adminConfig, err := config.adminServerConfig()
+		if err != nil {
+			return nil, err
+		}
+		// The admin endpoint serves the in-process RPC server of the node, which has all its APIs.
+		n.admin = newHTTPServer(adminConfig, n.monitor)
+		n.admin.rpcServer = node.RPCHandler
+		node.RegisterLifecycle(n.admin)
+	}
+	return n, nil
 }
 
 // ExtRPCEnabled returns whether or not the external RPC service is enabled.
 func (n *Node) ExtRPCEnabled() bool {
-	return n.Node.Config().ExtRPCEnabled()
+	return n.http != nil || n.ws != nil || n.Node.Config().ExtRPCEnabled()
+}
+
+// RegisterAPIs registers the given APIs with the node, and with the Polaris servers if used.
+func (n *Node) RegisterAPIs(apis []rpc.API) {
+	n.Node.RegisterAPIs(apis)
+	if n.http != nil {
+		n.http.registerAPIs(apis)
+	}
+	if n.ws != nil {
+		n.ws.registerAPIs(apis)
+	}
+}
+
+// RegisterHandler registers the given handler with the node, and with the Polaris HTTP server if
+// used.
+func (n *Node) RegisterHandler(name, path string, handler http.Handler) {
+	n.Node.RegisterHandler(name, path, handler)
+	if n.http != nil {
+		n.http.registerHandler(path, handler)
+	}
+}
+
+// registerRPCHandler registers the given handler of an RPC server like `RegisterHandler`, but
+// without the CORS and virtual hosts checks of the Polaris HTTP server, which the handler applies
+// itself as it also serves WebSocket requests.
+func (n *Node) registerRPCHandler(name, path string, handler http.Handler) {
+	n.Node.RegisterHandler(name, path, handler)
+	if n.http != nil {
+		n.http.mux.Handle(path, handler)
+	}
 }
 
 // Start starts the networking stack.
@@ -61,7 +335,7 @@ func DefaultGethNodeConfig() *node.Config {
 	nodeCfg.P2P.NoDiscovery = true
 	nodeCfg.P2P.MaxPeers = 0
 	nodeCfg.Name = clientIdentifier
-	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net")
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "polaris")
 	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth")
 	nodeCfg.HTTPHost = "0.0.0.0"
 	nodeCfg.WSHost = "0.0.0.0"
@@ -72,3 +346,40 @@ func DefaultGethNodeConfig() *node.Config {
 	nodeCfg.GraphQLVirtualHosts = []string{"*"}
 	return &nodeCfg
 }
+
+// DefaultNodeConfig returns the default configuration of the networking stack, which serves the
+// JSON-RPC APIs over HTTP without TLS nor connection limits. The `admin`, `debug` and `txpool`
+// namespaces are admin modules, and the admin endpoint is disabled.
+func DefaultNodeConfig() *NodeConfig {
+	return &NodeConfig{
+		Config:       *DefaultGethNodeConfig(),
+		AdminModules: []string{"admin", "debug", "txpool"},
+		AdminPort:    defaultAdminPort,
+	}
+}
+
+// LoadNodeConfigFromFilePath reads in the `NodeConfig` section of a Polaris config file from the
+// filesystem, on top of the default node config.
+func LoadNodeConfigFromFilePath(filename string) (*NodeConfig, error) {
+	file := struct {
+		NodeConfig NodeConfig
+	}{
+		NodeConfig: *DefaultNodeConfig(),
+	}
+
+	// Read the TOML file
+	bytes, err := os.ReadFile(filename) //#nosec: G304 // required.
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %s: %w", filename, err)
+	}
+
+	// Unmarshal the TOML data into a struct
+	if _, err = toml.Decode(string(bytes), &file); err != nil {
+		return nil, fmt.Errorf("error parsing TOML data: %w", err)
+	}
+
+	if err = file.NodeConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return &file.NodeConfig, nil
+}
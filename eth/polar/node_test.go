@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/p2p"
+
+	"pkg.berachain.dev/polaris/eth/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testNodeConfig returns a node config in a temporary data directory, which serves none of the
+// networking endpoints.
+func testNodeConfig() *NodeConfig {
+	cfg := DefaultNodeConfig()
+	cfg.DataDir = GinkgoT().TempDir()
+	cfg.HTTPHost, cfg.WSHost = "", ""
+	cfg.P2P = p2p.Config{NoDiscovery: true, ListenAddr: ""}
+	return cfg
+}
+
+// startNode starts the networking stack of the given config with the given APIs, which is closed
+// at the end of the spec.
+func startNode(cfg *NodeConfig, apis ...rpc.API) *Node {
+	stack, err := NewGethNetworkingStack(cfg)
+	Expect(err).ToNot(HaveOccurred())
+	n, ok := stack.(*Node)
+	Expect(ok).To(BeTrue())
+	n.RegisterAPIs(apis)
+	Expect(n.Start()).To(Succeed())
+	DeferCleanup(n.Close)
+	return n
+}
+
+var _ = Describe("Node config", func() {
+	var cfg *NodeConfig
+
+	BeforeEach(func() {
+		cfg = DefaultNodeConfig()
+	})
+
+	It("should validate the TLS and connection limits of the HTTP server", func() {
+		Expect(cfg.Validate()).To(Succeed())
+
+		cfg.HTTPTLSCertFile = "cert.pem"
+		Expect(cfg.Validate()).ToNot(Succeed())
+		cfg.HTTPTLSKeyFile = "key.pem"
+		Expect(cfg.Validate()).To(Succeed())
+
+		cfg.HTTPMaxConnections = -1
+		Expect(cfg.Validate()).ToNot(Succeed())
+	})
+
+	It("should only serve the APIs of the node with the Polaris server if needed", func() {
+		Expect(cfg.customHTTP()).To(BeFalse())
+
+		cfg.HTTPMaxConnections = 10
+		Expect(cfg.customHTTP()).To(BeTrue())
+		cfg.HTTPMaxConnections = 0
+		cfg.HTTPTLSCertFile = "cert.pem"
+		Expect(cfg.customHTTP()).To(BeTrue())
+
+		// the HTTP server is disabled.
+		cfg.HTTPHost = ""
+		Expect(cfg.customHTTP()).To(BeFalse())
+	})
+
+	It("should configure the HTTP server with the node config", func() {
+		cfg.HTTPModules = []string{"eth", "polaris"}
+		cfg.HTTPMaxConnections = 10
+		cfg.HTTPTLSCertFile, cfg.HTTPTLSKeyFile = "cert.pem", "key.pem"
+
+		httpCfg := cfg.httpServerConfig()
+		Expect(httpCfg.endpoint).To(Equal(cfg.HTTPEndpoint()))
+		Expect(httpCfg.modules).To(Equal([]string{"eth", "polaris"}))
+		Expect(httpCfg.cors).To(Equal(cfg.HTTPCors))
+		Expect(httpCfg.vhosts).To(Equal(cfg.HTTPVirtualHosts))
+		Expect(httpCfg.maxConnections).To(Equal(10))
+		Expect(httpCfg.tlsCertFile).To(Equal("cert.pem"))
+		Expect(httpCfg.tlsKeyFile).To(Equal("key.pem"))
+	})
+})
+
+var _ = Describe("IPC endpoint", func() {
+	var cfg *NodeConfig
+
+	BeforeEach(func() {
+		cfg = testNodeConfig()
+	})
+
+	It("should fit in the path of a unix socket", func() {
+		cfg.IPCPath = "/" + strings.Repeat("a", maxIPCPathLength)
+		Expect(cfg.Validate()).ToNot(Succeed())
+	})
+
+	It("should serve all the APIs of the node", func() {
+		cfg.IPCPath = "polaris.ipc"
+		startNode(cfg, rpc.API{Namespace: "debug", Service: echoAPI{}})
+
+		client, err := rpc.DialContext(context.Background(), cfg.IPCEndpoint())
+		Expect(err).ToNot(HaveOccurred())
+		defer client.Close()
+		var res string
+		Expect(client.Call(&res, "debug_echo", "hello")).To(Succeed())
+		Expect(res).To(Equal("hello"))
+	})
+})
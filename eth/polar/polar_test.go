@@ -18,14 +18,16 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package journal
+package polar
 
-import "github.com/ethereum/go-ethereum/core/state"
+import (
+	"testing"
 
-type (
-	AccessList = state.AccessList
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 )
 
-var (
-	NewAccessList = state.NewAccessList
-)
+func TestPolar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "eth/polar")
+}
@@ -21,8 +21,11 @@
 package polar
 
 import (
+	"context"
 	"net/http"
 	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/cmd/utils"
@@ -31,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/graphql"
 
 	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
 	"pkg.berachain.dev/polaris/eth/log"
 	polarapi "pkg.berachain.dev/polaris/eth/polar/api"
 	"pkg.berachain.dev/polaris/eth/rpc"
@@ -58,14 +62,22 @@ type NetworkingStack interface {
 
 	// Start starts the networking stack.
 	Start() error
+
+	// Close stops the networking stack, which stops serving the JSON-RPC APIs.
+	Close() error
 }
 
 // Polaris is the only object that an implementing chain should use.
 type Polaris struct {
 	cfg *Config
+	// rpcLimits are the caps of the rpc calls, initialized from the config and updated at runtime.
+	rpcLimits atomic.Pointer[RPCLimits]
 	// NetworkingStack represents the networking stack responsible for exposes the JSON-RPC APIs.
 	// Although possible, it does not handle p2p networking like its sibling in geth would.
 	stack NetworkingStack
+	// stackMu guards the start of the networking stack against its close.
+	stackMu sync.Mutex
+	closed  bool
 
 	// txPool     *txpool.TxPool
 	// blockchain represents the canonical chain.
@@ -74,6 +86,30 @@ type Polaris struct {
 	// backend is utilize by the api handlers as a middleware between the JSON-RPC APIs and the blockchain.
 	backend Backend
 
+	// nativeTxs lists the native transactions of the blocks of the host chain, if it can.
+	nativeTxs NativeTxsProvider
+	// txHashes translates the hashes of the EVM transactions and of the host chain transactions
+	// that wrapped them, if the host chain indexes them.
+	txHashes HostTxHashesProvider
+	// addresses converts the addresses of the host chain, if it has its own representation.
+	addresses HostAddressConverter
+	// syncStatus reports the sync status of the host chain, if it can.
+	syncStatus HostSyncStatusProvider
+	// bloomBits persists the bloom bits index, if the host chain can.
+	bloomBits BloomBitsStore
+	// logsBloomHeight reports from which block the headers commit to their logs bloom, if the
+	// host chain was upgraded to it.
+	logsBloomHeight HostLogsBloomHeight
+
+	// forwarder forwards the transactions sent over rpc to the sentries in read replica mode.
+	forwarder *txForwarder
+	// localTxs rebroadcasts the transactions sent over rpc until they are included, if enabled.
+	localTxs *localTxs
+	// bloomIndexer builds and serves the bloom bits index of the log filters, if enabled.
+	bloomIndexer *bloomIndexer
+	// shadow re-executes the blocks against an in-memory state, if enabled.
+	shadow *shadowExecutor
+
 	// filterSystem is the filter system that is used by the filter API.
 	// TODO: relocate
 	filterSystem *filters.FilterSystem
@@ -90,6 +126,21 @@ func NewWithNetworkingStack(
 		blockchain: core.NewChain(host),
 		stack:      stack,
 	}
+	pl.SetRPCLimits(RPCLimits{
+		GasCap:       cfg.RPCGasCap,
+		EVMTimeout:   cfg.RPCEVMTimeout,
+		TraceTimeout: cfg.RPCTraceTimeout,
+		TxFeeCap:     cfg.RPCTxFeeCap,
+	})
+	pl.nativeTxs, _ = host.(NativeTxsProvider)
+	pl.txHashes, _ = host.(HostTxHashesProvider)
+	pl.addresses, _ = host.(HostAddressConverter)
+	pl.syncStatus, _ = host.(HostSyncStatusProvider)
+	pl.bloomBits, _ = host.(BloomBitsStore)
+	pl.logsBloomHeight, _ = host.(HostLogsBloomHeight)
+	if cfg.Shadow.Enabled {
+		pl.shadow = newShadowExecutor(&cfg.Shadow, pl, host.GetStatePlugin())
+	}
 	// When creating a Polaris EVM, we allow the implementing chain
 	// to specify their own log handler. If logHandler is nil then we
 	// we use the default geth log handler.
@@ -106,12 +157,55 @@ func NewWithNetworkingStack(
 	return pl
 }
 
+// RPCLimits returns the current caps of the rpc calls.
+func (pl *Polaris) RPCLimits() RPCLimits {
+	return *pl.rpcLimits.Load()
+}
+
+// SetRPCLimits updates the caps of the rpc calls, which apply to the calls served afterwards.
+func (pl *Polaris) SetRPCLimits(limits RPCLimits) {
+	pl.rpcLimits.Store(&limits)
+}
+
 // APIs return the collection of RPC services the polar package offers.
 // NOTE, some of these services probably need to be moved to somewhere else.
 func (pl *Polaris) APIs() []rpc.API {
 	// Grab a bunch of the apis from go-ethereum (thx bae)
 	apis := polarapi.GethAPIs(pl.backend, pl.blockchain)
 
+	// Represent the native transactions of the host chain in the blocks, if enabled. The service
+	// is registered after the `eth` namespace of go-ethereum, whose block methods it overrides.
+	if pl.cfg.RPCNativeTxs && pl.nativeTxs != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "eth",
+			Service:   newNativeTxsAPI(pl.backend, pl.nativeTxs),
+		})
+	}
+
+	// Translate the transaction hashes of the host chain, if it indexes them.
+	if pl.txHashes != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "polaris",
+			Service:   newTxHashesAPI(pl.txHashes),
+		})
+	}
+
+	// Serve the status of the transactions sent over rpc, if they are tracked.
+	if pl.localTxs != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "txpool",
+			Service:   &localTxsAPI{lt: pl.localTxs},
+		})
+	}
+
+	// Convert the addresses of the host chain, if it has its own representation.
+	if pl.addresses != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "polaris",
+			Service:   newAddressAPI(pl.addresses),
+		})
+	}
+
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
 		{
@@ -122,17 +216,83 @@ func (pl *Polaris) APIs() []rpc.API {
 			Namespace: "web3",
 			Service:   polarapi.NewWeb3API(pl.backend),
 		},
+		{
+			Namespace: "polaris",
+			Service:   newContractsAPI(pl.blockchain),
+		},
+		{
+			Namespace: "trace",
+			Service:   newTraceAPI(pl.blockchain),
+		},
+		{
+			Namespace: "debug",
+			Service:   &debugAPI{pl: pl},
+		},
 	}...)
 }
 
 // StartServices notifies the NetworkStack to spin up (i.e json-rpc).
 func (pl *Polaris) StartServices() error {
+	// Forward the transactions to the sentries in read replica mode.
+	if pl.cfg.Replica.Enabled {
+		forwarder, err := newTxForwarder(&pl.cfg.Replica)
+		if err != nil {
+			return err
+		}
+		pl.forwarder = forwarder
+		log.Root().Info("serving as a read replica", "sentries", pl.cfg.Replica.Sentries)
+	}
+
+	// Rebroadcast the transactions sent over rpc until they are included.
+	if pl.cfg.Rebroadcast.Interval > 0 {
+		pl.localTxs = newLocalTxs(&pl.cfg.Rebroadcast, pl)
+		pl.localTxs.start()
+	}
+
+	// Index the bloom bits of the blocks for the log filters, if the host chain can persist them.
+	if pl.cfg.RPCBloomIndex && pl.bloomBits != nil {
+		bloomIndexer, err := newBloomIndexer(pl.bloomBits, pl.blockchain)
+		if err != nil {
+			return err
+		}
+		pl.bloomIndexer = bloomIndexer
+		pl.bloomIndexer.start()
+	}
+
+	// Re-execute the blocks against an in-memory state, to alert on the divergences.
+	if pl.shadow != nil {
+		pl.shadow.start()
+		log.Root().Warn("shadow executing the blocks", "maxLag", pl.cfg.Shadow.MaxLag)
+	}
+
 	// Register the JSON-RPCs with the networking stack.
 	pl.stack.RegisterAPIs(pl.APIs())
 
+	// Serve the faucet of testnets, if enabled.
+	if pl.cfg.Faucet.Enabled {
+		faucet, err := newFaucet(&pl.cfg.Faucet, pl.backend)
+		if err != nil {
+			return err
+		}
+		pl.stack.RegisterAPIs([]rpc.API{{Namespace: "faucet", Service: newFaucetAPI(faucet)}})
+		pl.stack.RegisterHandler("faucet", faucetPath, faucet)
+	}
+
+	// Serve the health and readiness checks of load balancers.
+	pl.stack.RegisterHandler("health", healthPath, &healthHandler{pl: pl})
+	pl.stack.RegisterHandler("ready", readyPath, &healthHandler{pl: pl, ready: true})
+
 	// Register the filter API separately in order to get access to the filterSystem
 	pl.filterSystem = utils.RegisterFilterAPI(pl.stack, pl.backend, &defaultEthConfig)
 
+	// Limit eth_getLogs, whose method of the filter API is overridden, and serve its paginated
+	// variant.
+	lq := &logsQuerier{cfg: &pl.cfg.Logs, chain: pl.blockchain, system: pl.filterSystem}
+	pl.stack.RegisterAPIs([]rpc.API{
+		{Namespace: "eth", Service: &logsAPI{lq: lq}},
+		{Namespace: "polaris", Service: &logsPageAPI{lq: lq}},
+	})
+
 	// Register the GraphQL API (todo update cors stuff)
 	// TODO: gate this behind a flag
 	if err := graphql.New(pl.stack, pl.backend, pl.filterSystem, []string{"*"}, []string{"*"}); err != nil {
@@ -142,9 +302,48 @@ func (pl *Polaris) StartServices() error {
 	go func() {
 		// TODO: unhack this.
 		time.Sleep(2 * time.Second) //nolint:gomnd // we will fix this eventually.
+		pl.stackMu.Lock()
+		defer pl.stackMu.Unlock()
+		if pl.closed {
+			return
+		}
 		if pl.stack.Start() != nil {
 			os.Exit(1)
 		}
 	}()
 	return nil
 }
+
+// sendTx broadcasts the given transaction, to the sentries in read replica mode or to the host
+// chain otherwise.
+func (pl *Polaris) sendTx(ctx context.Context, tx *types.Transaction) error {
+	if pl.forwarder != nil {
+		return pl.forwarder.forward(tx)
+	}
+	return pl.blockchain.SendTx(ctx, tx)
+}
+
+// Close drains and stops the networking stack on shutdown: the JSON-RPC servers stop accepting
+// requests, wait for the in-flight ones and close the WebSocket connections, which ends their
+// subscriptions. The stack is not started if it was not yet.
+func (pl *Polaris) Close() error {
+	pl.stackMu.Lock()
+	defer pl.stackMu.Unlock()
+	if pl.closed {
+		return nil
+	}
+	pl.closed = true
+	if pl.localTxs != nil {
+		pl.localTxs.stop()
+	}
+	if pl.bloomIndexer != nil {
+		pl.bloomIndexer.stop()
+	}
+	if pl.shadow != nil {
+		pl.shadow.stop()
+	}
+	if pl.forwarder != nil {
+		defer pl.forwarder.close()
+	}
+	return pl.stack.Close()
+}
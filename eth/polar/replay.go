@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+	"pkg.berachain.dev/polaris/lib/utils"
+)
+
+// HostLogsBloomHeight is implemented by the host chains that were upgraded to commit to the logs
+// bloom of the blocks in their headers, whose older headers have an empty logs bloom.
+type HostLogsBloomHeight interface {
+	// LogsBloomHeight returns the number of the first block whose header commits to the logs bloom
+	// of its receipts.
+	LogsBloomHeight() uint64
+}
+
+// ReplayReceipts re-executes the transactions of the block with the given number on top of the
+// state of its parent block and returns the resulting receipts. It is used to rebuild receipts
+// that were lost before they could be written to the historical store.
+func (pl *Polaris) ReplayReceipts(ctx context.Context, number uint64) (types.Receipts, error) {
+	if number == 0 {
+		return types.Receipts{}, nil
+	}
+	block := pl.blockchain.GetBlockByNumber(number)
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	gethState, err := pl.blockchain.StateAtBlockNumber(number - 1)
+	if err != nil {
+		return nil, err
+	}
+	receipts, _, err := pl.applyBlock(
+		ctx, block, utils.MustGetAs[vm.PolarisStateDB](gethState), nil, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The replayed receipts must match the logs bloom committed to by the header of the block.
+	// The headers of the blocks before the host chain committed to it have an empty logs bloom.
+	if pl.commitsLogsBloom(number) {
+		if err = core.VerifyLogsBloom(block.Bloom(), receipts); err != nil {
+			return nil, err
+		}
+	}
+	return receipts, nil
+}
+
+// commitsLogsBloom reports whether the header of the block with the given number commits to the
+// logs bloom of its receipts.
+func (pl *Polaris) commitsLogsBloom(number uint64) bool {
+	return pl.logsBloomHeight == nil || number >= pl.logsBloomHeight.LogsBloomHeight()
+}
+
+// ReplayedTx is a transaction of a block replayed by `ReplayBlock`.
+type ReplayedTx struct {
+	Hash  common.Hash
+	Index int
+	// Receipt is the receipt of the replay, and Stored the receipt stored by the chain, nil if it
+	// is missing.
+	Receipt *types.Receipt
+	Stored  *types.Receipt
+	// Diffs are the differences of the stored receipt with the receipt of the replay.
+	Diffs []string
+	// Trace is the output of the tracer, if one was requested.
+	Trace json.RawMessage
+}
+
+// ReplayBlock re-executes the transactions of the block with the given number on top of the state
+// of its parent block, tracing each of them with the tracer requested by the given config if it
+// is not nil, and compares their receipts with the stored ones. It is meant to debug the blocks on
+// which the nodes disagree, as the replay is deterministic.
+func (pl *Polaris) ReplayBlock(
+	ctx context.Context, number uint64, cfg *tracers.TraceConfig,
+) ([]*ReplayedTx, error) {
+	if number == 0 {
+		return nil, ErrGenesisNotTraceable
+	}
+	block := pl.blockchain.GetBlockByNumber(number)
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	gethState, err := pl.blockchain.StateAtBlockNumber(number - 1)
+	if err != nil {
+		return nil, err
+	}
+	var traces []json.RawMessage
+	receipts, _, err := pl.applyBlock(
+		ctx, block, utils.MustGetAs[vm.PolarisStateDB](gethState), cfg, &traces,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := pl.blockchain.GetReceiptsByHash(block.Hash())
+	replayed := make([]*ReplayedTx, len(receipts))
+	for idx, receipt := range receipts {
+		replayed[idx] = &ReplayedTx{
+			Hash:    receipt.TxHash,
+			Index:   idx,
+			Receipt: receipt,
+		}
+		if idx < len(stored) {
+			replayed[idx].Stored = stored[idx]
+			replayed[idx].Diffs = diffReceipts(stored[idx], receipt)
+		} else {
+			replayed[idx].Diffs = []string{"stored receipt missing"}
+		}
+		if traces != nil {
+			replayed[idx].Trace = traces[idx]
+		}
+	}
+	return replayed, nil
+}
+
+// diffReceipts returns the differences of the given stored receipt with the given replayed one.
+func diffReceipts(stored, replayed *types.Receipt) []string {
+	var diffs []string
+	if stored.TxHash != replayed.TxHash {
+		diffs = append(diffs, fmt.Sprintf("tx hash %s, want %s", stored.TxHash, replayed.TxHash))
+	}
+	if stored.Status != replayed.Status {
+		diffs = append(diffs, fmt.Sprintf("status %d, want %d", stored.Status, replayed.Status))
+	}
+	if stored.GasUsed != replayed.GasUsed {
+		diffs = append(diffs, fmt.Sprintf("gas used %d, want %d", stored.GasUsed, replayed.GasUsed))
+	}
+	if stored.CumulativeGasUsed != replayed.CumulativeGasUsed {
+		diffs = append(diffs, fmt.Sprintf("cumulative gas used %d, want %d",
+			stored.CumulativeGasUsed, replayed.CumulativeGasUsed))
+	}
+	if stored.ContractAddress != replayed.ContractAddress {
+		diffs = append(diffs, fmt.Sprintf("contract address %s, want %s",
+			stored.ContractAddress, replayed.ContractAddress))
+	}
+	if len(stored.Logs) != len(replayed.Logs) {
+		return append(diffs, fmt.Sprintf("%d logs, want %d", len(stored.Logs), len(replayed.Logs)))
+	}
+	for i := range stored.Logs {
+		if !equalLogs(stored.Logs[i], replayed.Logs[i]) {
+			diffs = append(diffs, fmt.Sprintf("log %d differs", i))
+		}
+	}
+	return diffs
+}
+
+// equalLogs reports whether the given logs have the same address, topics and data.
+func equalLogs(a, b *types.Log) bool {
+	if a.Address != b.Address || len(a.Topics) != len(b.Topics) || !bytes.Equal(a.Data, b.Data) {
+		return false
+	}
+	for i, topic := range a.Topics {
+		if topic != b.Topics[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// applyBlock executes the transactions of the given block on top of the given state, which is the
+// state of its parent block, and returns the resulting receipts and the gas they used. If the
+// given trace config is not nil, each transaction is traced with the tracer it requests, and the
+// outputs are appended to the given traces.
+func (pl *Polaris) applyBlock(
+	ctx context.Context, block *types.Block, statedb vm.PolarisStateDB,
+	cfg *tracers.TraceConfig, traces *[]json.RawMessage,
+) (types.Receipts, uint64, error) {
+	var (
+		header    = block.Header()
+		blockHash = block.Hash()
+		usedGas   uint64
+		receipts  = make(types.Receipts, 0, len(block.Transactions()))
+		gasPool   = new(core.GasPool).AddGas(header.GasLimit)
+		vmConfig  = pl.blockchain.GetVMConfig()
+		evm       = pl.blockchain.GetEVM(ctx, vm.TxContext{}, statedb, header, vmConfig)
+	)
+	for idx, tx := range block.Transactions() {
+		var tracer tracers.Tracer
+		if cfg != nil {
+			var err error
+			if tracer, err = newTracer(cfg, &tracers.Context{
+				BlockHash:   blockHash,
+				BlockNumber: block.Number(),
+				TxIndex:     idx,
+				TxHash:      tx.Hash(),
+			}); err != nil {
+				return nil, 0, err
+			}
+			tracedConfig := *vmConfig
+			tracedConfig.Tracer = tracer
+			evm = pl.blockchain.GetEVM(ctx, vm.TxContext{}, statedb, header, &tracedConfig)
+		}
+
+		statedb.SetTxContext(tx.Hash(), idx)
+		receipt, _, err := core.ApplyTransactionWithEVMWithResult(
+			evm, pl.blockchain.Config(), gasPool, statedb, header.BaseFee,
+			header.Number, blockHash, header.Time, tx, &usedGas,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		receipt.BlockHash = blockHash
+		receipts = append(receipts, receipt)
+
+		if tracer != nil {
+			trace, err := tracer.GetResult()
+			if err != nil {
+				return nil, 0, err
+			}
+			*traces = append(*traces, trace)
+		}
+	}
+	return receipts, usedGas, nil
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// replayChain is a blockchain with the given blocks, whose states are all pruned.
+type replayChain struct {
+	core.Blockchain
+	blocks map[uint64]*types.Block
+}
+
+func (bc *replayChain) GetBlockByNumber(number uint64) *types.Block {
+	return bc.blocks[number]
+}
+
+func (bc *replayChain) StateAtBlockNumber(uint64) (vm.GethStateDB, error) {
+	return nil, errors.New("state pruned")
+}
+
+// staticLogsBloomHeight is a host chain that commits to the logs bloom from the given height.
+type staticLogsBloomHeight uint64
+
+func (h staticLogsBloomHeight) LogsBloomHeight() uint64 {
+	return uint64(h)
+}
+
+var _ = Describe("Replay", func() {
+	var pl *Polaris
+
+	BeforeEach(func() {
+		pl = &Polaris{blockchain: &replayChain{blocks: map[uint64]*types.Block{
+			5: types.NewBlockWithHeader(&types.Header{Number: big.NewInt(5)}),
+		}}}
+	})
+
+	Context("ReplayReceipts", func() {
+		It("should return no receipts for the genesis block", func() {
+			receipts, err := pl.ReplayReceipts(context.Background(), 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(BeEmpty())
+		})
+
+		It("should fail without the block or the state of its parent", func() {
+			_, err := pl.ReplayReceipts(context.Background(), 4)
+			Expect(err).To(MatchError(ErrBlockNotFound))
+			_, err = pl.ReplayReceipts(context.Background(), 5)
+			Expect(err).To(MatchError("state pruned"))
+		})
+	})
+
+	Context("ReplayBlock", func() {
+		It("should not replay the genesis block", func() {
+			_, err := pl.ReplayBlock(context.Background(), 0, nil)
+			Expect(err).To(MatchError(ErrGenesisNotTraceable))
+		})
+
+		It("should fail without the block or the state of its parent", func() {
+			_, err := pl.ReplayBlock(context.Background(), 4, nil)
+			Expect(err).To(MatchError(ErrBlockNotFound))
+			_, err = pl.ReplayBlock(context.Background(), 5, nil)
+			Expect(err).To(MatchError("state pruned"))
+		})
+	})
+
+	It("should verify the logs bloom from the height the host chain commits to it", func() {
+		Expect(pl.commitsLogsBloom(1)).To(BeTrue())
+		pl.logsBloomHeight = staticLogsBloomHeight(10)
+		Expect(pl.commitsLogsBloom(9)).To(BeFalse())
+		Expect(pl.commitsLogsBloom(10)).To(BeTrue())
+	})
+
+	Context("diffReceipts", func() {
+		var receipt *types.Receipt
+
+		BeforeEach(func() {
+			receipt = &types.Receipt{
+				TxHash:            common.Hash{0x01},
+				Status:            types.ReceiptStatusSuccessful,
+				GasUsed:           21000,
+				CumulativeGasUsed: 42000,
+				Logs: []*types.Log{{
+					Address: common.Address{0x02},
+					Topics:  []common.Hash{{0x03}},
+					Data:    []byte{0x04},
+				}},
+			}
+		})
+
+		// copyReceipt returns a copy of the receipt, with copies of its logs.
+		copyReceipt := func() *types.Receipt {
+			cpy := *receipt
+			cpy.Logs = nil
+			for _, log := range receipt.Logs {
+				logCpy := *log
+				logCpy.Topics = append([]common.Hash{}, log.Topics...)
+				cpy.Logs = append(cpy.Logs, &logCpy)
+			}
+			return &cpy
+		}
+
+		It("should not report the same receipts", func() {
+			replayed := copyReceipt()
+			replayed.BlockNumber = big.NewInt(5)
+			replayed.Logs[0].Index = 3
+			Expect(diffReceipts(receipt, replayed)).To(BeEmpty())
+		})
+
+		It("should report the differences of the receipts", func() {
+			replayed := copyReceipt()
+			replayed.TxHash = common.Hash{0x05}
+			replayed.Status = types.ReceiptStatusFailed
+			replayed.GasUsed = 30000
+			replayed.CumulativeGasUsed = 51000
+			replayed.ContractAddress = common.Address{0x06}
+			replayed.Logs[0].Data = []byte{0x07}
+
+			Expect(diffReceipts(receipt, replayed)).To(Equal([]string{
+				"tx hash " + receipt.TxHash.String() + ", want " + replayed.TxHash.String(),
+				"status 1, want 0",
+				"gas used 21000, want 30000",
+				"cumulative gas used 42000, want 51000",
+				"contract address " + receipt.ContractAddress.String() + ", want " +
+					replayed.ContractAddress.String(),
+				"log 0 differs",
+			}))
+		})
+
+		It("should report a different number of logs", func() {
+			replayed := copyReceipt()
+			replayed.Logs = append(replayed.Logs, &types.Log{})
+			Expect(diffReceipts(receipt, replayed)).To(Equal([]string{"1 logs, want 2"}))
+		})
+	})
+
+	It("should compare the address, topics and data of the logs", func() {
+		log := &types.Log{Address: common.Address{0x01}, Topics: []common.Hash{{0x02}}}
+		Expect(equalLogs(log, &types.Log{
+			Address: common.Address{0x01}, Topics: []common.Hash{{0x02}}, BlockNumber: 5,
+		})).To(BeTrue())
+		Expect(equalLogs(log, &types.Log{Topics: []common.Hash{{0x02}}})).To(BeFalse())
+		Expect(equalLogs(log, &types.Log{Address: common.Address{0x01}})).To(BeFalse())
+		Expect(equalLogs(log, &types.Log{
+			Address: common.Address{0x01}, Topics: []common.Hash{{0x03}},
+		})).To(BeFalse())
+		Expect(equalLogs(log, &types.Log{
+			Address: common.Address{0x01}, Topics: []common.Hash{{0x02}}, Data: []byte{0x01},
+		})).To(BeFalse())
+	})
+})
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+// errNoSentries is returned on start if the read replica mode is enabled without sentries.
+var errNoSentries = errors.New("read replica mode requires at least one sentry endpoint")
+
+// ReplicaConfig is the config of the read replica mode, in which the node serves the JSON-RPC
+// APIs without holding a validator key and forwards the transactions it is sent to sentry nodes.
+// Read traffic is then scaled horizontally with replicas, while writes converge on the
+// validators.
+type ReplicaConfig struct {
+	// Enabled forwards the transactions sent over rpc to the sentries instead of adding them to
+	// the mempool of the node.
+	Enabled bool `toml:""`
+
+	// Sentries are the JSON-RPC endpoints, over HTTP or WebSocket, of the nodes that the
+	// transactions are forwarded to.
+	Sentries []string `toml:""`
+
+	// Timeout is the time given to the sentries to accept a transaction.
+	Timeout time.Duration `toml:""`
+}
+
+// defaultReplicaConfig returns the default config of the read replica mode, which is disabled.
+func defaultReplicaConfig() ReplicaConfig {
+	return ReplicaConfig{
+		Timeout: 5 * time.Second, //nolint:gomnd // the shutdown timeout of go-ethereum.
+	}
+}
+
+// txForwarder forwards the transactions of a read replica to its sentries with
+// `eth_sendRawTransaction`.
+type txForwarder struct {
+	cfg *ReplicaConfig
+
+	// mu guards the clients of the sentries, which are dialed on first use.
+	mu      sync.Mutex
+	clients map[string]*rpc.Client
+}
+
+// newTxForwarder creates a new forwarder to the sentries of the given config.
+func newTxForwarder(cfg *ReplicaConfig) (*txForwarder, error) {
+	if len(cfg.Sentries) == 0 {
+		return nil, errNoSentries
+	}
+	return &txForwarder{
+		cfg:     cfg,
+		clients: make(map[string]*rpc.Client),
+	}, nil
+}
+
+// forward sends the given transaction to all the sentries at once, and returns as soon as one of
+// them accepts it. If none does, the rejection of the first one to answer is returned, e.g. for
+// a nonce too low. The sentries not done yet are given the rest of the timeout.
+func (f *txForwarder) forward(tx *types.Transaction) error {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.cfg.Timeout)
+	var (
+		wg      sync.WaitGroup
+		results = make(chan error, len(f.cfg.Sentries))
+	)
+	for _, endpoint := range f.cfg.Sentries {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+			results <- f.send(ctx, endpoint, bz)
+		}(endpoint)
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+
+	var firstErr error
+	for range f.cfg.Sentries {
+		err := <-results
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// send sends the given encoded transaction to the sentry of the given endpoint.
+func (f *txForwarder) send(ctx context.Context, endpoint string, bz []byte) error {
+	client, err := f.client(ctx, endpoint)
+	if err == nil {
+		err = client.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(bz))
+	}
+	if err != nil {
+		log.Root().Debug("failed to forward transaction", "sentry", endpoint, "err", err)
+	}
+	return err
+}
+
+// client returns the client of the sentry of the given endpoint, which is dialed on first use.
+// The sentry is dialed without holding the lock, so that a slow sentry does not hold back the
+// others; if two dials race, the client stored first is kept and the other is closed.
+func (f *txForwarder) client(ctx context.Context, endpoint string) (*rpc.Client, error) {
+	f.mu.Lock()
+	client, ok := f.clients[endpoint]
+	f.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	dialed, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if client, ok = f.clients[endpoint]; ok {
+		dialed.Close()
+		return client, nil
+	}
+	f.clients[endpoint] = dialed
+	return dialed, nil
+}
+
+// close closes the clients of the sentries.
+func (f *txForwarder) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for endpoint, client := range f.clients {
+		client.Close()
+		delete(f.clients, endpoint)
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"net"
+	"net/http/httptest"
+	"time"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/rpc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// sentryAPI is the `eth` namespace of a sentry, which accepts every transaction.
+type sentryAPI struct {
+	received chan hexutil.Bytes
+}
+
+func (api *sentryAPI) SendRawTransaction(bz hexutil.Bytes) common.Hash {
+	api.received <- bz
+	return common.Hash{}
+}
+
+var _ = Describe("Transaction forwarder", func() {
+	var (
+		api    *sentryAPI
+		sentry *httptest.Server
+		hung   net.Listener
+		tx     = types.NewTx(&types.LegacyTx{Nonce: 1})
+	)
+
+	BeforeEach(func() {
+		api = &sentryAPI{received: make(chan hexutil.Bytes, 1)}
+		server := rpc.NewServer()
+		Expect(server.RegisterName("eth", api)).To(Succeed())
+		sentry = httptest.NewServer(server)
+
+		// a sentry that accepts connections but never completes the websocket handshake.
+		var err error
+		hung, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			for {
+				conn, err := hung.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		sentry.Close()
+		Expect(hung.Close()).To(Succeed())
+	})
+
+	It("should not be held back by a sentry that is slow to dial", func() {
+		f, err := newTxForwarder(&ReplicaConfig{
+			Sentries: []string{"ws://" + hung.Addr().String(), sentry.URL},
+			Timeout:  time.Minute,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		defer f.close()
+
+		done := make(chan error, 1)
+		go func() { done <- f.forward(tx) }()
+		Eventually(done, 5*time.Second).Should(Receive(BeNil()))
+
+		bz, err := tx.MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.received).To(Receive(Equal(hexutil.Bytes(bz))))
+	})
+
+	It("should require a sentry", func() {
+		_, err := newTxForwarder(&ReplicaConfig{})
+		Expect(err).To(MatchError(errNoSentries))
+	})
+})
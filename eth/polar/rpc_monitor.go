@@ -0,0 +1,448 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/gorilla/websocket"
+
+	"pkg.berachain.dev/polaris/eth/log"
+	"pkg.berachain.dev/polaris/eth/rpc"
+)
+
+const (
+	// maxRPCRequestSize is the maximum size of an HTTP JSON-RPC request, the one of go-ethereum.
+	maxRPCRequestSize = 5 * 1024 * 1024
+
+	// wsReadLimit is the maximum size of a WebSocket message, the one of go-ethereum.
+	wsReadLimit = 32 * 1024 * 1024
+
+	// wsBufferSize is the size of the read and write buffers of the WebSocket connections.
+	wsBufferSize = 1024
+
+	// wsCloseTimeout is the time given to write the close message of a WebSocket connection.
+	wsCloseTimeout = time.Second
+
+	// maxLoggedParamLength is the maximum length of the string params of the logged calls. Longer
+	// ones, such as signed transactions and call data, are redacted.
+	maxLoggedParamLength = 66
+)
+
+var (
+	wsConnectionsGauge   = metrics.NewRegisteredGauge("polaris/rpc/ws/connections", nil)
+	wsSubscriptionsGauge = metrics.NewRegisteredGauge("polaris/rpc/ws/subscriptions", nil)
+	wsBacklogGauge       = metrics.NewRegisteredGauge("polaris/rpc/ws/backlog", nil)
+	slowCallsCounter     = metrics.NewRegisteredCounter("polaris/rpc/slow", nil)
+)
+
+// redactedNamespaces are the API namespaces whose params are never logged, as they may hold
+// passwords or keys.
+var redactedNamespaces = []string{"personal", "engine"}
+
+// RPCConnectionStats are the stats of a WebSocket connection of the JSON-RPC server.
+type RPCConnectionStats struct {
+	RemoteAddr  string    `json:"remoteAddr"`
+	ConnectedAt time.Time `json:"connectedAt"`
+	// Subscriptions is the number of active subscriptions of the connection.
+	Subscriptions int `json:"subscriptions"`
+	// Backlog is the number of calls received on the connection and not answered yet.
+	Backlog int `json:"backlog"`
+}
+
+// rpcMonitor tracks the WebSocket connections of the JSON-RPC servers of Polaris, and logs the
+// calls slower than a threshold, to help operators find abusive query patterns. The methods of a
+// nil monitor return the handlers of the RPC server as is.
+type rpcMonitor struct {
+	// slowThreshold is the duration above which calls are logged, or zero to not log them.
+	slowThreshold time.Duration
+
+	mu     sync.Mutex
+	nextID uint64
+	conns  map[uint64]*wsConnection
+}
+
+// newRPCMonitor creates a new monitor, which logs the calls slower than the given threshold.
+func newRPCMonitor(slowThreshold time.Duration) *rpcMonitor {
+	return &rpcMonitor{
+		slowThreshold: slowThreshold,
+		conns:         make(map[uint64]*wsConnection),
+	}
+}
+
+// httpHandler returns the given HTTP handler of an RPC server, which logs the slow calls. As the
+// calls of a batch are answered together, they are all logged with the duration of the batch.
+func (m *rpcMonitor) httpHandler(next http.Handler) http.Handler {
+	if m == nil || m.slowThreshold == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRPCRequestSize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		elapsed := time.Since(start)
+		if elapsed < m.slowThreshold {
+			return
+		}
+		for _, msg := range parseRPCMessages(body) {
+			if msg.Method != "" {
+				m.logSlowCall("http", r.RemoteAddr, msg.Method, msg.Params, elapsed)
+			}
+		}
+	})
+}
+
+// wsHandler returns the WebSocket handler of the given RPC server, which accepts the requests from
+// the given origins and tracks its connections.
+func (m *rpcMonitor) wsHandler(server *rpc.Server, origins []string) http.Handler {
+	if m == nil {
+		return server.WebsocketHandler(origins)
+	}
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  wsBufferSize,
+		WriteBufferSize: wsBufferSize,
+		CheckOrigin: func(r *http.Request) bool {
+			return allowedOrigin(origins, r.Header.Get("Origin"))
+		},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Root().Debug("websocket upgrade failed", "remote", r.RemoteAddr, "err", err)
+			return
+		}
+		conn.SetReadLimit(wsReadLimit)
+
+		c := m.connect(conn, r.RemoteAddr)
+		defer m.disconnect(c)
+		server.ServeCodec(rpc.NewFuncCodec(conn, c.encoder(conn), c.decoder(conn)), 0)
+	})
+}
+
+// connect starts tracking the given new WebSocket connection from the given address.
+func (m *rpcMonitor) connect(conn *websocket.Conn, remoteAddr string) *wsConnection {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c := &wsConnection{
+		monitor:     m,
+		conn:        conn,
+		id:          m.nextID,
+		remoteAddr:  remoteAddr,
+		connectedAt: time.Now(),
+		pending:     make(map[string]rpcMessage),
+	}
+	m.nextID++
+	m.conns[c.id] = c
+	wsConnectionsGauge.Inc(1)
+	return c
+}
+
+// disconnect stops tracking the given closed WebSocket connection.
+func (m *rpcMonitor) disconnect(c *wsConnection) {
+	m.mu.Lock()
+	delete(m.conns, c.id)
+	m.mu.Unlock()
+
+	stats := c.stats()
+	wsConnectionsGauge.Dec(1)
+	wsSubscriptionsGauge.Dec(int64(stats.Subscriptions))
+	wsBacklogGauge.Dec(int64(stats.Backlog))
+	log.Root().Debug(
+		"websocket connection closed", "remote", stats.RemoteAddr,
+		"duration", time.Since(stats.ConnectedAt), "subscriptions", stats.Subscriptions,
+		"backlog", stats.Backlog,
+	)
+}
+
+// closeConnections tells the clients of the open WebSocket connections that the server is going
+// away, so that they can resubscribe elsewhere rather than wait for subscription events which
+// will not come. The RPC server closes the connections when stopped.
+func (m *rpcMonitor) closeConnections() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	conns := make([]*wsConnection, 0, len(m.conns))
+	for _, c := range m.conns {
+		conns = append(conns, c)
+	}
+	m.mu.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	deadline := time.Now().Add(wsCloseTimeout)
+	for _, c := range conns {
+		if err := c.conn.WriteControl(websocket.CloseMessage, msg, deadline); err != nil {
+			log.Root().Debug("websocket close failed", "remote", c.remoteAddr, "err", err)
+		}
+	}
+}
+
+// connectionStats returns the stats of the open WebSocket connections, oldest first.
+func (m *rpcMonitor) connectionStats() []RPCConnectionStats {
+	m.mu.Lock()
+	stats := make([]RPCConnectionStats, 0, len(m.conns))
+	for _, c := range m.conns {
+		stats = append(stats, c.stats())
+	}
+	m.mu.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].ConnectedAt.Before(stats[j].ConnectedAt)
+	})
+	return stats
+}
+
+// logSlowCall logs the given call, with its params redacted, if it is slower than the threshold.
+func (m *rpcMonitor) logSlowCall(
+	transport, remoteAddr, method string, params json.RawMessage, elapsed time.Duration,
+) {
+	if m.slowThreshold == 0 || elapsed < m.slowThreshold {
+		return
+	}
+	slowCallsCounter.Inc(1)
+	log.Root().Warn(
+		"slow rpc call", "method", method, "params", redactParams(method, params),
+		"elapsed", elapsed, "transport", transport, "remote", remoteAddr,
+	)
+}
+
+// wsConnection is a WebSocket connection tracked by an `rpcMonitor`, which matches the calls it
+// receives with their responses.
+type wsConnection struct {
+	monitor     *rpcMonitor
+	conn        *websocket.Conn
+	id          uint64
+	remoteAddr  string
+	connectedAt time.Time
+
+	mu sync.Mutex
+	// pending are the calls not answered yet, by id, with the time they were received.
+	pending       map[string]rpcMessage
+	subscriptions int
+}
+
+// decoder returns the function that reads the messages of the given connection for the RPC
+// server, which tracks the calls received.
+func (c *wsConnection) decoder(conn *websocket.Conn) func(v interface{}) error {
+	return func(v interface{}) error {
+		_, bz, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		c.received(bz)
+		return json.Unmarshal(bz, v)
+	}
+}
+
+// encoder returns the function that writes the messages of the RPC server to the given
+// connection, which tracks the responses sent.
+func (c *wsConnection) encoder(conn *websocket.Conn) func(v interface{}, isErrorResponse bool) error {
+	return func(v interface{}, _ bool) error {
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err = conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+			return err
+		}
+		c.sent(bz)
+		return nil
+	}
+}
+
+// received adds the calls of the given message to the backlog of the connection.
+func (c *wsConnection) received(bz []byte) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, msg := range parseRPCMessages(bz) {
+		if msg.Method == "" || !msg.hasID() {
+			continue
+		}
+		if _, ok := c.pending[string(msg.ID)]; !ok {
+			wsBacklogGauge.Inc(1)
+		}
+		msg.received = now
+		c.pending[string(msg.ID)] = msg
+	}
+}
+
+// sent removes the calls answered by the given message from the backlog of the connection, and
+// counts the subscriptions they open or close.
+func (c *wsConnection) sent(bz []byte) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, msg := range parseRPCMessages(bz) {
+		call, ok := c.pending[string(msg.ID)]
+		if msg.Method != "" || !ok {
+			continue
+		}
+		delete(c.pending, string(msg.ID))
+		wsBacklogGauge.Dec(1)
+
+		switch {
+		case msg.Error != nil:
+		case strings.HasSuffix(call.Method, "_subscribe"):
+			c.subscriptions++
+			wsSubscriptionsGauge.Inc(1)
+		case strings.HasSuffix(call.Method, "_unsubscribe") && string(msg.Result) == "true":
+			c.subscriptions--
+			wsSubscriptionsGauge.Dec(1)
+		}
+		c.monitor.logSlowCall("ws", c.remoteAddr, call.Method, call.Params, now.Sub(call.received))
+	}
+}
+
+// stats returns the current stats of the connection.
+func (c *wsConnection) stats() RPCConnectionStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return RPCConnectionStats{
+		RemoteAddr:    c.remoteAddr,
+		ConnectedAt:   c.connectedAt,
+		Subscriptions: c.subscriptions,
+		Backlog:       len(c.pending),
+	}
+}
+
+// rpcMessage holds the fields of a JSON-RPC call or response needed to track it.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+
+	// received is the time a call was received at.
+	received time.Time
+}
+
+// hasID returns true if the message has an id, i.e. is not a notification.
+func (msg *rpcMessage) hasID() bool {
+	return len(msg.ID) > 0 && string(msg.ID) != "null"
+}
+
+// parseRPCMessages returns the messages of the given JSON-RPC message or batch, or nil if invalid.
+func parseRPCMessages(bz []byte) []rpcMessage {
+	bz = bytes.TrimSpace(bz)
+	if len(bz) > 0 && bz[0] == '[' {
+		var msgs []rpcMessage
+		if err := json.Unmarshal(bz, &msgs); err != nil {
+			return nil
+		}
+		return msgs
+	}
+	var msg rpcMessage
+	if err := json.Unmarshal(bz, &msg); err != nil {
+		return nil
+	}
+	return []rpcMessage{msg}
+}
+
+// redactParams returns the given params of a call to log. The params of the redacted namespaces
+// are never logged, and the long strings, such as signed transactions, are redacted.
+func redactParams(method string, params json.RawMessage) string {
+	for _, namespace := range redactedNamespaces {
+		if strings.HasPrefix(method, namespace+"_") {
+			return "<redacted>"
+		}
+	}
+	if len(params) == 0 {
+		return "[]"
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "<redacted>"
+	}
+	bz, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return "<redacted>"
+	}
+	return string(bz)
+}
+
+// redactValue returns the given decoded JSON value with its long strings redacted.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		if len(v) > maxLoggedParamLength {
+			return fmt.Sprintf("<redacted %d bytes>", len(v))
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	case map[string]interface{}:
+		for key := range v {
+			v[key] = redactValue(v[key])
+		}
+	}
+	return value
+}
+
+// allowedOrigin returns true if WebSocket requests from the given origin are accepted, which is
+// the case of the requests without origin, i.e. not from browsers.
+func allowedOrigin(origins []string, origin string) bool {
+	if origin == "" {
+		return true
+	}
+	var host string
+	if u, err := url.Parse(origin); err == nil {
+		host = u.Host
+	}
+	for _, allowed := range origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) ||
+			(host != "" && strings.EqualFold(allowed, host)) {
+			return true
+		}
+	}
+	return false
+}
+
+// rpcMonitorAPI serves the stats of the WebSocket connections in the `admin` namespace.
+type rpcMonitorAPI struct {
+	monitor *rpcMonitor
+}
+
+// ConnectionStats returns the stats of the open WebSocket connections of the JSON-RPC server.
+func (api *rpcMonitorAPI) ConnectionStats() []RPCConnectionStats {
+	return api.monitor.connectionStats()
+}
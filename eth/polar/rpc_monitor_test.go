@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/polaris/eth/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// captureSlowCalls returns the params of the slow calls logged until the end of the spec, by
+// method.
+func captureSlowCalls() func() map[string][]string {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string][]string)
+	)
+	handler := log.Root().GetHandler()
+	DeferCleanup(func() { log.Root().SetHandler(handler) })
+	log.Root().SetHandler(log.FuncHandler(func(r *log.Record) error {
+		if r.Msg != "slow rpc call" {
+			return nil
+		}
+		fields := make(map[string]interface{})
+		for i := 0; i+1 < len(r.Ctx); i += 2 {
+			fields[r.Ctx[i].(string)] = r.Ctx[i+1]
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		method := fields["method"].(string)
+		calls[method] = append(calls[method], fields["params"].(string))
+		return nil
+	}))
+	return func() map[string][]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+}
+
+var _ = Describe("RPC monitor", func() {
+	It("should parse the calls and batches", func() {
+		msgs := parseRPCMessages([]byte(echoCall))
+		Expect(msgs).To(HaveLen(1))
+		Expect(msgs[0].Method).To(Equal("test_echo"))
+		Expect(msgs[0].hasID()).To(BeTrue())
+
+		msgs = parseRPCMessages([]byte(` [` + echoCall + `,{"jsonrpc":"2.0","method":"n"}]`))
+		Expect(msgs).To(HaveLen(2))
+		Expect(msgs[1].hasID()).To(BeFalse())
+
+		Expect(parseRPCMessages([]byte(`{"id":`))).To(BeNil())
+		Expect(parseRPCMessages([]byte(`[1`))).To(BeNil())
+	})
+
+	It("should redact the params of the logged calls", func() {
+		long := "0x" + strings.Repeat("ab", maxLoggedParamLength)
+		Expect(redactParams("personal_unlockAccount", json.RawMessage(`["0x1","pass"]`))).
+			To(Equal("<redacted>"))
+		Expect(redactParams("engine_newPayloadV1", nil)).To(Equal("<redacted>"))
+		Expect(redactParams("eth_blockNumber", nil)).To(Equal("[]"))
+		Expect(redactParams("eth_getBalance", json.RawMessage(`["0x1", "latest"]`))).
+			To(Equal(`["0x1","latest"]`))
+		Expect(redactParams("eth_sendRawTransaction", json.RawMessage(`["`+long+`"]`))).
+			To(Equal(`["<redacted 134 bytes>"]`))
+		Expect(redactParams("eth_call", json.RawMessage(`[{"data":"`+long+`","gas":1e30}]`))).
+			To(Equal(`[{"data":"<redacted 134 bytes>","gas":1e30}]`))
+		Expect(redactParams("eth_call", json.RawMessage(`[`))).To(Equal("<redacted>"))
+	})
+
+	It("should only accept the websocket requests of the allowed origins", func() {
+		Expect(allowedOrigin(nil, "")).To(BeTrue())
+		Expect(allowedOrigin(nil, "https://app.example")).To(BeFalse())
+		Expect(allowedOrigin([]string{"*"}, "https://app.example")).To(BeTrue())
+		Expect(allowedOrigin([]string{"https://APP.example"}, "https://app.example")).To(BeTrue())
+		Expect(allowedOrigin([]string{"app.example"}, "https://app.example")).To(BeTrue())
+		Expect(allowedOrigin([]string{"app.example"}, "https://evil.example")).To(BeFalse())
+	})
+
+	It("should not wrap the handlers if disabled", func() {
+		next := http.NewServeMux()
+		var m *rpcMonitor
+		Expect(m.httpHandler(next)).To(BeIdenticalTo(next))
+		Expect(newRPCMonitor(0).httpHandler(next)).To(BeIdenticalTo(next))
+		m.closeConnections()
+	})
+
+	It("should log the slow HTTP calls", func() {
+		slowCalls := captureSlowCalls()
+		s := startHTTPServer(
+			httpServerConfig{modules: []string{"test"}, vhosts: []string{"*"}},
+			newRPCMonitor(time.Nanosecond),
+		)
+
+		batch := `[` + echoCall + `,{"jsonrpc":"2.0","id":2,"method":"test_echo","params":["` +
+			strings.Repeat("a", maxLoggedParamLength+1) + `"]}]`
+		res, err := postRPC(http.DefaultClient, "http://"+s.cfg.endpoint, "", batch)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Eventually(slowCalls).Should(HaveKeyWithValue("test_echo", ConsistOf(
+			`["hello"]`, `["<redacted 67 bytes>"]`,
+		)))
+	})
+
+	It("should not log the calls faster than the threshold", func() {
+		slowCalls := captureSlowCalls()
+		s := startHTTPServer(
+			httpServerConfig{modules: []string{"test"}, vhosts: []string{"*"}},
+			newRPCMonitor(time.Hour),
+		)
+
+		res, err := postRPC(http.DefaultClient, "http://"+s.cfg.endpoint, "", echoCall)
+		Expect(err).ToNot(HaveOccurred())
+		res.Body.Close()
+		Expect(slowCalls()).To(BeEmpty())
+	})
+
+	When("serving websocket connections", func() {
+		var (
+			m       *rpcMonitor
+			s       *httpServer
+			client  *rpc.Client
+			stopped bool
+		)
+
+		BeforeEach(func() {
+			m = newRPCMonitor(0)
+			s = newHTTPServer(httpServerConfig{
+				endpoint:  freeEndpoint(),
+				vhosts:    []string{"*"},
+				ws:        true,
+				wsModules: []string{"test"},
+				wsOrigins: []string{"*"},
+				wsOnly:    true,
+			}, m)
+			s.registerAPIs([]rpc.API{{Namespace: "test", Service: echoAPI{}}})
+			Expect(s.Start()).To(Succeed())
+			stopped = false
+
+			var err error
+			client, err = rpc.DialContext(context.Background(), "ws://"+s.cfg.endpoint)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			client.Close()
+			if !stopped {
+				Expect(s.Stop()).To(Succeed())
+			}
+		})
+
+		It("should track the subscriptions of the connections", func() {
+			var res string
+			Expect(client.Call(&res, "test_echo", "hello")).To(Succeed())
+			Eventually(m.connectionStats).Should(ConsistOf(And(
+				HaveField("Backlog", BeZero()), HaveField("Subscriptions", BeZero()),
+			)))
+
+			sub, err := client.Subscribe(context.Background(), "test", make(chan int), "ticks")
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(func() int {
+				return m.connectionStats()[0].Subscriptions
+			}).Should(Equal(1))
+			Expect((&rpcMonitorAPI{monitor: m}).ConnectionStats()).To(Equal(m.connectionStats()))
+
+			sub.Unsubscribe()
+			Eventually(func() int {
+				return m.connectionStats()[0].Subscriptions
+			}).Should(BeZero())
+
+			client.Close()
+			Eventually(m.connectionStats).Should(BeEmpty())
+		})
+
+		It("should tell the clients that the server is going away on stop", func() {
+			sub, err := client.Subscribe(context.Background(), "test", make(chan int), "ticks")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(s.Stop()).To(Succeed())
+			stopped = true
+			Eventually(sub.Err(), 5*time.Second).Should(Receive())
+		})
+	})
+})
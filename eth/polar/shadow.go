@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/state"
+	"pkg.berachain.dev/polaris/eth/core/types"
+	"pkg.berachain.dev/polaris/eth/log"
+)
+
+const (
+	// shadowInterval is the time between two checks for new blocks to re-execute.
+	shadowInterval = time.Second
+	// maxShadowDiffs is the maximum number of state differences logged for a block.
+	maxShadowDiffs = 32
+)
+
+var (
+	shadowHeightGauge        = metrics.NewRegisteredGauge("polaris/shadow/height", nil)
+	shadowDivergencesCounter = metrics.NewRegisteredCounter("polaris/shadow/divergences", nil)
+	shadowFailuresCounter    = metrics.NewRegisteredCounter("polaris/shadow/failures", nil)
+)
+
+// ShadowConfig is the config of the shadow execution, which re-executes every block against an
+// in-memory state on top of the state of its parent block, and compares the results with the
+// ones of the host chain. It is a safety net for the changes of the state plugin on testnets, as
+// it doubles the execution of the blocks.
+//
+// The changes made to the EVM state outside of the transactions, or by the precompiles through
+// the host chain, are not seen by the in-memory state, and are reported as divergences.
+type ShadowConfig struct {
+	// Enabled enables the shadow execution of the blocks.
+	Enabled bool `toml:""`
+
+	// MaxLag is the maximum number of blocks the shadow execution can lag behind the chain. The
+	// blocks further behind are skipped, as their state may be pruned.
+	MaxLag uint64 `toml:""`
+}
+
+// defaultShadowConfig returns the default config of the shadow execution.
+func defaultShadowConfig() ShadowConfig {
+	return ShadowConfig{
+		MaxLag: 100, //nolint:gomnd // a few minutes of blocks.
+	}
+}
+
+// shadowExecutor re-executes the blocks of the chain in the background against an in-memory
+// state, and alerts on the blocks whose receipts or state changes diverge from the chain.
+type shadowExecutor struct {
+	cfg *ShadowConfig
+	pl  *Polaris
+	sp  core.StatePlugin
+	// next is the number of the next block to re-execute, zero until the first check.
+	next uint64
+
+	quit chan struct{}
+	// done is closed once the re-execution stopped, nil if it was never started.
+	done chan struct{}
+	// stopOnce stops the re-execution once.
+	stopOnce sync.Once
+}
+
+// newShadowExecutor creates a new shadow executor of the blocks of the given Polaris, reading the
+// state of the blocks from the given state plugin.
+func newShadowExecutor(cfg *ShadowConfig, pl *Polaris, sp core.StatePlugin) *shadowExecutor {
+	return &shadowExecutor{
+		cfg:  cfg,
+		pl:   pl,
+		sp:   sp,
+		quit: make(chan struct{}),
+	}
+}
+
+// start starts re-executing the new blocks.
+func (se *shadowExecutor) start() {
+	se.done = make(chan struct{})
+	go func() {
+		defer close(se.done)
+		ticker := time.NewTicker(shadowInterval)
+		defer ticker.Stop()
+		for {
+			se.catchUp()
+			select {
+			case <-ticker.C:
+			case <-se.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops re-executing the blocks. Stopping it twice has no effect.
+func (se *shadowExecutor) stop() {
+	se.stopOnce.Do(func() {
+		close(se.quit)
+		if se.done != nil {
+			<-se.done
+		}
+	})
+}
+
+// catchUp re-executes the blocks whose state was committed since the last check, until it is
+// stopped. A block is re-executed once it is followed by another, so that its state is committed.
+func (se *shadowExecutor) catchUp() {
+	head := se.pl.blockchain.CurrentBlock()
+	if head == nil || head.Number.Uint64() < 2 { //nolint:gomnd // the parent and the block.
+		return
+	}
+	last := head.Number.Uint64() - 1
+	if se.next == 0 {
+		// The blocks before the start of the node are not re-executed.
+		se.next = last
+	}
+	if last >= se.next && last-se.next > se.cfg.MaxLag {
+		log.Root().Warn(
+			"shadow execution is lagging, skipping blocks", "from", se.next, "to", last-se.cfg.MaxLag,
+		)
+		se.next = last - se.cfg.MaxLag
+	}
+
+	for ; se.next <= last; se.next++ {
+		select {
+		case <-se.quit:
+			return
+		default:
+		}
+		diffs, err := se.check(se.next)
+		if err != nil {
+			shadowFailuresCounter.Inc(1)
+			log.Root().Error("failed to shadow execute block", "block", se.next, "err", err)
+			continue
+		}
+		shadowHeightGauge.Update(int64(se.next))
+		if len(diffs) == 0 {
+			continue
+		}
+		shadowDivergencesCounter.Inc(1)
+		log.Root().Error("shadow execution diverged", "block", se.next, "differences", len(diffs))
+		for i, diff := range diffs {
+			if i == maxShadowDiffs {
+				log.Root().Error("shadow execution diverged", "block", se.next, "omitted", len(diffs)-i)
+				break
+			}
+			log.Root().Error("shadow execution diverged", "block", se.next, "difference", diff)
+		}
+	}
+}
+
+// check re-executes the block with the given number against an in-memory state on top of the
+// state of its parent block, and returns the differences of the results with the ones committed
+// to by the block and with the state of the chain after it.
+func (se *shadowExecutor) check(number uint64) ([]string, error) {
+	block := se.pl.blockchain.GetBlockByNumber(number)
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+	parent, err := se.sp.StateAtBlockNumber(number - 1)
+	if err != nil {
+		return nil, err
+	}
+	overlay := state.NewOverlay(parent)
+	receipts, usedGas, err := se.pl.applyBlock(
+		context.Background(), block, state.NewStateDB(overlay), nil, nil,
+	)
+	if err != nil {
+		// A block of the chain which cannot be re-executed diverges.
+		return []string{fmt.Sprintf("execution failed: %v", err)}, nil
+	}
+
+	var diffs []string
+	header := block.Header()
+	if usedGas != header.GasUsed {
+		diffs = append(diffs, fmt.Sprintf("gas used %d, want %d", header.GasUsed, usedGas))
+	}
+	if root := types.DeriveSha(receipts, trie.NewStackTrie(nil)); root != header.ReceiptHash {
+		diffs = append(diffs, fmt.Sprintf("receipts root %s, want %s", header.ReceiptHash, root))
+	}
+	if se.pl.commitsLogsBloom(number) {
+		if err = core.VerifyLogsBloom(header.Bloom, receipts); err != nil {
+			diffs = append(diffs, err.Error())
+		}
+	}
+
+	post, err := se.sp.StateAtBlockNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	return append(diffs, overlay.Diff(post)...), nil
+}
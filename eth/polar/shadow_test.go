@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// shadowChain is a blockchain with the given head, whose blocks are found only if set, and which
+// records the blocks requested.
+type shadowChain struct {
+	core.Blockchain
+
+	mu        sync.Mutex
+	head      *types.Header
+	found     bool
+	requested []uint64
+}
+
+func (bc *shadowChain) CurrentBlock() *types.Header {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return bc.head
+}
+
+func (bc *shadowChain) GetBlockByNumber(number uint64) *types.Block {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.requested = append(bc.requested, number)
+	if !bc.found {
+		return nil
+	}
+	return types.NewBlockWithHeader(&types.Header{Number: new(big.Int).SetUint64(number)})
+}
+
+// requestedBlocks returns the blocks requested since the last call.
+func (bc *shadowChain) requestedBlocks() []uint64 {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	requested := bc.requested
+	bc.requested = nil
+	return requested
+}
+
+// prunedStatePlugin is a state plugin whose states are all pruned.
+type prunedStatePlugin struct {
+	core.StatePlugin
+}
+
+func (sp *prunedStatePlugin) StateAtBlockNumber(uint64) (core.StatePlugin, error) {
+	return nil, errors.New("state pruned")
+}
+
+var _ = Describe("Shadow execution", func() {
+	var (
+		chain *shadowChain
+		se    *shadowExecutor
+	)
+
+	BeforeEach(func() {
+		chain = &shadowChain{}
+		cfg := &ShadowConfig{Enabled: true, MaxLag: 10}
+		se = newShadowExecutor(cfg, &Polaris{blockchain: chain}, &prunedStatePlugin{})
+	})
+
+	// setHead sets the head of the chain to the block with the given number.
+	setHead := func(number int64) {
+		chain.mu.Lock()
+		defer chain.mu.Unlock()
+		chain.head = &types.Header{Number: big.NewInt(number)}
+	}
+
+	Context("catchUp", func() {
+		It("should wait for a block followed by another", func() {
+			se.catchUp()
+			setHead(1)
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(BeEmpty())
+			Expect(se.next).To(BeZero())
+		})
+
+		It("should re-execute the blocks from the start of the node", func() {
+			setHead(50)
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(Equal([]uint64{49}))
+			Expect(se.next).To(Equal(uint64(50)))
+
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(BeEmpty())
+
+			setHead(53)
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(Equal([]uint64{50, 51, 52}))
+			Expect(se.next).To(Equal(uint64(53)))
+		})
+
+		It("should skip the blocks behind the max lag", func() {
+			setHead(50)
+			se.catchUp()
+			chain.requestedBlocks()
+
+			setHead(70)
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(Equal([]uint64{
+				59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69,
+			}))
+		})
+
+		It("should stop once stopped", func() {
+			setHead(50)
+			se.stop()
+			se.catchUp()
+			Expect(chain.requestedBlocks()).To(BeEmpty())
+		})
+	})
+
+	Context("check", func() {
+		It("should fail for the blocks not found", func() {
+			_, err := se.check(5)
+			Expect(err).To(MatchError(ErrBlockNotFound))
+		})
+
+		It("should fail without the state of the parent block", func() {
+			chain.found = true
+			_, err := se.check(5)
+			Expect(err).To(MatchError("state pruned"))
+		})
+	})
+
+	Context("stop", func() {
+		It("should stop the re-execution once", func() {
+			setHead(50)
+			se.start()
+			Eventually(func() uint64 {
+				chain.mu.Lock()
+				defer chain.mu.Unlock()
+				return uint64(len(chain.requested))
+			}).Should(BeNumerically(">", 0))
+
+			se.stop()
+			Expect(se.done).To(BeClosed())
+			Expect(se.stop).ToNot(Panic())
+		})
+
+		It("should stop a re-execution that was never started", func() {
+			Expect(se.stop).ToNot(Panic())
+			Expect(se.stop).ToNot(Panic())
+		})
+	})
+})
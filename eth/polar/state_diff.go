@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package polar
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/common/hexutil"
+)
+
+// StateDiffTracer is the name of the tracer that returns the changes a transaction made to the
+// balance, nonce, code and storage of each account, in the format of the `stateDiff` trace mode
+// of OpenEthereum, which accounting and compliance tools consume.
+const StateDiffTracer = "stateDiffTracer"
+
+// The markers of the `stateDiff` trace mode, which tell how a value of an account changed.
+const (
+	// diffSame is the value of an unchanged field.
+	diffSame = "="
+	// diffBorn marks the value of a field of a created account.
+	diffBorn = "+"
+	// diffDied marks the value of a field of a deleted account.
+	diffDied = "-"
+	// diffChanged marks the value before and after of a changed field.
+	diffChanged = "*"
+)
+
+func init() {
+	RegisterTracer(StateDiffTracer, newStateDiffTracer)
+}
+
+// AccountDiff is the change a transaction made to an account. Each field is either `"="` if it is
+// unchanged, `{"+": value}` if the account was created, `{"-": value}` if it was deleted, or
+// `{"*": {"from": before, "to": after}}` if it was changed.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// ValueChange is the value of a field before and after it was changed.
+type ValueChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// prestateAccount is an account of the result of the prestate tracer of go-ethereum in diff mode.
+// Its fields are omitted in the post state if they are unchanged.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Nonce   *uint64                     `json:"nonce"`
+	Code    *hexutil.Bytes              `json:"code"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// stateDiffTracer records the state changes of a transaction with the prestate tracer of
+// go-ethereum in diff mode, and returns them in the `stateDiff` format.
+type stateDiffTracer struct {
+	tracers.Tracer
+}
+
+// newStateDiffTracer returns a new state diff tracer, which takes no config.
+func newStateDiffTracer(tctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	prestate, err := tracers.DefaultDirectory.New(
+		"prestateTracer", tctx, json.RawMessage(`{"diffMode":true}`),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &stateDiffTracer{Tracer: prestate}, nil
+}
+
+// GetResult returns the changes of the accounts, keyed by address.
+func (t *stateDiffTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.Tracer.GetResult()
+	if err != nil {
+		return nil, err
+	}
+	var prestate struct {
+		Pre  map[common.Address]*prestateAccount `json:"pre"`
+		Post map[common.Address]*prestateAccount `json:"post"`
+	}
+	if err = json.Unmarshal(res, &prestate); err != nil {
+		return nil, err
+	}
+	return json.Marshal(stateDiff(prestate.Pre, prestate.Post))
+}
+
+// stateDiff returns the changes of the accounts between the given pre and post states, as
+// returned by the prestate tracer in diff mode: an account only in the post state was created,
+// an account only in the pre state was deleted, and the unchanged fields of the other accounts
+// are omitted from the post state.
+func stateDiff(pre, post map[common.Address]*prestateAccount) map[common.Address]*AccountDiff {
+	diffs := make(map[common.Address]*AccountDiff, len(post))
+	for addr, after := range post {
+		before, ok := pre[addr]
+		if !ok {
+			diffs[addr] = bornOrDied(diffBorn, after)
+			continue
+		}
+		diff := &AccountDiff{
+			Balance: changed(balanceOf(before), balanceOf(after), after.Balance != nil),
+			Nonce:   changed(nonceOf(before), nonceOf(after), after.Nonce != nil),
+			Code:    changed(codeOf(before), codeOf(after), after.Code != nil),
+			Storage: make(map[common.Hash]interface{}),
+		}
+		// the slots that changed are in the pre state, unless they were empty, and in the post
+		// state, unless they were emptied.
+		for slot, value := range before.Storage {
+			diff.Storage[slot] = map[string]*ValueChange{
+				diffChanged: {From: value, To: after.Storage[slot]},
+			}
+		}
+		for slot, value := range after.Storage {
+			diff.Storage[slot] = map[string]*ValueChange{
+				diffChanged: {From: before.Storage[slot], To: value},
+			}
+		}
+		diffs[addr] = diff
+	}
+	for addr, before := range pre {
+		if _, ok := post[addr]; !ok {
+			diffs[addr] = bornOrDied(diffDied, before)
+		}
+	}
+	return diffs
+}
+
+// bornOrDied returns the diff of an account that was created or deleted, with the given marker.
+func bornOrDied(marker string, account *prestateAccount) *AccountDiff {
+	diff := &AccountDiff{
+		Balance: map[string]interface{}{marker: balanceOf(account)},
+		Nonce:   map[string]interface{}{marker: nonceOf(account)},
+		Code:    map[string]interface{}{marker: codeOf(account)},
+		Storage: make(map[common.Hash]interface{}, len(account.Storage)),
+	}
+	for slot, value := range account.Storage {
+		diff.Storage[slot] = map[string]interface{}{marker: value}
+	}
+	return diff
+}
+
+// changed returns the diff of a field of an account that exists before and after the transaction.
+func changed(from, to interface{}, isChanged bool) interface{} {
+	if !isChanged {
+		return diffSame
+	}
+	return map[string]*ValueChange{diffChanged: {From: from, To: to}}
+}
+
+// balanceOf returns the balance of the given account, which is omitted if zero.
+func balanceOf(account *prestateAccount) *hexutil.Big {
+	if account.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return account.Balance
+}
+
+// nonceOf returns the nonce of the given account, which is omitted if zero.
+func nonceOf(account *prestateAccount) hexutil.Uint64 {
+	if account.Nonce == nil {
+		return 0
+	}
+	return hexutil.Uint64(*account.Nonce)
+}
+
+// codeOf returns the code of the given account, which is omitted if empty.
+func codeOf(account *prestateAccount) hexutil.Bytes {
+	if account.Code == nil {
+		return hexutil.Bytes{}
+	}
+	return *account.Code
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("State diff tracer", func() {
+	const (
+		slot1 = "0x0000000000000000000000000000000000000000000000000000000000000001"
+		slot2 = "0x0000000000000000000000000000000000000000000000000000000000000002"
+		value = "0x00000000000000000000000000000000000000000000000000000000000000aa"
+		empty = "0x0000000000000000000000000000000000000000000000000000000000000000"
+	)
+
+	// diffOf returns the state diff of the given result of the prestate tracer in diff mode.
+	diffOf := func(prestate string) (json.RawMessage, error) {
+		return (&stateDiffTracer{Tracer: &configTracer{cfg: json.RawMessage(prestate)}}).GetResult()
+	}
+
+	It("should return the changes of the accounts in the stateDiff format", func() {
+		diff, err := diffOf(`{
+			"pre": {
+				"0x0000000000000000000000000000000000000001": {
+					"balance": "0x10", "nonce": 1,
+					"storage": {"` + slot1 + `": "` + value + `"}
+				},
+				"0x0000000000000000000000000000000000000003": {"balance": "0x5", "code": "0x60"}
+			},
+			"post": {
+				"0x0000000000000000000000000000000000000001": {
+					"balance": "0x20",
+					"storage": {"` + slot2 + `": "` + value + `"}
+				},
+				"0x0000000000000000000000000000000000000002": {"balance": "0x1", "nonce": 1}
+			}
+		}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(diff).To(MatchJSON(`{
+			"0x0000000000000000000000000000000000000001": {
+				"balance": {"*": {"from": "0x10", "to": "0x20"}},
+				"nonce": "=",
+				"code": "=",
+				"storage": {
+					"` + slot1 + `": {"*": {"from": "` + value + `", "to": "` + empty + `"}},
+					"` + slot2 + `": {"*": {"from": "` + empty + `", "to": "` + value + `"}}
+				}
+			},
+			"0x0000000000000000000000000000000000000002": {
+				"balance": {"+": "0x1"},
+				"nonce": {"+": "0x1"},
+				"code": {"+": "0x"},
+				"storage": {}
+			},
+			"0x0000000000000000000000000000000000000003": {
+				"balance": {"-": "0x5"},
+				"nonce": {"-": "0x0"},
+				"code": {"-": "0x60"},
+				"storage": {}
+			}
+		}`))
+	})
+
+	It("should return the errors of the prestate tracer", func() {
+		_, err := diffOf(`{"pre":`)
+		Expect(err).To(HaveOccurred())
+	})
+})
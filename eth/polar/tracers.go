@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	"pkg.berachain.dev/polaris/eth/common"
+	"pkg.berachain.dev/polaris/eth/core"
+	"pkg.berachain.dev/polaris/eth/core/vm"
+
+	// Register the JavaScript tracer engine and the native tracers with the tracer directory.
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+)
+
+// TracerConstructor creates a tracer for a transaction from the config given in the trace
+// request, i.e. the raw `tracerConfig` field of the request, which may be empty.
+type TracerConstructor = func(tctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error)
+
+// RegisterTracer compiles in a custom Go tracer, which is then available to
+// `debug_traceTransaction` under the given name, next to the native tracers of go-ethereum
+// (e.g. `callTracer` or `prestateTracer`). A tracer registered under the name of an existing
+// tracer replaces it. It is meant to be called by chains from an `init` function, before the
+// node starts serving requests.
+func RegisterTracer(name string, ctor TracerConstructor) {
+	tracers.DefaultDirectory.Register(name, ctor, false)
+}
+
+const (
+	// defaultTraceTimeout is the amount of time a tracer may run for, as in go-ethereum.
+	defaultTraceTimeout = 5 * time.Second
+	// defaultMaxTraceTimeout is the default maximum amount of time a tracer may run for, whatever
+	// the timeout requested in its config.
+	defaultMaxTraceTimeout = 30 * time.Second
+)
+
+// errTraceTimeout is returned by a tracer that ran for longer than its timeout.
+var errTraceTimeout = errors.New("execution timeout")
+
+// newTracer returns the tracer requested by the given config. It is the struct logger unless the
+// config names a tracer, which is either the JavaScript code of a tracer or the name of a
+// registered one, which is given the tracer config of the request.
+func newTracer(cfg *tracers.TraceConfig, tctx *tracers.Context) (tracers.Tracer, error) {
+	if cfg == nil || cfg.Tracer == nil {
+		var logCfg *logger.Config
+		if cfg != nil {
+			logCfg = cfg.Config
+		}
+		return logger.NewStructLogger(logCfg), nil
+	}
+	return tracers.DefaultDirectory.New(*cfg.Tracer, tctx, cfg.TracerConfig)
+}
+
+// traceTimeout returns the timeout requested by the given trace config, the default one if it
+// requests none, capped by the given maximum unless it is 0.
+func traceTimeout(cfg *tracers.TraceConfig, maxTimeout time.Duration) (time.Duration, error) {
+	timeout := defaultTraceTimeout
+	if cfg != nil && cfg.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*cfg.Timeout); err != nil {
+			return 0, err
+		}
+	}
+	if maxTimeout != 0 && timeout > maxTimeout {
+		timeout = maxTimeout
+	}
+	return timeout, nil
+}
+
+// traceMessage applies the message with the given EVM, whose config holds the given tracer, and
+// returns the result of the tracer. The tracer and the EVM are stopped once the timeout of the
+// config, capped by the given maximum, expires.
+func traceMessage(
+	ctx context.Context, evm *vm.GethEVM, msg *core.Message,
+	tracer tracers.Tracer, cfg *tracers.TraceConfig, maxTimeout time.Duration,
+) (json.RawMessage, error) {
+	timeout, err := traceTimeout(cfg, maxTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errTraceTimeout)
+			evm.Cancel()
+		}
+	}()
+
+	if _, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.GasLimit)); err != nil {
+		return nil, err
+	}
+	return tracer.GetResult()
+}
+
+// debugAPI serves `debug_traceTransaction`, which re-executes a transaction with the requested
+// tracer, e.g. `stateDiffTracer` for the changes it made to the accounts.
+type debugAPI struct {
+	pl *Polaris
+}
+
+// TraceTransaction returns the output of the tracer requested by the given config for the
+// transaction with the given hash.
+func (api *debugAPI) TraceTransaction(
+	ctx context.Context, hash common.Hash, cfg *tracers.TraceConfig,
+) (json.RawMessage, error) {
+	return api.pl.TraceTransaction(ctx, hash, cfg)
+}
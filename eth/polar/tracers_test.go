@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// configTracer is a custom tracer that returns the tracer config it was created with.
+type configTracer struct {
+	*logger.StructLogger
+	cfg json.RawMessage
+}
+
+func (t *configTracer) GetResult() (json.RawMessage, error) {
+	return t.cfg, nil
+}
+
+var _ = Describe("Tracers", func() {
+	tctx := &tracers.Context{}
+
+	It("should fall back to the struct logger", func() {
+		tracer, err := newTracer(nil, tctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tracer).To(BeAssignableToTypeOf(&logger.StructLogger{}))
+	})
+
+	It("should create the registered tracers with the config of the request", func() {
+		name := "configTracer"
+		RegisterTracer(name, func(_ *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+			return &configTracer{StructLogger: logger.NewStructLogger(nil), cfg: cfg}, nil
+		})
+
+		cfg := json.RawMessage(`{"depth":2}`)
+		tracer, err := newTracer(&tracers.TraceConfig{Tracer: &name, TracerConfig: cfg}, tctx)
+		Expect(err).ToNot(HaveOccurred())
+		result, err := tracer.GetResult()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(MatchJSON(cfg))
+
+		unknown := "unknownTracer"
+		_, err = newTracer(&tracers.TraceConfig{Tracer: &unknown}, tctx)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should register the native tracers and the state diff tracer", func() {
+		for _, name := range []string{"callTracer", "prestateTracer", StateDiffTracer} {
+			tracer, err := newTracer(&tracers.TraceConfig{Tracer: &name}, tctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tracer).ToNot(BeNil())
+		}
+	})
+
+	It("should cap the requested timeout", func() {
+		timeout, err := traceTimeout(nil, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeout).To(Equal(defaultTraceTimeout))
+
+		requested := "1h"
+		cfg := &tracers.TraceConfig{Timeout: &requested}
+		timeout, err = traceTimeout(cfg, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeout).To(Equal(time.Minute))
+		timeout, err = traceTimeout(cfg, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeout).To(Equal(time.Hour))
+
+		requested = "1s"
+		timeout, err = traceTimeout(cfg, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(timeout).To(Equal(time.Second))
+
+		requested = "forever"
+		_, err = traceTimeout(cfg, time.Minute)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Berachain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package polar
+
+import (
+	"context"
+
+	"pkg.berachain.dev/polaris/eth/common"
+)
+
+// HostTxHashesProvider is implemented by the host chains that index the hashes of the
+// transactions of the host chain that wrapped the EVM transactions.
+type HostTxHashesProvider interface {
+	// GetHostTxHash returns the hash of the host chain transaction that wrapped the EVM
+	// transaction with the given hash.
+	GetHostTxHash(ethTxHash common.Hash) (common.Hash, error)
+	// GetEthTxHash returns the hash of the EVM transaction wrapped by the host chain transaction
+	// with the given hash.
+	GetEthTxHash(hostTxHash common.Hash) (common.Hash, error)
+}
+
+// txHashesAPI serves the `polaris` namespace methods that translate between the hashes of the EVM
+// transactions and the ones of the host chain transactions that wrapped them, so that a
+// transaction can be located in the explorers of both ecosystems.
+type txHashesAPI struct {
+	thp HostTxHashesProvider
+}
+
+// newTxHashesAPI creates a new `polaris` namespace service that translates the transaction hashes
+// with the given provider.
+func newTxHashesAPI(thp HostTxHashesProvider) *txHashesAPI {
+	return &txHashesAPI{thp: thp}
+}
+
+// GetHostTransactionHash returns the hash of the host chain transaction that wrapped the EVM
+// transaction with the given hash, or nil if it is unknown.
+func (api *txHashesAPI) GetHostTransactionHash(
+	_ context.Context, hash common.Hash,
+) (*common.Hash, error) {
+	hostTxHash, err := api.thp.GetHostTxHash(hash)
+	if err != nil {
+		return nil, nil //nolint:nilnil // null is returned for unknown transactions.
+	}
+	return &hostTxHash, nil
+}
+
+// GetEthTransactionHash returns the hash of the EVM transaction wrapped by the host chain
+// transaction with the given hash, or nil if it is unknown or did not wrap an EVM transaction.
+func (api *txHashesAPI) GetEthTransactionHash(
+	_ context.Context, hash common.Hash,
+) (*common.Hash, error) {
+	ethTxHash, err := api.thp.GetEthTxHash(hash)
+	if err != nil {
+		return nil, nil //nolint:nilnil // null is returned for unknown transactions.
+	}
+	return &ethTxHash, nil
+}
@@ -28,14 +28,20 @@ type (
 	API               = rpc.API
 	BlockNumber       = rpc.BlockNumber
 	BlockNumberOrHash = rpc.BlockNumberOrHash
+	Client            = rpc.Client
+	HTTPTimeouts      = rpc.HTTPTimeouts
 	Server            = rpc.Server
 )
 
 var (
-	NewServer            = rpc.NewServer
-	SafeBlockNumber      = rpc.SafeBlockNumber
-	FinalizedBlockNumber = rpc.FinalizedBlockNumber
-	LatestBlockNumber    = rpc.LatestBlockNumber
-	PendingBlockNumber   = rpc.PendingBlockNumber
-	EarliestBlockNumber  = rpc.EarliestBlockNumber
+	NewServer                   = rpc.NewServer
+	NewFuncCodec                = rpc.NewFuncCodec
+	DialContext                 = rpc.DialContext
+	BlockNumberOrHashWithNumber = rpc.BlockNumberOrHashWithNumber
+	SafeBlockNumber             = rpc.SafeBlockNumber
+	FinalizedBlockNumber        = rpc.FinalizedBlockNumber
+	LatestBlockNumber           = rpc.LatestBlockNumber
+	PendingBlockNumber          = rpc.PendingBlockNumber
+	EarliestBlockNumber         = rpc.EarliestBlockNumber
+	PeerInfoFromContext         = rpc.PeerInfoFromContext
 )
@@ -64,3 +64,27 @@ type CloneableStack[T libtypes.Cloneable[T]] interface {
 	// CloneableStack implements `Cloneable`.
 	libtypes.Cloneable[CloneableStack[T]]
 }
+
+// Journal is an append-only log of changes, which can be reverted to any of its previous sizes by
+// undoing the changes appended since, most recent first.
+type Journal[T any] interface {
+	// Journal implements `Cloneable`, shallow copying the entries.
+	libtypes.Cloneable[Journal[T]]
+
+	// Append adds a new entry to the end of the journal and returns the size of the journal before
+	// the append.
+	Append(entry T) int
+
+	// PeekAt returns the entry at the given index.
+	PeekAt(index int) T
+
+	// RevertToSize calls undo on every entry after and including the given size, most recent
+	// first, and discards them.
+	RevertToSize(size int, undo func(T))
+
+	// Reset discards all entries without undoing them.
+	Reset()
+
+	// Size returns the current number of entries in the journal.
+	Size() int
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package journal
+
+import (
+	"pkg.berachain.dev/polaris/lib/ds"
+)
+
+// journal is an append-only log of entries, implemented by the built-in `append` operation.
+// Reverting only truncates the slice, so the buffer allocated by deep call stacks is reused for
+// the rest of the transaction.
+type journal[T any] struct {
+	entries []T
+}
+
+// New creates a new, empty journal with the given initial capacity.
+func New[T any](initialCapacity int) ds.Journal[T] {
+	return &journal[T]{
+		entries: make([]T, 0, initialCapacity),
+	}
+}
+
+// Append implements `Journal`.
+func (j *journal[T]) Append(entry T) int {
+	j.entries = append(j.entries, entry)
+	return len(j.entries) - 1
+}
+
+// PeekAt implements `Journal`.
+func (j *journal[T]) PeekAt(index int) T {
+	if index < 0 || index >= len(j.entries) {
+		panic("index out of bounds")
+	}
+	return j.entries[index]
+}
+
+// RevertToSize implements `Journal`.
+func (j *journal[T]) RevertToSize(size int, undo func(T)) {
+	if size < 0 || size > len(j.entries) {
+		panic("size out of bounds")
+	}
+
+	var zero T
+	for i := len(j.entries) - 1; i >= size; i-- {
+		undo(j.entries[i])
+		j.entries[i] = zero // release the reference to the entry
+	}
+	j.entries = j.entries[:size]
+}
+
+// Reset implements `Journal`.
+func (j *journal[T]) Reset() {
+	var zero T
+	for i := range j.entries {
+		j.entries[i] = zero
+	}
+	j.entries = j.entries[:0]
+}
+
+// Size implements `Journal`.
+func (j *journal[T]) Size() int {
+	return len(j.entries)
+}
+
+// Clone implements `Journal`.
+func (j *journal[T]) Clone() ds.Journal[T] {
+	entries := make([]T, len(j.entries), cap(j.entries))
+	copy(entries, j.entries)
+	return &journal[T]{entries: entries}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package journal_test
+
+import (
+	"testing"
+
+	"pkg.berachain.dev/polaris/lib/ds"
+	"pkg.berachain.dev/polaris/lib/ds/journal"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestJournal(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "lib/ds/journal")
+}
+
+var _ = Describe("Journal", func() {
+	var (
+		j      ds.Journal[int]
+		undone []int
+		undo   = func(i int) { undone = append(undone, i) }
+	)
+
+	BeforeEach(func() {
+		j = journal.New[int](1)
+		undone = nil
+	})
+
+	It("should return the size before each append", func() {
+		Expect(j.Append(10)).To(Equal(0))
+		Expect(j.Append(20)).To(Equal(1))
+		Expect(j.Size()).To(Equal(2))
+		Expect(j.PeekAt(1)).To(Equal(20))
+		Expect(func() { j.PeekAt(2) }).To(Panic())
+	})
+
+	When("reverting to a size", func() {
+		BeforeEach(func() {
+			for i := 1; i <= 5; i++ {
+				j.Append(i)
+			}
+		})
+
+		It("should undo the entries after the size, most recent first", func() {
+			j.RevertToSize(2, undo)
+			Expect(undone).To(Equal([]int{5, 4, 3}))
+			Expect(j.Size()).To(Equal(2))
+		})
+
+		It("should be a no-op when reverting to the current size", func() {
+			j.RevertToSize(5, undo)
+			Expect(undone).To(BeEmpty())
+			Expect(j.Size()).To(Equal(5))
+		})
+
+		It("should allow appending after a revert", func() {
+			j.RevertToSize(1, undo)
+			Expect(j.Append(6)).To(Equal(1))
+			Expect(j.PeekAt(1)).To(Equal(6))
+		})
+
+		It("should panic on an out of bounds size", func() {
+			Expect(func() { j.RevertToSize(6, undo) }).To(Panic())
+			Expect(func() { j.RevertToSize(-1, undo) }).To(Panic())
+		})
+	})
+
+	It("should reset without undoing", func() {
+		j.Append(1)
+		j.Append(2)
+		j.Reset()
+		Expect(j.Size()).To(Equal(0))
+		j.RevertToSize(0, undo)
+		Expect(undone).To(BeEmpty())
+	})
+
+	It("should clone independently", func() {
+		j.Append(1)
+		clone := j.Clone()
+		clone.Append(2)
+		Expect(j.Size()).To(Equal(1))
+		Expect(clone.Size()).To(Equal(2))
+
+		j.RevertToSize(0, undo)
+		Expect(clone.PeekAt(0)).To(Equal(1))
+	})
+})
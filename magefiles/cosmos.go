@@ -212,6 +212,15 @@ func (c Cosmos) TestIntegration() error {
 	return testIntegration(c.directory() + "/testing/integration")
 }
 
+// Runs the ethereum/tests GeneralStateTests in the given directory against the Polaris EVM.
+func (c Cosmos) TestStateTests(dir string) error {
+	LogGreen("Running the Ethereum state tests for the Cosmos SDK chain.")
+	return sh.RunWithV(
+		map[string]string{"POLARIS_STATE_TESTS": dir},
+		"ginkgo", "-timeout", "2h", "-v", "./"+c.directory()+"/testing/statetests",
+	)
+}
+
 func (c Cosmos) DockerBuildHive() error {
 	LogGreen("Building hive docker image for the Cosmos SDK chain...")
 	return c.dockerBuildNode("polard-base", execDockerPath, goVersion, "test-hive", runtime.GOARCH, false)
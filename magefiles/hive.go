@@ -78,6 +78,12 @@ func (h Hive) Setup() error {
 	if err := sh.RunV("cp", "-rf", "./e2e/hive/simulators", simulatorsPath); err != nil {
 		return err
 	}
+	// The precompile simulator deploys the Solidity checks with Foundry from its own image.
+	if err := sh.RunV(
+		"cp", "-rf", "./contracts", simulatorsPath+"/precompile/contracts",
+	); err != nil {
+		return err
+	}
 
 	return ExecuteInDirectory(clonePath, func(...string) error {
 		LogGreen("Building Hive...")